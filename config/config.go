@@ -2,6 +2,7 @@ package config
 
 import (
 	"os"
+	"strconv"
 
 	"github.com/joho/godotenv"
 )
@@ -24,6 +25,27 @@ type AppConfig struct {
 		AccessKey string
 		SecretKey string
 	}
+	Search struct {
+		IndexPath string
+	}
+	Payment struct {
+		Provider      string
+		WebhookSecret string
+		FakeCheckout  bool
+	}
+	Ticket struct {
+		Secret string
+	}
+	Mail struct {
+		Host     string
+		Port     string
+		Username string
+		Password string
+		From     string
+	}
+	Reminder struct {
+		Offsets string
+	}
 }
 
 var appConfig *AppConfig
@@ -53,6 +75,14 @@ func initConfig() *AppConfig {
 		config.AwsS3.Region = ""
 		config.AwsS3.AccessKey = ""
 		config.AwsS3.SecretKey = ""
+		config.Search.IndexPath = "storage/search/events.bleve"
+		config.Payment.Provider = os.Getenv("PAYMENT_PROVIDER")
+		config.Payment.WebhookSecret = os.Getenv("PAYMENT_WEBHOOK_SECRET")
+		config.Payment.FakeCheckout, _ = strconv.ParseBool(os.Getenv("PAYMENT_FAKE_CHECKOUT"))
+		config.Ticket.Secret = os.Getenv("TICKET_SECRET")
+		config.Mail.Port = "587"
+		config.Mail.From = "no-reply@tupulung.local"
+		config.Reminder.Offsets = "24h,1h"
 
 		return &config
 	}
@@ -69,6 +99,29 @@ func initConfig() *AppConfig {
 	config.AwsS3.Region = os.Getenv("AWS_S3_REGION")
 	config.AwsS3.AccessKey = os.Getenv("AWS_S3_ACCESS_KEY")
 	config.AwsS3.SecretKey = os.Getenv("AWS_S3_SECRET_KEY")
+	config.Search.IndexPath = os.Getenv("SEARCH_INDEX_PATH")
+	if config.Search.IndexPath == "" {
+		config.Search.IndexPath = "storage/search/events.bleve"
+	}
+	config.Payment.Provider = os.Getenv("PAYMENT_PROVIDER")
+	config.Payment.WebhookSecret = os.Getenv("PAYMENT_WEBHOOK_SECRET")
+	config.Payment.FakeCheckout, _ = strconv.ParseBool(os.Getenv("PAYMENT_FAKE_CHECKOUT"))
+	config.Ticket.Secret = os.Getenv("TICKET_SECRET")
+	config.Mail.Host = os.Getenv("MAIL_HOST")
+	config.Mail.Port = os.Getenv("MAIL_PORT")
+	if config.Mail.Port == "" {
+		config.Mail.Port = "587"
+	}
+	config.Mail.Username = os.Getenv("MAIL_USERNAME")
+	config.Mail.Password = os.Getenv("MAIL_PASSWORD")
+	config.Mail.From = os.Getenv("MAIL_FROM")
+	if config.Mail.From == "" {
+		config.Mail.From = "no-reply@tupulung.local"
+	}
+	config.Reminder.Offsets = os.Getenv("REMINDER_OFFSETS")
+	if config.Reminder.Offsets == "" {
+		config.Reminder.Offsets = "24h,1h"
+	}
 
 	return &config
 }
@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+	"tupulung/config"
+	"tupulung/deliveries/helpers"
+	"tupulung/deliveries/middleware"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	announcementService "tupulung/services/announcement"
+
+	"github.com/labstack/echo/v4"
+)
+
+type AnnouncementHandler struct {
+	announcementService *announcementService.AnnouncementService
+}
+
+func NewAnnouncementHandler(announcementService *announcementService.AnnouncementService) *AnnouncementHandler {
+	return &AnnouncementHandler{
+		announcementService: announcementService,
+	}
+}
+
+/*
+ * -------------------------------------------
+ * List announcements of an event
+ * -------------------------------------------
+ */
+func (handler AnnouncementHandler) Index(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/" + c.Param("id") + "/announcements"}
+	eventID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	viewerID := middleware.ReadOptionalToken(c.Get("user"))
+
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil {
+		limit = 20
+	}
+	page, err := strconv.Atoi(c.QueryParam("page"))
+	if err != nil {
+		page = 1
+	}
+
+	announcementsRes, pagination, err := handler.announcementService.FindAll(eventID, viewerID, c.QueryParam("invite"), limit, page)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	pageURL := func(page int) string {
+		return config.Get().App.BaseURL + "/api/events/" + c.Param("id") + "/announcements?limit=" + strconv.Itoa(limit) + "&page=" + strconv.Itoa(page)
+	}
+	links["first"] = pageURL(1)
+	links["last"] = pageURL(pagination.TotalPages)
+	if pagination.Page > 1 {
+		links["prev"] = pageURL(pagination.Page - 1)
+	}
+	if pagination.Page < pagination.TotalPages {
+		links["next"] = pageURL(pagination.Page + 1)
+	}
+
+	return c.JSON(200, web.SuccessListResponse{
+		Status:     "OK",
+		Code:       200,
+		Error:      nil,
+		Links:      links,
+		Data:       announcementsRes,
+		Pagination: pagination,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Post an announcement to participants, host & editor only
+ * -------------------------------------------
+ */
+func (handler AnnouncementHandler) Create(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/" + c.Param("id") + "/announcements"}
+	eventID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	announcementReq := entities.AnnouncementRequest{}
+	c.Bind(&announcementReq)
+
+	announcementRes, err := handler.announcementService.Create(announcementReq, eventID, userID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(201, web.SuccessResponse{
+		Status: "OK",
+		Code:   201,
+		Error:  nil,
+		Links:  links,
+		Data:   announcementRes,
+	})
+}
+
+func (handler AnnouncementHandler) unauthorized(c echo.Context, links map[string]string) error {
+	return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+		Code:   http.StatusUnauthorized,
+		Status: "ERROR",
+		Error:  "unauthorized",
+		Links:  links,
+	})
+}
+
+func (handler AnnouncementHandler) errorResponse(c echo.Context, err error, links map[string]string) error {
+	if reflect.TypeOf(err).String() == "web.WebError" {
+		webErr := err.(web.WebError)
+		return c.JSON(webErr.Code, helpers.MakeErrorResponse("ERROR", webErr.Code, webErr.Error(), links))
+	} else if reflect.TypeOf(err).String() == "web.ValidationError" {
+		valErr := err.(web.ValidationError)
+		return c.JSON(valErr.Code, web.ValidationErrorResponse{
+			Status: "ERROR",
+			Code:   valErr.Code,
+			Error:  valErr.Error(),
+			Errors: valErr.Errors,
+			Links:  links,
+		})
+	}
+	return c.JSON(500, helpers.MakeErrorResponse("ERROR", 500, err.Error(), links))
+}
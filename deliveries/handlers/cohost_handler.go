@@ -0,0 +1,299 @@
+package handlers
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+	"tupulung/config"
+	"tupulung/deliveries/helpers"
+	"tupulung/deliveries/middleware"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	cohostService "tupulung/services/cohost"
+	searchProvider "tupulung/utilities/search"
+
+	"github.com/labstack/echo/v4"
+)
+
+type CohostHandler struct {
+	cohostService  *cohostService.CohostService
+	searchProvider searchProvider.SearchInterface
+}
+
+func NewCohostHandler(cohostService *cohostService.CohostService, searchProvider searchProvider.SearchInterface) *CohostHandler {
+	return &CohostHandler{
+		cohostService:  cohostService,
+		searchProvider: searchProvider,
+	}
+}
+
+/*
+ * Find All co-host
+ * -------------------------------
+ * Mengambil tim co-host sebuah event
+ */
+func (handler CohostHandler) Index(c echo.Context) error {
+
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/" + c.Param("id") + "/cohosts"}
+	eventID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID := middleware.ReadOptionalToken(c.Get("user"))
+
+	cohostsRes, err := handler.cohostService.FindAll(eventID, userID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   cohostsRes,
+	})
+}
+
+/*
+ * Find co-host invitations
+ * -------------------------------
+ * Mengambil undangan co-host milik user yang sedang login
+ */
+func (handler CohostHandler) Invitations(c echo.Context) error {
+
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/users/cohost-invitations"}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+			Code:   http.StatusUnauthorized,
+			Status: "ERROR",
+			Error:  "unauthorized",
+			Links:  links,
+		})
+	}
+
+	cohostsRes, err := handler.cohostService.FindInvitations(userID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   cohostsRes,
+	})
+}
+
+/*
+ * Invite co-host
+ * -------------------------------
+ * Mengundang user menjadi co-host event
+ */
+func (handler CohostHandler) Create(c echo.Context) error {
+
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/" + c.Param("id") + "/cohosts"}
+	eventID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+			Code:   http.StatusUnauthorized,
+			Status: "ERROR",
+			Error:  "unauthorized",
+			Links:  links,
+		})
+	}
+
+	cohostReq := entities.EventCohostRequest{}
+	c.Bind(&cohostReq)
+
+	cohostRes, err := handler.cohostService.Invite(cohostReq, eventID, userID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(201, web.SuccessResponse{
+		Status: "OK",
+		Code:   201,
+		Error:  nil,
+		Links:  links,
+		Data:   cohostRes,
+	})
+}
+
+/*
+ * Accept co-host invitation
+ * -------------------------------
+ * Menerima undangan menjadi co-host
+ */
+func (handler CohostHandler) Accept(c echo.Context) error {
+
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/cohosts/" + c.Param("cohostID") + "/accept"}
+	id, err := strconv.Atoi(c.Param("cohostID"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+			Code:   http.StatusUnauthorized,
+			Status: "ERROR",
+			Error:  "unauthorized",
+			Links:  links,
+		})
+	}
+
+	cohostRes, err := handler.cohostService.Accept(id, userID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   cohostRes,
+	})
+}
+
+/*
+ * Update co-host role
+ * -------------------------------
+ * Mengubah role co-host oleh owner event
+ */
+func (handler CohostHandler) Update(c echo.Context) error {
+
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/cohosts/" + c.Param("cohostID")}
+	id, err := strconv.Atoi(c.Param("cohostID"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+			Code:   http.StatusUnauthorized,
+			Status: "ERROR",
+			Error:  "unauthorized",
+			Links:  links,
+		})
+	}
+
+	cohostReq := entities.EventCohostRequest{}
+	c.Bind(&cohostReq)
+
+	cohostRes, err := handler.cohostService.UpdateRole(cohostReq.Role, id, userID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   cohostRes,
+	})
+}
+
+/*
+ * Remove co-host
+ * -------------------------------
+ * Menghapus co-host, menolak undangan, atau keluar dari tim
+ */
+func (handler CohostHandler) Delete(c echo.Context) error {
+
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/cohosts/" + c.Param("cohostID")}
+	id, err := strconv.Atoi(c.Param("cohostID"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+			Code:   http.StatusUnauthorized,
+			Status: "ERROR",
+			Error:  "unauthorized",
+			Links:  links,
+		})
+	}
+
+	err = handler.cohostService.Remove(id, userID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data: map[string]interface{}{
+			"id": id,
+		},
+	})
+}
+
+/*
+ * Transfer event
+ * -------------------------------
+ * Memindahkan kepemilikan event ke co-host lain
+ */
+func (handler CohostHandler) Transfer(c echo.Context) error {
+
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/" + c.Param("id") + "/transfer"}
+	eventID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+			Code:   http.StatusUnauthorized,
+			Status: "ERROR",
+			Error:  "unauthorized",
+			Links:  links,
+		})
+	}
+
+	transferReq := entities.EventTransferRequest{}
+	c.Bind(&transferReq)
+
+	err = handler.cohostService.Transfer(transferReq, eventID, userID, handler.searchProvider)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data: map[string]interface{}{
+			"id":      eventID,
+			"user_id": transferReq.UserID,
+		},
+	})
+}
+
+func (handler CohostHandler) errorResponse(c echo.Context, err error, links map[string]string) error {
+	if reflect.TypeOf(err).String() == "web.WebError" {
+		webErr := err.(web.WebError)
+		return c.JSON(webErr.Code, helpers.MakeErrorResponse("ERROR", webErr.Code, webErr.Error(), links))
+	} else if reflect.TypeOf(err).String() == "web.ValidationError" {
+		valErr := err.(web.ValidationError)
+		return c.JSON(valErr.Code, web.ValidationErrorResponse{
+			Status: "ERROR",
+			Code:   valErr.Code,
+			Error:  valErr.Error(),
+			Errors: valErr.Errors,
+			Links:  links,
+		})
+	}
+	return c.JSON(500, helpers.MakeErrorResponse("ERROR", 500, err.Error(), links))
+}
@@ -2,14 +2,17 @@ package handlers
 
 import (
 	"net/http"
+	"net/url"
 	"reflect"
 	"strconv"
+	"strings"
 	"tupulung/config"
 	"tupulung/deliveries/helpers"
 	"tupulung/deliveries/middleware"
 	"tupulung/entities"
 	"tupulung/entities/web"
 	eventService "tupulung/services/event"
+	searchProvider "tupulung/utilities/search"
 	storageProvider "tupulung/utilities/storage"
 
 	"github.com/labstack/echo/v4"
@@ -18,12 +21,14 @@ import (
 type EventHandler struct {
 	eventService    *eventService.EventService
 	storageProvider storageProvider.StorageInterface
+	searchProvider  searchProvider.SearchInterface
 }
 
-func NewEventHandler(service *eventService.EventService, storageProvider storageProvider.StorageInterface) *EventHandler {
+func NewEventHandler(service *eventService.EventService, storageProvider storageProvider.StorageInterface, searchProvider searchProvider.SearchInterface) *EventHandler {
 	return &EventHandler{
 		eventService:    service,
 		storageProvider: storageProvider,
+		searchProvider:  searchProvider,
 	}
 }
 
@@ -34,51 +39,30 @@ func NewEventHandler(service *eventService.EventService, storageProvider storage
  */
 func (handler EventHandler) Index(c echo.Context) error {
 
-	// Translate query param to map of filters
-	filters := []map[string]string{}
-	q := c.QueryParam("q")
-	if q != "" {
-		filters = append(filters, map[string]string{
-			"field":    "title",
-			"operator": "LIKE",
-			"value":    "%" + q + "%",
-		})
-	}
-	category_id := c.QueryParam("category_id")
-	if category_id != "" {
-		filters = append(filters, map[string]string{
-			"field":    "category_id",
-			"operator": "=",
-			"value":    category_id,
-		})
-	}
-	location := c.QueryParam("location")
-	if location != "" {
-		filters = append(filters, map[string]string{
-			"field":    "location",
-			"operator": "LIKE",
-			"value":    "%" + location + "%",
-		})
-	}
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events?" + c.QueryString()}
 
-	// Sort parameter
-	sorts := []map[string]interface{}{}
-	sortLocation := c.QueryParam("sortLocation")
-	if sortLocation != "" {
-		switch sortLocation {
-		case "1":
-			sorts = append(sorts, map[string]interface{}{
-				"field": "title",
-				"desc":  true,
-			})
-		case "0":
-			sorts = append(sorts, map[string]interface{}{
-				"field": "title",
-				"desc":  false,
+	// Translate query param to whitelisted filters & sorts
+	query := entities.EventListQuery{}
+	err := c.Bind(&query)
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "Query parameter format is invalid", links))
+	}
+	query.CategoryID = strings.Join(c.QueryParams()["category_id"], ",")
+	q := query.Q
+	filters, sorts, err := handler.eventService.BuildListFilters(query)
+	if err != nil {
+		if reflect.TypeOf(err).String() == "web.ValidationError" {
+			valErr := err.(web.ValidationError)
+			return c.JSON(valErr.Code, web.ValidationErrorResponse{
+				Status: "ERROR",
+				Code:   valErr.Code,
+				Error:  valErr.Error(),
+				Errors: valErr.Errors,
+				Links:  links,
 			})
 		}
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, err.Error(), links))
 	}
-	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events?limit=" + c.QueryParam("limit") + "&page=" + c.QueryParam("page")}
 
 	// pagination param
 	limit, err := strconv.Atoi(c.QueryParam("limit"))
@@ -90,10 +74,15 @@ func (handler EventHandler) Index(c echo.Context) error {
 		links := map[string]string{"self": config.Get().App.BaseURL}
 		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "page Parameter format is invalid", links))
 	}
-	links["self"] = config.Get().App.BaseURL + "/api/events?limit=" + c.QueryParam("limit") + "&page=" + c.QueryParam("page")
 
-	// Get all events
-	eventsRes, err := handler.eventService.FindAll(limit, page, filters, sorts)
+	// Get all events, ranked by relevance when searching
+	eventsRes := []entities.EventResponse{}
+	pagination := web.Pagination{}
+	if q != "" {
+		eventsRes, pagination, err = handler.eventService.Search(q, limit, page, filters, sorts, handler.searchProvider)
+	} else {
+		eventsRes, err = handler.eventService.FindAll(limit, page, filters, sorts)
+	}
 	if err != nil {
 		if reflect.TypeOf(err).String() == "web.WebError" {
 			webErr := err.(web.WebError)
@@ -103,22 +92,30 @@ func (handler EventHandler) Index(c echo.Context) error {
 	}
 
 	// Get pagination data
-	pagination, err := handler.eventService.GetPagination(limit, page, filters)
-	if err != nil {
-		if reflect.TypeOf(err).String() == "web.WebError" {
-			webErr := err.(web.WebError)
-			return c.JSON(webErr.Code, helpers.MakeErrorResponse("ERROR", webErr.Code, webErr.Error(), links))
+	if q == "" {
+		pagination, err = handler.eventService.GetPagination(limit, page, filters)
+		if err != nil {
+			if reflect.TypeOf(err).String() == "web.WebError" {
+				webErr := err.(web.WebError)
+				return c.JSON(webErr.Code, helpers.MakeErrorResponse("ERROR", webErr.Code, webErr.Error(), links))
+			}
+			panic("not returning custom error")
 		}
-		panic("not returning custom error")
 	}
 
-	links["first"] = config.Get().App.BaseURL + "/api/events?limit=" + c.QueryParam("limit") + "&page=1"
-	links["last"] = config.Get().App.BaseURL + "/api/events?limit=" + c.QueryParam("limit") + "&page=" + strconv.Itoa(pagination.TotalPages)
+	// Keep every filter on pagination links
+	pageURL := func(page int) string {
+		params := c.QueryParams()
+		params.Set("page", strconv.Itoa(page))
+		return config.Get().App.BaseURL + "/api/events?" + params.Encode()
+	}
+	links["first"] = pageURL(1)
+	links["last"] = pageURL(pagination.TotalPages)
 	if pagination.Page > 1 {
-		links["prev"] = config.Get().App.BaseURL + "/api/events?limit=" + c.QueryParam("limit") + "&page=" + strconv.Itoa(pagination.Page-1)
+		links["prev"] = pageURL(pagination.Page - 1)
 	}
 	if pagination.Page < pagination.TotalPages {
-		links["next"] = config.Get().App.BaseURL + "/api/events?limit=" + c.QueryParam("limit") + "&page=" + strconv.Itoa(pagination.Page+1)
+		links["next"] = pageURL(pagination.Page + 1)
 	}
 
 	// success response
@@ -132,6 +129,38 @@ func (handler EventHandler) Index(c echo.Context) error {
 	})
 }
 
+/*
+ * -------------------------------------------
+ * Autocomplete event title while typing
+ * -------------------------------------------
+ */
+func (handler EventHandler) Autocomplete(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/autocomplete?q=" + c.QueryParam("q")}
+
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil {
+		limit = 10
+	}
+
+	suggestions, err := handler.eventService.Suggest(c.QueryParam("q"), limit, handler.searchProvider)
+	if err != nil {
+		if reflect.TypeOf(err).String() == "web.WebError" {
+			webErr := err.(web.WebError)
+			return c.JSON(webErr.Code, helpers.MakeErrorResponse("ERROR", webErr.Code, webErr.Error(), links))
+		}
+		return c.JSON(500, helpers.MakeErrorResponse("ERROR", 500, err.Error(), links))
+	}
+
+	// response
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   suggestions,
+	})
+}
+
 /*
  * -------------------------------------------
  * Show single event detail by ID
@@ -145,14 +174,23 @@ func (handler EventHandler) Show(c echo.Context) error {
 		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, err.Error(), links))
 	}
 
-	// Get eventdata
-	event, err := handler.eventService.Find(id)
+	// Get eventdata, private event requires invitation
+	viewerID := middleware.ReadOptionalToken(c.Get("user"))
+	event, err := handler.eventService.FindForViewer(id, viewerID, c.QueryParam("invite"))
 	if err != nil {
 		if reflect.TypeOf(err).String() == "web.WebError" {
 			webErr := err.(web.WebError)
 			return c.JSON(webErr.Code, helpers.MakeErrorResponse("ERROR", webErr.Code, webErr.Error(), links))
 		}
+		return c.JSON(500, helpers.MakeErrorResponse("ERROR", 500, err.Error(), links))
+	}
+
+	// Count the view for trending score, failure must not break the response
+	err = handler.eventService.RecordView(id, viewerID, c.RealIP())
+	if err != nil {
+		c.Logger().Warn("Cannot record view of event " + c.Param("id") + ": " + err.Error())
 	}
+
 	// response
 	return c.JSON(200, web.SuccessResponse{
 		Status: "OK",
@@ -163,6 +201,47 @@ func (handler EventHandler) Show(c echo.Context) error {
 	})
 }
 
+/*
+ * -------------------------------------------
+ * Get single event based on slug
+ * -------------------------------------------
+ * Slug lama diarahkan secara permanen ke slug terbaru
+ */
+func (handler EventHandler) ShowBySlug(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/by-slug/" + c.Param("slug")}
+
+	viewerID := middleware.ReadOptionalToken(c.Get("user"))
+	event, current, err := handler.eventService.FindBySlug(c.Param("slug"), viewerID, c.QueryParam("invite"))
+	if err != nil {
+		if reflect.TypeOf(err).String() == "web.WebError" {
+			webErr := err.(web.WebError)
+			return c.JSON(webErr.Code, helpers.MakeErrorResponse("ERROR", webErr.Code, webErr.Error(), links))
+		}
+		return c.JSON(500, helpers.MakeErrorResponse("ERROR", 500, err.Error(), links))
+	}
+	if current != "" {
+		location := config.Get().App.BaseURL + "/api/events/by-slug/" + url.PathEscape(current)
+		if c.QueryString() != "" {
+			location += "?" + c.QueryString()
+		}
+		return c.Redirect(http.StatusMovedPermanently, location)
+	}
+
+	// Count the view for trending score, failure must not break the response
+	err = handler.eventService.RecordView(int(event.ID), viewerID, c.RealIP())
+	if err != nil {
+		c.Logger().Warn("Cannot record view of event " + event.Slug + ": " + err.Error())
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   event,
+	})
+}
+
 /*
  * -------------------------------------------
  * Get All user's events based on available queries
@@ -221,6 +300,15 @@ func (handler EventHandler) GetUserEvent(c echo.Context) error {
 		"value":    strconv.Itoa(userID),
 	})
 
+	// Unlisted & private events are only listed to their owner
+	if middleware.ReadOptionalToken(c.Get("user")) != userID {
+		filters = append(filters, map[string]string{
+			"field":    "visibility",
+			"operator": "=",
+			"value":    "public",
+		})
+	}
+
 	// Get all events
 	eventsRes, err := handler.eventService.FindAll(limit, page, filters, sorts)
 	if err != nil {
@@ -280,7 +368,7 @@ func (handler EventHandler) Create(c echo.Context) error {
 	cover, _ := c.FormFile("cover")
 
 	// Insert event
-	eventRes, err := handler.eventService.Create(eventReq, userID, cover, handler.storageProvider)
+	eventRes, err := handler.eventService.Create(eventReq, userID, cover, handler.storageProvider, handler.searchProvider)
 	if err != nil {
 		if reflect.TypeOf(err).String() == "web.WebError" {
 			webErr := err.(web.WebError)
@@ -338,7 +426,7 @@ func (handler EventHandler) Update(c echo.Context) error {
 	cover, _ := c.FormFile("cover")
 
 	// Product service call
-	eventRes, err := handler.eventService.Update(eventReq, id, userID, cover, handler.storageProvider)
+	eventRes, err := handler.eventService.Update(eventReq, id, userID, cover, handler.storageProvider, handler.searchProvider)
 	if err != nil {
 		if reflect.TypeOf(err).String() == "web.WebError" {
 			webErr := err.(web.WebError)
@@ -392,7 +480,7 @@ func (handler EventHandler) Delete(c echo.Context) error {
 	}
 
 	// call delete on event service
-	err = handler.eventService.Delete(id, userID, handler.storageProvider)
+	err = handler.eventService.Delete(id, userID, handler.storageProvider, handler.searchProvider)
 	if err != nil {
 		if reflect.TypeOf(err).String() == "web.WebError" {
 			webErr := err.(web.WebError)
@@ -411,3 +499,183 @@ func (handler EventHandler) Delete(c echo.Context) error {
 		},
 	})
 }
+
+/*
+ * -------------------------------------------
+ * Duplicate an event with a new date
+ * -------------------------------------------
+ */
+func (handler EventHandler) Duplicate(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/" + c.Param("id") + "/duplicate"}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+			Code:   http.StatusUnauthorized,
+			Status: "ERROR",
+			Error:  "unauthorized",
+			Links:  links,
+		})
+	}
+
+	duplicateReq := entities.EventDuplicateRequest{}
+	c.Bind(&duplicateReq)
+	cover, _ := c.FormFile("cover")
+
+	eventRes, err := handler.eventService.Duplicate(duplicateReq, id, userID, cover, handler.storageProvider, handler.searchProvider)
+	if err != nil {
+		if reflect.TypeOf(err).String() == "web.WebError" {
+			webErr := err.(web.WebError)
+			return c.JSON(webErr.Code, helpers.MakeErrorResponse("ERROR", webErr.Code, webErr.Error(), links))
+		} else if reflect.TypeOf(err).String() == "web.ValidationError" {
+			valErr := err.(web.ValidationError)
+			return c.JSON(valErr.Code, web.ValidationErrorResponse{
+				Status: "ERROR",
+				Code:   valErr.Code,
+				Error:  valErr.Error(),
+				Errors: valErr.Errors,
+				Links:  links,
+			})
+		}
+		return c.JSON(500, helpers.MakeErrorResponse("ERROR", 500, err.Error(), links))
+	}
+
+	return c.JSON(201, web.SuccessResponse{
+		Status: "OK",
+		Code:   201,
+		Error:  nil,
+		Links:  links,
+		Data:   eventRes,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Create an event prefilled from a template
+ * -------------------------------------------
+ */
+func (handler EventHandler) CreateFromTemplate(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/templates/" + c.Param("id") + "/events"}
+	templateID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+			Code:   http.StatusUnauthorized,
+			Status: "ERROR",
+			Error:  "unauthorized",
+			Links:  links,
+		})
+	}
+
+	eventReq := entities.EventRequest{}
+	c.Bind(&eventReq)
+	cover, _ := c.FormFile("cover")
+
+	eventRes, err := handler.eventService.CreateFromTemplate(eventReq, templateID, userID, cover, handler.storageProvider, handler.searchProvider)
+	if err != nil {
+		if reflect.TypeOf(err).String() == "web.WebError" {
+			webErr := err.(web.WebError)
+			return c.JSON(webErr.Code, helpers.MakeErrorResponse("ERROR", webErr.Code, webErr.Error(), links))
+		} else if reflect.TypeOf(err).String() == "web.ValidationError" {
+			valErr := err.(web.ValidationError)
+			return c.JSON(valErr.Code, web.ValidationErrorResponse{
+				Status: "ERROR",
+				Code:   valErr.Code,
+				Error:  valErr.Error(),
+				Errors: valErr.Errors,
+				Links:  links,
+			})
+		}
+		return c.JSON(500, helpers.MakeErrorResponse("ERROR", 500, err.Error(), links))
+	}
+
+	return c.JSON(201, web.SuccessResponse{
+		Status: "OK",
+		Code:   201,
+		Error:  nil,
+		Links:  links,
+		Data:   eventRes,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Trending upcoming events, optionally per category
+ * -------------------------------------------
+ */
+func (handler EventHandler) Trending(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/trending?" + c.QueryString()}
+
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil || limit <= 0 {
+		limit = 10
+	}
+	page, err := strconv.Atoi(c.QueryParam("page"))
+	if err != nil || page <= 0 {
+		page = 1
+	}
+	categoryID := strings.Join(c.QueryParams()["category_id"], ",")
+
+	eventsRes, pagination, err := handler.eventService.Trending(categoryID, limit, page)
+	if err != nil {
+		if reflect.TypeOf(err).String() == "web.WebError" {
+			webErr := err.(web.WebError)
+			return c.JSON(webErr.Code, helpers.MakeErrorResponse("ERROR", webErr.Code, webErr.Error(), links))
+		}
+		return c.JSON(500, helpers.MakeErrorResponse("ERROR", 500, err.Error(), links))
+	}
+
+	return c.JSON(200, web.SuccessListResponse{
+		Status:     "OK",
+		Code:       200,
+		Error:      nil,
+		Links:      links,
+		Data:       eventsRes,
+		Pagination: pagination,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Recommended upcoming events for authenticated user
+ * -------------------------------------------
+ */
+func (handler EventHandler) Recommended(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/recommended"}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+			Code:   http.StatusUnauthorized,
+			Status: "ERROR",
+			Error:  "unauthorized",
+			Links:  links,
+		})
+	}
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil || limit <= 0 || limit > 50 {
+		limit = 10
+	}
+
+	eventsRes, err := handler.eventService.Recommended(userID, limit)
+	if err != nil {
+		if reflect.TypeOf(err).String() == "web.WebError" {
+			webErr := err.(web.WebError)
+			return c.JSON(webErr.Code, helpers.MakeErrorResponse("ERROR", webErr.Code, webErr.Error(), links))
+		}
+		return c.JSON(500, helpers.MakeErrorResponse("ERROR", 500, err.Error(), links))
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   eventsRes,
+	})
+}
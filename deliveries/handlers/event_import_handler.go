@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+	"tupulung/config"
+	"tupulung/deliveries/helpers"
+	"tupulung/deliveries/middleware"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	eventImportService "tupulung/services/eventimport"
+
+	"github.com/labstack/echo/v4"
+)
+
+type EventImportHandler struct {
+	eventImportService *eventImportService.EventImportService
+}
+
+func NewEventImportHandler(eventImportService *eventImportService.EventImportService) *EventImportHandler {
+	return &EventImportHandler{
+		eventImportService: eventImportService,
+	}
+}
+
+/*
+ * -------------------------------------------
+ * Import events from a CSV or ICS file
+ * dry_run=true only validates every row
+ * -------------------------------------------
+ */
+func (handler EventImportHandler) Create(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/import"}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	importReq := entities.EventImportRequest{}
+	c.Bind(&importReq)
+	file, _ := c.FormFile("file")
+
+	if importReq.DryRun {
+		reportRes, err := handler.eventImportService.DryRun(importReq, file)
+		if err != nil {
+			return handler.errorResponse(c, err, links)
+		}
+		return c.JSON(200, web.SuccessResponse{
+			Status: "OK",
+			Code:   200,
+			Error:  nil,
+			Links:  links,
+			Data:   reportRes,
+		})
+	}
+
+	importRes, err := handler.eventImportService.Create(importReq, file, userID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+	links["progress"] = config.Get().App.BaseURL + "/api/events/imports/" + strconv.Itoa(int(importRes.ID))
+
+	return c.JSON(http.StatusAccepted, web.SuccessResponse{
+		Status: "OK",
+		Code:   http.StatusAccepted,
+		Error:  nil,
+		Links:  links,
+		Data:   importRes,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Progress and row errors of an import
+ * -------------------------------------------
+ */
+func (handler EventImportHandler) Show(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/imports/" + c.Param("id")}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	importRes, err := handler.eventImportService.Find(id, userID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   importRes,
+	})
+}
+
+func (handler EventImportHandler) unauthorized(c echo.Context, links map[string]string) error {
+	return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+		Code:   http.StatusUnauthorized,
+		Status: "ERROR",
+		Error:  "unauthorized",
+		Links:  links,
+	})
+}
+
+func (handler EventImportHandler) errorResponse(c echo.Context, err error, links map[string]string) error {
+	if reflect.TypeOf(err).String() == "web.WebError" {
+		webErr := err.(web.WebError)
+		return c.JSON(webErr.Code, helpers.MakeErrorResponse("ERROR", webErr.Code, webErr.Error(), links))
+	} else if reflect.TypeOf(err).String() == "web.ValidationError" {
+		valErr := err.(web.ValidationError)
+		return c.JSON(valErr.Code, web.ValidationErrorResponse{
+			Status: "ERROR",
+			Code:   valErr.Code,
+			Error:  valErr.Error(),
+			Errors: valErr.Errors,
+			Links:  links,
+		})
+	}
+	return c.JSON(500, helpers.MakeErrorResponse("ERROR", 500, err.Error(), links))
+}
@@ -0,0 +1,268 @@
+package handlers
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+	"tupulung/config"
+	"tupulung/deliveries/helpers"
+	"tupulung/deliveries/middleware"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	galleryService "tupulung/services/gallery"
+	storageProvider "tupulung/utilities/storage"
+
+	"github.com/labstack/echo/v4"
+)
+
+type GalleryHandler struct {
+	galleryService  *galleryService.GalleryService
+	storageProvider storageProvider.StorageInterface
+}
+
+func NewGalleryHandler(galleryService *galleryService.GalleryService, storageProvider storageProvider.StorageInterface) *GalleryHandler {
+	return &GalleryHandler{
+		galleryService:  galleryService,
+		storageProvider: storageProvider,
+	}
+}
+
+/*
+ * Find All gallery images
+ * -------------------------------
+ * Mengambil gallery sebuah event sesuai urutan
+ */
+func (handler GalleryHandler) Index(c echo.Context) error {
+
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/" + c.Param("id") + "/images"}
+	eventID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID := middleware.ReadOptionalToken(c.Get("user"))
+
+	imagesRes, err := handler.galleryService.FindAll(eventID, userID, c.QueryParam("invite"))
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   imagesRes,
+	})
+}
+
+/*
+ * Upload gallery images
+ * -------------------------------
+ * Mengupload satu atau beberapa gambar melalui field "image"
+ */
+func (handler GalleryHandler) Create(c echo.Context) error {
+
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/" + c.Param("id") + "/images"}
+	eventID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+			Code:   http.StatusUnauthorized,
+			Status: "ERROR",
+			Error:  "unauthorized",
+			Links:  links,
+		})
+	}
+
+	imageReq := entities.EventImageRequest{}
+	c.Bind(&imageReq)
+	form, err := c.MultipartForm()
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "request must be a multipart form", links))
+	}
+
+	imagesRes, err := handler.galleryService.Upload(imageReq, eventID, userID, form.File["image"], handler.storageProvider)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(201, web.SuccessResponse{
+		Status: "OK",
+		Code:   201,
+		Error:  nil,
+		Links:  links,
+		Data:   imagesRes,
+	})
+}
+
+/*
+ * Reorder gallery images
+ * -------------------------------
+ * Menyimpan urutan gallery berdasarkan daftar ID gambar
+ */
+func (handler GalleryHandler) Reorder(c echo.Context) error {
+
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/" + c.Param("id") + "/images/order"}
+	eventID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+			Code:   http.StatusUnauthorized,
+			Status: "ERROR",
+			Error:  "unauthorized",
+			Links:  links,
+		})
+	}
+
+	orderReq := entities.EventImageOrderRequest{}
+	c.Bind(&orderReq)
+
+	imagesRes, err := handler.galleryService.Reorder(orderReq, eventID, userID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   imagesRes,
+	})
+}
+
+/*
+ * Update gallery image
+ * -------------------------------
+ * Mengubah caption gambar
+ */
+func (handler GalleryHandler) Update(c echo.Context) error {
+
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/images/" + c.Param("imageID")}
+	id, err := strconv.Atoi(c.Param("imageID"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+			Code:   http.StatusUnauthorized,
+			Status: "ERROR",
+			Error:  "unauthorized",
+			Links:  links,
+		})
+	}
+
+	imageReq := entities.EventImageRequest{}
+	c.Bind(&imageReq)
+
+	imageRes, err := handler.galleryService.Update(imageReq, id, userID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   imageRes,
+	})
+}
+
+/*
+ * Set gallery image as cover
+ * -------------------------------
+ * Menjadikan gambar gallery sebagai cover event
+ */
+func (handler GalleryHandler) SetCover(c echo.Context) error {
+
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/images/" + c.Param("imageID") + "/cover"}
+	id, err := strconv.Atoi(c.Param("imageID"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+			Code:   http.StatusUnauthorized,
+			Status: "ERROR",
+			Error:  "unauthorized",
+			Links:  links,
+		})
+	}
+
+	imageRes, err := handler.galleryService.SetCover(id, userID, handler.storageProvider)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   imageRes,
+	})
+}
+
+/*
+ * Delete gallery image
+ * -------------------------------
+ * Menghapus gambar dari gallery dan storage
+ */
+func (handler GalleryHandler) Delete(c echo.Context) error {
+
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/images/" + c.Param("imageID")}
+	id, err := strconv.Atoi(c.Param("imageID"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+			Code:   http.StatusUnauthorized,
+			Status: "ERROR",
+			Error:  "unauthorized",
+			Links:  links,
+		})
+	}
+
+	err = handler.galleryService.Delete(id, userID, handler.storageProvider)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data: map[string]interface{}{
+			"id": id,
+		},
+	})
+}
+
+func (handler GalleryHandler) errorResponse(c echo.Context, err error, links map[string]string) error {
+	if reflect.TypeOf(err).String() == "web.WebError" {
+		webErr := err.(web.WebError)
+		return c.JSON(webErr.Code, helpers.MakeErrorResponse("ERROR", webErr.Code, webErr.Error(), links))
+	} else if reflect.TypeOf(err).String() == "web.ValidationError" {
+		valErr := err.(web.ValidationError)
+		return c.JSON(valErr.Code, web.ValidationErrorResponse{
+			Status: "ERROR",
+			Code:   valErr.Code,
+			Error:  valErr.Error(),
+			Errors: valErr.Errors,
+			Links:  links,
+		})
+	}
+	return c.JSON(500, helpers.MakeErrorResponse("ERROR", 500, err.Error(), links))
+}
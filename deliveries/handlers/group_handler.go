@@ -0,0 +1,475 @@
+package handlers
+
+import (
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"tupulung/config"
+	"tupulung/deliveries/helpers"
+	"tupulung/deliveries/middleware"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	groupService "tupulung/services/group"
+	searchProvider "tupulung/utilities/search"
+	storageProvider "tupulung/utilities/storage"
+
+	"github.com/labstack/echo/v4"
+)
+
+type GroupHandler struct {
+	groupService    *groupService.GroupService
+	storageProvider storageProvider.StorageInterface
+	searchProvider  searchProvider.SearchInterface
+}
+
+func NewGroupHandler(groupService *groupService.GroupService, storageProvider storageProvider.StorageInterface, searchProvider searchProvider.SearchInterface) *GroupHandler {
+	return &GroupHandler{
+		groupService:    groupService,
+		storageProvider: storageProvider,
+		searchProvider:  searchProvider,
+	}
+}
+
+/*
+ * -------------------------------------------
+ * List groups, optionally filtered by name
+ * -------------------------------------------
+ */
+func (handler GroupHandler) Index(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/groups"}
+	keyword := c.QueryParam("q")
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil {
+		limit = 20
+	}
+	page, err := strconv.Atoi(c.QueryParam("page"))
+	if err != nil {
+		page = 1
+	}
+
+	groupsRes, pagination, err := handler.groupService.FindAll(keyword, limit, page)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	pageURL := func(page int) string {
+		return config.Get().App.BaseURL + "/api/groups?q=" + url.QueryEscape(keyword) + "&limit=" + strconv.Itoa(limit) + "&page=" + strconv.Itoa(page)
+	}
+	links["first"] = pageURL(1)
+	links["last"] = pageURL(pagination.TotalPages)
+	if pagination.Page > 1 {
+		links["prev"] = pageURL(pagination.Page - 1)
+	}
+	if pagination.Page < pagination.TotalPages {
+		links["next"] = pageURL(pagination.Page + 1)
+	}
+
+	return c.JSON(200, web.SuccessListResponse{
+		Status:     "OK",
+		Code:       200,
+		Error:      nil,
+		Links:      links,
+		Data:       groupsRes,
+		Pagination: pagination,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Show a group profile
+ * -------------------------------------------
+ */
+func (handler GroupHandler) Show(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/groups/" + c.Param("id")}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	viewerID := middleware.ReadOptionalToken(c.Get("user"))
+
+	groupRes, err := handler.groupService.Find(id, viewerID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+	links["events"] = config.Get().App.BaseURL + "/api/events?group_id=" + c.Param("id")
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   groupRes,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Create a group, the creator becomes organizer
+ * -------------------------------------------
+ */
+func (handler GroupHandler) Create(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/groups"}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	groupReq := entities.GroupRequest{}
+	c.Bind(&groupReq)
+	cover, _ := c.FormFile("cover")
+
+	groupRes, err := handler.groupService.Create(groupReq, userID, cover, handler.storageProvider)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(201, web.SuccessResponse{
+		Status: "OK",
+		Code:   201,
+		Error:  nil,
+		Links:  links,
+		Data:   groupRes,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Update a group profile, organizers only
+ * -------------------------------------------
+ */
+func (handler GroupHandler) Update(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/groups/" + c.Param("id")}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	groupReq := entities.GroupRequest{}
+	c.Bind(&groupReq)
+	cover, _ := c.FormFile("cover")
+
+	groupRes, err := handler.groupService.Update(groupReq, id, userID, cover, handler.storageProvider, handler.searchProvider)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   groupRes,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Delete a group, organizers only
+ * -------------------------------------------
+ */
+func (handler GroupHandler) Delete(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/groups/" + c.Param("id")}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	err = handler.groupService.Delete(id, userID, handler.storageProvider)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   map[string]interface{}{"id": id},
+	})
+}
+
+/*
+ * -------------------------------------------
+ * List group members, status=pending lists
+ * join requests for organizers
+ * -------------------------------------------
+ */
+func (handler GroupHandler) Members(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/groups/" + c.Param("id") + "/members"}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	viewerID := middleware.ReadOptionalToken(c.Get("user"))
+	status := c.QueryParam("status")
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil {
+		limit = 20
+	}
+	page, err := strconv.Atoi(c.QueryParam("page"))
+	if err != nil {
+		page = 1
+	}
+
+	membersRes, pagination, err := handler.groupService.Members(id, viewerID, status, limit, page)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	pageURL := func(page int) string {
+		return config.Get().App.BaseURL + "/api/groups/" + c.Param("id") + "/members?status=" + url.QueryEscape(status) + "&limit=" + strconv.Itoa(limit) + "&page=" + strconv.Itoa(page)
+	}
+	links["first"] = pageURL(1)
+	links["last"] = pageURL(pagination.TotalPages)
+	if pagination.Page > 1 {
+		links["prev"] = pageURL(pagination.Page - 1)
+	}
+	if pagination.Page < pagination.TotalPages {
+		links["next"] = pageURL(pagination.Page + 1)
+	}
+
+	return c.JSON(200, web.SuccessListResponse{
+		Status:     "OK",
+		Code:       200,
+		Error:      nil,
+		Links:      links,
+		Data:       membersRes,
+		Pagination: pagination,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Join a group or request to join
+ * -------------------------------------------
+ */
+func (handler GroupHandler) Join(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/groups/" + c.Param("id") + "/join"}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	memberRes, err := handler.groupService.Join(id, userID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(201, web.SuccessResponse{
+		Status: "OK",
+		Code:   201,
+		Error:  nil,
+		Links:  links,
+		Data:   memberRes,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Leave a group or cancel a join request
+ * -------------------------------------------
+ */
+func (handler GroupHandler) Leave(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/groups/" + c.Param("id") + "/leave"}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	err = handler.groupService.Leave(id, userID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   map[string]interface{}{"group_id": id},
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Approve a join request, organizers only
+ * -------------------------------------------
+ */
+func (handler GroupHandler) Approve(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/groups/" + c.Param("id") + "/members/" + c.Param("userID") + "/approve"}
+	id, memberID, err := handler.memberParams(c)
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	memberRes, err := handler.groupService.Approve(id, userID, memberID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   memberRes,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Reject a join request or remove a member,
+ * organizers only
+ * -------------------------------------------
+ */
+func (handler GroupHandler) RemoveMember(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/groups/" + c.Param("id") + "/members/" + c.Param("userID")}
+	id, memberID, err := handler.memberParams(c)
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	err = handler.groupService.RemoveMember(id, userID, memberID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   map[string]interface{}{"group_id": id, "user_id": memberID},
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Change the role of a member, organizers only
+ * -------------------------------------------
+ */
+func (handler GroupHandler) SetRole(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/groups/" + c.Param("id") + "/members/" + c.Param("userID") + "/role"}
+	id, memberID, err := handler.memberParams(c)
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	roleReq := entities.GroupMemberRoleRequest{}
+	c.Bind(&roleReq)
+
+	memberRes, err := handler.groupService.SetRole(roleReq, id, userID, memberID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   memberRes,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Host an event on behalf of a group,
+ * organizers only
+ * -------------------------------------------
+ */
+func (handler GroupHandler) CreateEvent(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/groups/" + c.Param("id") + "/events"}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	eventReq := entities.EventRequest{}
+	c.Bind(&eventReq)
+	cover, _ := c.FormFile("cover")
+
+	eventRes, err := handler.groupService.CreateEvent(eventReq, id, userID, cover, handler.storageProvider, handler.searchProvider)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(201, web.SuccessResponse{
+		Status: "OK",
+		Code:   201,
+		Error:  nil,
+		Links:  links,
+		Data:   eventRes,
+	})
+}
+
+func (handler GroupHandler) memberParams(c echo.Context) (int, int, error) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return 0, 0, err
+	}
+	memberID, err := strconv.Atoi(c.Param("userID"))
+	if err != nil {
+		return 0, 0, err
+	}
+	return id, memberID, nil
+}
+
+func (handler GroupHandler) unauthorized(c echo.Context, links map[string]string) error {
+	return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+		Code:   http.StatusUnauthorized,
+		Status: "ERROR",
+		Error:  "unauthorized",
+		Links:  links,
+	})
+}
+
+func (handler GroupHandler) errorResponse(c echo.Context, err error, links map[string]string) error {
+	if reflect.TypeOf(err).String() == "web.WebError" {
+		webErr := err.(web.WebError)
+		return c.JSON(webErr.Code, helpers.MakeErrorResponse("ERROR", webErr.Code, webErr.Error(), links))
+	} else if reflect.TypeOf(err).String() == "web.ValidationError" {
+		valErr := err.(web.ValidationError)
+		return c.JSON(valErr.Code, web.ValidationErrorResponse{
+			Status: "ERROR",
+			Code:   valErr.Code,
+			Error:  valErr.Error(),
+			Errors: valErr.Errors,
+			Links:  links,
+		})
+	}
+	return c.JSON(500, helpers.MakeErrorResponse("ERROR", 500, err.Error(), links))
+}
@@ -0,0 +1,160 @@
+package handlers
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+	"tupulung/config"
+	"tupulung/deliveries/helpers"
+	"tupulung/deliveries/middleware"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	inviteService "tupulung/services/invite"
+
+	"github.com/labstack/echo/v4"
+)
+
+type InviteHandler struct {
+	inviteService *inviteService.InviteService
+}
+
+func NewInviteHandler(inviteService *inviteService.InviteService) *InviteHandler {
+	return &InviteHandler{
+		inviteService: inviteService,
+	}
+}
+
+/*
+ * Find All invite
+ * -------------------------------
+ * Mengambil semua invite milik event, hanya untuk host
+ */
+func (handler InviteHandler) Index(c echo.Context) error {
+
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/" + c.Param("id") + "/invites"}
+	eventID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	token := c.Get("user")
+	userID, err := middleware.ReadToken(token)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+			Code:   http.StatusUnauthorized,
+			Status: "ERROR",
+			Error:  "unauthorized",
+			Links:  links,
+		})
+	}
+
+	invitesRes, err := handler.inviteService.FindAll(eventID, userID)
+	if err != nil {
+		if reflect.TypeOf(err).String() == "web.WebError" {
+			webErr := err.(web.WebError)
+			return c.JSON(webErr.Code, helpers.MakeErrorResponse("ERROR", webErr.Code, webErr.Error(), links))
+		}
+		return c.JSON(500, helpers.MakeErrorResponse("ERROR", 500, err.Error(), links))
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   invitesRes,
+	})
+}
+
+/*
+ * Create invite
+ * -------------------------------
+ * Membuat link / kode invite baru untuk event
+ */
+func (handler InviteHandler) Create(c echo.Context) error {
+
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/" + c.Param("id") + "/invites"}
+	eventID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	token := c.Get("user")
+	userID, err := middleware.ReadToken(token)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+			Code:   http.StatusUnauthorized,
+			Status: "ERROR",
+			Error:  "unauthorized",
+			Links:  links,
+		})
+	}
+
+	inviteReq := entities.EventInviteRequest{}
+	c.Bind(&inviteReq)
+
+	inviteRes, err := handler.inviteService.Create(inviteReq, eventID, userID)
+	if err != nil {
+		if reflect.TypeOf(err).String() == "web.WebError" {
+			webErr := err.(web.WebError)
+			return c.JSON(webErr.Code, helpers.MakeErrorResponse("ERROR", webErr.Code, webErr.Error(), links))
+		} else if reflect.TypeOf(err).String() == "web.ValidationError" {
+			valErr := err.(web.ValidationError)
+			return c.JSON(valErr.Code, web.ValidationErrorResponse{
+				Status: "ERROR",
+				Code:   valErr.Code,
+				Error:  valErr.Error(),
+				Errors: valErr.Errors,
+				Links:  links,
+			})
+		}
+		return c.JSON(500, helpers.MakeErrorResponse("ERROR", 500, err.Error(), links))
+	}
+
+	return c.JSON(201, web.SuccessResponse{
+		Status: "OK",
+		Code:   201,
+		Error:  nil,
+		Links:  links,
+		Data:   inviteRes,
+	})
+}
+
+/*
+ * Revoke invite
+ * -------------------------------
+ * Mencabut invite sehingga tidak dapat digunakan lagi
+ */
+func (handler InviteHandler) Revoke(c echo.Context) error {
+
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/invites/" + c.Param("inviteID")}
+	id, err := strconv.Atoi(c.Param("inviteID"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	token := c.Get("user")
+	userID, err := middleware.ReadToken(token)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+			Code:   http.StatusUnauthorized,
+			Status: "ERROR",
+			Error:  "unauthorized",
+			Links:  links,
+		})
+	}
+
+	inviteRes, err := handler.inviteService.Revoke(id, userID)
+	if err != nil {
+		if reflect.TypeOf(err).String() == "web.WebError" {
+			webErr := err.(web.WebError)
+			return c.JSON(webErr.Code, helpers.MakeErrorResponse("ERROR", webErr.Code, webErr.Error(), links))
+		}
+		return c.JSON(500, helpers.MakeErrorResponse("ERROR", 500, err.Error(), links))
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   inviteRes,
+	})
+}
@@ -0,0 +1,268 @@
+package handlers
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+	"tupulung/config"
+	"tupulung/deliveries/helpers"
+	"tupulung/deliveries/middleware"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	moderationService "tupulung/services/moderation"
+	searchProvider "tupulung/utilities/search"
+	storageProvider "tupulung/utilities/storage"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ModerationHandler struct {
+	moderationService *moderationService.ModerationService
+	storageProvider   storageProvider.StorageInterface
+	searchProvider    searchProvider.SearchInterface
+}
+
+func NewModerationHandler(moderationService *moderationService.ModerationService, storageProvider storageProvider.StorageInterface, searchProvider searchProvider.SearchInterface) *ModerationHandler {
+	return &ModerationHandler{
+		moderationService: moderationService,
+		storageProvider:   storageProvider,
+		searchProvider:    searchProvider,
+	}
+}
+
+/*
+ * -------------------------------------------
+ * Report an event
+ * -------------------------------------------
+ */
+func (handler ModerationHandler) ReportEvent(c echo.Context) error {
+	return handler.report(c, moderationService.TargetEvent, "id", "/api/events/"+c.Param("id")+"/reports")
+}
+
+/*
+ * -------------------------------------------
+ * Report a comment
+ * -------------------------------------------
+ */
+func (handler ModerationHandler) ReportComment(c echo.Context) error {
+	return handler.report(c, moderationService.TargetComment, "commentID", "/api/events/comments/"+c.Param("commentID")+"/reports")
+}
+
+/*
+ * -------------------------------------------
+ * Report a user
+ * -------------------------------------------
+ */
+func (handler ModerationHandler) ReportUser(c echo.Context) error {
+	return handler.report(c, moderationService.TargetUser, "id", "/api/users/"+c.Param("id")+"/reports")
+}
+
+func (handler ModerationHandler) report(c echo.Context, targetType string, param string, path string) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + path}
+	targetID, err := strconv.Atoi(c.Param(param))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	reportReq := entities.ReportRequest{}
+	c.Bind(&reportReq)
+
+	reportRes, err := handler.moderationService.Report(targetType, targetID, userID, reportReq)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(201, web.SuccessResponse{
+		Status: "OK",
+		Code:   201,
+		Error:  nil,
+		Links:  links,
+		Data:   reportRes,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Moderator report queue
+ * -------------------------------------------
+ */
+func (handler ModerationHandler) Index(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/moderation/reports"}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil {
+		limit = 20
+	}
+	page, err := strconv.Atoi(c.QueryParam("page"))
+	if err != nil {
+		page = 1
+	}
+	status := c.QueryParam("status")
+	if status == "" {
+		status = moderationService.StatusOpen
+	} else if status == "all" {
+		status = ""
+	}
+
+	reportsRes, pagination, err := handler.moderationService.FindAll(userID, status, c.QueryParam("target_type"), limit, page)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	// Keep every filter on pagination links
+	pageURL := func(page int) string {
+		params := c.QueryParams()
+		params.Set("page", strconv.Itoa(page))
+		return config.Get().App.BaseURL + "/api/moderation/reports?" + params.Encode()
+	}
+	links["first"] = pageURL(1)
+	links["last"] = pageURL(pagination.TotalPages)
+	if pagination.Page > 1 {
+		links["prev"] = pageURL(pagination.Page - 1)
+	}
+	if pagination.Page < pagination.TotalPages {
+		links["next"] = pageURL(pagination.Page + 1)
+	}
+
+	return c.JSON(200, web.SuccessListResponse{
+		Status:     "OK",
+		Code:       200,
+		Error:      nil,
+		Links:      links,
+		Data:       reportsRes,
+		Pagination: pagination,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Moderator report detail
+ * -------------------------------------------
+ */
+func (handler ModerationHandler) Show(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/moderation/reports/" + c.Param("id")}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	reportRes, err := handler.moderationService.Find(userID, id)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   reportRes,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Act on a report: dismiss, hide, delete, warn or suspend
+ * -------------------------------------------
+ */
+func (handler ModerationHandler) Act(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/moderation/reports/" + c.Param("id")}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	actionReq := entities.ModerationActionRequest{}
+	c.Bind(&actionReq)
+
+	reportRes, err := handler.moderationService.Act(userID, id, actionReq, handler.storageProvider, handler.searchProvider)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   reportRes,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Moderator audit trail
+ * -------------------------------------------
+ */
+func (handler ModerationHandler) Audit(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/moderation/audit"}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil {
+		limit = 50
+	}
+	page, err := strconv.Atoi(c.QueryParam("page"))
+	if err != nil {
+		page = 1
+	}
+	targetID, _ := strconv.Atoi(c.QueryParam("target_id"))
+
+	logsRes, err := handler.moderationService.AuditTrail(userID, c.QueryParam("target_type"), targetID, limit, page)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   logsRes,
+	})
+}
+
+func (handler ModerationHandler) unauthorized(c echo.Context, links map[string]string) error {
+	return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+		Code:   http.StatusUnauthorized,
+		Status: "ERROR",
+		Error:  "unauthorized",
+		Links:  links,
+	})
+}
+
+func (handler ModerationHandler) errorResponse(c echo.Context, err error, links map[string]string) error {
+	if reflect.TypeOf(err).String() == "web.WebError" {
+		webErr := err.(web.WebError)
+		return c.JSON(webErr.Code, helpers.MakeErrorResponse("ERROR", webErr.Code, webErr.Error(), links))
+	} else if reflect.TypeOf(err).String() == "web.ValidationError" {
+		valErr := err.(web.ValidationError)
+		return c.JSON(valErr.Code, web.ValidationErrorResponse{
+			Status: "ERROR",
+			Code:   valErr.Code,
+			Error:  valErr.Error(),
+			Errors: valErr.Errors,
+			Links:  links,
+		})
+	}
+	return c.JSON(500, helpers.MakeErrorResponse("ERROR", 500, err.Error(), links))
+}
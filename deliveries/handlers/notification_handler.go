@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+	"tupulung/config"
+	"tupulung/deliveries/helpers"
+	"tupulung/deliveries/middleware"
+	"tupulung/entities/web"
+	notificationService "tupulung/services/notification"
+
+	"github.com/labstack/echo/v4"
+)
+
+type NotificationHandler struct {
+	notificationService *notificationService.NotificationService
+}
+
+func NewNotificationHandler(notificationService *notificationService.NotificationService) *NotificationHandler {
+	return &NotificationHandler{
+		notificationService: notificationService,
+	}
+}
+
+/*
+ * -------------------------------------------
+ * Notifications of the authenticated user
+ * -------------------------------------------
+ */
+func (handler NotificationHandler) Index(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/notifications"}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil {
+		limit = 20
+	}
+	page, err := strconv.Atoi(c.QueryParam("page"))
+	if err != nil {
+		page = 1
+	}
+	unreadOnly := c.QueryParam("unread") == "true"
+
+	notificationsRes, err := handler.notificationService.FindAll(userID, unreadOnly, limit, page)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   notificationsRes,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Mark a notification as read
+ * -------------------------------------------
+ */
+func (handler NotificationHandler) MarkRead(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/notifications/" + c.Param("id") + "/read"}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	if err := handler.notificationService.MarkRead(id, userID); err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   map[string]interface{}{"id": id},
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Mark all notifications as read
+ * -------------------------------------------
+ */
+func (handler NotificationHandler) MarkAllRead(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/notifications/read-all"}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	if err := handler.notificationService.MarkAllRead(userID); err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   nil,
+	})
+}
+
+func (handler NotificationHandler) unauthorized(c echo.Context, links map[string]string) error {
+	return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+		Code:   http.StatusUnauthorized,
+		Status: "ERROR",
+		Error:  "unauthorized",
+		Links:  links,
+	})
+}
+
+func (handler NotificationHandler) errorResponse(c echo.Context, err error, links map[string]string) error {
+	if reflect.TypeOf(err).String() == "web.WebError" {
+		webErr := err.(web.WebError)
+		return c.JSON(webErr.Code, helpers.MakeErrorResponse("ERROR", webErr.Code, webErr.Error(), links))
+	}
+	return c.JSON(500, helpers.MakeErrorResponse("ERROR", 500, err.Error(), links))
+}
@@ -0,0 +1,307 @@
+package handlers
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strconv"
+	"tupulung/config"
+	"tupulung/deliveries/helpers"
+	"tupulung/deliveries/middleware"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	orderService "tupulung/services/order"
+	paymentProvider "tupulung/utilities/payment"
+
+	"github.com/labstack/echo/v4"
+)
+
+type OrderHandler struct {
+	orderService    *orderService.OrderService
+	paymentProvider paymentProvider.PaymentInterface
+}
+
+func NewOrderHandler(orderService *orderService.OrderService, paymentProvider paymentProvider.PaymentInterface) *OrderHandler {
+	return &OrderHandler{
+		orderService:    orderService,
+		paymentProvider: paymentProvider,
+	}
+}
+
+/*
+ * Find my orders
+ * -------------------------------
+ * Mengambil semua order milik user yang sedang login
+ */
+func (handler OrderHandler) Index(c echo.Context) error {
+
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/orders"}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+			Code:   http.StatusUnauthorized,
+			Status: "ERROR",
+			Error:  "unauthorized",
+			Links:  links,
+		})
+	}
+
+	ordersRes, err := handler.orderService.FindMine(userID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   ordersRes,
+	})
+}
+
+/*
+ * Find event orders
+ * -------------------------------
+ * Mengambil semua order sebuah event untuk tim host
+ */
+func (handler OrderHandler) EventIndex(c echo.Context) error {
+
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/" + c.Param("id") + "/orders"}
+	eventID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+			Code:   http.StatusUnauthorized,
+			Status: "ERROR",
+			Error:  "unauthorized",
+			Links:  links,
+		})
+	}
+
+	ordersRes, err := handler.orderService.FindByEvent(eventID, userID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   ordersRes,
+	})
+}
+
+/*
+ * Show order
+ * -------------------------------
+ * Mengambil detail order beserta tiketnya
+ */
+func (handler OrderHandler) Show(c echo.Context) error {
+
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/orders/" + c.Param("id")}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+			Code:   http.StatusUnauthorized,
+			Status: "ERROR",
+			Error:  "unauthorized",
+			Links:  links,
+		})
+	}
+
+	orderRes, err := handler.orderService.Find(id, userID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   orderRes,
+	})
+}
+
+/*
+ * Create order
+ * -------------------------------
+ * Memesan tiket event
+ */
+func (handler OrderHandler) Create(c echo.Context) error {
+
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/" + c.Param("id") + "/orders"}
+	eventID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+			Code:   http.StatusUnauthorized,
+			Status: "ERROR",
+			Error:  "unauthorized",
+			Links:  links,
+		})
+	}
+
+	orderReq := entities.OrderRequest{}
+	c.Bind(&orderReq)
+	if force, err := strconv.ParseBool(c.QueryParam("force")); err == nil {
+		orderReq.Force = force
+	}
+
+	orderRes, err := handler.orderService.Create(orderReq, eventID, userID, handler.paymentProvider)
+	if err != nil {
+		if webErr, ok := err.(web.WebError); ok && webErr.Code == http.StatusConflict {
+			// Sertakan event yang bentrok agar user bisa memesan ulang dengan force
+			return c.JSON(webErr.Code, web.SuccessResponse{
+				Status: "ERROR",
+				Code:   webErr.Code,
+				Error:  webErr.Error(),
+				Links:  links,
+				Data:   orderRes,
+			})
+		}
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(201, web.SuccessResponse{
+		Status: "OK",
+		Code:   201,
+		Error:  nil,
+		Links:  links,
+		Data:   orderRes,
+	})
+}
+
+/*
+ * Refund order
+ * -------------------------------
+ * Membatalkan order yang sudah dibayar dan mengembalikan dananya
+ */
+func (handler OrderHandler) Refund(c echo.Context) error {
+
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/orders/" + c.Param("id") + "/refund"}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+			Code:   http.StatusUnauthorized,
+			Status: "ERROR",
+			Error:  "unauthorized",
+			Links:  links,
+		})
+	}
+
+	orderRes, err := handler.orderService.Refund(id, userID, handler.paymentProvider)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   orderRes,
+	})
+}
+
+/*
+ * Payment webhook
+ * -------------------------------
+ * Menerima notifikasi pembayaran dari payment provider,
+ * signature dikirim melalui header X-Payment-Signature
+ */
+func (handler OrderHandler) Webhook(c echo.Context) error {
+
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/payments/webhook"}
+	body, err := ioutil.ReadAll(c.Request().Body)
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "cannot read request body", links))
+	}
+
+	err = handler.orderService.HandleWebhook(body, c.Request().Header.Get("X-Payment-Signature"), handler.paymentProvider)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   nil,
+	})
+}
+
+/*
+ * Fake checkout
+ * -------------------------------
+ * Halaman pembayaran untuk fake payment provider,
+ * mensimulasikan webhook dengan status ?status=paid|failed
+ */
+func (handler OrderHandler) FakeCheckout(c echo.Context) error {
+
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/payments/fake/" + c.Param("reference")}
+	fake, ok := handler.paymentProvider.(*paymentProvider.Fake)
+	if !ok {
+		return c.JSON(404, helpers.MakeErrorResponse("ERROR", 404, "fake payment provider is not enabled", links))
+	}
+
+	status := c.QueryParam("status")
+	if status == "" {
+		status = "paid"
+	}
+	body, _ := json.Marshal(entities.PaymentEvent{
+		Reference: c.Param("reference"),
+		Status:    status,
+	})
+
+	err := handler.orderService.HandleWebhook(body, fake.Sign(body), handler.paymentProvider)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data: map[string]interface{}{
+			"reference": c.Param("reference"),
+			"status":    status,
+		},
+	})
+}
+
+func (handler OrderHandler) errorResponse(c echo.Context, err error, links map[string]string) error {
+	if reflect.TypeOf(err).String() == "web.WebError" {
+		webErr := err.(web.WebError)
+		return c.JSON(webErr.Code, helpers.MakeErrorResponse("ERROR", webErr.Code, webErr.Error(), links))
+	} else if reflect.TypeOf(err).String() == "web.ValidationError" {
+		valErr := err.(web.ValidationError)
+		return c.JSON(valErr.Code, web.ValidationErrorResponse{
+			Status: "ERROR",
+			Code:   valErr.Code,
+			Error:  valErr.Error(),
+			Errors: valErr.Errors,
+			Links:  links,
+		})
+	}
+	return c.JSON(500, helpers.MakeErrorResponse("ERROR", 500, err.Error(), links))
+}
@@ -1,14 +1,18 @@
 package handlers
 
 import (
+	"io"
 	"net/http"
 	"reflect"
 	"strconv"
+	"time"
 	"tupulung/config"
 	"tupulung/deliveries/helpers"
 	"tupulung/deliveries/middleware"
+	"tupulung/entities"
 	"tupulung/entities/web"
 	participantService "tupulung/services/participant"
+	"tupulung/utilities/qrcode"
 
 	"github.com/labstack/echo/v4"
 )
@@ -23,6 +27,48 @@ func NewParticipantHandler(service *participantService.ParticipantService) *Part
 	}
 }
 
+/*
+ * -------------------------------------------
+ * List event participants, host only
+ * filter by RSVP status with ?status=
+ * -------------------------------------------
+ */
+func (handler ParticipantHandler) Index(c echo.Context) error {
+
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/" + c.Param("id") + "/participants"}
+	eventID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	token := c.Get("user")
+	userID, err := middleware.ReadToken(token)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+			Code:   http.StatusUnauthorized,
+			Status: "ERROR",
+			Error:  "unauthorized",
+			Links:  links,
+		})
+	}
+
+	participantsRes, err := handler.participantService.FindAll(eventID, userID, c.QueryParam("status"))
+	if err != nil {
+		if reflect.TypeOf(err).String() == "web.WebError" {
+			webErr := err.(web.WebError)
+			return c.JSON(webErr.Code, helpers.MakeErrorResponse("ERROR", webErr.Code, webErr.Error(), links))
+		}
+		return c.JSON(500, helpers.MakeErrorResponse("ERROR", 500, err.Error(), links))
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   participantsRes,
+	})
+}
+
 func (handler ParticipantHandler) Append(c echo.Context) error {
 
 	eventID, err := strconv.Atoi(c.Param("id"))
@@ -41,12 +87,37 @@ func (handler ParticipantHandler) Append(c echo.Context) error {
 		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, err.Error(), links))
 	}
 
-	tx := handler.participantService.Append(userID, eventID)
+	participantReq := entities.ParticipantRequest{}
+	c.Bind(&participantReq)
+	if force, err := strconv.ParseBool(c.QueryParam("force")); err == nil {
+		participantReq.Force = force
+	}
+
+	joinRes, tx := handler.participantService.Append(userID, eventID, participantReq.InviteCode, participantReq.Answers, participantReq.Force)
 
 	if tx != nil {
 		if reflect.TypeOf(tx).String() == "web.WebError" {
 			webErr := tx.(web.WebError)
+			if webErr.Code == http.StatusConflict {
+				// Sertakan event yang bentrok agar user bisa memutuskan join dengan force
+				return c.JSON(webErr.Code, web.SuccessResponse{
+					Status: "ERROR",
+					Code:   webErr.Code,
+					Error:  webErr.Error(),
+					Links:  links,
+					Data:   joinRes,
+				})
+			}
 			return c.JSON(webErr.Code, helpers.MakeErrorResponse("ERROR", webErr.Code, webErr.Error(), links))
+		} else if reflect.TypeOf(tx).String() == "web.ValidationError" {
+			valErr := tx.(web.ValidationError)
+			return c.JSON(valErr.Code, web.ValidationErrorResponse{
+				Status: "ERROR",
+				Code:   valErr.Code,
+				Error:  valErr.Error(),
+				Errors: valErr.Errors,
+				Links:  links,
+			})
 		}
 		return c.JSON(500, helpers.MakeErrorResponse("ERROR", 500, tx.Error(), links))
 
@@ -57,7 +128,44 @@ func (handler ParticipantHandler) Append(c echo.Context) error {
 		Code:   200,
 		Error:  nil,
 		Links:  links,
-		Data:   "Success joined this event",
+		Data:   joinRes,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * My schedule, joined events that haven't ended
+ * with overlapping events highlighted
+ * -------------------------------------------
+ */
+func (handler ParticipantHandler) Schedule(c echo.Context) error {
+
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/schedule"}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+			Code:   http.StatusUnauthorized,
+			Status: "ERROR",
+			Error:  "unauthorized",
+			Links:  links,
+		})
+	}
+
+	scheduleRes, err := handler.participantService.Schedule(userID, time.Now())
+	if err != nil {
+		if reflect.TypeOf(err).String() == "web.WebError" {
+			webErr := err.(web.WebError)
+			return c.JSON(webErr.Code, helpers.MakeErrorResponse("ERROR", webErr.Code, webErr.Error(), links))
+		}
+		return c.JSON(500, helpers.MakeErrorResponse("ERROR", 500, err.Error(), links))
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   scheduleRes,
 	})
 }
 
@@ -96,3 +204,334 @@ func (handler ParticipantHandler) Delete(c echo.Context) error {
 		Data:   "Success leave this event",
 	})
 }
+
+/*
+ * -------------------------------------------
+ * Export participants to CSV or XLSX, host only
+ * -------------------------------------------
+ */
+func (handler ParticipantHandler) Export(c echo.Context) error {
+
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/" + c.Param("id") + "/participants/export"}
+	eventID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+			Code:   http.StatusUnauthorized,
+			Status: "ERROR",
+			Error:  "unauthorized",
+			Links:  links,
+		})
+	}
+	format := c.QueryParam("format")
+	if format == "" {
+		format = "csv"
+	}
+
+	// Header baru dikirim saat file mulai ditulis
+	streaming := false
+	err = handler.participantService.Export(eventID, userID, format, func(filename string, contentType string) io.Writer {
+		streaming = true
+		c.Response().Header().Set(echo.HeaderContentType, contentType)
+		c.Response().Header().Set(echo.HeaderContentDisposition, "attachment; filename=\""+filename+"\"")
+		c.Response().WriteHeader(200)
+		return c.Response()
+	})
+	if err != nil {
+		if streaming {
+			c.Logger().Error("Export participants failed: " + err.Error())
+			return nil
+		}
+		if reflect.TypeOf(err).String() == "web.WebError" {
+			webErr := err.(web.WebError)
+			return c.JSON(webErr.Code, helpers.MakeErrorResponse("ERROR", webErr.Code, webErr.Error(), links))
+		}
+		return c.JSON(500, helpers.MakeErrorResponse("ERROR", 500, err.Error(), links))
+	}
+	return nil
+}
+
+/*
+ * -------------------------------------------
+ * Set RSVP status: going, maybe or not_going
+ * -------------------------------------------
+ */
+func (handler ParticipantHandler) RSVP(c echo.Context) error {
+
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/" + c.Param("id") + "/rsvp"}
+	eventID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+			Code:   http.StatusUnauthorized,
+			Status: "ERROR",
+			Error:  "unauthorized",
+			Links:  links,
+		})
+	}
+
+	participantReq := entities.ParticipantRequest{}
+	c.Bind(&participantReq)
+	if force, err := strconv.ParseBool(c.QueryParam("force")); err == nil {
+		participantReq.Force = force
+	}
+
+	rsvpRes, err := handler.participantService.RSVP(userID, eventID, participantReq.Status, participantReq.InviteCode, participantReq.Answers, participantReq.Force)
+	if err != nil {
+		if reflect.TypeOf(err).String() == "web.WebError" {
+			webErr := err.(web.WebError)
+			if webErr.Code == http.StatusConflict {
+				// Sertakan event yang bentrok agar user bisa mengganti status dengan force
+				return c.JSON(webErr.Code, web.SuccessResponse{
+					Status: "ERROR",
+					Code:   webErr.Code,
+					Error:  webErr.Error(),
+					Links:  links,
+					Data:   rsvpRes,
+				})
+			}
+			return c.JSON(webErr.Code, helpers.MakeErrorResponse("ERROR", webErr.Code, webErr.Error(), links))
+		} else if reflect.TypeOf(err).String() == "web.ValidationError" {
+			valErr := err.(web.ValidationError)
+			return c.JSON(valErr.Code, web.ValidationErrorResponse{
+				Status: "ERROR",
+				Code:   valErr.Code,
+				Error:  valErr.Error(),
+				Errors: valErr.Errors,
+				Links:  links,
+			})
+		}
+		return c.JSON(500, helpers.MakeErrorResponse("ERROR", 500, err.Error(), links))
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   rsvpRes,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Remove a participant, host & moderator only
+ * -------------------------------------------
+ */
+func (handler ParticipantHandler) Remove(c echo.Context) error {
+
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/" + c.Param("id") + "/participants/" + c.Param("userID")}
+	eventID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	participantUserID, err := strconv.Atoi(c.Param("userID"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested user id is invalid", links))
+	}
+	token := c.Get("user")
+	userID, err := middleware.ReadToken(token)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+			Code:   http.StatusUnauthorized,
+			Status: "ERROR",
+			Error:  "unauthorized",
+			Links:  links,
+		})
+	}
+
+	err = handler.participantService.Remove(eventID, participantUserID, userID)
+	if err != nil {
+		if reflect.TypeOf(err).String() == "web.WebError" {
+			webErr := err.(web.WebError)
+			return c.JSON(webErr.Code, helpers.MakeErrorResponse("ERROR", webErr.Code, webErr.Error(), links))
+		}
+		return c.JSON(500, helpers.MakeErrorResponse("ERROR", 500, err.Error(), links))
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   "Participant removed from this event",
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Ticket code of the authenticated participant
+ * -------------------------------------------
+ */
+func (handler ParticipantHandler) Ticket(c echo.Context) error {
+
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/" + c.Param("id") + "/ticket"}
+	eventID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+			Code:   http.StatusUnauthorized,
+			Status: "ERROR",
+			Error:  "unauthorized",
+			Links:  links,
+		})
+	}
+
+	ticketRes, err := handler.participantService.Ticket(eventID, userID)
+	if err != nil {
+		if reflect.TypeOf(err).String() == "web.WebError" {
+			webErr := err.(web.WebError)
+			return c.JSON(webErr.Code, helpers.MakeErrorResponse("ERROR", webErr.Code, webErr.Error(), links))
+		}
+		return c.JSON(500, helpers.MakeErrorResponse("ERROR", 500, err.Error(), links))
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   ticketRes,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Ticket QR code as PNG (default) or SVG
+ * -------------------------------------------
+ */
+func (handler ParticipantHandler) TicketQR(c echo.Context) error {
+
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/" + c.Param("id") + "/ticket/qr"}
+	eventID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+			Code:   http.StatusUnauthorized,
+			Status: "ERROR",
+			Error:  "unauthorized",
+			Links:  links,
+		})
+	}
+	format := c.QueryParam("format")
+	if format != "" && format != "png" && format != "svg" {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "format must be png or svg", links))
+	}
+
+	ticketRes, err := handler.participantService.Ticket(eventID, userID)
+	if err != nil {
+		if reflect.TypeOf(err).String() == "web.WebError" {
+			webErr := err.(web.WebError)
+			return c.JSON(webErr.Code, helpers.MakeErrorResponse("ERROR", webErr.Code, webErr.Error(), links))
+		}
+		return c.JSON(500, helpers.MakeErrorResponse("ERROR", 500, err.Error(), links))
+	}
+
+	if format == "svg" {
+		image, err := qrcode.SVG(ticketRes.Code, 320)
+		if err != nil {
+			return c.JSON(500, helpers.MakeErrorResponse("ERROR", 500, err.Error(), links))
+		}
+		return c.Blob(200, "image/svg+xml", image)
+	}
+	image, err := qrcode.PNG(ticketRes.Code, 320)
+	if err != nil {
+		return c.JSON(500, helpers.MakeErrorResponse("ERROR", 500, err.Error(), links))
+	}
+	return c.Blob(200, "image/png", image)
+}
+
+/*
+ * -------------------------------------------
+ * Check in a participant by ticket code, host & moderator only
+ * -------------------------------------------
+ */
+func (handler ParticipantHandler) CheckIn(c echo.Context) error {
+
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/" + c.Param("id") + "/check-in"}
+	eventID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+			Code:   http.StatusUnauthorized,
+			Status: "ERROR",
+			Error:  "unauthorized",
+			Links:  links,
+		})
+	}
+
+	checkInReq := entities.CheckInRequest{}
+	c.Bind(&checkInReq)
+
+	participantRes, err := handler.participantService.CheckIn(checkInReq.Code, eventID, userID)
+	if err != nil {
+		if reflect.TypeOf(err).String() == "web.WebError" {
+			webErr := err.(web.WebError)
+			return c.JSON(webErr.Code, helpers.MakeErrorResponse("ERROR", webErr.Code, webErr.Error(), links))
+		}
+		return c.JSON(500, helpers.MakeErrorResponse("ERROR", 500, err.Error(), links))
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   participantRes,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Attendance summary, host & moderator only
+ * -------------------------------------------
+ */
+func (handler ParticipantHandler) Attendance(c echo.Context) error {
+
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/" + c.Param("id") + "/attendance"}
+	eventID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+			Code:   http.StatusUnauthorized,
+			Status: "ERROR",
+			Error:  "unauthorized",
+			Links:  links,
+		})
+	}
+
+	attendanceRes, err := handler.participantService.Attendance(eventID, userID)
+	if err != nil {
+		if reflect.TypeOf(err).String() == "web.WebError" {
+			webErr := err.(web.WebError)
+			return c.JSON(webErr.Code, helpers.MakeErrorResponse("ERROR", webErr.Code, webErr.Error(), links))
+		}
+		return c.JSON(500, helpers.MakeErrorResponse("ERROR", 500, err.Error(), links))
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   attendanceRes,
+	})
+}
@@ -0,0 +1,241 @@
+package handlers
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+	"tupulung/config"
+	"tupulung/deliveries/helpers"
+	"tupulung/deliveries/middleware"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	questionService "tupulung/services/question"
+
+	"github.com/labstack/echo/v4"
+)
+
+type QuestionHandler struct {
+	questionService *questionService.QuestionService
+}
+
+func NewQuestionHandler(questionService *questionService.QuestionService) *QuestionHandler {
+	return &QuestionHandler{
+		questionService: questionService,
+	}
+}
+
+/*
+ * -------------------------------------------
+ * List registration questions of an event
+ * -------------------------------------------
+ */
+func (handler QuestionHandler) Index(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/" + c.Param("id") + "/questions"}
+	eventID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+
+	questionsRes, err := handler.questionService.FindAll(eventID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   questionsRes,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Add a registration question, host & editor only
+ * -------------------------------------------
+ */
+func (handler QuestionHandler) Create(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/" + c.Param("id") + "/questions"}
+	eventID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	questionReq := entities.EventQuestionRequest{}
+	c.Bind(&questionReq)
+
+	questionRes, err := handler.questionService.Create(questionReq, eventID, userID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(201, web.SuccessResponse{
+		Status: "OK",
+		Code:   201,
+		Error:  nil,
+		Links:  links,
+		Data:   questionRes,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Update a registration question, host & editor only
+ * -------------------------------------------
+ */
+func (handler QuestionHandler) Update(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/questions/" + c.Param("questionID")}
+	id, err := strconv.Atoi(c.Param("questionID"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	questionReq := entities.EventQuestionRequest{}
+	c.Bind(&questionReq)
+
+	questionRes, err := handler.questionService.Update(questionReq, id, userID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   questionRes,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Delete a registration question, host & editor only
+ * -------------------------------------------
+ */
+func (handler QuestionHandler) Delete(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/questions/" + c.Param("questionID")}
+	id, err := strconv.Atoi(c.Param("questionID"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	err = handler.questionService.Delete(id, userID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   "Question deleted",
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Registration answers per participant, host & moderator only
+ * filter by participant with ?user_id=
+ * -------------------------------------------
+ */
+func (handler QuestionHandler) Answers(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/" + c.Param("id") + "/answers"}
+	eventID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+	participantUserID := 0
+	if c.QueryParam("user_id") != "" {
+		participantUserID, err = strconv.Atoi(c.QueryParam("user_id"))
+		if err != nil {
+			return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested user id is invalid", links))
+		}
+	}
+
+	answersRes, err := handler.questionService.Answers(eventID, userID, participantUserID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   answersRes,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Registration answers in aggregate, host & moderator only
+ * -------------------------------------------
+ */
+func (handler QuestionHandler) Summary(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/" + c.Param("id") + "/answers/summary"}
+	eventID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	summaryRes, err := handler.questionService.Summary(eventID, userID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   summaryRes,
+	})
+}
+
+func (handler QuestionHandler) unauthorized(c echo.Context, links map[string]string) error {
+	return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+		Code:   http.StatusUnauthorized,
+		Status: "ERROR",
+		Error:  "unauthorized",
+		Links:  links,
+	})
+}
+
+func (handler QuestionHandler) errorResponse(c echo.Context, err error, links map[string]string) error {
+	if reflect.TypeOf(err).String() == "web.WebError" {
+		webErr := err.(web.WebError)
+		return c.JSON(webErr.Code, helpers.MakeErrorResponse("ERROR", webErr.Code, webErr.Error(), links))
+	} else if reflect.TypeOf(err).String() == "web.ValidationError" {
+		valErr := err.(web.ValidationError)
+		return c.JSON(valErr.Code, web.ValidationErrorResponse{
+			Status: "ERROR",
+			Code:   valErr.Code,
+			Error:  valErr.Error(),
+			Errors: valErr.Errors,
+			Links:  links,
+		})
+	}
+	return c.JSON(500, helpers.MakeErrorResponse("ERROR", 500, err.Error(), links))
+}
@@ -0,0 +1,251 @@
+package handlers
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+	"tupulung/config"
+	"tupulung/deliveries/helpers"
+	"tupulung/deliveries/middleware"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	reviewService "tupulung/services/review"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ReviewHandler struct {
+	reviewService *reviewService.ReviewService
+}
+
+func NewReviewHandler(reviewService *reviewService.ReviewService) *ReviewHandler {
+	return &ReviewHandler{
+		reviewService: reviewService,
+	}
+}
+
+/*
+ * -------------------------------------------
+ * Rating summary and reviews of an event
+ * -------------------------------------------
+ */
+func (handler ReviewHandler) Index(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/" + c.Param("id") + "/reviews"}
+	eventID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	viewerID := middleware.ReadOptionalToken(c.Get("user"))
+
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil {
+		limit = 20
+	}
+	page, err := strconv.Atoi(c.QueryParam("page"))
+	if err != nil {
+		page = 1
+	}
+
+	reviewsRes, pagination, err := handler.reviewService.FindAll(eventID, viewerID, c.QueryParam("invite"), limit, page)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	pageURL := func(page int) string {
+		return config.Get().App.BaseURL + "/api/events/" + c.Param("id") + "/reviews?limit=" + strconv.Itoa(limit) + "&page=" + strconv.Itoa(page)
+	}
+	links["first"] = pageURL(1)
+	links["last"] = pageURL(pagination.TotalPages)
+	if pagination.Page > 1 {
+		links["prev"] = pageURL(pagination.Page - 1)
+	}
+	if pagination.Page < pagination.TotalPages {
+		links["next"] = pageURL(pagination.Page + 1)
+	}
+
+	return c.JSON(200, web.SuccessListResponse{
+		Status:     "OK",
+		Code:       200,
+		Error:      nil,
+		Links:      links,
+		Data:       reviewsRes,
+		Pagination: pagination,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Review an event after it ends
+ * -------------------------------------------
+ */
+func (handler ReviewHandler) Create(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/" + c.Param("id") + "/reviews"}
+	eventID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	reviewReq := entities.ReviewRequest{}
+	c.Bind(&reviewReq)
+	reviewRes, err := handler.reviewService.Create(reviewReq, eventID, userID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(201, web.SuccessResponse{
+		Status: "OK",
+		Code:   201,
+		Error:  nil,
+		Links:  links,
+		Data:   reviewRes,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Edit own review
+ * -------------------------------------------
+ */
+func (handler ReviewHandler) Update(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/reviews/" + c.Param("reviewID")}
+	id, err := strconv.Atoi(c.Param("reviewID"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	reviewReq := entities.ReviewRequest{}
+	c.Bind(&reviewReq)
+	reviewRes, err := handler.reviewService.Update(reviewReq, id, userID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   reviewRes,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Delete own review
+ * -------------------------------------------
+ */
+func (handler ReviewHandler) Delete(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/reviews/" + c.Param("reviewID")}
+	id, err := strconv.Atoi(c.Param("reviewID"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	if err := handler.reviewService.Delete(id, userID); err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   map[string]interface{}{"id": id},
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Host reply to a review
+ * -------------------------------------------
+ */
+func (handler ReviewHandler) Reply(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/reviews/" + c.Param("reviewID") + "/reply"}
+	id, err := strconv.Atoi(c.Param("reviewID"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	replyReq := entities.ReviewReplyRequest{}
+	c.Bind(&replyReq)
+	reviewRes, err := handler.reviewService.Reply(replyReq, id, userID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   reviewRes,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Reputation of a host
+ * -------------------------------------------
+ */
+func (handler ReviewHandler) Reputation(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/users/" + c.Param("id") + "/reputation"}
+	hostID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+
+	reputationRes, err := handler.reviewService.Reputation(hostID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   reputationRes,
+	})
+}
+
+func (handler ReviewHandler) unauthorized(c echo.Context, links map[string]string) error {
+	return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+		Code:   http.StatusUnauthorized,
+		Status: "ERROR",
+		Error:  "unauthorized",
+		Links:  links,
+	})
+}
+
+func (handler ReviewHandler) errorResponse(c echo.Context, err error, links map[string]string) error {
+	if reflect.TypeOf(err).String() == "web.WebError" {
+		webErr := err.(web.WebError)
+		return c.JSON(webErr.Code, helpers.MakeErrorResponse("ERROR", webErr.Code, webErr.Error(), links))
+	} else if reflect.TypeOf(err).String() == "web.ValidationError" {
+		valErr := err.(web.ValidationError)
+		return c.JSON(valErr.Code, web.ValidationErrorResponse{
+			Status: "ERROR",
+			Code:   valErr.Code,
+			Error:  valErr.Error(),
+			Errors: valErr.Errors,
+			Links:  links,
+		})
+	}
+	return c.JSON(500, helpers.MakeErrorResponse("ERROR", 500, err.Error(), links))
+}
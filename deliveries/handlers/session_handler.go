@@ -0,0 +1,278 @@
+package handlers
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+	"tupulung/config"
+	"tupulung/deliveries/helpers"
+	"tupulung/deliveries/middleware"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	sessionService "tupulung/services/session"
+
+	"github.com/labstack/echo/v4"
+)
+
+type SessionHandler struct {
+	sessionService *sessionService.SessionService
+}
+
+func NewSessionHandler(sessionService *sessionService.SessionService) *SessionHandler {
+	return &SessionHandler{
+		sessionService: sessionService,
+	}
+}
+
+/*
+ * -------------------------------------------
+ * List sessions of an event agenda
+ * -------------------------------------------
+ */
+func (handler SessionHandler) Index(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/" + c.Param("id") + "/sessions"}
+	eventID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	viewerID := middleware.ReadOptionalToken(c.Get("user"))
+
+	sessionsRes, err := handler.sessionService.FindAll(eventID, viewerID, c.QueryParam("invite"))
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   sessionsRes,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Add a session to the agenda, host & editor only
+ * -------------------------------------------
+ */
+func (handler SessionHandler) Create(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/" + c.Param("id") + "/sessions"}
+	eventID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	sessionReq := entities.EventSessionRequest{}
+	c.Bind(&sessionReq)
+
+	sessionRes, err := handler.sessionService.Create(sessionReq, eventID, userID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(201, web.SuccessResponse{
+		Status: "OK",
+		Code:   201,
+		Error:  nil,
+		Links:  links,
+		Data:   sessionRes,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Update a session, host & editor only
+ * -------------------------------------------
+ */
+func (handler SessionHandler) Update(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/sessions/" + c.Param("sessionID")}
+	id, err := strconv.Atoi(c.Param("sessionID"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	sessionReq := entities.EventSessionRequest{}
+	c.Bind(&sessionReq)
+
+	sessionRes, err := handler.sessionService.Update(sessionReq, id, userID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   sessionRes,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Delete a session, host & editor only
+ * -------------------------------------------
+ */
+func (handler SessionHandler) Delete(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/sessions/" + c.Param("sessionID")}
+	id, err := strconv.Atoi(c.Param("sessionID"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	err = handler.sessionService.Delete(id, userID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   "Session deleted",
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Add a session to my personal agenda
+ * -------------------------------------------
+ */
+func (handler SessionHandler) AddToAgenda(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/sessions/" + c.Param("sessionID") + "/agenda"}
+	id, err := strconv.Atoi(c.Param("sessionID"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	err = handler.sessionService.AddToAgenda(id, userID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   "Session added to agenda",
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Remove a session from my personal agenda
+ * -------------------------------------------
+ */
+func (handler SessionHandler) RemoveFromAgenda(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/sessions/" + c.Param("sessionID") + "/agenda"}
+	id, err := strconv.Atoi(c.Param("sessionID"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	err = handler.sessionService.RemoveFromAgenda(id, userID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   "Session removed from agenda",
+	})
+}
+
+/*
+ * -------------------------------------------
+ * My personal agenda of an event
+ * -------------------------------------------
+ */
+func (handler SessionHandler) EventAgenda(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/" + c.Param("id") + "/agenda"}
+	eventID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	return handler.agenda(c, eventID, links)
+}
+
+/*
+ * -------------------------------------------
+ * My personal agenda across all events
+ * -------------------------------------------
+ */
+func (handler SessionHandler) Agenda(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/agenda"}
+	return handler.agenda(c, 0, links)
+}
+
+func (handler SessionHandler) agenda(c echo.Context, eventID int, links map[string]string) error {
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	sessionsRes, err := handler.sessionService.Agenda(eventID, userID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   sessionsRes,
+	})
+}
+
+func (handler SessionHandler) unauthorized(c echo.Context, links map[string]string) error {
+	return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+		Code:   http.StatusUnauthorized,
+		Status: "ERROR",
+		Error:  "unauthorized",
+		Links:  links,
+	})
+}
+
+func (handler SessionHandler) errorResponse(c echo.Context, err error, links map[string]string) error {
+	if reflect.TypeOf(err).String() == "web.WebError" {
+		webErr := err.(web.WebError)
+		return c.JSON(webErr.Code, helpers.MakeErrorResponse("ERROR", webErr.Code, webErr.Error(), links))
+	} else if reflect.TypeOf(err).String() == "web.ValidationError" {
+		valErr := err.(web.ValidationError)
+		return c.JSON(valErr.Code, web.ValidationErrorResponse{
+			Status: "ERROR",
+			Code:   valErr.Code,
+			Error:  valErr.Error(),
+			Errors: valErr.Errors,
+			Links:  links,
+		})
+	}
+	return c.JSON(500, helpers.MakeErrorResponse("ERROR", 500, err.Error(), links))
+}
@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"reflect"
+	"strconv"
+	"tupulung/config"
+	"tupulung/deliveries/helpers"
+	"tupulung/entities/web"
+	tagService "tupulung/services/tag"
+
+	"github.com/labstack/echo/v4"
+)
+
+type TagHandler struct {
+	tagService *tagService.TagService
+}
+
+func NewTagHandler(tagService *tagService.TagService) *TagHandler {
+	return &TagHandler{
+		tagService: tagService,
+	}
+}
+
+/*
+ * -------------------------------------------
+ * Autocomplete tag name while typing
+ * -------------------------------------------
+ */
+func (handler TagHandler) Autocomplete(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/tags/autocomplete?q=" + c.QueryParam("q")}
+
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil {
+		limit = 10
+	}
+
+	tags, err := handler.tagService.Autocomplete(c.QueryParam("q"), limit)
+	if err != nil {
+		if reflect.TypeOf(err).String() == "web.WebError" {
+			webErr := err.(web.WebError)
+			return c.JSON(webErr.Code, helpers.MakeErrorResponse("ERROR", webErr.Code, webErr.Error(), links))
+		}
+		return c.JSON(500, helpers.MakeErrorResponse("ERROR", 500, err.Error(), links))
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   tags,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Most used tags on public events
+ * -------------------------------------------
+ */
+func (handler TagHandler) Popular(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/tags/popular"}
+
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil {
+		limit = 20
+	}
+
+	tags, err := handler.tagService.Popular(limit)
+	if err != nil {
+		if reflect.TypeOf(err).String() == "web.WebError" {
+			webErr := err.(web.WebError)
+			return c.JSON(webErr.Code, helpers.MakeErrorResponse("ERROR", webErr.Code, webErr.Error(), links))
+		}
+		return c.JSON(500, helpers.MakeErrorResponse("ERROR", 500, err.Error(), links))
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   tags,
+	})
+}
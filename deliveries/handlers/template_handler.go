@@ -0,0 +1,234 @@
+package handlers
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+	"tupulung/config"
+	"tupulung/deliveries/helpers"
+	"tupulung/deliveries/middleware"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	templateService "tupulung/services/template"
+
+	"github.com/labstack/echo/v4"
+)
+
+type TemplateHandler struct {
+	templateService *templateService.TemplateService
+}
+
+func NewTemplateHandler(templateService *templateService.TemplateService) *TemplateHandler {
+	return &TemplateHandler{
+		templateService: templateService,
+	}
+}
+
+/*
+ * -------------------------------------------
+ * List templates of authenticated user
+ * -------------------------------------------
+ */
+func (handler TemplateHandler) Index(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/templates"}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	templatesRes, err := handler.templateService.FindAll(userID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   templatesRes,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Show template detail
+ * -------------------------------------------
+ */
+func (handler TemplateHandler) Show(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/templates/" + c.Param("id")}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	templateRes, err := handler.templateService.Find(id, userID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   templateRes,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Create a new template
+ * -------------------------------------------
+ */
+func (handler TemplateHandler) Create(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/templates"}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	templateReq := entities.EventTemplateRequest{}
+	c.Bind(&templateReq)
+
+	templateRes, err := handler.templateService.Create(templateReq, userID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(201, web.SuccessResponse{
+		Status: "OK",
+		Code:   201,
+		Error:  nil,
+		Links:  links,
+		Data:   templateRes,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Save an existing event as template
+ * -------------------------------------------
+ */
+func (handler TemplateHandler) CreateFromEvent(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/events/" + c.Param("id") + "/template"}
+	eventID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	templateReq := entities.EventTemplateRequest{}
+	c.Bind(&templateReq)
+
+	templateRes, err := handler.templateService.CreateFromEvent(eventID, templateReq.Name, userID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(201, web.SuccessResponse{
+		Status: "OK",
+		Code:   201,
+		Error:  nil,
+		Links:  links,
+		Data:   templateRes,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Update template
+ * -------------------------------------------
+ */
+func (handler TemplateHandler) Update(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/templates/" + c.Param("id")}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	templateReq := entities.EventTemplateRequest{}
+	c.Bind(&templateReq)
+
+	templateRes, err := handler.templateService.Update(templateReq, id, userID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data:   templateRes,
+	})
+}
+
+/*
+ * -------------------------------------------
+ * Delete template
+ * -------------------------------------------
+ */
+func (handler TemplateHandler) Delete(c echo.Context) error {
+	links := map[string]string{"self": config.Get().App.BaseURL + "/api/templates/" + c.Param("id")}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(400, helpers.MakeErrorResponse("ERROR", 400, "requested id is invalid", links))
+	}
+	userID, err := middleware.ReadToken(c.Get("user"))
+	if err != nil {
+		return handler.unauthorized(c, links)
+	}
+
+	err = handler.templateService.Delete(id, userID)
+	if err != nil {
+		return handler.errorResponse(c, err, links)
+	}
+
+	return c.JSON(200, web.SuccessResponse{
+		Status: "OK",
+		Code:   200,
+		Error:  nil,
+		Links:  links,
+		Data: map[string]interface{}{
+			"id": id,
+		},
+	})
+}
+
+func (handler TemplateHandler) unauthorized(c echo.Context, links map[string]string) error {
+	return c.JSON(http.StatusUnauthorized, web.ErrorResponse{
+		Code:   http.StatusUnauthorized,
+		Status: "ERROR",
+		Error:  "unauthorized",
+		Links:  links,
+	})
+}
+
+func (handler TemplateHandler) errorResponse(c echo.Context, err error, links map[string]string) error {
+	if reflect.TypeOf(err).String() == "web.WebError" {
+		webErr := err.(web.WebError)
+		return c.JSON(webErr.Code, helpers.MakeErrorResponse("ERROR", webErr.Code, webErr.Error(), links))
+	} else if reflect.TypeOf(err).String() == "web.ValidationError" {
+		valErr := err.(web.ValidationError)
+		return c.JSON(valErr.Code, web.ValidationErrorResponse{
+			Status: "ERROR",
+			Code:   valErr.Code,
+			Error:  valErr.Error(),
+			Errors: valErr.Errors,
+			Links:  links,
+		})
+	}
+	return c.JSON(500, helpers.MakeErrorResponse("ERROR", 500, err.Error(), links))
+}
@@ -1,19 +1,72 @@
 package middleware
 
 import (
+	"net/http"
 	"time"
+	"tupulung/config"
 	"tupulung/entities"
+	"tupulung/entities/web"
 
 	"github.com/golang-jwt/jwt"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 )
 
+/*
+ * Suspension Check
+ * -------------------------------
+ * Diisi saat server dijalankan agar token milik user yang sedang
+ * ditangguhkan ditolak, tidak hanya saat login
+ */
+var SuspensionCheck = func(userID int) bool {
+	return false
+}
+
 func JWTMiddleware() echo.MiddlewareFunc {
-	return middleware.JWTWithConfig(middleware.JWTConfig{
+	return withSuspensionCheck(middleware.JWTWithConfig(middleware.JWTConfig{
 		SigningKey:    []byte("jeweteuwu"),
 		SigningMethod: jwt.SigningMethodHS256.Name,
-	})
+	}))
+}
+
+/*
+ * JWT Optional Middleware
+ * -------------------------------
+ * Token hanya divalidasi jika header Authorization dikirim,
+ * digunakan untuk endpoint publik yang hasilnya bergantung pada user
+ */
+func JWTOptionalMiddleware() echo.MiddlewareFunc {
+	return withSuspensionCheck(middleware.JWTWithConfig(middleware.JWTConfig{
+		SigningKey:    []byte("jeweteuwu"),
+		SigningMethod: jwt.SigningMethodHS256.Name,
+		Skipper: func(c echo.Context) bool {
+			return c.Request().Header.Get(echo.HeaderAuthorization) == ""
+		},
+	}))
+}
+
+/*
+ * With Suspension Check
+ * -------------------------------
+ * Menolak request dengan token yang valid jika pemiliknya
+ * sedang ditangguhkan moderator
+ */
+func withSuspensionCheck(jwtMiddleware echo.MiddlewareFunc) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return jwtMiddleware(func(c echo.Context) error {
+			if token := c.Get("user"); token != nil {
+				if id, err := ReadToken(token); err == nil && SuspensionCheck(id) {
+					return c.JSON(http.StatusForbidden, web.ErrorResponse{
+						Status: "ERROR",
+						Code:   http.StatusForbidden,
+						Error:  "Account is suspended",
+						Links:  map[string]string{"self": config.Get().App.BaseURL + c.Request().URL.Path},
+					})
+				}
+			}
+			return next(c)
+		})
+	}
 }
 
 func CreateToken(user entities.User) (string, error) {
@@ -34,3 +87,19 @@ func ReadToken(token interface{}) (int, error) {
 	id := int(claims["userID"].(float64))
 	return id, nil
 }
+
+/*
+ * Read Optional Token
+ * -------------------------------
+ * Mengembalikan 0 jika request tidak membawa token
+ */
+func ReadOptionalToken(token interface{}) int {
+	if token == nil {
+		return 0
+	}
+	id, err := ReadToken(token)
+	if err != nil {
+		return 0
+	}
+	return id
+}
@@ -19,16 +19,47 @@ func RegisterEventRoute(e *echo.Echo, eventHandler *handlers.EventHandler, parti
 	group := e.Group("/api/events")
 	group.POST("", eventHandler.Create, middleware.JWTMiddleware())                   // Registration event
 	group.GET("", eventHandler.Index)                                                 // Get all Event
-	group.GET("/:id", eventHandler.Show)                                              // Detail event
-	e.GET("/api/users/:id/events", eventHandler.GetUserEvent)                         // Detail user event
+	group.GET("/autocomplete", eventHandler.Autocomplete)                             // Autocomplete event title
+	group.GET("/trending", eventHandler.Trending)                                     // Trending events
+	group.GET("/recommended", eventHandler.Recommended, middleware.JWTMiddleware())   // Recommended events
+	group.GET("/:id", eventHandler.Show, middleware.JWTOptionalMiddleware())          // Detail event
+	group.GET("/by-slug/:slug", eventHandler.ShowBySlug, middleware.JWTOptionalMiddleware()) // Detail event by slug
+	e.GET("/api/users/:id/events", eventHandler.GetUserEvent, middleware.JWTOptionalMiddleware()) // Detail user event
 	group.PUT("/:id", eventHandler.Update, middleware.JWTMiddleware())                // Edit profile event
 	group.DELETE("/:id", eventHandler.Delete, middleware.JWTMiddleware())             // Delete event
+	group.POST("/:id/duplicate", eventHandler.Duplicate, middleware.JWTMiddleware())  // Duplicate event
+	e.POST("/api/templates/:id/events", eventHandler.CreateFromTemplate, middleware.JWTMiddleware()) // Create event from template
 	group.POST("/join/:id", participantHandler.Append, middleware.JWTMiddleware())    // Join an event
 	group.DELETE("/leave/:id", participantHandler.Delete, middleware.JWTMiddleware()) // Leave an event
+	group.PUT("/:id/rsvp", participantHandler.RSVP, middleware.JWTMiddleware())                       // Set RSVP status
+	group.GET("/:id/participants", participantHandler.Index, middleware.JWTMiddleware()) // Participants & their invites
+	group.GET("/:id/participants/export", participantHandler.Export, middleware.JWTMiddleware())     // Export participants to CSV / XLSX
+	group.DELETE("/:id/participants/:userID", participantHandler.Remove, middleware.JWTMiddleware()) // Remove a participant
+	group.GET("/:id/ticket", participantHandler.Ticket, middleware.JWTMiddleware())                   // My ticket code
+	group.GET("/:id/ticket/qr", participantHandler.TicketQR, middleware.JWTMiddleware())              // My ticket QR code
+	group.POST("/:id/check-in", participantHandler.CheckIn, middleware.JWTMiddleware())               // Check in a participant
+	group.GET("/:id/attendance", participantHandler.Attendance, middleware.JWTMiddleware())           // Attendance summary
+	e.GET("/api/schedule", participantHandler.Schedule, middleware.JWTMiddleware())                  // My schedule & conflicts
 	group.POST("/like/:id", likeHandler.Append, middleware.JWTMiddleware())           // Like an event
 	group.DELETE("/dislike/:id", likeHandler.Delete, middleware.JWTMiddleware())      // Dislike an event
 }
 
+func RegisterInviteRoute(e *echo.Echo, inviteHandler *handlers.InviteHandler) {
+	e.GET("/api/events/:id/invites", inviteHandler.Index, middleware.JWTMiddleware())
+	e.POST("/api/events/:id/invites", inviteHandler.Create, middleware.JWTMiddleware())
+	e.DELETE("/api/events/invites/:inviteID", inviteHandler.Revoke, middleware.JWTMiddleware())
+}
+
+func RegisterCohostRoute(e *echo.Echo, cohostHandler *handlers.CohostHandler) {
+	e.GET("/api/events/:id/cohosts", cohostHandler.Index, middleware.JWTOptionalMiddleware())
+	e.POST("/api/events/:id/cohosts", cohostHandler.Create, middleware.JWTMiddleware())
+	e.POST("/api/events/:id/transfer", cohostHandler.Transfer, middleware.JWTMiddleware())
+	e.POST("/api/events/cohosts/:cohostID/accept", cohostHandler.Accept, middleware.JWTMiddleware())
+	e.PUT("/api/events/cohosts/:cohostID", cohostHandler.Update, middleware.JWTMiddleware())
+	e.DELETE("/api/events/cohosts/:cohostID", cohostHandler.Delete, middleware.JWTMiddleware())
+	e.GET("/api/users/cohost-invitations", cohostHandler.Invitations, middleware.JWTMiddleware())
+}
+
 func RegisterAuthRoute(e *echo.Echo, authHandler *handlers.AuthHandler) {
 	e.POST("/api/auth", authHandler.Login)
 	e.GET("/api/auth/me", authHandler.Me, middleware.JWTMiddleware())
@@ -47,3 +78,123 @@ func RegisterCommentRoute(e *echo.Echo, commentHandler *handlers.CommentHandler)
 	e.PUT("/api/events/comments/:commentID", commentHandler.Update, middleware.JWTMiddleware())
 	e.DELETE("/api/events/comments/:commentID", commentHandler.Delete, middleware.JWTMiddleware())
 }
+
+func RegisterTicketRoute(e *echo.Echo, ticketHandler *handlers.TicketHandler) {
+	e.GET("/api/events/:id/ticket-types", ticketHandler.Index)
+	e.POST("/api/events/:id/ticket-types", ticketHandler.Create, middleware.JWTMiddleware())
+	e.PUT("/api/events/ticket-types/:ticketTypeID", ticketHandler.Update, middleware.JWTMiddleware())
+	e.DELETE("/api/events/ticket-types/:ticketTypeID", ticketHandler.Delete, middleware.JWTMiddleware())
+}
+
+func RegisterOrderRoute(e *echo.Echo, orderHandler *handlers.OrderHandler) {
+	e.GET("/api/events/:id/orders", orderHandler.EventIndex, middleware.JWTMiddleware())
+	e.POST("/api/events/:id/orders", orderHandler.Create, middleware.JWTMiddleware())
+	e.GET("/api/orders", orderHandler.Index, middleware.JWTMiddleware())
+	e.GET("/api/orders/:id", orderHandler.Show, middleware.JWTMiddleware())
+	e.POST("/api/orders/:id/refund", orderHandler.Refund, middleware.JWTMiddleware())
+	e.POST("/api/payments/webhook", orderHandler.Webhook)
+}
+
+// Hanya untuk development & testing, didaftarkan jika PAYMENT_FAKE_CHECKOUT=true
+func RegisterFakePaymentRoute(e *echo.Echo, orderHandler *handlers.OrderHandler) {
+	e.POST("/api/payments/fake/:reference", orderHandler.FakeCheckout)
+}
+
+func RegisterTagRoute(e *echo.Echo, tagHandler *handlers.TagHandler) {
+	e.GET("/api/tags/autocomplete", tagHandler.Autocomplete)
+	e.GET("/api/tags/popular", tagHandler.Popular)
+}
+
+func RegisterGalleryRoute(e *echo.Echo, galleryHandler *handlers.GalleryHandler) {
+	e.GET("/api/events/:id/images", galleryHandler.Index, middleware.JWTOptionalMiddleware())
+	e.POST("/api/events/:id/images", galleryHandler.Create, middleware.JWTMiddleware())
+	e.PUT("/api/events/:id/images/order", galleryHandler.Reorder, middleware.JWTMiddleware())
+	e.PUT("/api/events/images/:imageID", galleryHandler.Update, middleware.JWTMiddleware())
+	e.POST("/api/events/images/:imageID/cover", galleryHandler.SetCover, middleware.JWTMiddleware())
+	e.DELETE("/api/events/images/:imageID", galleryHandler.Delete, middleware.JWTMiddleware())
+}
+
+func RegisterTemplateRoute(e *echo.Echo, templateHandler *handlers.TemplateHandler) {
+	group := e.Group("/api/templates")
+	group.GET("", templateHandler.Index, middleware.JWTMiddleware())
+	group.POST("", templateHandler.Create, middleware.JWTMiddleware())
+	group.GET("/:id", templateHandler.Show, middleware.JWTMiddleware())
+	group.PUT("/:id", templateHandler.Update, middleware.JWTMiddleware())
+	group.DELETE("/:id", templateHandler.Delete, middleware.JWTMiddleware())
+	e.POST("/api/events/:id/template", templateHandler.CreateFromEvent, middleware.JWTMiddleware())
+}
+
+func RegisterRevisionRoute(e *echo.Echo, revisionHandler *handlers.RevisionHandler) {
+	e.GET("/api/events/:id/revisions", revisionHandler.Index, middleware.JWTOptionalMiddleware())
+	e.GET("/api/events/:id/changes", revisionHandler.SinceJoined, middleware.JWTMiddleware())
+}
+
+func RegisterQuestionRoute(e *echo.Echo, questionHandler *handlers.QuestionHandler) {
+	e.GET("/api/events/:id/questions", questionHandler.Index)
+	e.POST("/api/events/:id/questions", questionHandler.Create, middleware.JWTMiddleware())
+	e.PUT("/api/events/questions/:questionID", questionHandler.Update, middleware.JWTMiddleware())
+	e.DELETE("/api/events/questions/:questionID", questionHandler.Delete, middleware.JWTMiddleware())
+	e.GET("/api/events/:id/answers", questionHandler.Answers, middleware.JWTMiddleware())
+	e.GET("/api/events/:id/answers/summary", questionHandler.Summary, middleware.JWTMiddleware())
+}
+
+func RegisterNotificationRoute(e *echo.Echo, notificationHandler *handlers.NotificationHandler) {
+	e.GET("/api/notifications", notificationHandler.Index, middleware.JWTMiddleware())
+	e.PUT("/api/notifications/read-all", notificationHandler.MarkAllRead, middleware.JWTMiddleware())
+	e.PUT("/api/notifications/:id/read", notificationHandler.MarkRead, middleware.JWTMiddleware())
+}
+
+func RegisterModerationRoute(e *echo.Echo, moderationHandler *handlers.ModerationHandler) {
+	e.POST("/api/events/:id/reports", moderationHandler.ReportEvent, middleware.JWTMiddleware())
+	e.POST("/api/events/comments/:commentID/reports", moderationHandler.ReportComment, middleware.JWTMiddleware())
+	e.POST("/api/users/:id/reports", moderationHandler.ReportUser, middleware.JWTMiddleware())
+	e.GET("/api/moderation/reports", moderationHandler.Index, middleware.JWTMiddleware())
+	e.GET("/api/moderation/reports/:id", moderationHandler.Show, middleware.JWTMiddleware())
+	e.PUT("/api/moderation/reports/:id", moderationHandler.Act, middleware.JWTMiddleware())
+	e.GET("/api/moderation/audit", moderationHandler.Audit, middleware.JWTMiddleware())
+}
+
+func RegisterReviewRoute(e *echo.Echo, reviewHandler *handlers.ReviewHandler) {
+	e.GET("/api/events/:id/reviews", reviewHandler.Index, middleware.JWTOptionalMiddleware())
+	e.POST("/api/events/:id/reviews", reviewHandler.Create, middleware.JWTMiddleware())
+	e.PUT("/api/events/reviews/:reviewID", reviewHandler.Update, middleware.JWTMiddleware())
+	e.DELETE("/api/events/reviews/:reviewID", reviewHandler.Delete, middleware.JWTMiddleware())
+	e.PUT("/api/events/reviews/:reviewID/reply", reviewHandler.Reply, middleware.JWTMiddleware())
+	e.GET("/api/users/:id/reputation", reviewHandler.Reputation)
+}
+
+func RegisterSessionRoute(e *echo.Echo, sessionHandler *handlers.SessionHandler) {
+	e.GET("/api/events/:id/sessions", sessionHandler.Index, middleware.JWTOptionalMiddleware())
+	e.POST("/api/events/:id/sessions", sessionHandler.Create, middleware.JWTMiddleware())
+	e.PUT("/api/events/sessions/:sessionID", sessionHandler.Update, middleware.JWTMiddleware())
+	e.DELETE("/api/events/sessions/:sessionID", sessionHandler.Delete, middleware.JWTMiddleware())
+	e.POST("/api/events/sessions/:sessionID/agenda", sessionHandler.AddToAgenda, middleware.JWTMiddleware())
+	e.DELETE("/api/events/sessions/:sessionID/agenda", sessionHandler.RemoveFromAgenda, middleware.JWTMiddleware())
+	e.GET("/api/events/:id/agenda", sessionHandler.EventAgenda, middleware.JWTMiddleware())
+	e.GET("/api/agenda", sessionHandler.Agenda, middleware.JWTMiddleware())
+}
+
+func RegisterAnnouncementRoute(e *echo.Echo, announcementHandler *handlers.AnnouncementHandler) {
+	e.GET("/api/events/:id/announcements", announcementHandler.Index, middleware.JWTOptionalMiddleware())
+	e.POST("/api/events/:id/announcements", announcementHandler.Create, middleware.JWTMiddleware())
+}
+
+func RegisterEventImportRoute(e *echo.Echo, eventImportHandler *handlers.EventImportHandler) {
+	e.POST("/api/events/import", eventImportHandler.Create, middleware.JWTMiddleware())
+	e.GET("/api/events/imports/:id", eventImportHandler.Show, middleware.JWTMiddleware())
+}
+
+func RegisterGroupRoute(e *echo.Echo, groupHandler *handlers.GroupHandler) {
+	e.GET("/api/groups", groupHandler.Index)
+	e.POST("/api/groups", groupHandler.Create, middleware.JWTMiddleware())
+	e.GET("/api/groups/:id", groupHandler.Show, middleware.JWTOptionalMiddleware())
+	e.PUT("/api/groups/:id", groupHandler.Update, middleware.JWTMiddleware())
+	e.DELETE("/api/groups/:id", groupHandler.Delete, middleware.JWTMiddleware())
+	e.GET("/api/groups/:id/members", groupHandler.Members, middleware.JWTOptionalMiddleware())
+	e.POST("/api/groups/:id/join", groupHandler.Join, middleware.JWTMiddleware())
+	e.DELETE("/api/groups/:id/leave", groupHandler.Leave, middleware.JWTMiddleware())
+	e.POST("/api/groups/:id/members/:userID/approve", groupHandler.Approve, middleware.JWTMiddleware())
+	e.DELETE("/api/groups/:id/members/:userID", groupHandler.RemoveMember, middleware.JWTMiddleware())
+	e.PUT("/api/groups/:id/members/:userID/role", groupHandler.SetRole, middleware.JWTMiddleware())
+	e.POST("/api/groups/:id/events", groupHandler.CreateEvent, middleware.JWTMiddleware())
+}
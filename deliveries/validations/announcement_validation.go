@@ -0,0 +1,50 @@
+package validations
+
+import (
+	"reflect"
+	"tupulung/entities"
+	"tupulung/entities/web"
+
+	"github.com/go-playground/validator/v10"
+)
+
+/*
+ * Announcement Validation - Error Message
+ * -------------------------------
+ * Kumpulan custom error message yang ditampilkan
+ * ke response berdasarkan struct field dan validate tagnya
+ */
+var announcementErrorMessages = map[string]string{
+	"Title|required": "title field must be filled",
+	"Title|max":      "title cannot be more than 191 characters",
+	"Body|required":  "body field must be filled",
+	"Body|max":       "body cannot be more than 5000 characters",
+}
+
+/*
+ * Announcement Validation - Validate Announcement Request
+ * -------------------------------
+ * Validasi judul dan isi pengumuman berdasarkan validate tag
+ */
+func ValidateAnnouncementRequest(validate *validator.Validate, announcementReq entities.AnnouncementRequest) error {
+	errors := []web.ValidationErrorItem{}
+	err := validate.Struct(announcementReq)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			field, _ := reflect.TypeOf(announcementReq).FieldByName(err.Field())
+			errors = append(errors, web.ValidationErrorItem{
+				Field: field.Tag.Get("form"),
+				Error: announcementErrorMessages[err.Field()+"|"+err.ActualTag()],
+			})
+		}
+	}
+
+	if len(errors) > 0 {
+		return web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors:  errors,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package validations
+
+import (
+	"reflect"
+	"tupulung/entities/web"
+
+	"github.com/go-playground/validator/v10"
+)
+
+/*
+ * Cohost Validation - Error Message
+ * -------------------------------
+ * Kumpulan custom error message yang ditampilkan
+ * ke response berdasarkan struct field dan validate tagnya
+ */
+var cohostErrorMessages = map[string]string{
+	"UserID|required": "user_id field must be filled",
+	"Role|required":   "role field must be filled",
+	"Role|oneof":      "role must be one of owner, editor, moderator",
+}
+
+/*
+ * Cohost Validation - Validate Cohost Request
+ * -------------------------------
+ * Validasi undangan co-host & transfer event
+ * berdasarkan validate tag yang ada pada request
+ */
+func ValidateCohostRequest(validate *validator.Validate, cohostReq interface{}) error {
+
+	errors := []web.ValidationErrorItem{}
+
+	err := validate.Struct(cohostReq)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			field, _ := reflect.TypeOf(cohostReq).FieldByName(err.Field())
+			errors = append(errors, web.ValidationErrorItem{
+				Field: field.Tag.Get("form"),
+				Error: cohostErrorMessages[err.Field()+"|"+err.ActualTag()],
+			})
+		}
+	}
+
+	if len(errors) > 0 {
+		return web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors:  errors,
+		}
+	}
+	return nil
+}
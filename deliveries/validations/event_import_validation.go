@@ -0,0 +1,77 @@
+package validations
+
+import (
+	"mime/multipart"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"tupulung/entities"
+	"tupulung/entities/web"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// Ukuran maksimal file import (5 MB)
+const eventImportMaxSize = 5 * 1024 * 1024
+
+/*
+ * Event Import Validation - Error Message
+ * -------------------------------
+ * Kumpulan custom error message yang ditampilkan
+ * ke response berdasarkan struct field dan validate tagnya
+ */
+var eventImportErrorMessages = map[string]string{
+	"Format|oneof": "format must be one of csv or ics",
+}
+
+/*
+ * Event Import Validation - Validate Event Import Request
+ * -------------------------------
+ * Validasi request import dan file yang diupload,
+ * file wajib ada dan berformat csv atau ics
+ */
+func ValidateEventImportRequest(validate *validator.Validate, importReq entities.EventImportRequest, file *multipart.FileHeader) error {
+
+	errors := []web.ValidationErrorItem{}
+
+	err := validate.Struct(importReq)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			field, _ := reflect.TypeOf(importReq).FieldByName(err.Field())
+			errors = append(errors, web.ValidationErrorItem{
+				Field: field.Tag.Get("form"),
+				Error: eventImportErrorMessages[err.Field()+"|"+err.ActualTag()],
+			})
+		}
+	}
+
+	if file == nil {
+		errors = append(errors, web.ValidationErrorItem{
+			Field: "file",
+			Error: "file field must be filled",
+		})
+	} else {
+		if file.Size > eventImportMaxSize {
+			errors = append(errors, web.ValidationErrorItem{
+				Field: "file",
+				Error: "file size cannot more than 5 MB",
+			})
+		}
+		fileExt := strings.ToLower(strings.TrimPrefix(filepath.Ext(file.Filename), "."))
+		if importReq.Format == "" && fileExt != "csv" && fileExt != "ics" {
+			errors = append(errors, web.ValidationErrorItem{
+				Field: "file",
+				Error: "file extension must be one of csv or ics",
+			})
+		}
+	}
+
+	if len(errors) > 0 {
+		return web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors:  errors,
+		}
+	}
+	return nil
+}
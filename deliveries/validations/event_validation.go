@@ -19,12 +19,31 @@ import (
  * ke response berdasarkan struct field dan validate tagnya
  */
 var eventErrorMessages = map[string]string{
-	"Title|required":         "Title field must be filled",
-	"HostedBy|required":      "HostedBy field must be filled",
-	"CategoryID|required":    "Category id field must be filled",
-	"DatetimeEvent|required": "DatetimeEvent field must be filled",
-	"Location|required":      "Location field must be filled",
-	"Description|required":   "Description field must be filled",
+	"Title|required":            "Title field must be filled",
+	"Slug|max":                  "Slug cannot be more than 80 characters",
+	"HostedBy|required":         "HostedBy field must be filled",
+	"CategoryID|required":       "Category id field must be filled",
+	"DatetimeEvent|required":    "DatetimeEvent field must be filled",
+	"DatetimeEventEnd|datetime": "datetime_event_end format must be YYYY-MM-DD",
+	"Location|required_unless":  "Location field must be filled for in-person and hybrid events",
+	"Mode|oneof":                "Mode must be one of in_person, online, hybrid",
+	"MeetingURL|url":            "Meeting url must be a valid URL",
+	"Description|required":      "Description field must be filled",
+	"Visibility|oneof":          "Visibility must be one of public, unlisted, private",
+}
+
+/*
+ * Event List Validation - Error Message
+ * -------------------------------
+ * Error message untuk query parameter list event
+ */
+var eventListErrorMessages = map[string]string{
+	"Date|oneof":        "date must be one of today, this_weekend, upcoming, past",
+	"DateFrom|datetime": "date_from format must be YYYY-MM-DD",
+	"DateTo|datetime":   "date_to format must be YYYY-MM-DD",
+	"Sort|oneof":        "sort must be one of date, newest, most_liked, most_joined, trending",
+	"Mode|oneof":        "mode must be one of in_person, online, hybrid",
+	"TagsMode|oneof":    "tags_mode must be one of any, all",
 }
 
 /*
@@ -35,6 +54,7 @@ var eventErrorMessages = map[string]string{
  */
 var eventFileSizeRules = map[string]int{
 	"cover": 2024 * 2024, // 2MB
+	"image": 2024 * 2024, // 2MB
 }
 
 /*
@@ -45,6 +65,7 @@ var eventFileSizeRules = map[string]int{
  */
 var eventFileExtRules = map[string]string{
 	"cover": "jpg|jpeg|png|webp|bmp",
+	"image": "jpg|jpeg|png|webp|bmp",
 }
 
 /*
@@ -58,6 +79,12 @@ func ValidateCreateEventRequest(validate *validator.Validate, eventReq entities.
 	errors := []web.ValidationErrorItem{}
 
 	validateEventStruct(validate, eventReq, &errors)
+	if eventReq.DatetimeEventEnd != "" && eventReq.DatetimeEventEnd < eventReq.DatetimeEvent {
+		errors = append(errors, web.ValidationErrorItem{
+			Field: "datetime_event_end",
+			Error: "datetime_event_end cannot be before datetime_event",
+		})
+	}
 	validateEventFiles(eventFiles, &errors)
 
 	if len(errors) > 0 {
@@ -74,12 +101,22 @@ func ValidateCreateEventRequest(validate *validator.Validate, eventReq entities.
  * Event Validation - Validate Update Event Request
  * -------------------------------
  * Validasi event saat edit event berdasarkan
- * file rules diatas
+ * file rules diatas dan field yang boleh diubah sebagian
  */
-func ValidateUpdateEventRequest(eventFiles []*multipart.FileHeader) error {
+func ValidateUpdateEventRequest(validate *validator.Validate, eventReq entities.EventRequest, eventFiles []*multipart.FileHeader) error {
 
 	errors := []web.ValidationErrorItem{}
 
+	err := validate.StructPartial(eventReq, "Visibility", "Mode", "MeetingURL", "DatetimeEventEnd")
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			field, _ := reflect.TypeOf(eventReq).FieldByName(err.Field())
+			errors = append(errors, web.ValidationErrorItem{
+				Field: field.Tag.Get("form"),
+				Error: eventErrorMessages[err.Field()+"|"+err.ActualTag()],
+			})
+		}
+	}
 	validateEventFiles(eventFiles, &errors)
 	if len(errors) > 0 {
 		return web.ValidationError{
@@ -91,6 +128,88 @@ func ValidateUpdateEventRequest(eventFiles []*multipart.FileHeader) error {
 	return nil
 }
 
+/*
+ * Event Validation - Validate Event Image Request
+ * -------------------------------
+ * Validasi upload gallery event, setiap gambar mengikuti
+ * file rules yang sama dengan cover
+ */
+func ValidateEventImageRequest(validate *validator.Validate, imageReq entities.EventImageRequest, imageFiles []*multipart.FileHeader) error {
+
+	errors := []web.ValidationErrorItem{}
+
+	err := validate.Struct(imageReq)
+	if err != nil {
+		errors = append(errors, web.ValidationErrorItem{
+			Field: "caption",
+			Error: "caption cannot be more than 255 characters",
+		})
+	}
+	if len(imageFiles) == 0 {
+		errors = append(errors, web.ValidationErrorItem{
+			Field: "image",
+			Error: "image field must be filled",
+		})
+	}
+	validateEventFiles(imageFiles, &errors)
+
+	if len(errors) > 0 {
+		return web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors:  errors,
+		}
+	}
+	return nil
+}
+
+/*
+ * Event Validation - Validate Event List Query
+ * -------------------------------
+ * Validasi query parameter filter dan sort pada list event
+ */
+func ValidateEventListQuery(validate *validator.Validate, query entities.EventListQuery) error {
+
+	errors := []web.ValidationErrorItem{}
+
+	err := validate.Struct(query)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			field, _ := reflect.TypeOf(query).FieldByName(err.Field())
+			errors = append(errors, web.ValidationErrorItem{
+				Field: field.Tag.Get("query"),
+				Error: eventListErrorMessages[err.Field()+"|"+err.ActualTag()],
+			})
+		}
+	}
+	if query.CategoryID != "" {
+		for _, id := range strings.Split(query.CategoryID, ",") {
+			if _, err := strconv.Atoi(strings.TrimSpace(id)); err != nil {
+				errors = append(errors, web.ValidationErrorItem{
+					Field: "category_id",
+					Error: "category_id must be a comma separated list of id",
+				})
+				break
+			}
+		}
+	}
+	if query.DateFrom != "" && query.DateTo != "" && query.DateFrom > query.DateTo {
+		errors = append(errors, web.ValidationErrorItem{
+			Field: "date_to",
+			Error: "date_to cannot be before date_from",
+		})
+	}
+
+	if len(errors) > 0 {
+		return web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors:  errors,
+		}
+	}
+	return nil
+}
+
 func validateEventStruct(validate *validator.Validate, eventReq entities.EventRequest, errors *[]web.ValidationErrorItem) {
 	err := validate.Struct(eventReq)
 	if err != nil {
@@ -0,0 +1,83 @@
+package validations
+
+import (
+	"mime/multipart"
+	"reflect"
+	"tupulung/entities"
+	"tupulung/entities/web"
+
+	"github.com/go-playground/validator/v10"
+)
+
+/*
+ * Group Validation - Error Message
+ * -------------------------------
+ * Kumpulan custom error message yang ditampilkan
+ * ke response berdasarkan struct field dan validate tagnya
+ */
+var groupErrorMessages = map[string]string{
+	"Name|required":    "name field must be filled",
+	"Name|max":         "name cannot be more than 191 characters",
+	"Description|max":  "description cannot be more than 5000 characters",
+	"JoinPolicy|oneof": "join_policy must be open or approval",
+	"Role|required":    "role field must be filled",
+	"Role|oneof":       "role must be organizer or member",
+}
+
+/*
+ * Group Validation - Validate Group Request
+ * -------------------------------
+ * Validasi profil group berdasarkan validate tag,
+ * cover divalidasi dengan aturan file cover event
+ */
+func ValidateGroupRequest(validate *validator.Validate, groupReq entities.GroupRequest, groupFiles []*multipart.FileHeader) error {
+	errors := []web.ValidationErrorItem{}
+	err := validate.Struct(groupReq)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			field, _ := reflect.TypeOf(groupReq).FieldByName(err.Field())
+			errors = append(errors, web.ValidationErrorItem{
+				Field: field.Tag.Get("form"),
+				Error: groupErrorMessages[err.Field()+"|"+err.ActualTag()],
+			})
+		}
+	}
+	validateEventFiles(groupFiles, &errors)
+
+	if len(errors) > 0 {
+		return web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors:  errors,
+		}
+	}
+	return nil
+}
+
+/*
+ * Group Validation - Validate Group Member Role Request
+ * -------------------------------
+ * Validasi perubahan role anggota group
+ */
+func ValidateGroupMemberRoleRequest(validate *validator.Validate, roleReq entities.GroupMemberRoleRequest) error {
+	errors := []web.ValidationErrorItem{}
+	err := validate.Struct(roleReq)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			field, _ := reflect.TypeOf(roleReq).FieldByName(err.Field())
+			errors = append(errors, web.ValidationErrorItem{
+				Field: field.Tag.Get("form"),
+				Error: groupErrorMessages[err.Field()+"|"+err.ActualTag()],
+			})
+		}
+	}
+
+	if len(errors) > 0 {
+		return web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors:  errors,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package validations
+
+import (
+	"reflect"
+	"tupulung/entities"
+	"tupulung/entities/web"
+
+	"github.com/go-playground/validator/v10"
+)
+
+/*
+ * Invite Validation - Error Message
+ * -------------------------------
+ * Kumpulan custom error message yang ditampilkan
+ * ke response berdasarkan struct field dan validate tagnya
+ */
+var inviteErrorMessages = map[string]string{
+	"ExpiresAt|datetime": "expires_at format must be YYYY-MM-DD HH:mm",
+}
+
+/*
+ * Invite Validation - Validate Create Invite Request
+ * -------------------------------
+ * Validasi invite saat dibuat berdasarkan validate tag
+ * yang ada pada invite request
+ */
+func ValidateCreateInviteRequest(validate *validator.Validate, inviteReq entities.EventInviteRequest) error {
+
+	errors := []web.ValidationErrorItem{}
+
+	err := validate.Struct(inviteReq)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			field, _ := reflect.TypeOf(inviteReq).FieldByName(err.Field())
+			errors = append(errors, web.ValidationErrorItem{
+				Field: field.Tag.Get("form"),
+				Error: inviteErrorMessages[err.Field()+"|"+err.ActualTag()],
+			})
+		}
+	}
+
+	if len(errors) > 0 {
+		return web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors:  errors,
+		}
+	}
+	return nil
+}
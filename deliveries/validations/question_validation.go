@@ -0,0 +1,84 @@
+package validations
+
+import (
+	"reflect"
+	"strings"
+	"tupulung/entities"
+	"tupulung/entities/web"
+
+	"github.com/go-playground/validator/v10"
+)
+
+/*
+ * Question Validation - Error Message
+ * -------------------------------
+ * Kumpulan custom error message yang ditampilkan
+ * ke response berdasarkan struct field dan validate tagnya
+ */
+var questionErrorMessages = map[string]string{
+	"Label|required": "label field must be filled",
+	"Label|max":      "label cannot be more than 255 characters",
+	"Type|required":  "type field must be filled",
+	"Type|oneof":     "type must be one of text, single_choice or multi_choice",
+	"Options|max":    "options cannot be more than 50 items",
+	"Position|min":   "position cannot be negative",
+}
+
+/*
+ * Question Validation - Validate Event Question Request
+ * -------------------------------
+ * Validasi pertanyaan registrasi berdasarkan validate tag,
+ * pertanyaan pilihan wajib memiliki minimal dua opsi yang berbeda
+ * sedangkan pertanyaan text tidak boleh memiliki opsi
+ */
+func ValidateEventQuestionRequest(validate *validator.Validate, questionReq entities.EventQuestionRequest) error {
+
+	errors := []web.ValidationErrorItem{}
+
+	err := validate.Struct(questionReq)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			field, _ := reflect.TypeOf(questionReq).FieldByName(err.Field())
+			errors = append(errors, web.ValidationErrorItem{
+				Field: field.Tag.Get("form"),
+				Error: questionErrorMessages[err.Field()+"|"+err.ActualTag()],
+			})
+		}
+	}
+
+	if questionReq.Type == "text" && len(questionReq.Options) > 0 {
+		errors = append(errors, web.ValidationErrorItem{
+			Field: "options",
+			Error: "text question cannot have options",
+		})
+	}
+	if questionReq.Type == "single_choice" || questionReq.Type == "multi_choice" {
+		seen := map[string]bool{}
+		for _, option := range questionReq.Options {
+			option = strings.TrimSpace(option)
+			if option == "" || seen[option] {
+				errors = append(errors, web.ValidationErrorItem{
+					Field: "options",
+					Error: "options must not be empty or duplicated",
+				})
+				break
+			}
+			seen[option] = true
+		}
+		if len(questionReq.Options) < 2 {
+			errors = append(errors, web.ValidationErrorItem{
+				Field: "options",
+				Error: "choice question must have at least 2 options",
+			})
+		}
+	}
+
+	if len(errors) > 0 {
+		return web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors:  errors,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package validations
+
+import (
+	"reflect"
+	"tupulung/entities"
+	"tupulung/entities/web"
+
+	"github.com/go-playground/validator/v10"
+)
+
+/*
+ * Report Validation - Error Message
+ * -------------------------------
+ * Kumpulan custom error message yang ditampilkan
+ * ke response berdasarkan struct field dan validate tagnya
+ */
+var reportErrorMessages = map[string]string{
+	"Reason|required": "reason field must be filled",
+	"Reason|oneof":    "reason must be one of spam, harassment, hate, scam, inappropriate or other",
+	"Details|max":     "details cannot be more than 2000 characters",
+	"Action|required": "action field must be filled",
+	"Action|oneof":    "action must be one of dismiss, hide, delete, warn or suspend",
+	"Note|max":        "note cannot be more than 1000 characters",
+	"Days|min":        "days must be at least 1",
+	"Days|max":        "days cannot be more than 365",
+}
+
+/*
+ * Report Validation - Validate Report Request
+ * -------------------------------
+ * Validasi report konten berdasarkan validate tag,
+ * alasan other wajib disertai penjelasan
+ */
+func ValidateReportRequest(validate *validator.Validate, reportReq entities.ReportRequest) error {
+
+	errors := []web.ValidationErrorItem{}
+
+	validateReportStruct(validate, reportReq, &errors)
+	if reportReq.Reason == "other" && reportReq.Details == "" {
+		errors = append(errors, web.ValidationErrorItem{
+			Field: "details",
+			Error: "details must be filled when reason is other",
+		})
+	}
+
+	if len(errors) > 0 {
+		return web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors:  errors,
+		}
+	}
+	return nil
+}
+
+/*
+ * Report Validation - Validate Moderation Action Request
+ * -------------------------------
+ * Validasi aksi moderator terhadap report berdasarkan validate tag
+ */
+func ValidateModerationActionRequest(validate *validator.Validate, actionReq entities.ModerationActionRequest) error {
+
+	errors := []web.ValidationErrorItem{}
+
+	validateReportStruct(validate, actionReq, &errors)
+
+	if len(errors) > 0 {
+		return web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors:  errors,
+		}
+	}
+	return nil
+}
+
+func validateReportStruct(validate *validator.Validate, request interface{}, errors *[]web.ValidationErrorItem) {
+	err := validate.Struct(request)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			field, _ := reflect.TypeOf(request).FieldByName(err.Field())
+			*errors = append(*errors, web.ValidationErrorItem{
+				Field: field.Tag.Get("form"),
+				Error: reportErrorMessages[err.Field()+"|"+err.ActualTag()],
+			})
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package validations
+
+import (
+	"reflect"
+	"tupulung/entities"
+	"tupulung/entities/web"
+
+	"github.com/go-playground/validator/v10"
+)
+
+/*
+ * Review Validation - Error Message
+ * -------------------------------
+ * Kumpulan custom error message yang ditampilkan
+ * ke response berdasarkan struct field dan validate tagnya
+ */
+var reviewErrorMessages = map[string]string{
+	"Rating|required": "rating field must be filled",
+	"Rating|min":      "rating must be between 1 and 5",
+	"Rating|max":      "rating must be between 1 and 5",
+	"Body|max":        "body cannot be more than 2000 characters",
+	"Reply|required":  "reply field must be filled",
+	"Reply|max":       "reply cannot be more than 2000 characters",
+}
+
+/*
+ * Review Validation - Validate Review Request
+ * -------------------------------
+ * Validasi rating dan isi review berdasarkan validate tag
+ */
+func ValidateReviewRequest(validate *validator.Validate, reviewReq entities.ReviewRequest) error {
+	return validateReviewStruct(validate, reviewReq)
+}
+
+/*
+ * Review Validation - Validate Review Reply Request
+ * -------------------------------
+ * Validasi balasan host terhadap review
+ */
+func ValidateReviewReplyRequest(validate *validator.Validate, replyReq entities.ReviewReplyRequest) error {
+	return validateReviewStruct(validate, replyReq)
+}
+
+func validateReviewStruct(validate *validator.Validate, request interface{}) error {
+	errors := []web.ValidationErrorItem{}
+	err := validate.Struct(request)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			field, _ := reflect.TypeOf(request).FieldByName(err.Field())
+			errors = append(errors, web.ValidationErrorItem{
+				Field: field.Tag.Get("form"),
+				Error: reviewErrorMessages[err.Field()+"|"+err.ActualTag()],
+			})
+		}
+	}
+
+	if len(errors) > 0 {
+		return web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors:  errors,
+		}
+	}
+	return nil
+}
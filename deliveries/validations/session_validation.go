@@ -0,0 +1,74 @@
+package validations
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"tupulung/entities"
+	"tupulung/entities/web"
+
+	"github.com/go-playground/validator/v10"
+)
+
+/*
+ * Session Validation - Error Message
+ * -------------------------------
+ * Kumpulan custom error message yang ditampilkan
+ * ke response berdasarkan struct field dan validate tagnya
+ */
+var sessionErrorMessages = map[string]string{
+	"Title|required":    "title field must be filled",
+	"Title|max":         "title cannot be more than 191 characters",
+	"Room|max":          "room cannot be more than 100 characters",
+	"StartsAt|required": "starts_at field must be filled",
+	"StartsAt|datetime": "starts_at must use format YYYY-MM-DD HH:MM",
+	"EndsAt|required":   "ends_at field must be filled",
+	"EndsAt|datetime":   "ends_at must use format YYYY-MM-DD HH:MM",
+	"Speakers|max":      "speakers cannot be more than 20 items",
+}
+
+/*
+ * Session Validation - Validate Event Session Request
+ * -------------------------------
+ * Validasi sesi agenda berdasarkan validate tag,
+ * setiap pembicara wajib memiliki user_id atau nama
+ */
+func ValidateEventSessionRequest(validate *validator.Validate, sessionReq entities.EventSessionRequest) error {
+
+	errors := []web.ValidationErrorItem{}
+
+	err := validate.Struct(sessionReq)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			field, _ := reflect.TypeOf(sessionReq).FieldByName(err.Field())
+			errors = append(errors, web.ValidationErrorItem{
+				Field: field.Tag.Get("form"),
+				Error: sessionErrorMessages[err.Field()+"|"+err.ActualTag()],
+			})
+		}
+	}
+
+	for i, speaker := range sessionReq.Speakers {
+		name := strings.TrimSpace(speaker.Name)
+		if speaker.UserID == 0 && name == "" {
+			errors = append(errors, web.ValidationErrorItem{
+				Field: "speakers[" + strconv.Itoa(i) + "]",
+				Error: "speaker must have a user_id or a name",
+			})
+		} else if len(name) > 100 {
+			errors = append(errors, web.ValidationErrorItem{
+				Field: "speakers[" + strconv.Itoa(i) + "].name",
+				Error: "speaker name cannot be more than 100 characters",
+			})
+		}
+	}
+
+	if len(errors) > 0 {
+		return web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors:  errors,
+		}
+	}
+	return nil
+}
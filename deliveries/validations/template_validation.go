@@ -0,0 +1,53 @@
+package validations
+
+import (
+	"reflect"
+	"tupulung/entities"
+	"tupulung/entities/web"
+
+	"github.com/go-playground/validator/v10"
+)
+
+/*
+ * Template Validation - Error Message
+ * -------------------------------
+ * Kumpulan custom error message yang ditampilkan
+ * ke response berdasarkan struct field dan validate tagnya
+ */
+var templateErrorMessages = map[string]string{
+	"Name|required":    "name field must be filled",
+	"Name|max":         "name cannot be more than 100 characters",
+	"Visibility|oneof": "visibility must be one of public, unlisted or private",
+}
+
+/*
+ * Template Validation - Validate Event Template Request
+ * -------------------------------
+ * Validasi template event berdasarkan validate tag,
+ * field event lainnya boleh kosong karena akan dilengkapi
+ * saat membuat event dari template
+ */
+func ValidateEventTemplateRequest(validate *validator.Validate, templateReq entities.EventTemplateRequest) error {
+
+	errors := []web.ValidationErrorItem{}
+
+	err := validate.Struct(templateReq)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			field, _ := reflect.TypeOf(templateReq).FieldByName(err.Field())
+			errors = append(errors, web.ValidationErrorItem{
+				Field: field.Tag.Get("form"),
+				Error: templateErrorMessages[err.Field()+"|"+err.ActualTag()],
+			})
+		}
+	}
+
+	if len(errors) > 0 {
+		return web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors:  errors,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package validations
+
+import (
+	"reflect"
+	"tupulung/entities"
+	"tupulung/entities/web"
+
+	"github.com/go-playground/validator/v10"
+)
+
+/*
+ * Ticket Validation - Error Message
+ * -------------------------------
+ * Kumpulan custom error message yang ditampilkan
+ * ke response berdasarkan struct field dan validate tagnya
+ */
+var ticketErrorMessages = map[string]string{
+	"Name|required":         "name field must be filled",
+	"Price|min":             "price cannot be negative",
+	"Currency|required":     "currency field must be filled",
+	"Currency|len":          "currency must be a 3 letter ISO 4217 code",
+	"Currency|alpha":        "currency must be a 3 letter ISO 4217 code",
+	"Quantity|required":     "quantity field must be filled",
+	"Quantity|min":          "quantity must be at least 1",
+	"Quantity|max":          "quantity cannot be more than 10 per order",
+	"SalesStart|datetime":   "sales_start format must be YYYY-MM-DD HH:mm",
+	"SalesEnd|datetime":     "sales_end format must be YYYY-MM-DD HH:mm",
+	"TicketTypeID|required": "ticket_type_id field must be filled",
+}
+
+/*
+ * Ticket Validation - Validate Ticket Type Request
+ * -------------------------------
+ * Validasi tipe tiket berdasarkan validate tag
+ * dan urutan sales window
+ */
+func ValidateTicketTypeRequest(validate *validator.Validate, ticketTypeReq entities.TicketTypeRequest) error {
+
+	errors := []web.ValidationErrorItem{}
+
+	validateTicketStruct(validate, ticketTypeReq, &errors)
+	if ticketTypeReq.SalesStart != "" && ticketTypeReq.SalesEnd != "" && ticketTypeReq.SalesStart >= ticketTypeReq.SalesEnd {
+		errors = append(errors, web.ValidationErrorItem{
+			Field: "sales_end",
+			Error: "sales_end must be after sales_start",
+		})
+	}
+
+	if len(errors) > 0 {
+		return web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors:  errors,
+		}
+	}
+	return nil
+}
+
+/*
+ * Ticket Validation - Validate Order Request
+ * -------------------------------
+ * Validasi order tiket berdasarkan validate tag
+ */
+func ValidateOrderRequest(validate *validator.Validate, orderReq entities.OrderRequest) error {
+
+	errors := []web.ValidationErrorItem{}
+
+	validateTicketStruct(validate, orderReq, &errors)
+
+	if len(errors) > 0 {
+		return web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors:  errors,
+		}
+	}
+	return nil
+}
+
+func validateTicketStruct(validate *validator.Validate, request interface{}, errors *[]web.ValidationErrorItem) {
+	err := validate.Struct(request)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			field, _ := reflect.TypeOf(request).FieldByName(err.Field())
+			*errors = append(*errors, web.ValidationErrorItem{
+				Field: field.Tag.Get("form"),
+				Error: ticketErrorMessages[err.Field()+"|"+err.ActualTag()],
+			})
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package entities
+
+import "time"
+
+/*
+ * Announcement
+ * -------------------------------
+ * Pengumuman host untuk participant event, dikirim
+ * di background lalu ditandai DeliveredAt
+ */
+type Announcement struct {
+	ID          uint   `gorm:"primaryKey"`
+	EventID     uint   `gorm:"index:idx_announcement_event_created"`
+	UserID      uint   `gorm:"index"`
+	Title       string `gorm:"size:191"`
+	Body        string `gorm:"type:text"`
+	Recipients  int64
+	DeliveredAt *time.Time `gorm:"index"`
+	CreatedAt   time.Time  `gorm:"index:idx_announcement_event_created"`
+	User        User       `gorm:"foreignKey:UserID;references:ID"`
+	Event       Event      `gorm:"foreignKey:EventID;references:ID"`
+}
+
+/*
+ * Announcement Email
+ * -------------------------------
+ * Antrian email pengumuman, disimpan bersama notifikasi saat
+ * pengumuman dikirim lalu dikirim terpisah ke setiap penerima
+ */
+type AnnouncementEmail struct {
+	ID             uint   `gorm:"primaryKey"`
+	AnnouncementID uint   `gorm:"index"`
+	UserID         uint   `gorm:"index"`
+	Email          string `gorm:"size:191"`
+	Subject        string `gorm:"size:255"`
+	Body           string `gorm:"type:text"`
+	Attempts       int
+	SentAt         *time.Time `gorm:"index"`
+	CreatedAt      time.Time
+}
+
+type AnnouncementRequest struct {
+	Title string `json:"title" form:"title" validate:"required,max=191"`
+	Body  string `json:"body" form:"body" validate:"required,max=5000"`
+}
+
+type AnnouncementResponse struct {
+	ID          uint         `json:"id"`
+	EventID     uint         `json:"event_id"`
+	UserID      uint         `json:"user_id"`
+	User        UserResponse `json:"user"`
+	Title       string       `json:"title"`
+	Body        string       `json:"body"`
+	Recipients  int64        `json:"recipients"`
+	DeliveredAt *time.Time   `json:"delivered_at"`
+	CreatedAt   time.Time    `json:"created_at"`
+}
@@ -1,8 +1,8 @@
 package entities
 
 type AuthResponse struct {
-	Token string       `json:"token"`
-	User  UserResponse `json:"user"`
+	Token string              `json:"token"`
+	User  UserProfileResponse `json:"user"`
 }
 
 type AuthRequest struct {
@@ -0,0 +1,38 @@
+package entities
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type EventCohost struct {
+	gorm.Model
+	EventID   uint   `gorm:"uniqueIndex:idx_event_cohost"`
+	UserID    uint   `gorm:"uniqueIndex:idx_event_cohost"`
+	Role      string `gorm:"size:16"`
+	Status    string `gorm:"size:16;default:pending"`
+	InvitedBy uint
+	User      User  `gorm:"foreignKey:UserID;references:ID"`
+	Event     Event `gorm:"foreignKey:EventID;references:ID"`
+}
+
+type EventCohostRequest struct {
+	UserID uint   `form:"user_id" validate:"required"`
+	Role   string `form:"role" validate:"required,oneof=owner editor moderator"`
+}
+
+type EventTransferRequest struct {
+	UserID uint `form:"user_id" validate:"required"`
+}
+
+type EventCohostResponse struct {
+	ID        uint         `json:"id"`
+	EventID   uint         `json:"event_id"`
+	UserID    uint         `json:"user_id"`
+	User      UserResponse `json:"user"`
+	Role      string       `json:"role"`
+	Status    string       `json:"status"`
+	InvitedBy uint         `json:"invited_by"`
+	CreatedAt time.Time    `json:"created_at"`
+}
@@ -11,6 +11,7 @@ type Comment struct {
 	EventID uint
 	UserID uint
 	Comment string
+	HiddenAt *time.Time
 	Event Event `gorm:"foreignKey:EventID;references:ID"`
 	User User `gorm:"foreignKey:UserID;references:ID"`
 }
@@ -8,44 +8,119 @@ import (
 
 type Event struct {
 	gorm.Model
-	Title         string
-	HostedBy      string
-	Cover         string
-	UserID        uint
-	CategoryID    uint
-	DatetimeEvent time.Time
-	Location      string
-	Description   string
-	User          User      `gorm:"foreignKey:UserID;references:ID"`
-	Category      Category  `gorm:"foreignKey:CategoryID;references:ID"`
-	Participants  []User    `gorm:"many2many:participants;foreignKey:ID;joinForeignKey:EventID;References:ID;joinReferences:UserID"`
-	Comments      []Comment `gorm:"foreignKey:EventID;references:ID"`
+	Title            string
+	Slug             *string `gorm:"size:191;uniqueIndex"`
+	HostedBy         string
+	Cover            string
+	UserID           uint
+	CategoryID       uint
+	DatetimeEvent    time.Time
+	DatetimeEventEnd *time.Time
+	Location         string
+	Mode             string `gorm:"size:16;default:in_person"`
+	MeetingURL       string
+	DialIn           string `gorm:"type:text"`
+	HideAddress      bool
+	Description      string
+	Capacity         uint
+	Visibility       string `gorm:"size:16;default:public"`
+	HiddenAt         *time.Time
+	GroupID          *uint         `gorm:"index"`
+	User             User          `gorm:"foreignKey:UserID;references:ID"`
+	Category         Category      `gorm:"foreignKey:CategoryID;references:ID"`
+	Participants     []User        `gorm:"many2many:participants;foreignKey:ID;joinForeignKey:EventID;References:ID;joinReferences:UserID"`
+	RSVPs            []Participant `gorm:"foreignKey:EventID;references:ID"`
+	Comments         []Comment     `gorm:"foreignKey:EventID;references:ID"`
+	Cohosts          []EventCohost `gorm:"foreignKey:EventID;references:ID"`
+	TicketTypes      []TicketType  `gorm:"foreignKey:EventID;references:ID"`
+	Tags             []Tag         `gorm:"many2many:event_tags"`
+	Images           []EventImage  `gorm:"foreignKey:EventID;references:ID"`
+	Trending         *EventScore   `gorm:"foreignKey:EventID;references:ID"`
+	Rating           *EventRating  `gorm:"foreignKey:EventID;references:ID"`
+	Group            *Group        `gorm:"foreignKey:GroupID;references:ID"`
+}
+
+// Waktu berakhirnya event, yaitu akhir hari terakhir event
+func (event Event) EndsAt() time.Time {
+	last := event.DatetimeEvent
+	if event.DatetimeEventEnd != nil {
+		last = *event.DatetimeEventEnd
+	}
+	return last.AddDate(0, 0, 1)
 }
 
 type EventRequest struct {
-	Title         string `form:"title" validate:"required"`
-	HostedBy      string `form:"hosted_by" validate:"required"`
-	Cover         string `form:"cover"`
-	DatetimeEvent string `form:"datetime_event" validate:"required"`
-	CategoryID    uint   `form:"category_id" validate:"required"`
-	Location      string `form:"location" validate:"required"`
-	Description   string `form:"description" validate:"required"`
+	Title            string   `form:"title" validate:"required"`
+	Slug             string   `form:"slug" validate:"omitempty,max=80"`
+	HostedBy         string   `form:"hosted_by" validate:"required"`
+	Cover            string   `form:"cover"`
+	DatetimeEvent    string   `form:"datetime_event" validate:"required"`
+	DatetimeEventEnd string   `form:"datetime_event_end" validate:"omitempty,datetime=2006-01-02"`
+	CategoryID       uint     `form:"category_id" validate:"required"`
+	Location         string   `form:"location" validate:"required_unless=Mode online"`
+	Mode             string   `form:"mode" validate:"omitempty,oneof=in_person online hybrid"`
+	MeetingURL       string   `form:"meeting_url" validate:"omitempty,url"`
+	DialIn           string   `form:"dial_in"`
+	HideAddress      *bool    `form:"hide_address"`
+	Description      string   `form:"description" validate:"required"`
+	Capacity         uint     `form:"capacity"`
+	Visibility       string   `form:"visibility" validate:"omitempty,oneof=public unlisted private"`
+	Tags             []string `form:"tags"`
 }
 
 type EventResponse struct {
-	ID            uint             `json:"id"`
-	Title         string           `json:"title"`
-	HostedBy      string           `json:"hosted_by"`
-	Cover         string           `json:"cover"`
-	DatetimeEvent time.Time        `json:"datetime_event"`
-	Location      string           `json:"location"`
-	Description   string           `json:"description"`
-	CategoryID    uint             `json:"category_id"`
-	Category      CategoryResponse `json:"category"`
-	UserID        uint             `json:"user_id"`
-	User          UserResponse     `json:"user"`
-	Likes         uint             `json:"likes"`
-	Participants  []UserResponse   `json:"participants"`
-	CreatedAt     time.Time        `json:"created_at"`
-	UpdatedAt     time.Time        `json:"updated_at"`
+	ID               uint                   `json:"id"`
+	Title            string                 `json:"title"`
+	Slug             string                 `json:"slug"`
+	HostedBy         string                 `json:"hosted_by"`
+	Cover            string                 `json:"cover"`
+	DatetimeEvent    time.Time              `json:"datetime_event"`
+	DatetimeEventEnd *time.Time             `json:"datetime_event_end"`
+	Location         string                 `json:"location"`
+	Mode             string                 `json:"mode"`
+	MeetingURL       string                 `json:"meeting_url"`
+	DialIn           string                 `json:"dial_in"`
+	HideAddress      bool                   `json:"hide_address"`
+	Redacted         bool                   `json:"redacted"`
+	Description      string                 `json:"description"`
+	Capacity         uint                   `json:"capacity"`
+	Visibility       string                 `json:"visibility"`
+	HiddenAt         *time.Time             `json:"hidden_at,omitempty"`
+	CategoryID       uint                   `json:"category_id"`
+	Category         CategoryResponse       `json:"category"`
+	UserID           uint                   `json:"user_id"`
+	User             UserResponse           `json:"user"`
+	Likes            uint                   `json:"likes"`
+	Rating           *RatingSummaryResponse `json:"rating,omitempty"`
+	GroupID          *uint                  `json:"group_id"`
+	Group            *GroupSummaryResponse  `json:"group,omitempty"`
+	Participants     []UserResponse         `json:"participants"`
+	RSVP             RSVPCountResponse      `json:"rsvp"`
+	Cohosts          []EventCohostResponse  `json:"cohosts"`
+	TicketTypes      []TicketTypeResponse   `json:"ticket_types"`
+	Tags             []TagResponse          `json:"tags"`
+	Images           []EventImageResponse   `json:"images"`
+	Score            float64                `json:"score,omitempty"`
+	TrendingScore    float64                `json:"trending_score,omitempty"`
+	Reason           string                 `json:"reason,omitempty"`
+	Highlights       map[string][]string    `json:"highlights,omitempty"`
+	CreatedAt        time.Time              `json:"created_at"`
+	UpdatedAt        time.Time              `json:"updated_at"`
+}
+
+type EventListQuery struct {
+	Q            string `query:"q"`
+	CategoryID   string `query:"category_id"`
+	HostID       uint   `query:"host_id"`
+	GroupID      uint   `query:"group_id"`
+	Location     string `query:"location"`
+	Mode         string `query:"mode" validate:"omitempty,oneof=in_person online hybrid"`
+	Date         string `query:"date" validate:"omitempty,oneof=today this_weekend upcoming past"`
+	DateFrom     string `query:"date_from" validate:"omitempty,datetime=2006-01-02"`
+	DateTo       string `query:"date_to" validate:"omitempty,datetime=2006-01-02"`
+	HasFreeSeats bool   `query:"has_free_seats"`
+	MinLikes     uint   `query:"min_likes"`
+	Tags         string `query:"tags"`
+	TagsMode     string `query:"tags_mode" validate:"omitempty,oneof=any all"`
+	Sort         string `query:"sort" validate:"omitempty,oneof=date newest most_liked most_joined trending"`
 }
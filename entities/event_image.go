@@ -0,0 +1,32 @@
+package entities
+
+import "time"
+
+type EventImage struct {
+	ID        uint `gorm:"primaryKey"`
+	EventID   uint `gorm:"index"`
+	URL       string
+	Caption   string `gorm:"size:255"`
+	Position  int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Event     Event `gorm:"foreignKey:EventID;references:ID"`
+}
+
+type EventImageRequest struct {
+	Caption string `form:"caption" validate:"max=255"`
+}
+
+type EventImageOrderRequest struct {
+	IDs []uint `json:"ids" form:"ids" validate:"required,min=1"`
+}
+
+type EventImageResponse struct {
+	ID        uint      `json:"id"`
+	EventID   uint      `json:"event_id"`
+	URL       string    `json:"url"`
+	Caption   string    `json:"caption"`
+	Position  int       `json:"position"`
+	IsCover   bool      `json:"is_cover"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,82 @@
+package entities
+
+import "time"
+
+/*
+ * Event Import
+ * -------------------------------
+ * Import event dari file CSV atau ICS. Baris file disimpan di
+ * Payload lalu dibuat menjadi event secara bertahap di background.
+ * Processed menunjukkan jumlah baris yang sudah diklaim, Recorded
+ * jumlah baris yang hasilnya sudah dicatat
+ */
+type EventImport struct {
+	ID         uint   `gorm:"primaryKey"`
+	UserID     uint   `gorm:"index"`
+	Format     string `gorm:"size:8"`
+	Filename   string
+	Status     string `gorm:"size:16;default:pending;index"`
+	Total      int
+	Processed  int
+	Recorded   int
+	Created    int
+	Failed     int
+	Payload    string `gorm:"type:longtext"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	ClaimedAt  *time.Time
+	FinishedAt *time.Time
+}
+
+type EventImportError struct {
+	ID            uint   `gorm:"primaryKey"`
+	EventImportID uint   `gorm:"index"`
+	Row           int    `gorm:"column:row_no"`
+	Field         string `gorm:"size:64"`
+	Error         string `gorm:"type:text"`
+}
+
+// Satu baris import, baris yang tidak valid tidak memiliki Request
+type EventImportRow struct {
+	Row     int
+	Title   string
+	Request *EventRequest
+}
+
+type EventImportRequest struct {
+	Format     string `form:"format" validate:"omitempty,oneof=csv ics"`
+	Mapping    string `form:"mapping"`
+	DryRun     bool   `form:"dry_run"`
+	HostedBy   string `form:"hosted_by"`
+	CategoryID uint   `form:"category_id"`
+	Mode       string `form:"mode"`
+	Visibility string `form:"visibility"`
+}
+
+type EventImportErrorResponse struct {
+	Row   int    `json:"row"`
+	Field string `json:"field"`
+	Error string `json:"error"`
+}
+
+type EventImportReportResponse struct {
+	Total   int                        `json:"total"`
+	Valid   int                        `json:"valid"`
+	Invalid int                        `json:"invalid"`
+	Errors  []EventImportErrorResponse `json:"errors"`
+}
+
+type EventImportResponse struct {
+	ID         uint                       `json:"id"`
+	Format     string                     `json:"format"`
+	Filename   string                     `json:"filename"`
+	Status     string                     `json:"status"`
+	Total      int                        `json:"total"`
+	Processed  int                        `json:"processed"`
+	Created    int                        `json:"created"`
+	Failed     int                        `json:"failed"`
+	Progress   float64                    `json:"progress"`
+	Errors     []EventImportErrorResponse `json:"errors"`
+	CreatedAt  time.Time                  `json:"created_at"`
+	FinishedAt *time.Time                 `json:"finished_at"`
+}
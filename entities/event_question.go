@@ -0,0 +1,73 @@
+package entities
+
+import "time"
+
+type EventQuestion struct {
+	ID        uint   `gorm:"primaryKey"`
+	EventID   uint   `gorm:"index"`
+	Label     string `gorm:"size:255"`
+	Type      string `gorm:"size:16"`
+	Options   string `gorm:"type:text"`
+	Required  bool
+	Position  int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Event     Event `gorm:"foreignKey:EventID;references:ID"`
+}
+
+type EventAnswer struct {
+	ID         uint   `gorm:"primaryKey"`
+	EventID    uint   `gorm:"index"`
+	QuestionID uint   `gorm:"uniqueIndex:idx_question_user"`
+	UserID     uint   `gorm:"uniqueIndex:idx_question_user"`
+	Value      string `gorm:"type:text"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	User       User `gorm:"foreignKey:UserID;references:ID"`
+}
+
+type EventQuestionRequest struct {
+	Label    string   `json:"label" form:"label" validate:"required,max=255"`
+	Type     string   `json:"type" form:"type" validate:"required,oneof=text single_choice multi_choice"`
+	Options  []string `json:"options" form:"options" validate:"max=50"`
+	Required bool     `json:"required" form:"required"`
+	Position int      `json:"position" form:"position" validate:"min=0"`
+}
+
+type EventAnswerRequest struct {
+	QuestionID uint     `json:"question_id" form:"question_id"`
+	Value      string   `json:"value" form:"value"`
+	Values     []string `json:"values" form:"values"`
+}
+
+type EventQuestionResponse struct {
+	ID       uint     `json:"id"`
+	EventID  uint     `json:"event_id"`
+	Label    string   `json:"label"`
+	Type     string   `json:"type"`
+	Options  []string `json:"options"`
+	Required bool     `json:"required"`
+	Position int      `json:"position"`
+}
+
+type EventAnswerResponse struct {
+	QuestionID uint     `json:"question_id"`
+	Label      string   `json:"label"`
+	Type       string   `json:"type"`
+	Values     []string `json:"values"`
+}
+
+type ParticipantAnswersResponse struct {
+	UserID  uint                  `json:"user_id"`
+	User    UserResponse          `json:"user"`
+	Answers []EventAnswerResponse `json:"answers"`
+}
+
+type EventQuestionSummaryResponse struct {
+	QuestionID uint           `json:"question_id"`
+	Label      string         `json:"label"`
+	Type       string         `json:"type"`
+	Responses  int            `json:"responses"`
+	Options    map[string]int `json:"options,omitempty"`
+	Answers    []string       `json:"answers,omitempty"`
+}
@@ -0,0 +1,34 @@
+package entities
+
+import "time"
+
+type EventRevision struct {
+	ID        uint `gorm:"primaryKey"`
+	EventID   uint `gorm:"index"`
+	UserID    uint
+	Changes   string    `gorm:"type:text"`
+	CreatedAt time.Time `gorm:"index"`
+	User      User      `gorm:"foreignKey:UserID;references:ID"`
+}
+
+type EventFieldChange struct {
+	Field string `json:"field"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
+
+type EventRevisionResponse struct {
+	ID        uint               `json:"id"`
+	EventID   uint               `json:"event_id"`
+	UserID    uint               `json:"user_id"`
+	User      UserResponse       `json:"user"`
+	Changes   []EventFieldChange `json:"changes"`
+	CreatedAt time.Time          `json:"created_at"`
+}
+
+type EventChangesResponse struct {
+	EventID   uint               `json:"event_id"`
+	JoinedAt  time.Time          `json:"joined_at"`
+	Revisions int                `json:"revisions"`
+	Changes   []EventFieldChange `json:"changes"`
+}
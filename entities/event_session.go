@@ -0,0 +1,81 @@
+package entities
+
+import "time"
+
+/*
+ * Event Session
+ * -------------------------------
+ * Sesi pada agenda event, waktu mulai dan selesai
+ * harus berada di dalam rentang waktu event
+ */
+type EventSession struct {
+	ID          uint   `gorm:"primaryKey"`
+	EventID     uint   `gorm:"index"`
+	Title       string `gorm:"size:191"`
+	Description string `gorm:"type:text"`
+	Room        string `gorm:"size:100"`
+	StartsAt    time.Time
+	EndsAt      time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Speakers    []SessionSpeaker `gorm:"foreignKey:SessionID;references:ID"`
+}
+
+/*
+ * Session Speaker
+ * -------------------------------
+ * Pembicara sebuah sesi, bisa user Tupulung
+ * atau nama bebas untuk pembicara tanpa akun
+ */
+type SessionSpeaker struct {
+	ID        uint   `gorm:"primaryKey"`
+	SessionID uint   `gorm:"index"`
+	UserID    *uint  `gorm:"index"`
+	Name      string `gorm:"size:100"`
+	Position  int
+	User      *User `gorm:"foreignKey:UserID;references:ID"`
+}
+
+/*
+ * Agenda Item
+ * -------------------------------
+ * Sesi yang dipilih participant untuk agenda pribadinya
+ */
+type AgendaItem struct {
+	ID        uint `gorm:"primaryKey"`
+	SessionID uint `gorm:"uniqueIndex:idx_agenda_session_user"`
+	UserID    uint `gorm:"uniqueIndex:idx_agenda_session_user;index"`
+	CreatedAt time.Time
+}
+
+type EventSessionRequest struct {
+	Title       string                  `json:"title" form:"title" validate:"required,max=191"`
+	Description string                  `json:"description" form:"description"`
+	Room        string                  `json:"room" form:"room" validate:"max=100"`
+	StartsAt    string                  `json:"starts_at" form:"starts_at" validate:"required,datetime=2006-01-02 15:04"`
+	EndsAt      string                  `json:"ends_at" form:"ends_at" validate:"required,datetime=2006-01-02 15:04"`
+	Speakers    []SessionSpeakerRequest `json:"speakers" form:"speakers" validate:"max=20"`
+}
+
+type SessionSpeakerRequest struct {
+	UserID uint   `json:"user_id" form:"user_id"`
+	Name   string `json:"name" form:"name"`
+}
+
+type EventSessionResponse struct {
+	ID          uint                     `json:"id"`
+	EventID     uint                     `json:"event_id"`
+	Title       string                   `json:"title"`
+	Description string                   `json:"description"`
+	Room        string                   `json:"room"`
+	StartsAt    time.Time                `json:"starts_at"`
+	EndsAt      time.Time                `json:"ends_at"`
+	Speakers    []SessionSpeakerResponse `json:"speakers"`
+	InAgenda    bool                     `json:"in_agenda"`
+}
+
+type SessionSpeakerResponse struct {
+	UserID *uint         `json:"user_id"`
+	Name   string        `json:"name"`
+	User   *UserResponse `json:"user,omitempty"`
+}
@@ -0,0 +1,10 @@
+package entities
+
+import "time"
+
+type EventSlugRedirect struct {
+	ID        uint   `gorm:"primaryKey"`
+	Slug      string `gorm:"size:191;uniqueIndex"`
+	EventID   uint   `gorm:"index"`
+	CreatedAt time.Time
+}
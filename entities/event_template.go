@@ -0,0 +1,54 @@
+package entities
+
+import "time"
+
+type EventTemplate struct {
+	ID          uint   `gorm:"primaryKey"`
+	UserID      uint   `gorm:"index"`
+	Name        string `gorm:"size:100"`
+	Title       string
+	HostedBy    string
+	CategoryID  uint
+	Location    string
+	Description string
+	Capacity    uint
+	Visibility  string `gorm:"size:16"`
+	Tags        string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	User        User `gorm:"foreignKey:UserID;references:ID"`
+}
+
+type EventTemplateRequest struct {
+	Name        string   `json:"name" form:"name" validate:"required,max=100"`
+	Title       string   `json:"title" form:"title"`
+	HostedBy    string   `json:"hosted_by" form:"hosted_by"`
+	CategoryID  uint     `json:"category_id" form:"category_id"`
+	Location    string   `json:"location" form:"location"`
+	Description string   `json:"description" form:"description"`
+	Capacity    uint     `json:"capacity" form:"capacity"`
+	Visibility  string   `json:"visibility" form:"visibility" validate:"omitempty,oneof=public unlisted private"`
+	Tags        []string `json:"tags" form:"tags"`
+}
+
+type EventTemplateResponse struct {
+	ID          uint      `json:"id"`
+	UserID      uint      `json:"user_id"`
+	Name        string    `json:"name"`
+	Title       string    `json:"title"`
+	HostedBy    string    `json:"hosted_by"`
+	CategoryID  uint      `json:"category_id"`
+	Location    string    `json:"location"`
+	Description string    `json:"description"`
+	Capacity    uint      `json:"capacity"`
+	Visibility  string    `json:"visibility"`
+	Tags        []string  `json:"tags"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type EventDuplicateRequest struct {
+	DatetimeEvent string `json:"datetime_event" form:"datetime_event"`
+	Title         string `json:"title" form:"title"`
+	ReuseCover    bool   `json:"reuse_cover" form:"reuse_cover"`
+}
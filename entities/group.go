@@ -0,0 +1,80 @@
+package entities
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+/*
+ * Group
+ * -------------------------------
+ * Komunitas yang menyelenggarakan event, event milik group
+ * memakai nama group sebagai HostedBy
+ */
+type Group struct {
+	gorm.Model
+	Name        string `gorm:"size:191"`
+	Description string `gorm:"type:text"`
+	Cover       string
+	JoinPolicy  string `gorm:"size:16;default:approval"`
+	UserID      uint   `gorm:"index"`
+	User        User   `gorm:"foreignKey:UserID;references:ID"`
+}
+
+/*
+ * Group Member
+ * -------------------------------
+ * Anggota group dengan role organizer atau member,
+ * permintaan bergabung disimpan dengan status pending
+ */
+type GroupMember struct {
+	ID        uint   `gorm:"primaryKey"`
+	GroupID   uint   `gorm:"uniqueIndex:idx_group_member"`
+	UserID    uint   `gorm:"uniqueIndex:idx_group_member;index"`
+	Role      string `gorm:"size:16;default:member"`
+	Status    string `gorm:"size:16;default:pending;index"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	User      User `gorm:"foreignKey:UserID;references:ID"`
+}
+
+type GroupRequest struct {
+	Name        string `form:"name" validate:"required,max=191"`
+	Description string `form:"description" validate:"max=5000"`
+	Cover       string `form:"cover"`
+	JoinPolicy  string `form:"join_policy" validate:"omitempty,oneof=open approval"`
+}
+
+type GroupMemberRoleRequest struct {
+	Role string `json:"role" form:"role" validate:"required,oneof=organizer member"`
+}
+
+type GroupResponse struct {
+	ID          uint                 `json:"id"`
+	Name        string               `json:"name"`
+	Description string               `json:"description"`
+	Cover       string               `json:"cover"`
+	JoinPolicy  string               `json:"join_policy"`
+	UserID      uint                 `json:"user_id"`
+	User        UserResponse         `json:"user"`
+	Members     int64                `json:"members"`
+	Membership  *GroupMemberResponse `json:"membership,omitempty"`
+	CreatedAt   time.Time            `json:"created_at"`
+	UpdatedAt   time.Time            `json:"updated_at"`
+}
+
+type GroupSummaryResponse struct {
+	ID    uint   `json:"id"`
+	Name  string `json:"name"`
+	Cover string `json:"cover"`
+}
+
+type GroupMemberResponse struct {
+	GroupID   uint         `json:"group_id"`
+	UserID    uint         `json:"user_id"`
+	User      UserResponse `json:"user"`
+	Role      string       `json:"role"`
+	Status    string       `json:"status"`
+	CreatedAt time.Time    `json:"created_at"`
+}
@@ -0,0 +1,38 @@
+package entities
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type EventInvite struct {
+	gorm.Model
+	EventID   uint
+	UserID    uint
+	Code      string `gorm:"uniqueIndex;size:32"`
+	MaxUses   uint
+	Uses      uint
+	ExpiresAt *time.Time
+	RevokedAt *time.Time
+	Event     Event `gorm:"foreignKey:EventID;references:ID"`
+}
+
+type EventInviteRequest struct {
+	UserID    uint   `form:"user_id"`
+	MaxUses   uint   `form:"max_uses"`
+	ExpiresAt string `form:"expires_at" validate:"omitempty,datetime=2006-01-02 15:04"`
+}
+
+type EventInviteResponse struct {
+	ID        uint       `json:"id"`
+	EventID   uint       `json:"event_id"`
+	UserID    uint       `json:"user_id"`
+	Code      string     `json:"code"`
+	Link      string     `json:"link"`
+	MaxUses   uint       `json:"max_uses"`
+	Uses      uint       `json:"uses"`
+	ExpiresAt *time.Time `json:"expires_at"`
+	RevokedAt *time.Time `json:"revoked_at"`
+	CreatedAt time.Time  `json:"created_at"`
+}
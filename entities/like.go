@@ -1,9 +1,12 @@
 package entities
 
+import "time"
+
 type Like struct {
-	ID      uint `gorm:"primary_key;auto_increment;not_null"`
-	EventID uint
-	UserID  uint
+	ID        uint `gorm:"primary_key;auto_increment;not_null"`
+	EventID   uint
+	UserID    uint
+	CreatedAt time.Time
 }
 
 type LikeRequest struct {
@@ -0,0 +1,50 @@
+package entities
+
+import "time"
+
+type Notification struct {
+	ID        uint   `gorm:"primaryKey"`
+	UserID    uint   `gorm:"index"`
+	EventID   *uint  `gorm:"index"`
+	Kind      string `gorm:"size:32"`
+	Title     string
+	Body      string `gorm:"type:text"`
+	ReadAt    *time.Time
+	CreatedAt time.Time `gorm:"index"`
+}
+
+type NotificationResponse struct {
+	ID        uint       `json:"id"`
+	EventID   *uint      `json:"event_id"`
+	Kind      string     `json:"kind"`
+	Title     string     `json:"title"`
+	Body      string     `json:"body"`
+	ReadAt    *time.Time `json:"read_at"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+type NotificationListResponse struct {
+	Unread        int64                  `json:"unread"`
+	Notifications []NotificationResponse `json:"notifications"`
+}
+
+type ReminderLog struct {
+	ID       uint      `gorm:"primaryKey"`
+	EventID  uint      `gorm:"uniqueIndex:idx_reminder_once"`
+	UserID   uint      `gorm:"uniqueIndex:idx_reminder_once"`
+	Reminder string    `gorm:"size:16;uniqueIndex:idx_reminder_once"`
+	StartsAt time.Time `gorm:"uniqueIndex:idx_reminder_once"`
+	SentAt   time.Time
+}
+
+type DueReminder struct {
+	EventID       uint
+	UserID        uint
+	Title         string
+	Location      string
+	Mode          string
+	MeetingURL    string
+	DatetimeEvent time.Time
+	Name          string
+	Email         string
+}
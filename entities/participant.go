@@ -1,11 +1,101 @@
 package entities
 
+import "time"
+
 type Participant struct {
-	ID      uint `gorm:"primary_key;auto_increment;not_null"`
-	EventID uint
-	UserID  uint
+	ID          uint `gorm:"primary_key;auto_increment;not_null"`
+	EventID     uint
+	UserID      uint
+	InviteID    *uint
+	CheckedInAt *time.Time
+	CheckedInBy *uint
+	Status      string `gorm:"size:16;default:going;index"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	User        User         `gorm:"foreignKey:UserID;references:ID"`
+	Invite      *EventInvite `gorm:"foreignKey:InviteID;references:ID"`
 }
 
 type ParticipantRequest struct {
-	EventID uint `form:"event_id"`
+	EventID    uint                 `json:"event_id" form:"event_id"`
+	InviteCode string               `json:"invite_code" form:"invite_code"`
+	Status     string               `json:"status" form:"status"`
+	Answers    []EventAnswerRequest `json:"answers" form:"answers"`
+	Force      bool                 `json:"force" form:"force"`
+}
+
+type ParticipantResponse struct {
+	ID          uint         `json:"id"`
+	EventID     uint         `json:"event_id"`
+	UserID      uint         `json:"user_id"`
+	User        UserResponse `json:"user"`
+	InviteID    *uint        `json:"invite_id"`
+	InviteCode  string       `json:"invite_code"`
+	Status      string       `json:"status"`
+	JoinedAt    time.Time    `json:"joined_at"`
+	CheckedInAt *time.Time   `json:"checked_in_at"`
+}
+
+type CheckInRequest struct {
+	Code string `form:"code"`
+}
+
+type ParticipantTicketResponse struct {
+	EventID     uint       `json:"event_id"`
+	UserID      uint       `json:"user_id"`
+	Code        string     `json:"code"`
+	CheckedInAt *time.Time `json:"checked_in_at"`
+	QRCodePNG   string     `json:"qr_code_png"`
+	QRCodeSVG   string     `json:"qr_code_svg"`
+}
+
+type AttendanceResponse struct {
+	EventID      uint    `json:"event_id"`
+	Joined       int64   `json:"joined"`
+	CheckedIn    int64   `json:"checked_in"`
+	NotCheckedIn int64   `json:"not_checked_in"`
+	Rate         float64 `json:"rate"`
+}
+
+type RSVPCountResponse struct {
+	Going    int `json:"going"`
+	Maybe    int `json:"maybe"`
+	NotGoing int `json:"not_going"`
+}
+
+type RSVPResponse struct {
+	EventID   uint                       `json:"event_id"`
+	UserID    uint                       `json:"user_id"`
+	Status    string                     `json:"status"`
+	RSVP      RSVPCountResponse          `json:"rsvp"`
+	Conflicts []ScheduleConflictResponse `json:"conflicts"`
+}
+
+type ScheduleConflictResponse struct {
+	EventID          uint       `json:"event_id"`
+	Title            string     `json:"title"`
+	Slug             *string    `json:"slug"`
+	DatetimeEvent    time.Time  `json:"datetime_event"`
+	DatetimeEventEnd *time.Time `json:"datetime_event_end"`
+}
+
+type JoinResponse struct {
+	EventID   uint                       `json:"event_id"`
+	UserID    uint                       `json:"user_id"`
+	Status    string                     `json:"status"`
+	Conflicts []ScheduleConflictResponse `json:"conflicts"`
+}
+
+type ScheduleResponse struct {
+	EventID          uint                       `json:"event_id"`
+	Title            string                     `json:"title"`
+	Slug             *string                    `json:"slug"`
+	HostedBy         string                     `json:"hosted_by"`
+	Location         string                     `json:"location"`
+	Mode             string                     `json:"mode"`
+	DatetimeEvent    time.Time                  `json:"datetime_event"`
+	DatetimeEventEnd *time.Time                 `json:"datetime_event_end"`
+	Status           string                     `json:"status"`
+	HasConflict      bool                       `json:"has_conflict"`
+	Conflicts        []ScheduleConflictResponse `json:"conflicts"`
 }
@@ -0,0 +1,69 @@
+package entities
+
+import "time"
+
+type Report struct {
+	ID         uint   `gorm:"primaryKey"`
+	ReporterID uint   `gorm:"index"`
+	TargetType string `gorm:"size:16;index:idx_report_target"`
+	TargetID   uint   `gorm:"index:idx_report_target"`
+	Reason     string `gorm:"size:32"`
+	Details    string `gorm:"type:text"`
+	Status     string `gorm:"size:16;default:open;index"`
+	Resolution string `gorm:"size:16"`
+	ResolvedBy *uint
+	ResolvedAt *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	Reporter   User `gorm:"foreignKey:ReporterID;references:ID"`
+}
+
+type ReportRequest struct {
+	Reason  string `json:"reason" form:"reason" validate:"required,oneof=spam harassment hate scam inappropriate other"`
+	Details string `json:"details" form:"details" validate:"max=2000"`
+}
+
+type ReportResponse struct {
+	ID         uint         `json:"id"`
+	ReporterID uint         `json:"reporter_id"`
+	Reporter   UserResponse `json:"reporter"`
+	TargetType string       `json:"target_type"`
+	TargetID   uint         `json:"target_id"`
+	Reason     string       `json:"reason"`
+	Details    string       `json:"details"`
+	Status     string       `json:"status"`
+	Resolution string       `json:"resolution"`
+	ResolvedBy *uint        `json:"resolved_by"`
+	ResolvedAt *time.Time   `json:"resolved_at"`
+	CreatedAt  time.Time    `json:"created_at"`
+}
+
+type ModerationActionRequest struct {
+	Action string `json:"action" form:"action" validate:"required,oneof=dismiss hide delete warn suspend"`
+	Note   string `json:"note" form:"note" validate:"max=1000"`
+	Days   int    `json:"days" form:"days" validate:"omitempty,min=1,max=365"`
+}
+
+type AuditLog struct {
+	ID         uint   `gorm:"primaryKey"`
+	ActorID    uint   `gorm:"index"`
+	Action     string `gorm:"size:32"`
+	TargetType string `gorm:"size:16;index:idx_audit_target"`
+	TargetID   uint   `gorm:"index:idx_audit_target"`
+	ReportID   *uint
+	Note       string    `gorm:"type:text"`
+	CreatedAt  time.Time `gorm:"index"`
+	Actor      User      `gorm:"foreignKey:ActorID;references:ID"`
+}
+
+type AuditLogResponse struct {
+	ID         uint         `json:"id"`
+	ActorID    uint         `json:"actor_id"`
+	Actor      UserResponse `json:"actor"`
+	Action     string       `json:"action"`
+	TargetType string       `json:"target_type"`
+	TargetID   uint         `json:"target_id"`
+	ReportID   *uint        `json:"report_id"`
+	Note       string       `json:"note"`
+	CreatedAt  time.Time    `json:"created_at"`
+}
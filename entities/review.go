@@ -0,0 +1,87 @@
+package entities
+
+import "time"
+
+type Review struct {
+	ID        uint   `gorm:"primaryKey"`
+	EventID   uint   `gorm:"uniqueIndex:idx_review_event_user"`
+	UserID    uint   `gorm:"uniqueIndex:idx_review_event_user"`
+	Rating    int    `gorm:"type:tinyint"`
+	Body      string `gorm:"type:text"`
+	Reply     string `gorm:"type:text"`
+	RepliedBy *uint
+	RepliedAt *time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	User      User `gorm:"foreignKey:UserID;references:ID"`
+}
+
+type ReviewRequest struct {
+	Rating int    `json:"rating" form:"rating" validate:"required,min=1,max=5"`
+	Body   string `json:"body" form:"body" validate:"max=2000"`
+}
+
+type ReviewReplyRequest struct {
+	Reply string `json:"reply" form:"reply" validate:"required,max=2000"`
+}
+
+type ReviewResponse struct {
+	ID        uint         `json:"id"`
+	EventID   uint         `json:"event_id"`
+	UserID    uint         `json:"user_id"`
+	User      UserResponse `json:"user"`
+	Rating    int          `json:"rating"`
+	Body      string       `json:"body"`
+	Reply     string       `json:"reply"`
+	RepliedBy *uint        `json:"replied_by"`
+	RepliedAt *time.Time   `json:"replied_at"`
+	CreatedAt time.Time    `json:"created_at"`
+	UpdatedAt time.Time    `json:"updated_at"`
+}
+
+/*
+ * Event Rating
+ * -------------------------------
+ * Ringkasan rating sebuah event, dihitung ulang
+ * setiap kali review event tersebut berubah
+ */
+type EventRating struct {
+	EventID   uint `gorm:"primaryKey;autoIncrement:false"`
+	Count     int64
+	Average   float64
+	Stars1    int64
+	Stars2    int64
+	Stars3    int64
+	Stars4    int64
+	Stars5    int64
+	UpdatedAt time.Time
+}
+
+type RatingSummaryResponse struct {
+	Count        int64            `json:"count"`
+	Average      float64          `json:"average"`
+	Distribution map[string]int64 `json:"distribution"`
+}
+
+type ReviewListResponse struct {
+	Rating  RatingSummaryResponse `json:"rating"`
+	Reviews []ReviewResponse      `json:"reviews"`
+}
+
+/*
+ * Host Reputation
+ * -------------------------------
+ * Hasil agregasi semua review dari event milik seorang host
+ */
+type HostReputation struct {
+	Events  int64
+	Reviews int64
+	Sum     int64
+}
+
+type HostReputationResponse struct {
+	Score   float64 `json:"score"`
+	Average float64 `json:"average"`
+	Reviews int64   `json:"reviews"`
+	Events  int64   `json:"events"`
+}
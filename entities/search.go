@@ -0,0 +1,13 @@
+package entities
+
+type EventSearchHit struct {
+	ID         uint
+	Score      float64
+	Highlights map[string][]string
+}
+
+type EventSuggestionResponse struct {
+	ID        uint   `json:"id"`
+	Title     string `json:"title"`
+	Highlight string `json:"highlight"`
+}
@@ -0,0 +1,15 @@
+package entities
+
+import "time"
+
+type Tag struct {
+	ID        uint   `gorm:"primaryKey"`
+	Name      string `gorm:"size:30;uniqueIndex"`
+	CreatedAt time.Time
+}
+
+type TagResponse struct {
+	ID     uint   `json:"id"`
+	Name   string `json:"name"`
+	Events int64  `json:"events,omitempty"`
+}
@@ -0,0 +1,138 @@
+package entities
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type TicketType struct {
+	gorm.Model
+	EventID     uint
+	Name        string
+	Description string
+	Price       int64
+	Currency    string `gorm:"size:3"`
+	Quantity    uint
+	Sold        uint
+	Reserved    uint
+	SalesStart  *time.Time
+	SalesEnd    *time.Time
+	Event       Event `gorm:"foreignKey:EventID;references:ID"`
+}
+
+// Sisa tiket yang masih bisa dipesan
+func (ticketType TicketType) Available() uint {
+	if ticketType.Sold+ticketType.Reserved >= ticketType.Quantity {
+		return 0
+	}
+	return ticketType.Quantity - ticketType.Sold - ticketType.Reserved
+}
+
+type TicketTypeRequest struct {
+	Name        string `form:"name" validate:"required"`
+	Description string `form:"description"`
+	Price       int64  `form:"price" validate:"min=0"`
+	Currency    string `form:"currency" validate:"required,len=3,alpha"`
+	Quantity    uint   `form:"quantity" validate:"required,min=1"`
+	SalesStart  string `form:"sales_start" validate:"omitempty,datetime=2006-01-02 15:04"`
+	SalesEnd    string `form:"sales_end" validate:"omitempty,datetime=2006-01-02 15:04"`
+}
+
+type TicketTypeResponse struct {
+	ID          uint       `json:"id"`
+	EventID     uint       `json:"event_id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Price       int64      `json:"price"`
+	Currency    string     `json:"currency"`
+	Quantity    uint       `json:"quantity"`
+	Available   uint       `json:"available"`
+	SalesStart  *time.Time `json:"sales_start"`
+	SalesEnd    *time.Time `json:"sales_end"`
+}
+
+type Order struct {
+	gorm.Model
+	EventID          uint
+	UserID           uint
+	TicketTypeID     uint
+	Quantity         uint
+	Amount           int64
+	Currency         string `gorm:"size:3"`
+	Status           string `gorm:"size:16;index"`
+	PaymentReference string `gorm:"size:64;index"`
+	PaymentURL       string
+	ExpiresAt        time.Time
+	PaidAt           *time.Time
+	RefundedAt       *time.Time
+	InviteID         *uint
+	Answers          string     `gorm:"type:text"`
+	User             User       `gorm:"foreignKey:UserID;references:ID"`
+	Event            Event      `gorm:"foreignKey:EventID;references:ID"`
+	TicketType       TicketType `gorm:"foreignKey:TicketTypeID;references:ID"`
+	Tickets          []Ticket   `gorm:"foreignKey:OrderID;references:ID"`
+}
+
+type OrderRequest struct {
+	TicketTypeID uint                 `json:"ticket_type_id" form:"ticket_type_id" validate:"required"`
+	Quantity     uint                 `json:"quantity" form:"quantity" validate:"required,min=1,max=10"`
+	InviteCode   string               `json:"invite_code" form:"invite_code"`
+	Answers      []EventAnswerRequest `json:"answers" form:"answers"`
+	Force        bool                 `json:"force" form:"force"`
+}
+
+type OrderResponse struct {
+	ID               uint                       `json:"id"`
+	EventID          uint                       `json:"event_id"`
+	UserID           uint                       `json:"user_id"`
+	TicketTypeID     uint                       `json:"ticket_type_id"`
+	TicketType       TicketTypeResponse         `json:"ticket_type"`
+	Quantity         uint                       `json:"quantity"`
+	Amount           int64                      `json:"amount"`
+	Currency         string                     `json:"currency"`
+	Status           string                     `json:"status"`
+	PaymentReference string                     `json:"payment_reference"`
+	PaymentURL       string                     `json:"payment_url"`
+	ExpiresAt        time.Time                  `json:"expires_at"`
+	PaidAt           *time.Time                 `json:"paid_at"`
+	RefundedAt       *time.Time                 `json:"refunded_at"`
+	Tickets          []TicketResponse           `json:"tickets"`
+	CreatedAt        time.Time                  `json:"created_at"`
+	Conflicts        []ScheduleConflictResponse `json:"conflicts,omitempty"`
+}
+
+type Ticket struct {
+	gorm.Model
+	OrderID      uint
+	EventID      uint
+	TicketTypeID uint
+	UserID       uint
+	Code         string `gorm:"uniqueIndex;size:64"`
+	Status       string `gorm:"size:16"`
+	CheckedInAt  *time.Time
+	CheckedInBy  *uint
+}
+
+type TicketResponse struct {
+	ID           uint       `json:"id"`
+	OrderID      uint       `json:"order_id"`
+	EventID      uint       `json:"event_id"`
+	TicketTypeID uint       `json:"ticket_type_id"`
+	UserID       uint       `json:"user_id"`
+	Code         string     `json:"code"`
+	Status       string     `json:"status"`
+	CheckedInAt  *time.Time `json:"checked_in_at"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+type PaymentCharge struct {
+	Reference   string
+	RedirectURL string
+	Status      string
+}
+
+type PaymentEvent struct {
+	Reference string `json:"reference"`
+	Status    string `json:"status"`
+}
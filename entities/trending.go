@@ -0,0 +1,34 @@
+package entities
+
+import "time"
+
+type EventView struct {
+	ID        uint      `gorm:"primaryKey"`
+	EventID   uint      `gorm:"index"`
+	UserID    *uint     `gorm:"index"`
+	IP        string    `gorm:"size:45"`
+	CreatedAt time.Time `gorm:"index"`
+}
+
+type EventScore struct {
+	EventID    uint    `gorm:"primaryKey;autoIncrement:false"`
+	Score      float64 `gorm:"index"`
+	Likes      int64
+	Joins      int64
+	Comments   int64
+	Views      int64
+	ComputedAt time.Time
+}
+
+/*
+ * Event Activity
+ * -------------------------------
+ * Hasil agregasi interaksi sebuah event untuk satu jenis aktivitas,
+ * Decayed adalah jumlah interaksi yang sudah diberi time decay
+ */
+type EventActivity struct {
+	EventID uint
+	Kind    string
+	Count   int64
+	Decayed float64
+}
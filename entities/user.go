@@ -1,6 +1,7 @@
 package entities
 
 import (
+	"encoding/json"
 	"time"
 
 	"gorm.io/gorm"
@@ -8,36 +9,77 @@ import (
 
 type User struct {
 	gorm.Model
-	Email     string `gorm:"unique"`
-	Password  string
-	Name      string
-	Gender    string
-	Address   string
-	Avatar    string
-	DOB       time.Time
-	DarkTheme string
-	Events	  []Event `gorm:"many2many:participants;foreignKey:ID;joinForeignKey:UserID;references:ID;joinReferences:EventID"`
+	Email          string `gorm:"unique"`
+	Password       string
+	Name           string
+	Gender         string
+	Address        string
+	Avatar         string
+	DOB            time.Time
+	DarkTheme      string
+	HideEmail      bool
+	ReminderOptOut bool
+	Role           string `gorm:"size:16;default:user"`
+	SuspendedUntil *time.Time
+	Events         []Event `gorm:"many2many:participants;foreignKey:ID;joinForeignKey:UserID;references:ID;joinReferences:EventID"`
 }
 
 type UserRequest struct {
-	Name     string `form:"name" validate:"required"`
-	Email    string `form:"email" validate:"required,email"`
-	Password string `form:"password" validate:"required"`
-	Gender   string `form:"gender" validate:"required"`
-	Address  string `form:"address"`
-	Avatar   string `form:"avatar"`
-	DOB      string `form:"dob" validate:"required"`
+	Name           string `form:"name" validate:"required"`
+	Email          string `form:"email" validate:"required,email"`
+	Password       string `form:"password" validate:"required"`
+	Gender         string `form:"gender" validate:"required"`
+	Address        string `form:"address"`
+	Avatar         string `form:"avatar"`
+	DOB            string `form:"dob" validate:"required"`
+	HideEmail      *bool  `form:"hide_email"`
+	ReminderOptOut *bool  `form:"reminder_opt_out"`
 }
 
 type UserResponse struct {
-	ID        uint      `json:"id"`
-	Name      string    `json:"name"`
-	Email     string    `json:"email"`
-	Gender    string    `json:"gender"`
-	Address   string    `json:"address"`
-	Avatar    string    `json:"avatar"`
-	DOB       time.Time `json:"dob"`
-	DarkTheme bool      `json:"dark_theme"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID             uint                    `json:"id"`
+	Name           string                  `json:"name"`
+	Email          string                  `json:"email"`
+	Gender         string                  `json:"gender"`
+	Address        string                  `json:"address"`
+	Avatar         string                  `json:"avatar"`
+	DOB            time.Time               `json:"dob"`
+	DarkTheme      bool                    `json:"dark_theme"`
+	HideEmail      bool                    `json:"-"`
+	Reputation     *HostReputationResponse `json:"reputation,omitempty"`
+	CreatedAt      time.Time               `json:"created_at"`
+	UpdatedAt      time.Time               `json:"updated_at"`
+}
+
+/*
+ * User Response - Marshal JSON
+ * -------------------------------
+ * UserResponse dipakai di semua response publik (profil user,
+ * host & co-host event, komentar, review, dsb), email user yang
+ * memilih menyembunyikan email selalu dikosongkan. Profil milik
+ * sendiri memakai UserProfileResponse
+ */
+func (user UserResponse) MarshalJSON() ([]byte, error) {
+	type userResponse UserResponse
+	res := userResponse(user)
+	if res.HideEmail {
+		res.Email = ""
+	}
+	return json.Marshal(res)
+}
+
+type UserProfileResponse struct {
+	ID             uint      `json:"id"`
+	Name           string    `json:"name"`
+	Email          string    `json:"email"`
+	Gender         string    `json:"gender"`
+	Address        string    `json:"address"`
+	Avatar         string    `json:"avatar"`
+	DOB            time.Time `json:"dob"`
+	DarkTheme      bool      `json:"dark_theme"`
+	HideEmail      bool      `json:"hide_email"`
+	ReminderOptOut bool      `json:"reminder_opt_out"`
+	Role           string    `json:"role"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
 }
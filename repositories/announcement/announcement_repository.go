@@ -0,0 +1,208 @@
+package announcement
+
+import (
+	"time"
+	"tupulung/entities"
+	"tupulung/entities/web"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type AnnouncementRepository struct {
+	db *gorm.DB
+}
+
+func NewAnnouncementRepository(db *gorm.DB) AnnouncementRepository {
+	return AnnouncementRepository{
+		db: db,
+	}
+}
+
+/*
+ * Find By Event
+ * -------------------------------
+ * Mengambil pengumuman event dari yang terbaru
+ */
+func (repo AnnouncementRepository) FindByEvent(eventID int, limit int, offset int) ([]entities.Announcement, error) {
+	announcements := []entities.Announcement{}
+	tx := repo.db.Preload("User").Where("event_id = ?", eventID).
+		Order("created_at DESC, id DESC").Limit(limit).Offset(offset).
+		Find(&announcements)
+	if tx.Error != nil {
+		return []entities.Announcement{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return announcements, nil
+}
+
+/*
+ * Count By Event
+ * -------------------------------
+ * Menghitung jumlah pengumuman event
+ */
+func (repo AnnouncementRepository) CountByEvent(eventID int) (int64, error) {
+	var count int64
+	tx := repo.db.Model(&entities.Announcement{}).Where("event_id = ?", eventID).Count(&count)
+	if tx.Error != nil {
+		return 0, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return count, nil
+}
+
+/*
+ * Store Limited
+ * -------------------------------
+ * Menambahkan pengumuman kedalam database jika jumlah pengumuman
+ * event sejak waktu tertentu belum mencapai limit. Row event dikunci
+ * selama transaksi agar hitungan dan insert tidak didahului request lain
+ */
+func (repo AnnouncementRepository) StoreLimited(announcement entities.Announcement, since time.Time, limit int64) (entities.Announcement, error) {
+	err := repo.db.Transaction(func(tx *gorm.DB) error {
+		event := entities.Event{}
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").Where("id = ?", announcement.EventID).Limit(1).Find(&event)
+		if result.Error != nil {
+			return web.WebError{Code: 500, Message: result.Error.Error()}
+		} else if result.RowsAffected == 0 {
+			return web.WebError{Code: 400, Message: "Event is not exist"}
+		}
+
+		var count int64
+		err := tx.Model(&entities.Announcement{}).Where("event_id = ? AND created_at >= ?", announcement.EventID, since).Count(&count).Error
+		if err != nil {
+			return web.WebError{Code: 500, Message: err.Error()}
+		}
+		if count >= limit {
+			return web.WebError{Code: 429, Message: "Too many announcements, please try again later"}
+		}
+
+		if err := tx.Omit("User", "Event").Create(&announcement).Error; err != nil {
+			return web.WebError{Code: 500, Message: err.Error()}
+		}
+		return nil
+	})
+	if err != nil {
+		return entities.Announcement{}, err
+	}
+	return announcement, nil
+}
+
+/*
+ * Find Pending
+ * -------------------------------
+ * Mengambil pengumuman yang belum dikirim dari yang terlama
+ */
+func (repo AnnouncementRepository) FindPending(limit int) ([]entities.Announcement, error) {
+	announcements := []entities.Announcement{}
+	tx := repo.db.Preload("Event").Where("delivered_at IS NULL").
+		Order("created_at ASC, id ASC").Limit(limit).
+		Find(&announcements)
+	if tx.Error != nil {
+		return []entities.Announcement{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return announcements, nil
+}
+
+/*
+ * Deliver
+ * -------------------------------
+ * Menandai pengumuman terkirim sekaligus menyimpan notifikasi
+ * dan antrian email penerima dalam satu transaksi, false jika
+ * pengumuman sudah dikirim proses lain
+ */
+func (repo AnnouncementRepository) Deliver(id uint, at time.Time, notifications []entities.Notification, emails []entities.AnnouncementEmail) (bool, error) {
+	delivered := false
+	err := repo.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&entities.Announcement{}).
+			Where("id = ? AND delivered_at IS NULL", id).
+			Updates(map[string]interface{}{"delivered_at": at, "recipients": len(notifications)})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected <= 0 {
+			return nil
+		}
+		if len(notifications) > 0 {
+			if err := tx.CreateInBatches(&notifications, 500).Error; err != nil {
+				return err
+			}
+		}
+		if len(emails) > 0 {
+			if err := tx.CreateInBatches(&emails, 500).Error; err != nil {
+				return err
+			}
+		}
+		delivered = true
+		return nil
+	})
+	if err != nil {
+		return false, web.WebError{Code: 500, Message: err.Error()}
+	}
+	return delivered, nil
+}
+
+/*
+ * Find Pending Emails
+ * -------------------------------
+ * Mengambil antrian email yang belum terkirim dan belum
+ * melewati batas percobaan dari yang terlama
+ */
+func (repo AnnouncementRepository) FindPendingEmails(maxAttempts int, limit int) ([]entities.AnnouncementEmail, error) {
+	emails := []entities.AnnouncementEmail{}
+	tx := repo.db.Where("sent_at IS NULL AND attempts < ?", maxAttempts).
+		Order("id ASC").Limit(limit).
+		Find(&emails)
+	if tx.Error != nil {
+		return []entities.AnnouncementEmail{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return emails, nil
+}
+
+/*
+ * Claim Email
+ * -------------------------------
+ * Menandai email sebagai terkirim sebelum dikirim,
+ * false jika email sudah diklaim proses lain
+ */
+func (repo AnnouncementRepository) ClaimEmail(id uint, at time.Time) (bool, error) {
+	tx := repo.db.Model(&entities.AnnouncementEmail{}).
+		Where("id = ? AND sent_at IS NULL", id).
+		Update("sent_at", at)
+	if tx.Error != nil {
+		return false, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return tx.RowsAffected > 0, nil
+}
+
+/*
+ * Release Email
+ * -------------------------------
+ * Membatalkan klaim email yang gagal dikirim
+ * agar dicoba lagi pada putaran berikutnya
+ */
+func (repo AnnouncementRepository) ReleaseEmail(id uint) error {
+	tx := repo.db.Model(&entities.AnnouncementEmail{}).Where("id = ?", id).
+		Updates(map[string]interface{}{"sent_at": nil, "attempts": gorm.Expr("attempts + 1")})
+	if tx.Error != nil {
+		return web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return nil
+}
+
+/*
+ * Recipients
+ * -------------------------------
+ * Mengambil participant event yang menerima pengumuman,
+ * participant yang tidak akan hadir dan pengirim tidak termasuk
+ */
+func (repo AnnouncementRepository) Recipients(eventID uint, exceptUserID uint) ([]entities.User, error) {
+	users := []entities.User{}
+	tx := repo.db.Model(&entities.User{}).
+		Joins("JOIN participants ON participants.user_id = users.id").
+		Where("participants.event_id = ? AND participants.status <> ? AND users.id <> ?", eventID, "not_going", exceptUserID).
+		Order("participants.id ASC").
+		Find(&users)
+	if tx.Error != nil {
+		return []entities.User{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return users, nil
+}
@@ -0,0 +1,78 @@
+package announcement
+
+import (
+	"time"
+	"tupulung/entities"
+)
+
+type AnnouncementRepositoryInterface interface {
+
+	/*
+	 * Find By Event
+	 * -------------------------------
+	 * Mengambil pengumuman event dari yang terbaru
+	 */
+	FindByEvent(eventID int, limit int, offset int) ([]entities.Announcement, error)
+
+	/*
+	 * Count By Event
+	 * -------------------------------
+	 * Menghitung jumlah pengumuman event
+	 */
+	CountByEvent(eventID int) (int64, error)
+
+	/*
+	 * Store Limited
+	 * -------------------------------
+	 * Menambahkan pengumuman kedalam database dalam satu transaksi
+	 * dengan pengecekan jumlah pengumuman event sejak waktu tertentu,
+	 * error 429 jika limit sudah tercapai
+	 */
+	StoreLimited(announcement entities.Announcement, since time.Time, limit int64) (entities.Announcement, error)
+
+	/*
+	 * Find Pending
+	 * -------------------------------
+	 * Mengambil pengumuman yang belum dikirim dari yang terlama
+	 */
+	FindPending(limit int) ([]entities.Announcement, error)
+
+	/*
+	 * Deliver
+	 * -------------------------------
+	 * Menandai pengumuman terkirim sekaligus menyimpan notifikasi
+	 * dan antrian email penerima dalam satu transaksi, false jika
+	 * pengumuman sudah dikirim proses lain
+	 */
+	Deliver(id uint, at time.Time, notifications []entities.Notification, emails []entities.AnnouncementEmail) (bool, error)
+
+	/*
+	 * Find Pending Emails
+	 * -------------------------------
+	 * Mengambil antrian email yang belum terkirim dan belum
+	 * melewati batas percobaan dari yang terlama
+	 */
+	FindPendingEmails(maxAttempts int, limit int) ([]entities.AnnouncementEmail, error)
+
+	/*
+	 * Claim Email
+	 * -------------------------------
+	 * Menandai email sebagai terkirim sebelum dikirim,
+	 * false jika email sudah diklaim proses lain
+	 */
+	ClaimEmail(id uint, at time.Time) (bool, error)
+
+	/*
+	 * Release Email
+	 * -------------------------------
+	 * Membatalkan klaim email yang gagal dikirim
+	 */
+	ReleaseEmail(id uint) error
+
+	/*
+	 * Recipients
+	 * -------------------------------
+	 * Mengambil participant event yang menerima pengumuman
+	 */
+	Recipients(eventID uint, exceptUserID uint) ([]entities.User, error)
+}
@@ -0,0 +1,79 @@
+package announcement
+
+import (
+	"time"
+	"tupulung/entities"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type AnnouncementRepositoryMock struct {
+	Mock *mock.Mock
+}
+
+func NewAnnouncementRepositoryMock(mock *mock.Mock) *AnnouncementRepositoryMock {
+	return &AnnouncementRepositoryMock{
+		Mock: mock,
+	}
+}
+
+var AnnouncementCollection = []entities.Announcement{
+	{
+		ID:        1,
+		EventID:   1,
+		UserID:    1,
+		Title:     "Venue changed",
+		Body:      "We moved to the second floor of the building",
+		CreatedAt: time.Now(),
+	},
+}
+
+var AnnouncementEmailCollection = []entities.AnnouncementEmail{
+	{ID: 1, AnnouncementID: 1, UserID: 1, Email: "test1@mail.com", Subject: "Venue changed", Body: "Hi test1"},
+	{ID: 2, AnnouncementID: 1, UserID: 2, Email: "test2@mail.com", Subject: "Venue changed", Body: "Hi test2"},
+}
+
+func (repo AnnouncementRepositoryMock) FindByEvent(eventID int, limit int, offset int) ([]entities.Announcement, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).([]entities.Announcement), args.Error(1)
+}
+
+func (repo AnnouncementRepositoryMock) CountByEvent(eventID int) (int64, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (repo AnnouncementRepositoryMock) StoreLimited(announcement entities.Announcement, since time.Time, limit int64) (entities.Announcement, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.Announcement), args.Error(1)
+}
+
+func (repo AnnouncementRepositoryMock) FindPending(limit int) ([]entities.Announcement, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).([]entities.Announcement), args.Error(1)
+}
+
+func (repo AnnouncementRepositoryMock) Deliver(id uint, at time.Time, notifications []entities.Notification, emails []entities.AnnouncementEmail) (bool, error) {
+	args := repo.Mock.Called(len(notifications), len(emails))
+	return args.Bool(0), args.Error(1)
+}
+
+func (repo AnnouncementRepositoryMock) FindPendingEmails(maxAttempts int, limit int) ([]entities.AnnouncementEmail, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).([]entities.AnnouncementEmail), args.Error(1)
+}
+
+func (repo AnnouncementRepositoryMock) ClaimEmail(id uint, at time.Time) (bool, error) {
+	args := repo.Mock.Called(id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (repo AnnouncementRepositoryMock) ReleaseEmail(id uint) error {
+	args := repo.Mock.Called(id)
+	return args.Error(0)
+}
+
+func (repo AnnouncementRepositoryMock) Recipients(eventID uint, exceptUserID uint) ([]entities.User, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).([]entities.User), args.Error(1)
+}
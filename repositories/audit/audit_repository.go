@@ -0,0 +1,53 @@
+package audit
+
+import (
+	"tupulung/entities"
+	"tupulung/entities/web"
+
+	"gorm.io/gorm"
+)
+
+type AuditRepository struct {
+	db *gorm.DB
+}
+
+func NewAuditRepository(db *gorm.DB) AuditRepository {
+	return AuditRepository{
+		db: db,
+	}
+}
+
+/*
+ * Find All
+ * -------------------------------
+ * Mengambil audit trail dari yang terbaru, target type
+ * kosong berarti semua dan target id 0 berarti semua target
+ */
+func (repo AuditRepository) FindAll(targetType string, targetID int, limit int, offset int) ([]entities.AuditLog, error) {
+	logs := []entities.AuditLog{}
+	builder := repo.db.Preload("Actor")
+	if targetType != "" {
+		builder = builder.Where("target_type = ?", targetType)
+	}
+	if targetID != 0 {
+		builder = builder.Where("target_id = ?", targetID)
+	}
+	tx := builder.Order("created_at DESC, id DESC").Limit(limit).Offset(offset).Find(&logs)
+	if tx.Error != nil {
+		return []entities.AuditLog{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return logs, nil
+}
+
+/*
+ * Store
+ * -------------------------------
+ * Mencatat aksi moderator kedalam audit trail
+ */
+func (repo AuditRepository) Store(log entities.AuditLog) (entities.AuditLog, error) {
+	tx := repo.db.Create(&log)
+	if tx.Error != nil {
+		return entities.AuditLog{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return log, nil
+}
@@ -0,0 +1,20 @@
+package audit
+
+import "tupulung/entities"
+
+type AuditRepositoryInterface interface {
+
+	/*
+	 * Find All
+	 * -------------------------------
+	 * Mengambil audit trail dari yang terbaru
+	 */
+	FindAll(targetType string, targetID int, limit int, offset int) ([]entities.AuditLog, error)
+
+	/*
+	 * Store
+	 * -------------------------------
+	 * Mencatat aksi moderator kedalam audit trail
+	 */
+	Store(log entities.AuditLog) (entities.AuditLog, error)
+}
@@ -0,0 +1,40 @@
+package audit
+
+import (
+	"time"
+	"tupulung/entities"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type AuditRepositoryMock struct {
+	Mock *mock.Mock
+}
+
+func NewAuditRepositoryMock(mock *mock.Mock) *AuditRepositoryMock {
+	return &AuditRepositoryMock{
+		Mock: mock,
+	}
+}
+
+var AuditLogCollection = []entities.AuditLog{
+	{
+		ID:         1,
+		ActorID:    1,
+		Action:     "hide",
+		TargetType: "event",
+		TargetID:   2,
+		Note:       "Spam",
+		CreatedAt:  time.Now(),
+	},
+}
+
+func (repo AuditRepositoryMock) FindAll(targetType string, targetID int, limit int, offset int) ([]entities.AuditLog, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).([]entities.AuditLog), args.Error(1)
+}
+
+func (repo AuditRepositoryMock) Store(log entities.AuditLog) (entities.AuditLog, error) {
+	args := repo.Mock.Called(log.Action)
+	return args.Get(0).(entities.AuditLog), args.Error(1)
+}
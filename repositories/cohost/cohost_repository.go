@@ -0,0 +1,148 @@
+package cohost
+
+import (
+	"tupulung/entities"
+	"tupulung/entities/web"
+
+	"gorm.io/gorm"
+)
+
+type CohostRepository struct {
+	db *gorm.DB
+}
+
+func NewCohostRepository(db *gorm.DB) CohostRepository {
+	return CohostRepository{
+		db: db,
+	}
+}
+
+/*
+ * Find By Event
+ * -------------------------------
+ * Mengambil semua co-host sebuah event, termasuk yang masih pending
+ */
+func (repo CohostRepository) FindByEvent(eventID int) ([]entities.EventCohost, error) {
+	cohosts := []entities.EventCohost{}
+	tx := repo.db.Preload("User").Where("event_id = ?", eventID).Order("created_at ASC").Find(&cohosts)
+	if tx.Error != nil {
+		return []entities.EventCohost{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return cohosts, nil
+}
+
+/*
+ * Find Pending By User
+ * -------------------------------
+ * Mengambil undangan co-host yang belum diterima oleh user
+ */
+func (repo CohostRepository) FindPendingByUser(userID int) ([]entities.EventCohost, error) {
+	cohosts := []entities.EventCohost{}
+	tx := repo.db.Preload("User").Where("user_id = ? AND status = ?", userID, "pending").Order("created_at DESC").Find(&cohosts)
+	if tx.Error != nil {
+		return []entities.EventCohost{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return cohosts, nil
+}
+
+/*
+ * Find
+ * -------------------------------
+ * Mencari co-host tunggal berdasarkan ID beserta event-nya
+ */
+func (repo CohostRepository) Find(id int) (entities.EventCohost, error) {
+	cohost := entities.EventCohost{}
+	tx := repo.db.Preload("User").Preload("Event").Preload("Event.Cohosts", "status = ?", "accepted").Find(&cohost, id)
+	if tx.Error != nil {
+		return entities.EventCohost{}, web.WebError{Code: 500, Message: "server error"}
+	} else if tx.RowsAffected <= 0 {
+		return entities.EventCohost{}, web.WebError{Code: 400, Message: "cannot get co-host data with specified id"}
+	}
+	return cohost, nil
+}
+
+/*
+ * Find By Event And User
+ * -------------------------------
+ * Mencari co-host berdasarkan event dan user
+ */
+func (repo CohostRepository) FindByEventAndUser(eventID int, userID int) (entities.EventCohost, error) {
+	cohost := entities.EventCohost{}
+	tx := repo.db.Where("event_id = ? AND user_id = ?", eventID, userID).Find(&cohost)
+	if tx.Error != nil {
+		return entities.EventCohost{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	} else if tx.RowsAffected <= 0 {
+		return entities.EventCohost{}, web.WebError{Code: 400, Message: "user is not a co-host of this event"}
+	}
+	return cohost, nil
+}
+
+/*
+ * Store
+ * -------------------------------
+ * Menambahkan co-host kedalam database
+ */
+func (repo CohostRepository) Store(cohost entities.EventCohost) (entities.EventCohost, error) {
+	tx := repo.db.Omit("User", "Event").Create(&cohost)
+	if tx.Error != nil {
+		return entities.EventCohost{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return cohost, nil
+}
+
+/*
+ * Update
+ * -------------------------------
+ * Mengupdate role atau status co-host
+ */
+func (repo CohostRepository) Update(cohost entities.EventCohost, id int) (entities.EventCohost, error) {
+	tx := repo.db.Omit("User", "Event").Save(&cohost)
+	if tx.Error != nil {
+		return entities.EventCohost{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return cohost, nil
+}
+
+/*
+ * Delete
+ * -------------------------------
+ * Menghapus co-host dari event secara permanen
+ * agar user dapat diundang kembali
+ */
+func (repo CohostRepository) Delete(id int) error {
+	tx := repo.db.Unscoped().Delete(&entities.EventCohost{}, id)
+	if tx.Error != nil {
+		return web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return nil
+}
+
+/*
+ * Transfer
+ * -------------------------------
+ * Memindahkan kepemilikan event ke co-host lain dalam satu transaksi,
+ * pemilik lama menjadi co-host dengan role owner
+ */
+func (repo CohostRepository) Transfer(eventID int, fromUserID int, toUserID int) error {
+	err := repo.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&entities.Event{}).Where("id = ? AND user_id = ?", eventID, fromUserID).Update("user_id", toUserID).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Unscoped().Where("event_id = ? AND user_id = ?", eventID, toUserID).Delete(&entities.EventCohost{}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Create(&entities.EventCohost{
+			EventID:   uint(eventID),
+			UserID:    uint(fromUserID),
+			Role:      "owner",
+			Status:    "accepted",
+			InvitedBy: uint(toUserID),
+		}).Error
+	})
+	if err != nil {
+		return web.WebError{Code: 500, Message: err.Error()}
+	}
+	return nil
+}
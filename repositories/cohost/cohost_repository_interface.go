@@ -0,0 +1,62 @@
+package cohost
+
+import "tupulung/entities"
+
+type CohostRepositoryInterface interface {
+	/*
+	 * Find By Event
+	 * -------------------------------
+	 * Mengambil semua co-host sebuah event, termasuk yang masih pending
+	 */
+	FindByEvent(eventID int) ([]entities.EventCohost, error)
+
+	/*
+	 * Find Pending By User
+	 * -------------------------------
+	 * Mengambil undangan co-host yang belum diterima oleh user
+	 */
+	FindPendingByUser(userID int) ([]entities.EventCohost, error)
+
+	/*
+	 * Find
+	 * -------------------------------
+	 * Mencari co-host tunggal berdasarkan ID beserta event-nya
+	 */
+	Find(id int) (entities.EventCohost, error)
+
+	/*
+	 * Find By Event And User
+	 * -------------------------------
+	 * Mencari co-host berdasarkan event dan user
+	 */
+	FindByEventAndUser(eventID int, userID int) (entities.EventCohost, error)
+
+	/*
+	 * Store
+	 * -------------------------------
+	 * Menambahkan co-host kedalam database
+	 */
+	Store(cohost entities.EventCohost) (entities.EventCohost, error)
+
+	/*
+	 * Update
+	 * -------------------------------
+	 * Mengupdate role atau status co-host
+	 */
+	Update(cohost entities.EventCohost, id int) (entities.EventCohost, error)
+
+	/*
+	 * Delete
+	 * -------------------------------
+	 * Menghapus co-host dari event
+	 */
+	Delete(id int) error
+
+	/*
+	 * Transfer
+	 * -------------------------------
+	 * Memindahkan kepemilikan event ke co-host lain,
+	 * pemilik lama menjadi co-host dengan role owner
+	 */
+	Transfer(eventID int, fromUserID int, toUserID int) error
+}
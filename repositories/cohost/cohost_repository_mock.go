@@ -0,0 +1,78 @@
+package cohost
+
+import (
+	"time"
+	"tupulung/entities"
+
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type CohostRepositoryMock struct {
+	Mock *mock.Mock
+}
+
+func NewCohostRepositoryMock(mock *mock.Mock) *CohostRepositoryMock {
+	return &CohostRepositoryMock{
+		Mock: mock,
+	}
+}
+
+var CohostCollection = []entities.EventCohost{
+	{
+		Model:     gorm.Model{ID: 1, CreatedAt: time.Now(), UpdatedAt: time.Now()},
+		EventID:   1,
+		UserID:    2,
+		Role:      "editor",
+		Status:    "accepted",
+		InvitedBy: 1,
+	},
+	{
+		Model:     gorm.Model{ID: 2, CreatedAt: time.Now(), UpdatedAt: time.Now()},
+		EventID:   2,
+		UserID:    1,
+		Role:      "moderator",
+		Status:    "pending",
+		InvitedBy: 2,
+	},
+}
+
+func (repo CohostRepositoryMock) FindByEvent(eventID int) ([]entities.EventCohost, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).([]entities.EventCohost), args.Error(1)
+}
+
+func (repo CohostRepositoryMock) FindPendingByUser(userID int) ([]entities.EventCohost, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).([]entities.EventCohost), args.Error(1)
+}
+
+func (repo CohostRepositoryMock) Find(id int) (entities.EventCohost, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.EventCohost), args.Error(1)
+}
+
+func (repo CohostRepositoryMock) FindByEventAndUser(eventID int, userID int) (entities.EventCohost, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.EventCohost), args.Error(1)
+}
+
+func (repo CohostRepositoryMock) Store(cohost entities.EventCohost) (entities.EventCohost, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.EventCohost), args.Error(1)
+}
+
+func (repo CohostRepositoryMock) Update(cohost entities.EventCohost, id int) (entities.EventCohost, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.EventCohost), args.Error(1)
+}
+
+func (repo CohostRepositoryMock) Delete(id int) error {
+	args := repo.Mock.Called()
+	return args.Error(0)
+}
+
+func (repo CohostRepositoryMock) Transfer(eventID int, fromUserID int, toUserID int) error {
+	args := repo.Mock.Called()
+	return args.Error(0)
+}
@@ -27,6 +27,9 @@ func (repo CommentRepository) FindAll(limit int, offset int, filters []map[strin
 	comments := []entities.Comment{}
 	builder := repo.db.Limit(limit).Offset(offset).Preload("User").Order("created_at DESC")
 
+	// Komentar yang disembunyikan moderator tidak ditampilkan
+	builder.Where("hidden_at IS NULL")
+
 	// Where filters
 	for _, filter := range filters {
 		builder.Where(filter["field"] + " " + filter["operator"] + " ?", filter["value"])
@@ -53,7 +56,7 @@ func (repo CommentRepository) FindAll(limit int, offset int, filters []map[strin
 func (repo CommentRepository) Find(id int) (entities.Comment, error) {
 	// Get user dari database
 	user := entities.Comment{}
-	tx := repo.db.Preload("User").Find(&user, id)
+	tx := repo.db.Preload("User").Preload("Event").Preload("Event.Cohosts", "status = ?", "accepted").Find(&user, id)
 	if tx.Error != nil {
 
 		// Return error dengan code 500 
@@ -96,7 +99,7 @@ func (repo CommentRepository) FindBy(field string, value string) (entities.Comme
 func (repo CommentRepository) CountAll(filters []map[string]string) (int64, error) {
 	
 	var count int64
-	builder := repo.db.Model(&entities.Comment{})
+	builder := repo.db.Model(&entities.Comment{}).Where("hidden_at IS NULL")
 	// Where filters
 	for _, filter := range filters {
 		builder.Where(filter["field"]+" "+filter["operator"]+" ?", filter["value"])
@@ -1,6 +1,7 @@
 package event
 
 import (
+	"strings"
 	"tupulung/entities"
 	"tupulung/entities/web"
 
@@ -8,6 +9,43 @@ import (
 	"gorm.io/gorm/clause"
 )
 
+/*
+ * Filter & sort whitelist
+ * -------------------------------
+ * Hanya field dan operator yang terdaftar disini yang boleh
+ * diteruskan ke query builder. Event yang disembunyikan moderator
+ * memiliki visibility hidden agar tidak pernah tampil di list
+ * [field]: [sql expression]
+ */
+var eventFilterColumns = map[string]string{
+	"id":             "events.id",
+	"title":          "events.title",
+	"category_id":    "events.category_id",
+	"user_id":        "events.user_id",
+	"group_id":       "events.group_id",
+	"location":       "events.location",
+	"hide_address":   "events.hide_address",
+	"mode":           "events.mode",
+	"datetime_event": "events.datetime_event",
+	"created_at":     "events.created_at",
+	"visibility":     "(CASE WHEN events.hidden_at IS NULL THEN events.visibility ELSE 'hidden' END)",
+	"likes":          "(SELECT COUNT(*) FROM likes WHERE likes.event_id = events.id)",
+	"participants":   "(SELECT COUNT(*) FROM participants WHERE participants.event_id = events.id AND participants.status = 'going')",
+	"free_seats":     "(CASE WHEN events.capacity = 0 THEN 1 ELSE CAST(events.capacity AS SIGNED) - (SELECT COUNT(*) FROM participants WHERE participants.event_id = events.id AND participants.status = 'going') END)",
+	"trending":       "COALESCE((SELECT event_scores.score FROM event_scores WHERE event_scores.event_id = events.id), 0)",
+}
+
+var eventFilterOperators = map[string]bool{
+	"=":    true,
+	"!=":   true,
+	"LIKE": true,
+	"IN":   true,
+	">":    true,
+	">=":   true,
+	"<":    true,
+	"<=":   true,
+}
+
 type EventRepository struct {
 	db *gorm.DB
 }
@@ -20,14 +58,16 @@ func NewEventRepository(db *gorm.DB) EventRepository {
 
 func (repo EventRepository) FindAll(limit int, offset int, filters []map[string]string, sorts []map[string]interface{}) ([]entities.Event, error) {
 	events := []entities.Event{}
-	builder := repo.db.Preload("User").Preload("Category").Preload("Participants").Limit(limit).Offset(offset)
+	builder := repo.db.Preload("User").Preload("Category").Preload("Participants").Preload("RSVPs").Preload("Cohosts", "status = ?", "accepted").Preload("Cohosts.User").Preload("TicketTypes").Preload("Tags").Preload("Images", orderImages).Preload("Trending").Preload("Rating").Preload("Group").Limit(limit).Offset(offset)
 	// Where filters
-	for _, filter := range filters {
-		builder.Where(filter["field"]+" "+filter["operator"]+" ?", filter["value"])
+	err := applyEventFilters(builder, filters)
+	if err != nil {
+		return []entities.Event{}, err
 	}
 	// OrderBy Filters
-	for _, sort := range sorts {
-		builder.Order(clause.OrderByColumn{Column: clause.Column{Name: sort["field"].(string)}, Desc: sort["desc"].(bool)})
+	err = applyEventSorts(builder, sorts)
+	if err != nil {
+		return []entities.Event{}, err
 	}
 	tx := builder.Find(&events)
 	if tx.Error != nil {
@@ -39,8 +79,9 @@ func (repo EventRepository) CountAll(filters []map[string]string) (int64, error)
 	var count int64
 	builder := repo.db.Model(&entities.Event{})
 	// Where filters
-	for _, filter := range filters {
-		builder.Where(filter["field"]+" "+filter["operator"]+" ?", filter["value"])
+	err := applyEventFilters(builder, filters)
+	if err != nil {
+		return -1, err
 	}
 	tx := builder.Count(&count)
 	if tx.Error != nil {
@@ -51,7 +92,18 @@ func (repo EventRepository) CountAll(filters []map[string]string) (int64, error)
 
 func (repo EventRepository) Find(id int) (entities.Event, error) {
 	event := entities.Event{}
-	tx := repo.db.Preload("User").Preload("Category").Preload("Participants").Find(&event, id)
+	tx := repo.db.Preload("User").Preload("Category").Preload("Participants").Preload("RSVPs").Preload("Cohosts", "status = ?", "accepted").Preload("Cohosts.User").Preload("TicketTypes").Preload("Tags").Preload("Images", orderImages).Preload("Rating").Preload("Group").Find(&event, id)
+	if tx.Error != nil {
+		return entities.Event{}, web.WebError{Code: 500, Message: "server error"}
+	} else if tx.RowsAffected <= 0 {
+		return entities.Event{}, web.WebError{Code: 400, Message: "cannot get event data with specified id"}
+	}
+	return event, nil
+}
+
+func (repo EventRepository) FindWithTeam(id int) (entities.Event, error) {
+	event := entities.Event{}
+	tx := repo.db.Preload("Cohosts", "status = ?", "accepted").Find(&event, id)
 	if tx.Error != nil {
 		return entities.Event{}, web.WebError{Code: 500, Message: "server error"}
 	} else if tx.RowsAffected <= 0 {
@@ -62,7 +114,7 @@ func (repo EventRepository) Find(id int) (entities.Event, error) {
 
 func (repo EventRepository) FindBy(field string, value string) (entities.Event, error) {
 	event := entities.Event{}
-	tx := repo.db.Preload("User").Preload("Category").Preload("Participants").Where(field+" = ?", value).Find(&event)
+	tx := repo.db.Preload("User").Preload("Category").Preload("Participants").Preload("RSVPs").Preload("Cohosts", "status = ?", "accepted").Preload("Cohosts.User").Preload("TicketTypes").Preload("Tags").Preload("Images", orderImages).Preload("Rating").Preload("Group").Where(field+" = ?", value).Find(&event)
 	if tx.Error != nil {
 		return entities.Event{}, web.WebError{Code: 500, Message: tx.Error.Error()}
 	} else if tx.RowsAffected <= 0 {
@@ -98,15 +150,91 @@ func (repo EventRepository) Delete(id int) error {
 
 func (repo EventRepository) DeleteBatch(filters []map[string]string) error {
 
-	builder := repo.db
-	// Where filters
-	// for _, filter := range filters {
-	// 	builder.Where(filter["field"]+" "+filter["operator"]+" ?", filter["value"])
-	// 	fmt.Println(filter["field"]+" "+filter["operator"]+" ?")
-	// }
-	tx := builder.Delete(&entities.Event{}, filters[0]["field"]+" "+filters[0]["operator"]+" ?", filters[0]["value"])
+	// Refuse to delete every event when no filter is given
+	if len(filters) == 0 {
+		return web.WebError{Code: 400, Message: "delete batch requires at least one filter"}
+	}
+	builder := repo.db.Model(&entities.Event{})
+	err := applyEventFilters(builder, filters)
+	if err != nil {
+		return err
+	}
+	tx := builder.Delete(&entities.Event{})
 	if tx.Error != nil {
 		return web.WebError{Code: 400, Message: tx.Error.Error()}
 	}
 	return nil
 }
+
+func orderImages(db *gorm.DB) *gorm.DB {
+	return db.Order("position ASC, id ASC")
+}
+
+/*
+ * Apply event filters
+ * -------------------------------
+ * Menerjemahkan filters menjadi where clause berdasarkan whitelist,
+ * operator IN menerima value yang dipisahkan koma
+ */
+func applyEventFilters(builder *gorm.DB, filters []map[string]string) error {
+	for _, filter := range filters {
+		if filter["field"] == "tags" {
+			err := applyTagFilter(builder, filter)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		column, ok := eventFilterColumns[filter["field"]]
+		if !ok {
+			return web.WebError{Code: 400, Message: "filter by " + filter["field"] + " is not allowed"}
+		}
+		operator := strings.ToUpper(filter["operator"])
+		if !eventFilterOperators[operator] {
+			return web.WebError{Code: 400, Message: "filter operator " + filter["operator"] + " is not allowed"}
+		}
+		if operator == "IN" {
+			builder.Where(column+" IN ?", strings.Split(filter["value"], ","))
+			continue
+		}
+		builder.Where(column+" "+operator+" ?", filter["value"])
+	}
+	return nil
+}
+
+/*
+ * Apply tag filter
+ * -------------------------------
+ * Operator IN mencari event yang memiliki salah satu tag,
+ * operator ALL mencari event yang memiliki semua tag
+ */
+func applyTagFilter(builder *gorm.DB, filter map[string]string) error {
+	names := strings.Split(filter["value"], ",")
+	switch strings.ToUpper(filter["operator"]) {
+	case "IN":
+		builder.Where("events.id IN (SELECT event_tags.event_id FROM event_tags JOIN tags ON tags.id = event_tags.tag_id WHERE tags.name IN ?)", names)
+	case "ALL":
+		builder.Where("(SELECT COUNT(DISTINCT event_tags.tag_id) FROM event_tags JOIN tags ON tags.id = event_tags.tag_id WHERE event_tags.event_id = events.id AND tags.name IN ?) = ?", names, len(names))
+	default:
+		return web.WebError{Code: 400, Message: "filter operator " + filter["operator"] + " is not allowed for tags"}
+	}
+	return nil
+}
+
+/*
+ * Apply event sorts
+ * -------------------------------
+ * Menerjemahkan sorts menjadi order by clause berdasarkan whitelist
+ */
+func applyEventSorts(builder *gorm.DB, sorts []map[string]interface{}) error {
+	for _, sort := range sorts {
+		field, _ := sort["field"].(string)
+		column, ok := eventFilterColumns[field]
+		if !ok {
+			return web.WebError{Code: 400, Message: "sort by " + field + " is not allowed"}
+		}
+		desc, _ := sort["desc"].(bool)
+		builder.Order(clause.OrderByColumn{Column: clause.Column{Name: column, Raw: true}, Desc: desc})
+	}
+	return nil
+}
@@ -17,6 +17,14 @@ type EventRepositoryInterface interface {
 	 */
 	Find(id int) (entities.Event, error)
 
+	/*
+	 * Find With Team
+	 * -------------------------------
+	 * Mencari event berdasarkan ID hanya beserta co-host yang accepted,
+	 * cukup untuk pengecekan permission tanpa memuat participant
+	 */
+	FindWithTeam(id int) (entities.Event, error)
+
 	/*
 	 * Find User
 	 * -------------------------------
@@ -57,6 +57,11 @@ func (repo EventRepositoryMock) Find(id int) (entities.Event, error) {
 	args := repo.Mock.Called()
 	return args.Get(0).(entities.Event), args.Error(1)
 }
+func (repo EventRepositoryMock) FindWithTeam(id int) (entities.Event, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.Event), args.Error(1)
+}
+
 func (repo EventRepositoryMock) FindBy(field string, value string) (entities.Event, error) {
 	args := repo.Mock.Called()
 	return args.Get(0).(entities.Event), args.Error(1)
@@ -0,0 +1,145 @@
+package eventimport
+
+import (
+	"errors"
+	"strconv"
+	"time"
+	"tupulung/entities"
+	"tupulung/entities/web"
+
+	"gorm.io/gorm"
+)
+
+type EventImportRepository struct {
+	db *gorm.DB
+}
+
+func NewEventImportRepository(db *gorm.DB) EventImportRepository {
+	return EventImportRepository{
+		db: db,
+	}
+}
+
+/*
+ * Store
+ * -------------------------------
+ * Menambahkan import beserta error baris yang tidak valid
+ */
+func (repo EventImportRepository) Store(eventImport entities.EventImport, importErrors []entities.EventImportError) (entities.EventImport, error) {
+	err := repo.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&eventImport).Error; err != nil {
+			return err
+		}
+		return storeImportErrors(tx, eventImport.ID, importErrors)
+	})
+	if err != nil {
+		return entities.EventImport{}, web.WebError{Code: 500, Message: err.Error()}
+	}
+	return eventImport, nil
+}
+
+/*
+ * Find
+ * -------------------------------
+ * Mencari import berdasarkan ID tanpa isi file
+ */
+func (repo EventImportRepository) Find(id int) (entities.EventImport, error) {
+	eventImport := entities.EventImport{}
+	tx := repo.db.Omit("payload").Find(&eventImport, id)
+	if tx.Error != nil {
+		return entities.EventImport{}, web.WebError{Code: 500, Message: "server error"}
+	} else if tx.RowsAffected <= 0 {
+		return entities.EventImport{}, web.WebError{Code: 400, Message: "cannot get import data with specified id"}
+	}
+	return eventImport, nil
+}
+
+/*
+ * Find Errors
+ * -------------------------------
+ * Mengambil error setiap baris import sesuai urutan baris
+ */
+func (repo EventImportRepository) FindErrors(importID int) ([]entities.EventImportError, error) {
+	importErrors := []entities.EventImportError{}
+	tx := repo.db.Where("event_import_id = ?", importID).Order("row_no ASC, id ASC").Find(&importErrors)
+	if tx.Error != nil {
+		return []entities.EventImportError{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return importErrors, nil
+}
+
+/*
+ * Find Pending
+ * -------------------------------
+ * Mengambil import yang belum selesai dari yang terlama
+ */
+func (repo EventImportRepository) FindPending(limit int) ([]entities.EventImport, error) {
+	imports := []entities.EventImport{}
+	tx := repo.db.Where("status IN ?", []string{"pending", "running"}).
+		Order("id ASC").Limit(limit).
+		Find(&imports)
+	if tx.Error != nil {
+		return []entities.EventImport{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return imports, nil
+}
+
+/*
+ * Claim
+ * -------------------------------
+ * Mengklaim baris from sampai to sebelum diproses, false jika
+ * baris tersebut sudah diklaim proses lain atau chunk sebelumnya
+ * belum dicatat
+ */
+func (repo EventImportRepository) Claim(id uint, from int, to int) (bool, error) {
+	tx := repo.db.Model(&entities.EventImport{}).
+		Where("id = ? AND processed = ? AND recorded = ? AND status IN ?", id, from, from, []string{"pending", "running"}).
+		Updates(map[string]interface{}{"processed": to, "status": "running", "claimed_at": time.Now()})
+	if tx.Error != nil {
+		return false, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return tx.RowsAffected > 0, nil
+}
+
+/*
+ * Record
+ * -------------------------------
+ * Mencatat hasil baris from sampai to yang sudah diklaim, import
+ * ditandai selesai jika finishedAt tidak nil
+ */
+func (repo EventImportRepository) Record(id uint, from int, to int, created int, failed int, importErrors []entities.EventImportError, finishedAt *time.Time) error {
+	err := repo.db.Transaction(func(tx *gorm.DB) error {
+		updates := map[string]interface{}{
+			"recorded": to,
+			"created":  gorm.Expr("created + ?", created),
+			"failed":   gorm.Expr("failed + ?", failed),
+		}
+		if finishedAt != nil {
+			updates["status"] = "completed"
+			updates["finished_at"] = *finishedAt
+		}
+		result := tx.Model(&entities.EventImport{}).Where("id = ? AND recorded = ?", id, from).Updates(updates)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected <= 0 {
+			return errors.New("rows " + strconv.Itoa(from) + " to " + strconv.Itoa(to) + " were already recorded")
+		}
+		return storeImportErrors(tx, id, importErrors)
+	})
+	if err != nil {
+		return web.WebError{Code: 500, Message: err.Error()}
+	}
+	return nil
+}
+
+func storeImportErrors(tx *gorm.DB, importID uint, importErrors []entities.EventImportError) error {
+	if len(importErrors) == 0 {
+		return nil
+	}
+	for i := range importErrors {
+		importErrors[i].ID = 0
+		importErrors[i].EventImportID = importID
+	}
+	return tx.CreateInBatches(&importErrors, 500).Error
+}
@@ -0,0 +1,54 @@
+package eventimport
+
+import (
+	"time"
+	"tupulung/entities"
+)
+
+type EventImportRepositoryInterface interface {
+
+	/*
+	 * Store
+	 * -------------------------------
+	 * Menambahkan import beserta error baris yang tidak valid
+	 */
+	Store(eventImport entities.EventImport, importErrors []entities.EventImportError) (entities.EventImport, error)
+
+	/*
+	 * Find
+	 * -------------------------------
+	 * Mencari import berdasarkan ID tanpa isi file
+	 */
+	Find(id int) (entities.EventImport, error)
+
+	/*
+	 * Find Errors
+	 * -------------------------------
+	 * Mengambil error setiap baris import sesuai urutan baris
+	 */
+	FindErrors(importID int) ([]entities.EventImportError, error)
+
+	/*
+	 * Find Pending
+	 * -------------------------------
+	 * Mengambil import yang belum selesai dari yang terlama
+	 */
+	FindPending(limit int) ([]entities.EventImport, error)
+
+	/*
+	 * Claim
+	 * -------------------------------
+	 * Mengklaim baris from sampai to sebelum diproses, false jika
+	 * baris tersebut sudah diklaim proses lain atau chunk sebelumnya
+	 * belum dicatat
+	 */
+	Claim(id uint, from int, to int) (bool, error)
+
+	/*
+	 * Record
+	 * -------------------------------
+	 * Mencatat hasil baris from sampai to yang sudah diklaim, import
+	 * ditandai selesai jika finishedAt tidak nil
+	 */
+	Record(id uint, from int, to int, created int, failed int, importErrors []entities.EventImportError, finishedAt *time.Time) error
+}
@@ -0,0 +1,69 @@
+package eventimport
+
+import (
+	"time"
+	"tupulung/entities"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type EventImportRepositoryMock struct {
+	Mock *mock.Mock
+}
+
+func NewEventImportRepositoryMock(mock *mock.Mock) *EventImportRepositoryMock {
+	return &EventImportRepositoryMock{
+		Mock: mock,
+	}
+}
+
+var EventImportCollection = []entities.EventImport{
+	{
+		ID:        1,
+		UserID:    1,
+		Format:    "csv",
+		Filename:  "events.csv",
+		Status:    "running",
+		Total:     4,
+		Processed: 2,
+		Recorded:  2,
+		Created:   1,
+		Failed:    1,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	},
+}
+
+var EventImportErrorCollection = []entities.EventImportError{
+	{ID: 1, EventImportID: 1, Row: 3, Field: "title", Error: "title field must be filled"},
+}
+
+func (repo EventImportRepositoryMock) Store(eventImport entities.EventImport, importErrors []entities.EventImportError) (entities.EventImport, error) {
+	args := repo.Mock.Called(len(importErrors))
+	return args.Get(0).(entities.EventImport), args.Error(1)
+}
+
+func (repo EventImportRepositoryMock) Find(id int) (entities.EventImport, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.EventImport), args.Error(1)
+}
+
+func (repo EventImportRepositoryMock) FindErrors(importID int) ([]entities.EventImportError, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).([]entities.EventImportError), args.Error(1)
+}
+
+func (repo EventImportRepositoryMock) FindPending(limit int) ([]entities.EventImport, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).([]entities.EventImport), args.Error(1)
+}
+
+func (repo EventImportRepositoryMock) Claim(id uint, from int, to int) (bool, error) {
+	args := repo.Mock.Called(from, to)
+	return args.Bool(0), args.Error(1)
+}
+
+func (repo EventImportRepositoryMock) Record(id uint, from int, to int, created int, failed int, importErrors []entities.EventImportError, finishedAt *time.Time) error {
+	args := repo.Mock.Called(created, failed)
+	return args.Error(0)
+}
@@ -0,0 +1,86 @@
+package gallery
+
+import (
+	"tupulung/entities"
+	"tupulung/entities/web"
+
+	"gorm.io/gorm"
+)
+
+type GalleryRepository struct {
+	db *gorm.DB
+}
+
+func NewGalleryRepository(db *gorm.DB) GalleryRepository {
+	return GalleryRepository{
+		db: db,
+	}
+}
+
+func (repo GalleryRepository) FindByEvent(eventID int) ([]entities.EventImage, error) {
+	images := []entities.EventImage{}
+	tx := repo.db.Where("event_id = ?", eventID).Order("position ASC, id ASC").Find(&images)
+	if tx.Error != nil {
+		return []entities.EventImage{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return images, nil
+}
+
+func (repo GalleryRepository) Find(id int) (entities.EventImage, error) {
+	image := entities.EventImage{}
+	tx := repo.db.Preload("Event").Preload("Event.Cohosts", "status = ?", "accepted").First(&image, id)
+	if tx.Error == gorm.ErrRecordNotFound {
+		return entities.EventImage{}, web.WebError{Code: 400, Message: "cannot get image data with specified id"}
+	} else if tx.Error != nil {
+		return entities.EventImage{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return image, nil
+}
+
+func (repo GalleryRepository) Store(images []entities.EventImage) ([]entities.EventImage, error) {
+	tx := repo.db.Omit("Event").Create(&images)
+	if tx.Error != nil {
+		return []entities.EventImage{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return images, nil
+}
+
+func (repo GalleryRepository) Update(image entities.EventImage) (entities.EventImage, error) {
+	tx := repo.db.Model(&entities.EventImage{ID: image.ID}).Update("caption", image.Caption)
+	if tx.Error != nil {
+		return entities.EventImage{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return image, nil
+}
+
+func (repo GalleryRepository) Reorder(eventID int, ids []uint) error {
+	err := repo.db.Transaction(func(tx *gorm.DB) error {
+		for position, id := range ids {
+			err := tx.Model(&entities.EventImage{}).Where("id = ? AND event_id = ?", id, eventID).Update("position", position).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return web.WebError{Code: 500, Message: err.Error()}
+	}
+	return nil
+}
+
+func (repo GalleryRepository) SetCover(eventID int, url string) error {
+	tx := repo.db.Model(&entities.Event{}).Where("id = ?", eventID).Update("cover", url)
+	if tx.Error != nil {
+		return web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return nil
+}
+
+func (repo GalleryRepository) Delete(id int) error {
+	tx := repo.db.Delete(&entities.EventImage{}, id)
+	if tx.Error != nil {
+		return web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return nil
+}
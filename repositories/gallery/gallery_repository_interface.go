@@ -0,0 +1,54 @@
+package gallery
+
+import "tupulung/entities"
+
+type GalleryRepositoryInterface interface {
+	/*
+	 * Find By Event
+	 * -------------------------------
+	 * Mengambil semua gambar gallery event sesuai urutan
+	 */
+	FindByEvent(eventID int) ([]entities.EventImage, error)
+
+	/*
+	 * Find
+	 * -------------------------------
+	 * Mencari gambar tunggal beserta event dan co-host-nya
+	 */
+	Find(id int) (entities.EventImage, error)
+
+	/*
+	 * Store
+	 * -------------------------------
+	 * Menambahkan beberapa gambar kedalam database
+	 */
+	Store(images []entities.EventImage) ([]entities.EventImage, error)
+
+	/*
+	 * Update
+	 * -------------------------------
+	 * Mengubah caption gambar
+	 */
+	Update(image entities.EventImage) (entities.EventImage, error)
+
+	/*
+	 * Reorder
+	 * -------------------------------
+	 * Menyimpan urutan gambar sesuai urutan ID yang diberikan
+	 */
+	Reorder(eventID int, ids []uint) error
+
+	/*
+	 * Set Cover
+	 * -------------------------------
+	 * Mengganti cover event dengan URL gambar
+	 */
+	SetCover(eventID int, url string) error
+
+	/*
+	 * Delete
+	 * -------------------------------
+	 * Menghapus gambar berdasarkan ID
+	 */
+	Delete(id int) error
+}
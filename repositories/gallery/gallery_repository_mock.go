@@ -0,0 +1,72 @@
+package gallery
+
+import (
+	"time"
+	"tupulung/entities"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type GalleryRepositoryMock struct {
+	Mock *mock.Mock
+}
+
+func NewGalleryRepositoryMock(mock *mock.Mock) *GalleryRepositoryMock {
+	return &GalleryRepositoryMock{
+		Mock: mock,
+	}
+}
+
+var EventImageCollection = []entities.EventImage{
+	{
+		ID:        1,
+		EventID:   1,
+		URL:       "https://tupulung.s3.amazonaws.com/event/gallery/venue.jpg",
+		Caption:   "Venue",
+		Position:  0,
+		CreatedAt: time.Now(),
+	},
+	{
+		ID:        2,
+		EventID:   1,
+		URL:       "https://tupulung.s3.amazonaws.com/event/gallery/agenda.png",
+		Caption:   "Agenda",
+		Position:  1,
+		CreatedAt: time.Now(),
+	},
+}
+
+func (repo GalleryRepositoryMock) FindByEvent(eventID int) ([]entities.EventImage, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).([]entities.EventImage), args.Error(1)
+}
+
+func (repo GalleryRepositoryMock) Find(id int) (entities.EventImage, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.EventImage), args.Error(1)
+}
+
+func (repo GalleryRepositoryMock) Store(images []entities.EventImage) ([]entities.EventImage, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).([]entities.EventImage), args.Error(1)
+}
+
+func (repo GalleryRepositoryMock) Update(image entities.EventImage) (entities.EventImage, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.EventImage), args.Error(1)
+}
+
+func (repo GalleryRepositoryMock) Reorder(eventID int, ids []uint) error {
+	args := repo.Mock.Called(ids)
+	return args.Error(0)
+}
+
+func (repo GalleryRepositoryMock) SetCover(eventID int, url string) error {
+	args := repo.Mock.Called(url)
+	return args.Error(0)
+}
+
+func (repo GalleryRepositoryMock) Delete(id int) error {
+	args := repo.Mock.Called()
+	return args.Error(0)
+}
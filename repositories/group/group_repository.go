@@ -0,0 +1,239 @@
+package group
+
+import (
+	"tupulung/entities"
+	"tupulung/entities/web"
+
+	"gorm.io/gorm"
+)
+
+type GroupRepository struct {
+	db *gorm.DB
+}
+
+func NewGroupRepository(db *gorm.DB) GroupRepository {
+	return GroupRepository{
+		db: db,
+	}
+}
+
+/*
+ * Find All
+ * -------------------------------
+ * Mengambil daftar group dari yang terbaru,
+ * keyword kosong berarti semua group
+ */
+func (repo GroupRepository) FindAll(keyword string, limit int, offset int) ([]entities.Group, error) {
+	groups := []entities.Group{}
+	builder := repo.db.Preload("User")
+	if keyword != "" {
+		builder = builder.Where("name LIKE ?", "%"+keyword+"%")
+	}
+	tx := builder.Order("created_at DESC, id DESC").Limit(limit).Offset(offset).Find(&groups)
+	if tx.Error != nil {
+		return []entities.Group{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return groups, nil
+}
+
+/*
+ * Count All
+ * -------------------------------
+ * Menghitung jumlah group sesuai keyword
+ */
+func (repo GroupRepository) CountAll(keyword string) (int64, error) {
+	var count int64
+	builder := repo.db.Model(&entities.Group{})
+	if keyword != "" {
+		builder = builder.Where("name LIKE ?", "%"+keyword+"%")
+	}
+	tx := builder.Count(&count)
+	if tx.Error != nil {
+		return 0, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return count, nil
+}
+
+/*
+ * Find
+ * -------------------------------
+ * Mencari group berdasarkan ID
+ */
+func (repo GroupRepository) Find(id int) (entities.Group, error) {
+	group := entities.Group{}
+	tx := repo.db.Preload("User").Find(&group, id)
+	if tx.Error != nil {
+		return entities.Group{}, web.WebError{Code: 500, Message: "server error"}
+	} else if tx.RowsAffected <= 0 {
+		return entities.Group{}, web.WebError{Code: 400, Message: "cannot get group data with specified id"}
+	}
+	return group, nil
+}
+
+/*
+ * Store
+ * -------------------------------
+ * Menambahkan group dengan pembuatnya sebagai organizer
+ */
+func (repo GroupRepository) Store(group entities.Group) (entities.Group, error) {
+	err := repo.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("User").Create(&group).Error; err != nil {
+			return err
+		}
+		return tx.Create(&entities.GroupMember{
+			GroupID: group.ID,
+			UserID:  group.UserID,
+			Role:    "organizer",
+			Status:  "approved",
+		}).Error
+	})
+	if err != nil {
+		return entities.Group{}, web.WebError{Code: 500, Message: err.Error()}
+	}
+	return group, nil
+}
+
+/*
+ * Update
+ * -------------------------------
+ * Mengubah profil group, HostedBy event milik group
+ * ikut diganti jika nama group berubah
+ */
+func (repo GroupRepository) Update(group entities.Group) (entities.Group, error) {
+	err := repo.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("User").Save(&group).Error; err != nil {
+			return err
+		}
+		return tx.Model(&entities.Event{}).Where("group_id = ?", group.ID).Update("hosted_by", group.Name).Error
+	})
+	if err != nil {
+		return entities.Group{}, web.WebError{Code: 500, Message: err.Error()}
+	}
+	return group, nil
+}
+
+/*
+ * Delete
+ * -------------------------------
+ * Menghapus group beserta anggotanya,
+ * event group tetap ada sebagai event organizer
+ */
+func (repo GroupRepository) Delete(id int) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("group_id = ?", id).Delete(&entities.GroupMember{}).Error; err != nil {
+			return web.WebError{Code: 500, Message: err.Error()}
+		}
+		if err := tx.Model(&entities.Event{}).Where("group_id = ?", id).Update("group_id", nil).Error; err != nil {
+			return web.WebError{Code: 500, Message: err.Error()}
+		}
+		if err := tx.Delete(&entities.Group{}, id).Error; err != nil {
+			return web.WebError{Code: 500, Message: err.Error()}
+		}
+		return nil
+	})
+}
+
+/*
+ * Find Member
+ * -------------------------------
+ * Mencari keanggotaan user pada group
+ */
+func (repo GroupRepository) FindMember(groupID int, userID int) (entities.GroupMember, error) {
+	member := entities.GroupMember{}
+	tx := repo.db.Preload("User").Where("group_id = ? AND user_id = ?", groupID, userID).Find(&member)
+	if tx.Error != nil {
+		return entities.GroupMember{}, web.WebError{Code: 500, Message: "server error"}
+	} else if tx.RowsAffected <= 0 {
+		return entities.GroupMember{}, web.WebError{Code: 400, Message: "user is not a member of this group"}
+	}
+	return member, nil
+}
+
+/*
+ * Find Members
+ * -------------------------------
+ * Mengambil anggota group berdasarkan status,
+ * organizer ditampilkan terlebih dahulu
+ */
+func (repo GroupRepository) FindMembers(groupID int, status string, limit int, offset int) ([]entities.GroupMember, error) {
+	members := []entities.GroupMember{}
+	tx := repo.db.Preload("User").Where("group_id = ? AND status = ?", groupID, status).
+		Order("role = 'organizer' DESC, created_at ASC, id ASC").Limit(limit).Offset(offset).
+		Find(&members)
+	if tx.Error != nil {
+		return []entities.GroupMember{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return members, nil
+}
+
+/*
+ * Count Members
+ * -------------------------------
+ * Menghitung anggota group berdasarkan status,
+ * role kosong berarti semua role
+ */
+func (repo GroupRepository) CountMembers(groupID int, status string, role string) (int64, error) {
+	var count int64
+	builder := repo.db.Model(&entities.GroupMember{}).Where("group_id = ? AND status = ?", groupID, status)
+	if role != "" {
+		builder = builder.Where("role = ?", role)
+	}
+	tx := builder.Count(&count)
+	if tx.Error != nil {
+		return 0, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return count, nil
+}
+
+/*
+ * Store Member
+ * -------------------------------
+ * Menambahkan anggota atau permintaan bergabung
+ */
+func (repo GroupRepository) StoreMember(member entities.GroupMember) (entities.GroupMember, error) {
+	tx := repo.db.Omit("User").Create(&member)
+	if tx.Error != nil {
+		return entities.GroupMember{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return member, nil
+}
+
+/*
+ * Update Member
+ * -------------------------------
+ * Mengubah role atau status anggota group
+ */
+func (repo GroupRepository) UpdateMember(member entities.GroupMember) (entities.GroupMember, error) {
+	tx := repo.db.Omit("User").Save(&member)
+	if tx.Error != nil {
+		return entities.GroupMember{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return member, nil
+}
+
+/*
+ * Delete Member
+ * -------------------------------
+ * Mengeluarkan anggota atau menolak permintaan bergabung
+ */
+func (repo GroupRepository) DeleteMember(groupID int, userID int) error {
+	tx := repo.db.Where("group_id = ? AND user_id = ?", groupID, userID).Delete(&entities.GroupMember{})
+	if tx.Error != nil {
+		return web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return nil
+}
+
+/*
+ * Member IDs
+ * -------------------------------
+ * Mengambil ID semua anggota group yang sudah disetujui
+ */
+func (repo GroupRepository) MemberIDs(groupID int) ([]uint, error) {
+	ids := []uint{}
+	tx := repo.db.Model(&entities.GroupMember{}).Where("group_id = ? AND status = ?", groupID, "approved").Pluck("user_id", &ids)
+	if tx.Error != nil {
+		return []uint{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return ids, nil
+}
@@ -0,0 +1,97 @@
+package group
+
+import "tupulung/entities"
+
+type GroupRepositoryInterface interface {
+
+	/*
+	 * Find All
+	 * -------------------------------
+	 * Mengambil daftar group dari yang terbaru
+	 */
+	FindAll(keyword string, limit int, offset int) ([]entities.Group, error)
+
+	/*
+	 * Count All
+	 * -------------------------------
+	 * Menghitung jumlah group sesuai keyword
+	 */
+	CountAll(keyword string) (int64, error)
+
+	/*
+	 * Find
+	 * -------------------------------
+	 * Mencari group berdasarkan ID
+	 */
+	Find(id int) (entities.Group, error)
+
+	/*
+	 * Store
+	 * -------------------------------
+	 * Menambahkan group dengan pembuatnya sebagai organizer
+	 */
+	Store(group entities.Group) (entities.Group, error)
+
+	/*
+	 * Update
+	 * -------------------------------
+	 * Mengubah profil group beserta HostedBy event group
+	 */
+	Update(group entities.Group) (entities.Group, error)
+
+	/*
+	 * Delete
+	 * -------------------------------
+	 * Menghapus group beserta anggotanya
+	 */
+	Delete(id int) error
+
+	/*
+	 * Find Member
+	 * -------------------------------
+	 * Mencari keanggotaan user pada group
+	 */
+	FindMember(groupID int, userID int) (entities.GroupMember, error)
+
+	/*
+	 * Find Members
+	 * -------------------------------
+	 * Mengambil anggota group berdasarkan status
+	 */
+	FindMembers(groupID int, status string, limit int, offset int) ([]entities.GroupMember, error)
+
+	/*
+	 * Count Members
+	 * -------------------------------
+	 * Menghitung anggota group berdasarkan status dan role
+	 */
+	CountMembers(groupID int, status string, role string) (int64, error)
+
+	/*
+	 * Store Member
+	 * -------------------------------
+	 * Menambahkan anggota atau permintaan bergabung
+	 */
+	StoreMember(member entities.GroupMember) (entities.GroupMember, error)
+
+	/*
+	 * Update Member
+	 * -------------------------------
+	 * Mengubah role atau status anggota group
+	 */
+	UpdateMember(member entities.GroupMember) (entities.GroupMember, error)
+
+	/*
+	 * Delete Member
+	 * -------------------------------
+	 * Mengeluarkan anggota dari group
+	 */
+	DeleteMember(groupID int, userID int) error
+
+	/*
+	 * Member IDs
+	 * -------------------------------
+	 * Mengambil ID anggota group yang sudah disetujui
+	 */
+	MemberIDs(groupID int) ([]uint, error)
+}
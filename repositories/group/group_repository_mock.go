@@ -0,0 +1,107 @@
+package group
+
+import (
+	"time"
+	"tupulung/entities"
+
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type GroupRepositoryMock struct {
+	Mock *mock.Mock
+}
+
+func NewGroupRepositoryMock(mock *mock.Mock) *GroupRepositoryMock {
+	return &GroupRepositoryMock{
+		Mock: mock,
+	}
+}
+
+var GroupCollection = []entities.Group{
+	{
+		Model:       gorm.Model{ID: 1, CreatedAt: time.Now()},
+		Name:        "Jakarta Gophers",
+		Description: "Komunitas Go di Jakarta",
+		JoinPolicy:  "approval",
+		UserID:      1,
+	},
+	{
+		Model:       gorm.Model{ID: 2, CreatedAt: time.Now()},
+		Name:        "Bandung Runners",
+		Description: "Lari pagi bersama setiap minggu",
+		JoinPolicy:  "open",
+		UserID:      2,
+	},
+}
+
+var GroupMemberCollection = []entities.GroupMember{
+	{ID: 1, GroupID: 1, UserID: 1, Role: "organizer", Status: "approved"},
+	{ID: 2, GroupID: 1, UserID: 2, Role: "member", Status: "approved"},
+	{ID: 3, GroupID: 1, UserID: 3, Role: "member", Status: "pending"},
+}
+
+func (repo GroupRepositoryMock) FindAll(keyword string, limit int, offset int) ([]entities.Group, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).([]entities.Group), args.Error(1)
+}
+
+func (repo GroupRepositoryMock) CountAll(keyword string) (int64, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (repo GroupRepositoryMock) Find(id int) (entities.Group, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.Group), args.Error(1)
+}
+
+func (repo GroupRepositoryMock) Store(group entities.Group) (entities.Group, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.Group), args.Error(1)
+}
+
+func (repo GroupRepositoryMock) Update(group entities.Group) (entities.Group, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.Group), args.Error(1)
+}
+
+func (repo GroupRepositoryMock) Delete(id int) error {
+	args := repo.Mock.Called(id)
+	return args.Error(0)
+}
+
+func (repo GroupRepositoryMock) FindMember(groupID int, userID int) (entities.GroupMember, error) {
+	args := repo.Mock.Called(userID)
+	return args.Get(0).(entities.GroupMember), args.Error(1)
+}
+
+func (repo GroupRepositoryMock) FindMembers(groupID int, status string, limit int, offset int) ([]entities.GroupMember, error) {
+	args := repo.Mock.Called(status)
+	return args.Get(0).([]entities.GroupMember), args.Error(1)
+}
+
+func (repo GroupRepositoryMock) CountMembers(groupID int, status string, role string) (int64, error) {
+	args := repo.Mock.Called(status, role)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (repo GroupRepositoryMock) StoreMember(member entities.GroupMember) (entities.GroupMember, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.GroupMember), args.Error(1)
+}
+
+func (repo GroupRepositoryMock) UpdateMember(member entities.GroupMember) (entities.GroupMember, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.GroupMember), args.Error(1)
+}
+
+func (repo GroupRepositoryMock) DeleteMember(groupID int, userID int) error {
+	args := repo.Mock.Called(userID)
+	return args.Error(0)
+}
+
+func (repo GroupRepositoryMock) MemberIDs(groupID int) ([]uint, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).([]uint), args.Error(1)
+}
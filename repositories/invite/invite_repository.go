@@ -0,0 +1,111 @@
+package invite
+
+import (
+	"time"
+	"tupulung/entities"
+	"tupulung/entities/web"
+
+	"gorm.io/gorm"
+)
+
+// Kondisi invite yang masih bisa dipakai, parameter berupa waktu sekarang
+const UsableCondition = "revoked_at IS NULL AND (expires_at IS NULL OR expires_at > ?) AND (max_uses = 0 OR uses < max_uses)"
+
+type InviteRepository struct {
+	db *gorm.DB
+}
+
+func NewInviteRepository(db *gorm.DB) InviteRepository {
+	return InviteRepository{
+		db: db,
+	}
+}
+
+/*
+ * Find By Event
+ * -------------------------------
+ * Mengambil semua invite milik sebuah event
+ */
+func (repo InviteRepository) FindByEvent(eventID int) ([]entities.EventInvite, error) {
+	invites := []entities.EventInvite{}
+	tx := repo.db.Where("event_id = ?", eventID).Order("created_at DESC").Find(&invites)
+	if tx.Error != nil {
+		return []entities.EventInvite{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return invites, nil
+}
+
+/*
+ * Find
+ * -------------------------------
+ * Mencari invite tunggal berdasarkan ID
+ */
+func (repo InviteRepository) Find(id int) (entities.EventInvite, error) {
+	invite := entities.EventInvite{}
+	tx := repo.db.Preload("Event").Preload("Event.Cohosts", "status = ?", "accepted").Find(&invite, id)
+	if tx.Error != nil {
+		return entities.EventInvite{}, web.WebError{Code: 500, Message: "server error"}
+	} else if tx.RowsAffected <= 0 {
+		return entities.EventInvite{}, web.WebError{Code: 400, Message: "cannot get invite data with specified id"}
+	}
+	return invite, nil
+}
+
+/*
+ * Find Usable By Code
+ * -------------------------------
+ * Mencari invite berdasarkan kode yang belum di-revoke,
+ * belum expired dan belum mencapai batas penggunaan
+ */
+func (repo InviteRepository) FindUsableByCode(eventID int, code string) (entities.EventInvite, error) {
+	invite := entities.EventInvite{}
+	tx := repo.db.Where("event_id = ? AND code = ?", eventID, code).Where(UsableCondition, time.Now()).Find(&invite)
+	if tx.Error != nil {
+		return entities.EventInvite{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	} else if tx.RowsAffected <= 0 {
+		return entities.EventInvite{}, web.WebError{Code: 400, Message: "invite code is invalid or no longer usable"}
+	}
+	return invite, nil
+}
+
+/*
+ * Find Usable By User
+ * -------------------------------
+ * Mencari invite personal untuk user tertentu yang masih berlaku
+ */
+func (repo InviteRepository) FindUsableByUser(eventID int, userID int) (entities.EventInvite, error) {
+	invite := entities.EventInvite{}
+	tx := repo.db.Where("event_id = ? AND user_id = ?", eventID, userID).Where(UsableCondition, time.Now()).Find(&invite)
+	if tx.Error != nil {
+		return entities.EventInvite{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	} else if tx.RowsAffected <= 0 {
+		return entities.EventInvite{}, web.WebError{Code: 400, Message: "user is not invited to this event"}
+	}
+	return invite, nil
+}
+
+/*
+ * Store
+ * -------------------------------
+ * Menambahkan invite kedalam database
+ */
+func (repo InviteRepository) Store(invite entities.EventInvite) (entities.EventInvite, error) {
+	tx := repo.db.Create(&invite)
+	if tx.Error != nil {
+		return entities.EventInvite{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return invite, nil
+}
+
+/*
+ * Update
+ * -------------------------------
+ * Mengupdate data invite berdasarkan ID
+ */
+func (repo InviteRepository) Update(invite entities.EventInvite, id int) (entities.EventInvite, error) {
+	tx := repo.db.Omit("Event").Save(&invite)
+	if tx.Error != nil {
+		return entities.EventInvite{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return invite, nil
+}
@@ -0,0 +1,48 @@
+package invite
+
+import "tupulung/entities"
+
+type InviteRepositoryInterface interface {
+	/*
+	 * Find By Event
+	 * -------------------------------
+	 * Mengambil semua invite milik sebuah event
+	 */
+	FindByEvent(eventID int) ([]entities.EventInvite, error)
+
+	/*
+	 * Find
+	 * -------------------------------
+	 * Mencari invite tunggal berdasarkan ID
+	 */
+	Find(id int) (entities.EventInvite, error)
+
+	/*
+	 * Find Usable By Code
+	 * -------------------------------
+	 * Mencari invite berdasarkan kode yang belum di-revoke,
+	 * belum expired dan belum mencapai batas penggunaan
+	 */
+	FindUsableByCode(eventID int, code string) (entities.EventInvite, error)
+
+	/*
+	 * Find Usable By User
+	 * -------------------------------
+	 * Mencari invite personal untuk user tertentu yang masih berlaku
+	 */
+	FindUsableByUser(eventID int, userID int) (entities.EventInvite, error)
+
+	/*
+	 * Store
+	 * -------------------------------
+	 * Menambahkan invite kedalam database
+	 */
+	Store(invite entities.EventInvite) (entities.EventInvite, error)
+
+	/*
+	 * Update
+	 * -------------------------------
+	 * Mengupdate data invite berdasarkan ID
+	 */
+	Update(invite entities.EventInvite, id int) (entities.EventInvite, error)
+}
@@ -0,0 +1,65 @@
+package invite
+
+import (
+	"time"
+	"tupulung/entities"
+
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type InviteRepositoryMock struct {
+	Mock *mock.Mock
+}
+
+func NewInviteRepositoryMock(mock *mock.Mock) *InviteRepositoryMock {
+	return &InviteRepositoryMock{
+		Mock: mock,
+	}
+}
+
+var InviteCollection = []entities.EventInvite{
+	{
+		Model:   gorm.Model{ID: 1, CreatedAt: time.Now(), UpdatedAt: time.Now()},
+		EventID: 1,
+		UserID:  2,
+		Code:    "a1b2c3d4e5f6",
+	},
+	{
+		Model:   gorm.Model{ID: 2, CreatedAt: time.Now(), UpdatedAt: time.Now()},
+		EventID: 1,
+		Code:    "f6e5d4c3b2a1",
+		MaxUses: 10,
+		Uses:    3,
+	},
+}
+
+func (repo InviteRepositoryMock) FindByEvent(eventID int) ([]entities.EventInvite, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).([]entities.EventInvite), args.Error(1)
+}
+
+func (repo InviteRepositoryMock) Find(id int) (entities.EventInvite, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.EventInvite), args.Error(1)
+}
+
+func (repo InviteRepositoryMock) FindUsableByCode(eventID int, code string) (entities.EventInvite, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.EventInvite), args.Error(1)
+}
+
+func (repo InviteRepositoryMock) FindUsableByUser(eventID int, userID int) (entities.EventInvite, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.EventInvite), args.Error(1)
+}
+
+func (repo InviteRepositoryMock) Store(invite entities.EventInvite) (entities.EventInvite, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.EventInvite), args.Error(1)
+}
+
+func (repo InviteRepositoryMock) Update(invite entities.EventInvite, id int) (entities.EventInvite, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.EventInvite), args.Error(1)
+}
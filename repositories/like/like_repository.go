@@ -69,3 +69,20 @@ func (repo LikeRepository) Delete(user entities.User, event entities.Event) erro
 // } else {
 //     fmt.Println("Ada")
 // }
+
+/*
+ * Find Liked Events
+ * -------------------------------
+ * Mengambil semua event yang di like oleh user
+ */
+func (repo LikeRepository) FindLikedEvents(userID int) ([]entities.Event, error) {
+	events := []entities.Event{}
+	tx := repo.db.Preload("User").Preload("Category").Preload("Tags").
+		Joins("JOIN likes ON likes.event_id = events.id").
+		Where("likes.user_id = ?", userID).
+		Find(&events)
+	if tx.Error != nil {
+		return []entities.Event{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return events, nil
+}
@@ -24,4 +24,11 @@ type LikeRepositoryInterface interface {
 	 * Menghapus user dari event
 	 */
 	Delete(user entities.User, event entities.Event) error
+
+	/*
+	 * Find Liked Events
+	 * -------------------------------
+	 * Mengambil semua event yang di like oleh user
+	 */
+	FindLikedEvents(userID int) ([]entities.Event, error)
 }
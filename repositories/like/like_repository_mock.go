@@ -43,3 +43,8 @@ func (repo LikeRepositoryMock) Delete(user entities.User, event entities.Event)
 	args := repo.Mock.Called()
 	return args.Error(0)
 }
+
+func (repo LikeRepositoryMock) FindLikedEvents(userID int) ([]entities.Event, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).([]entities.Event), args.Error(1)
+}
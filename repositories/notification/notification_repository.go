@@ -0,0 +1,98 @@
+package notification
+
+import (
+	"time"
+	"tupulung/entities"
+	"tupulung/entities/web"
+
+	"gorm.io/gorm"
+)
+
+type NotificationRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationRepository(db *gorm.DB) NotificationRepository {
+	return NotificationRepository{
+		db: db,
+	}
+}
+
+/*
+ * Find By User
+ * -------------------------------
+ * Mengambil notifikasi user dari yang terbaru
+ */
+func (repo NotificationRepository) FindByUser(userID int, unreadOnly bool, limit int, offset int) ([]entities.Notification, error) {
+	notifications := []entities.Notification{}
+	builder := repo.db.Where("user_id = ?", userID)
+	if unreadOnly {
+		builder = builder.Where("read_at IS NULL")
+	}
+	tx := builder.Order("created_at DESC, id DESC").Limit(limit).Offset(offset).Find(&notifications)
+	if tx.Error != nil {
+		return []entities.Notification{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return notifications, nil
+}
+
+/*
+ * Count Unread
+ * -------------------------------
+ * Menghitung notifikasi user yang belum dibaca
+ */
+func (repo NotificationRepository) CountUnread(userID int) (int64, error) {
+	var count int64
+	tx := repo.db.Model(&entities.Notification{}).Where("user_id = ? AND read_at IS NULL", userID).Count(&count)
+	if tx.Error != nil {
+		return 0, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return count, nil
+}
+
+/*
+ * Store
+ * -------------------------------
+ * Menambahkan notifikasi kedalam database
+ */
+func (repo NotificationRepository) Store(notification entities.Notification) (entities.Notification, error) {
+	tx := repo.db.Create(&notification)
+	if tx.Error != nil {
+		return entities.Notification{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return notification, nil
+}
+
+/*
+ * Store Many
+ * -------------------------------
+ * Menambahkan banyak notifikasi sekaligus secara bertahap
+ */
+func (repo NotificationRepository) StoreMany(notifications []entities.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+	tx := repo.db.CreateInBatches(&notifications, 500)
+	if tx.Error != nil {
+		return web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return nil
+}
+
+/*
+ * Mark Read
+ * -------------------------------
+ * Menandai notifikasi milik user sebagai sudah dibaca,
+ * id 0 berarti semua notifikasi user
+ */
+func (repo NotificationRepository) MarkRead(id int, userID int, at time.Time) error {
+	builder := repo.db.Model(&entities.Notification{}).Where("user_id = ? AND read_at IS NULL", userID)
+	if id != 0 {
+		builder = builder.Where("id = ?", id)
+	}
+	tx := builder.Update("read_at", at)
+	if tx.Error != nil {
+		return web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return nil
+}
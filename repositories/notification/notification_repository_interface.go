@@ -0,0 +1,45 @@
+package notification
+
+import (
+	"time"
+	"tupulung/entities"
+)
+
+type NotificationRepositoryInterface interface {
+
+	/*
+	 * Find By User
+	 * -------------------------------
+	 * Mengambil notifikasi user dari yang terbaru
+	 */
+	FindByUser(userID int, unreadOnly bool, limit int, offset int) ([]entities.Notification, error)
+
+	/*
+	 * Count Unread
+	 * -------------------------------
+	 * Menghitung notifikasi user yang belum dibaca
+	 */
+	CountUnread(userID int) (int64, error)
+
+	/*
+	 * Store
+	 * -------------------------------
+	 * Menambahkan notifikasi kedalam database
+	 */
+	Store(notification entities.Notification) (entities.Notification, error)
+
+	/*
+	 * Store Many
+	 * -------------------------------
+	 * Menambahkan banyak notifikasi sekaligus secara bertahap
+	 */
+	StoreMany(notifications []entities.Notification) error
+
+	/*
+	 * Mark Read
+	 * -------------------------------
+	 * Menandai notifikasi milik user sebagai sudah dibaca,
+	 * id 0 berarti semua notifikasi user
+	 */
+	MarkRead(id int, userID int, at time.Time) error
+}
@@ -0,0 +1,54 @@
+package notification
+
+import (
+	"time"
+	"tupulung/entities"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type NotificationRepositoryMock struct {
+	Mock *mock.Mock
+}
+
+func NewNotificationRepositoryMock(mock *mock.Mock) *NotificationRepositoryMock {
+	return &NotificationRepositoryMock{
+		Mock: mock,
+	}
+}
+
+var NotificationCollection = []entities.Notification{
+	{
+		ID:        1,
+		UserID:    1,
+		Kind:      "reminder",
+		Title:     "Reminder: Golang Meetup",
+		Body:      "Golang Meetup starts soon",
+		CreatedAt: time.Now(),
+	},
+}
+
+func (repo NotificationRepositoryMock) FindByUser(userID int, unreadOnly bool, limit int, offset int) ([]entities.Notification, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).([]entities.Notification), args.Error(1)
+}
+
+func (repo NotificationRepositoryMock) CountUnread(userID int) (int64, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (repo NotificationRepositoryMock) Store(notification entities.Notification) (entities.Notification, error) {
+	args := repo.Mock.Called(notification.UserID)
+	return args.Get(0).(entities.Notification), args.Error(1)
+}
+
+func (repo NotificationRepositoryMock) StoreMany(notifications []entities.Notification) error {
+	args := repo.Mock.Called(len(notifications))
+	return args.Error(0)
+}
+
+func (repo NotificationRepositoryMock) MarkRead(id int, userID int, at time.Time) error {
+	args := repo.Mock.Called(id)
+	return args.Error(0)
+}
@@ -0,0 +1,312 @@
+package order
+
+import (
+	"encoding/json"
+	"time"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	inviteRepository "tupulung/repositories/invite"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type OrderRepository struct {
+	db *gorm.DB
+}
+
+func NewOrderRepository(db *gorm.DB) OrderRepository {
+	return OrderRepository{
+		db: db,
+	}
+}
+
+/*
+ * Find By User
+ * -------------------------------
+ * Mengambil semua order milik user
+ */
+func (repo OrderRepository) FindByUser(userID int) ([]entities.Order, error) {
+	orders := []entities.Order{}
+	tx := repo.db.Preload("TicketType").Preload("Tickets").Where("user_id = ?", userID).Order("created_at DESC").Find(&orders)
+	if tx.Error != nil {
+		return []entities.Order{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return orders, nil
+}
+
+/*
+ * Find By Event
+ * -------------------------------
+ * Mengambil semua order sebuah event
+ */
+func (repo OrderRepository) FindByEvent(eventID int) ([]entities.Order, error) {
+	orders := []entities.Order{}
+	tx := repo.db.Preload("TicketType").Preload("Tickets").Where("event_id = ?", eventID).Order("created_at DESC").Find(&orders)
+	if tx.Error != nil {
+		return []entities.Order{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return orders, nil
+}
+
+/*
+ * Find
+ * -------------------------------
+ * Mencari order berdasarkan ID beserta tiket & event-nya
+ */
+func (repo OrderRepository) Find(id int) (entities.Order, error) {
+	order := entities.Order{}
+	tx := repo.preloadOrder().Find(&order, id)
+	if tx.Error != nil {
+		return entities.Order{}, web.WebError{Code: 500, Message: "server error"}
+	} else if tx.RowsAffected <= 0 {
+		return entities.Order{}, web.WebError{Code: 400, Message: "cannot get order data with specified id"}
+	}
+	return order, nil
+}
+
+/*
+ * Find By Reference
+ * -------------------------------
+ * Mencari order berdasarkan reference pembayaran dari provider
+ */
+func (repo OrderRepository) FindByReference(reference string) (entities.Order, error) {
+	order := entities.Order{}
+	tx := repo.preloadOrder().Where("payment_reference = ?", reference).Find(&order)
+	if tx.Error != nil {
+		return entities.Order{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	} else if tx.RowsAffected <= 0 {
+		return entities.Order{}, web.WebError{Code: 400, Message: "cannot get order data with specified reference"}
+	}
+	return order, nil
+}
+
+func (repo OrderRepository) preloadOrder() *gorm.DB {
+	return repo.db.Preload("TicketType").Preload("Tickets").Preload("Event").Preload("Event.Cohosts", "status = ?", "accepted")
+}
+
+/*
+ * Store
+ * -------------------------------
+ * Menambahkan order kedalam database
+ */
+func (repo OrderRepository) Store(order entities.Order) (entities.Order, error) {
+	tx := repo.db.Omit(clause.Associations).Create(&order)
+	if tx.Error != nil {
+		return entities.Order{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return order, nil
+}
+
+/*
+ * Update
+ * -------------------------------
+ * Mengupdate data pembayaran order
+ */
+func (repo OrderRepository) Update(order entities.Order, id int) (entities.Order, error) {
+	tx := repo.db.Omit(clause.Associations).Save(&order)
+	if tx.Error != nil {
+		return entities.Order{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return order, nil
+}
+
+/*
+ * Confirm
+ * -------------------------------
+ * Menandai order sebagai paid, memindahkan tiket reserved
+ * menjadi sold, menerbitkan tiket dan mendaftarkan participant
+ * beserta jawaban registrasinya.
+ * Order yang reservasinya sudah expired hanya bisa dikonfirmasi
+ * jika tiket masih tersedia
+ */
+func (repo OrderRepository) Confirm(order entities.Order, tickets []entities.Ticket) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		current := entities.Order{}
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&current, order.ID).Error
+		if err != nil {
+			return web.WebError{Code: 400, Message: "cannot get order data with specified id"}
+		}
+
+		switch current.Status {
+		case "pending":
+			err = tx.Model(&entities.TicketType{}).Where("id = ?", current.TicketTypeID).UpdateColumns(map[string]interface{}{
+				"reserved": gorm.Expr("reserved - ?", current.Quantity),
+				"sold":     gorm.Expr("sold + ?", current.Quantity),
+			}).Error
+			if err != nil {
+				return web.WebError{Code: 500, Message: err.Error()}
+			}
+		case "expired":
+			result := tx.Model(&entities.TicketType{}).
+				Where("id = ? AND sold + reserved + ? <= quantity", current.TicketTypeID, current.Quantity).
+				UpdateColumn("sold", gorm.Expr("sold + ?", current.Quantity))
+			if result.Error != nil {
+				return web.WebError{Code: 500, Message: result.Error.Error()}
+			} else if result.RowsAffected <= 0 {
+				return web.WebError{Code: 409, Message: "Not enough tickets left for this ticket type"}
+			}
+		default:
+			// Sudah diproses sebelumnya
+			return nil
+		}
+
+		now := time.Now()
+		err = tx.Model(&current).Updates(map[string]interface{}{"status": "paid", "paid_at": now}).Error
+		if err != nil {
+			return web.WebError{Code: 500, Message: err.Error()}
+		}
+		if len(tickets) > 0 {
+			err = tx.Create(&tickets).Error
+			if err != nil {
+				return web.WebError{Code: 500, Message: err.Error()}
+			}
+		}
+		participant := entities.Participant{}
+		result := tx.Where("event_id = ? AND user_id = ?", current.EventID, current.UserID).Limit(1).Find(&participant)
+		if result.Error != nil {
+			return web.WebError{Code: 500, Message: result.Error.Error()}
+		}
+		if result.RowsAffected > 0 {
+			err = tx.Model(&participant).Update("status", "going").Error
+		} else {
+			// Invite hanya dipakai jika participant benar-benar ditambahkan
+			err = tx.Create(&entities.Participant{EventID: current.EventID, UserID: current.UserID, InviteID: current.InviteID, Status: "going"}).Error
+		}
+		if err != nil {
+			return web.WebError{Code: 500, Message: err.Error()}
+		}
+		if result.RowsAffected <= 0 && current.InviteID != nil {
+			used := tx.Model(&entities.EventInvite{}).
+				Where("id = ?", *current.InviteID).
+				Where(inviteRepository.UsableCondition, time.Now()).
+				UpdateColumn("uses", gorm.Expr("uses + 1"))
+			if used.Error != nil {
+				return web.WebError{Code: 500, Message: used.Error.Error()}
+			} else if used.RowsAffected <= 0 {
+				return web.WebError{Code: 409, Message: "invite code is invalid or no longer usable"}
+			}
+		}
+
+		// Jawaban registrasi yang dikirim saat order menggantikan jawaban sebelumnya
+		if current.Answers == "" {
+			return nil
+		}
+		answers := []entities.EventAnswer{}
+		if err := json.Unmarshal([]byte(current.Answers), &answers); err != nil {
+			return web.WebError{Code: 500, Message: err.Error()}
+		}
+		err = tx.Where("event_id = ? AND user_id = ?", current.EventID, current.UserID).Delete(&entities.EventAnswer{}).Error
+		if err != nil {
+			return web.WebError{Code: 500, Message: err.Error()}
+		}
+		if len(answers) > 0 {
+			if err := tx.Omit(clause.Associations).Create(&answers).Error; err != nil {
+				return web.WebError{Code: 500, Message: err.Error()}
+			}
+		}
+		return nil
+	})
+}
+
+/*
+ * Release
+ * -------------------------------
+ * Membatalkan order pending dan mengembalikan tiket yang di-reserve
+ */
+func (repo OrderRepository) Release(order entities.Order, status string) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		return releaseOrder(tx, order.ID, status)
+	})
+}
+
+/*
+ * Expire Stale
+ * -------------------------------
+ * Membatalkan semua order pending yang sudah melewati batas waktu
+ */
+func (repo OrderRepository) ExpireStale(now time.Time) (int, error) {
+	orders := []entities.Order{}
+	tx := repo.db.Select("id").Where("status = ? AND expires_at < ?", "pending", now).Find(&orders)
+	if tx.Error != nil {
+		return 0, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	for _, order := range orders {
+		err := repo.db.Transaction(func(tx *gorm.DB) error {
+			return releaseOrder(tx, order.ID, "expired")
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+	return len(orders), nil
+}
+
+func releaseOrder(tx *gorm.DB, id uint, status string) error {
+	current := entities.Order{}
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&current, id).Error
+	if err != nil {
+		return web.WebError{Code: 400, Message: "cannot get order data with specified id"}
+	}
+	if current.Status != "pending" {
+		return nil
+	}
+	err = tx.Model(&current).Update("status", status).Error
+	if err != nil {
+		return web.WebError{Code: 500, Message: err.Error()}
+	}
+	err = tx.Model(&entities.TicketType{}).Where("id = ?", current.TicketTypeID).
+		UpdateColumn("reserved", gorm.Expr("reserved - ?", current.Quantity)).Error
+	if err != nil {
+		return web.WebError{Code: 500, Message: err.Error()}
+	}
+	return nil
+}
+
+/*
+ * Refund
+ * -------------------------------
+ * Menandai order paid sebagai refunded, membatalkan tiketnya
+ * dan mengeluarkan participant jika tidak punya tiket lain
+ */
+func (repo OrderRepository) Refund(order entities.Order) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		current := entities.Order{}
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&current, order.ID).Error
+		if err != nil {
+			return web.WebError{Code: 400, Message: "cannot get order data with specified id"}
+		}
+		if current.Status != "paid" {
+			return web.WebError{Code: 400, Message: "Only paid orders can be refunded"}
+		}
+
+		now := time.Now()
+		err = tx.Model(&current).Updates(map[string]interface{}{"status": "refunded", "refunded_at": now}).Error
+		if err != nil {
+			return web.WebError{Code: 500, Message: err.Error()}
+		}
+		err = tx.Model(&entities.Ticket{}).Where("order_id = ?", current.ID).Update("status", "refunded").Error
+		if err != nil {
+			return web.WebError{Code: 500, Message: err.Error()}
+		}
+		err = tx.Model(&entities.TicketType{}).Where("id = ?", current.TicketTypeID).
+			UpdateColumn("sold", gorm.Expr("sold - ?", current.Quantity)).Error
+		if err != nil {
+			return web.WebError{Code: 500, Message: err.Error()}
+		}
+
+		// Participant tetap terdaftar jika masih punya tiket lain
+		var validTickets int64
+		err = tx.Model(&entities.Ticket{}).Where("event_id = ? AND user_id = ? AND status = ?", current.EventID, current.UserID, "valid").Count(&validTickets).Error
+		if err != nil {
+			return web.WebError{Code: 500, Message: err.Error()}
+		}
+		if validTickets == 0 {
+			err = tx.Where("event_id = ? AND user_id = ?", current.EventID, current.UserID).Delete(&entities.Participant{}).Error
+			if err != nil {
+				return web.WebError{Code: 500, Message: err.Error()}
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,82 @@
+package order
+
+import (
+	"time"
+	"tupulung/entities"
+)
+
+type OrderRepositoryInterface interface {
+	/*
+	 * Find By User
+	 * -------------------------------
+	 * Mengambil semua order milik user
+	 */
+	FindByUser(userID int) ([]entities.Order, error)
+
+	/*
+	 * Find By Event
+	 * -------------------------------
+	 * Mengambil semua order sebuah event
+	 */
+	FindByEvent(eventID int) ([]entities.Order, error)
+
+	/*
+	 * Find
+	 * -------------------------------
+	 * Mencari order berdasarkan ID beserta tiket & event-nya
+	 */
+	Find(id int) (entities.Order, error)
+
+	/*
+	 * Find By Reference
+	 * -------------------------------
+	 * Mencari order berdasarkan reference pembayaran dari provider
+	 */
+	FindByReference(reference string) (entities.Order, error)
+
+	/*
+	 * Store
+	 * -------------------------------
+	 * Menambahkan order kedalam database
+	 */
+	Store(order entities.Order) (entities.Order, error)
+
+	/*
+	 * Update
+	 * -------------------------------
+	 * Mengupdate data pembayaran order
+	 */
+	Update(order entities.Order, id int) (entities.Order, error)
+
+	/*
+	 * Confirm
+	 * -------------------------------
+	 * Menandai order sebagai paid, memindahkan tiket reserved
+	 * menjadi sold, menerbitkan tiket dan mendaftarkan participant
+	 * beserta jawaban registrasinya.
+	 * Order yang sudah diproses diabaikan
+	 */
+	Confirm(order entities.Order, tickets []entities.Ticket) error
+
+	/*
+	 * Release
+	 * -------------------------------
+	 * Membatalkan order pending dan mengembalikan tiket yang di-reserve
+	 */
+	Release(order entities.Order, status string) error
+
+	/*
+	 * Expire Stale
+	 * -------------------------------
+	 * Membatalkan semua order pending yang sudah melewati batas waktu
+	 */
+	ExpireStale(now time.Time) (int, error)
+
+	/*
+	 * Refund
+	 * -------------------------------
+	 * Menandai order paid sebagai refunded, membatalkan tiketnya
+	 * dan mengeluarkan participant jika tidak punya tiket lain
+	 */
+	Refund(order entities.Order) error
+}
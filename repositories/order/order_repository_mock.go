@@ -0,0 +1,99 @@
+package order
+
+import (
+	"time"
+	"tupulung/entities"
+
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type OrderRepositoryMock struct {
+	Mock *mock.Mock
+}
+
+func NewOrderRepositoryMock(mock *mock.Mock) *OrderRepositoryMock {
+	return &OrderRepositoryMock{
+		Mock: mock,
+	}
+}
+
+var OrderCollection = []entities.Order{
+	{
+		Model:            gorm.Model{ID: 1, CreatedAt: time.Now(), UpdatedAt: time.Now()},
+		EventID:          1,
+		UserID:           2,
+		TicketTypeID:     1,
+		Quantity:         2,
+		Amount:           100000,
+		Currency:         "IDR",
+		Status:           "pending",
+		PaymentReference: "fake_pending",
+		ExpiresAt:        time.Now().Add(15 * time.Minute),
+	},
+	{
+		Model:            gorm.Model{ID: 2, CreatedAt: time.Now(), UpdatedAt: time.Now()},
+		EventID:          1,
+		UserID:           2,
+		TicketTypeID:     1,
+		Quantity:         1,
+		Amount:           50000,
+		Currency:         "IDR",
+		Status:           "paid",
+		PaymentReference: "fake_paid",
+		ExpiresAt:        time.Now(),
+		Tickets: []entities.Ticket{
+			{Model: gorm.Model{ID: 1}, OrderID: 2, EventID: 1, TicketTypeID: 1, UserID: 2, Code: "ticket-code", Status: "valid"},
+		},
+	},
+}
+
+func (repo OrderRepositoryMock) FindByUser(userID int) ([]entities.Order, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).([]entities.Order), args.Error(1)
+}
+
+func (repo OrderRepositoryMock) FindByEvent(eventID int) ([]entities.Order, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).([]entities.Order), args.Error(1)
+}
+
+func (repo OrderRepositoryMock) Find(id int) (entities.Order, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.Order), args.Error(1)
+}
+
+func (repo OrderRepositoryMock) FindByReference(reference string) (entities.Order, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.Order), args.Error(1)
+}
+
+func (repo OrderRepositoryMock) Store(order entities.Order) (entities.Order, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.Order), args.Error(1)
+}
+
+func (repo OrderRepositoryMock) Update(order entities.Order, id int) (entities.Order, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.Order), args.Error(1)
+}
+
+func (repo OrderRepositoryMock) Confirm(order entities.Order, tickets []entities.Ticket) error {
+	args := repo.Mock.Called()
+	return args.Error(0)
+}
+
+func (repo OrderRepositoryMock) Release(order entities.Order, status string) error {
+	args := repo.Mock.Called(status)
+	return args.Error(0)
+}
+
+func (repo OrderRepositoryMock) ExpireStale(now time.Time) (int, error) {
+	args := repo.Mock.Called()
+	return args.Int(0), args.Error(1)
+}
+
+func (repo OrderRepositoryMock) Refund(order entities.Order) error {
+	args := repo.Mock.Called()
+	return args.Error(0)
+}
@@ -1,10 +1,13 @@
 package participant
 
 import (
+	"time"
 	"tupulung/entities"
 	"tupulung/entities/web"
+	inviteRepository "tupulung/repositories/invite"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type ParticipantRepository struct {
@@ -17,19 +20,229 @@ func NewParticipantRepository(db *gorm.DB) ParticipantRepository {
 	}
 }
 
-func (repo ParticipantRepository) Append(user entities.User, event entities.Event) error {
+func (repo ParticipantRepository) FindByEvent(eventID int, status string) ([]entities.Participant, error) {
+	participants := []entities.Participant{}
+	builder := repo.db.Preload("User").Preload("Invite").Where("event_id = ?", eventID)
+	if status != "" {
+		builder = builder.Where("status = ?", status)
+	}
+	tx := builder.Order("created_at ASC").Find(&participants)
+	if tx.Error != nil {
+		return []entities.Participant{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return participants, nil
+}
 
+func (repo ParticipantRepository) FindInBatches(eventID int, batchSize int, fn func([]entities.Participant) error) error {
 	participants := []entities.Participant{}
-	err := repo.db.Model(entities.Participant{}).Where("user_id = ?", user.ID).Where("event_id = ?", event.ID).Find(&participants)
-	if err.RowsAffected > 0 {
-		return web.WebError{Code: 400, Message: "you have joined this event"}
+	tx := repo.db.Preload("User").Where("event_id = ?", eventID).FindInBatches(&participants, batchSize, func(tx *gorm.DB, batch int) error {
+		return fn(participants)
+	})
+	if tx.Error != nil {
+		if webErr, ok := tx.Error.(web.WebError); ok {
+			return webErr
+		}
+		return web.WebError{Code: 500, Message: tx.Error.Error()}
 	}
-	joins := entities.Participant{}
-	joins.UserID = user.ID
-	joins.EventID = event.ID
-	tx := repo.db.Create(&joins)
-	if tx.RowsAffected == 0 {
-		return web.WebError{Code: 500, Message: "server error"}
+	return nil
+}
+
+func (repo ParticipantRepository) FindByEventAndUser(eventID int, userID int) (entities.Participant, error) {
+	participant := entities.Participant{}
+	tx := repo.db.Preload("User").Where("event_id = ? AND user_id = ?", eventID, userID).First(&participant)
+	if tx.Error == gorm.ErrRecordNotFound {
+		return entities.Participant{}, web.WebError{Code: 404, Message: "you haven't joined this event"}
+	} else if tx.Error != nil {
+		return entities.Participant{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return participant, nil
+}
+
+func (repo ParticipantRepository) CheckIn(id int, checkedInBy int, at time.Time) error {
+	tx := repo.db.Model(&entities.Participant{}).
+		Where("id = ? AND checked_in_at IS NULL", id).
+		Updates(map[string]interface{}{"checked_in_at": at, "checked_in_by": checkedInBy})
+	if tx.Error != nil {
+		return web.WebError{Code: 500, Message: tx.Error.Error()}
+	} else if tx.RowsAffected == 0 {
+		return web.WebError{Code: 409, Message: "This ticket has already been checked in"}
+	}
+	return nil
+}
+
+func (repo ParticipantRepository) FindTicketByCode(code string) (entities.Ticket, error) {
+	ticket := entities.Ticket{}
+	tx := repo.db.Where("code = ?", code).Limit(1).Find(&ticket)
+	if tx.Error != nil {
+		return entities.Ticket{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	} else if tx.RowsAffected == 0 {
+		return entities.Ticket{}, web.WebError{Code: 400, Message: "Ticket code is invalid"}
+	}
+	return ticket, nil
+}
+
+func (repo ParticipantRepository) CheckInTicket(ticketID uint, participantID uint, checkedInBy int, at time.Time) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&entities.Ticket{}).
+			Where("id = ? AND status = ? AND checked_in_at IS NULL", ticketID, "valid").
+			Updates(map[string]interface{}{"checked_in_at": at, "checked_in_by": checkedInBy})
+		if result.Error != nil {
+			return web.WebError{Code: 500, Message: result.Error.Error()}
+		} else if result.RowsAffected == 0 {
+			return web.WebError{Code: 409, Message: "This ticket has already been checked in"}
+		}
+		// Kehadiran pembeli dicatat sekali untuk laporan attendance
+		err := tx.Model(&entities.Participant{}).
+			Where("id = ? AND checked_in_at IS NULL", participantID).
+			Updates(map[string]interface{}{"checked_in_at": at, "checked_in_by": checkedInBy}).Error
+		if err != nil {
+			return web.WebError{Code: 500, Message: err.Error()}
+		}
+		return nil
+	})
+}
+
+func (repo ParticipantRepository) CountAttendance(eventID int) (int64, int64, error) {
+	var joined, checkedIn int64
+	tx := repo.db.Model(&entities.Participant{}).Where("event_id = ? AND status = ?", eventID, "going").Count(&joined)
+	if tx.Error != nil {
+		return 0, 0, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	tx = repo.db.Model(&entities.Participant{}).Where("event_id = ? AND status = ? AND checked_in_at IS NOT NULL", eventID, "going").Count(&checkedIn)
+	if tx.Error != nil {
+		return 0, 0, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return joined, checkedIn, nil
+}
+
+func (repo ParticipantRepository) FindSchedule(userID int, from time.Time) ([]entities.Event, error) {
+	events := []entities.Event{}
+	tx := repo.db.Preload("RSVPs", "user_id = ?", userID).
+		Joins("JOIN participants ON participants.event_id = events.id").
+		Where("participants.user_id = ? AND participants.status <> ?", userID, "not_going").
+		Where("COALESCE(events.datetime_event_end, events.datetime_event) >= ?", from).
+		Order("events.datetime_event ASC, events.id ASC").
+		Find(&events)
+	if tx.Error != nil {
+		return []entities.Event{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return events, nil
+}
+
+func (repo ParticipantRepository) Append(participant entities.Participant, answers []entities.EventAnswer) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		participants := []entities.Participant{}
+		result := tx.Model(entities.Participant{}).Where("user_id = ?", participant.UserID).Where("event_id = ?", participant.EventID).Find(&participants)
+		if result.Error != nil {
+			return web.WebError{Code: 500, Message: result.Error.Error()}
+		} else if result.RowsAffected > 0 {
+			return web.WebError{Code: 400, Message: "You are already join this event"}
+		}
+
+		if participant.Status != "maybe" && participant.Status != "not_going" {
+			if err := ensureSeat(tx, participant.EventID, participant.UserID); err != nil {
+				return err
+			}
+		}
+
+		joins := entities.Participant{}
+		joins.UserID = participant.UserID
+		joins.EventID = participant.EventID
+		joins.InviteID = participant.InviteID
+		joins.Status = participant.Status
+		if err := tx.Create(&joins).Error; err != nil {
+			return web.WebError{Code: 500, Message: err.Error()}
+		}
+
+		// Invite dipakai setelah participant tersimpan
+		if participant.InviteID != nil {
+			result = tx.Model(&entities.EventInvite{}).
+				Where("id = ?", *participant.InviteID).
+				Where(inviteRepository.UsableCondition, time.Now()).
+				UpdateColumn("uses", gorm.Expr("uses + 1"))
+			if result.Error != nil {
+				return web.WebError{Code: 500, Message: result.Error.Error()}
+			} else if result.RowsAffected <= 0 {
+				return web.WebError{Code: 400, Message: "invite code is invalid or no longer usable"}
+			}
+		}
+
+		// Jawaban registrasi lama (dari join sebelumnya) diganti
+		err := tx.Where("event_id = ? AND user_id = ?", participant.EventID, participant.UserID).Delete(&entities.EventAnswer{}).Error
+		if err != nil {
+			return web.WebError{Code: 500, Message: err.Error()}
+		}
+		if len(answers) > 0 {
+			if err := tx.Omit(clause.Associations).Create(&answers).Error; err != nil {
+				return web.WebError{Code: 500, Message: err.Error()}
+			}
+		}
+		return nil
+	})
+}
+
+func (repo ParticipantRepository) UpdateStatus(id int, status string, answers []entities.EventAnswer) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		participant := entities.Participant{}
+		result := tx.Where("id = ?", id).Limit(1).Find(&participant)
+		if result.Error != nil {
+			return web.WebError{Code: 500, Message: result.Error.Error()}
+		} else if result.RowsAffected == 0 {
+			return web.WebError{Code: 400, Message: "you haven't joined this event"}
+		}
+		if status == "going" && participant.Status != "going" {
+			if err := ensureSeat(tx, participant.EventID, participant.UserID); err != nil {
+				return err
+			}
+		}
+		err := tx.Model(&participant).Update("status", status).Error
+		if err != nil {
+			return web.WebError{Code: 500, Message: err.Error()}
+		}
+		if answers == nil {
+			return nil
+		}
+
+		err = tx.Where("event_id = ? AND user_id = ?", participant.EventID, participant.UserID).Delete(&entities.EventAnswer{}).Error
+		if err != nil {
+			return web.WebError{Code: 500, Message: err.Error()}
+		}
+		if len(answers) > 0 {
+			if err := tx.Omit(clause.Associations).Create(&answers).Error; err != nil {
+				return web.WebError{Code: 500, Message: err.Error()}
+			}
+		}
+		return nil
+	})
+}
+
+/*
+ * Ensure Seat
+ * -------------------------------
+ * Mengecek ulang sisa kursi event di dalam transaksi. Baris event
+ * dikunci agar join dan perubahan status ke going yang bersamaan
+ * tidak melebihi kapasitas, excludeUserID tidak ikut dihitung
+ */
+func ensureSeat(tx *gorm.DB, eventID uint, excludeUserID uint) error {
+	event := entities.Event{}
+	result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id", "capacity").Where("id = ?", eventID).Limit(1).Find(&event)
+	if result.Error != nil {
+		return web.WebError{Code: 500, Message: result.Error.Error()}
+	} else if result.RowsAffected == 0 {
+		return web.WebError{Code: 400, Message: "Event is not exist"}
+	}
+	if event.Capacity == 0 {
+		return nil
+	}
+	var going int64
+	err := tx.Model(&entities.Participant{}).
+		Where("event_id = ? AND user_id <> ? AND status NOT IN ?", eventID, excludeUserID, []string{"maybe", "not_going"}).
+		Count(&going).Error
+	if err != nil {
+		return web.WebError{Code: 500, Message: err.Error()}
+	}
+	if going >= int64(event.Capacity) {
+		return web.WebError{Code: 400, Message: "This event is already full"}
 	}
 	return nil
 }
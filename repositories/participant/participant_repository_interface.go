@@ -1,15 +1,90 @@
 package participant
 
-import "tupulung/entities"
+import (
+	"time"
+	"tupulung/entities"
+)
 
 type ParticipantRepositoryInterface interface {
 
+	/*
+	 * Find By Event
+	 * -------------------------------
+	 * Mengambil semua participant sebuah event beserta user dan invite-nya,
+	 * status RSVP kosong berarti semua status
+	 */
+	FindByEvent(eventID int, status string) ([]entities.Participant, error)
+
+	/*
+	 * Find In Batches
+	 * -------------------------------
+	 * Membaca participant sebuah event beserta user-nya per batch
+	 * agar daftar yang besar tidak dimuat sekaligus ke memory
+	 */
+	FindInBatches(eventID int, batchSize int, fn func([]entities.Participant) error) error
+
+	/*
+	 * Find By Event And User
+	 * -------------------------------
+	 * Mencari participant tunggal sebuah event berdasarkan user
+	 */
+	FindByEventAndUser(eventID int, userID int) (entities.Participant, error)
+
+	/*
+	 * Check In
+	 * -------------------------------
+	 * Mencatat kehadiran participant secara atomic,
+	 * gagal jika participant sudah pernah check-in
+	 */
+	CheckIn(id int, checkedInBy int, at time.Time) error
+
+	/*
+	 * Find Ticket By Code
+	 * -------------------------------
+	 * Mencari tiket order berdasarkan kodenya
+	 */
+	FindTicketByCode(code string) (entities.Ticket, error)
+
+	/*
+	 * Check In Ticket
+	 * -------------------------------
+	 * Menandai tiket order terpakai secara atomic sekaligus mencatat
+	 * kehadiran participant pembelinya jika belum, gagal jika tiket
+	 * sudah terpakai atau tidak berlaku lagi
+	 */
+	CheckInTicket(ticketID uint, participantID uint, checkedInBy int, at time.Time) error
+
+	/*
+	 * Count Attendance
+	 * -------------------------------
+	 * Menghitung jumlah participant going dan yang sudah check-in
+	 */
+	CountAttendance(eventID int) (int64, int64, error)
+
+	/*
+	 * Find Schedule
+	 * -------------------------------
+	 * Mengambil event yang di-join user (selain not_going) yang
+	 * berakhir pada atau setelah from, urut dari yang paling awal.
+	 * RSVPs hanya berisi participant milik user tersebut
+	 */
+	FindSchedule(userID int, from time.Time) ([]entities.Event, error)
+
 	/*
 	 * Append
 	 * -------------------------------
-	 * Menambahkan user ke event
+	 * Menambahkan user ke event beserta jawaban registrasinya,
+	 * invite participant ikut dipakai dalam transaksi yang sama
+	 */
+	Append(participant entities.Participant, answers []entities.EventAnswer) error
+
+	/*
+	 * Update Status
+	 * -------------------------------
+	 * Mengubah status RSVP participant tanpa keluar dari event,
+	 * answers yang tidak nil menggantikan jawaban registrasi sebelumnya
 	 */
-	Append(user entities.User, event entities.Event) error
+	UpdateStatus(id int, status string, answers []entities.EventAnswer) error
 
 	/*
 	 * Delete
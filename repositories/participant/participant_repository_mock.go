@@ -1,6 +1,7 @@
 package participant
 
 import (
+	"time"
 	"tupulung/entities"
 
 	"github.com/stretchr/testify/mock"
@@ -21,16 +22,69 @@ var ParticipantCollection = []entities.Participant{
 		ID:      1,
 		UserID:  1,
 		EventID: 1,
+		Status:  "going",
 	},
 	{
 		ID:      2,
 		UserID:  2,
 		EventID: 1,
+		Status:  "going",
 	},
 }
 
-func (repo ParticipantRepositoryMock) Append(user entities.User, event entities.Event) error {
+func (repo ParticipantRepositoryMock) FindByEvent(eventID int, status string) ([]entities.Participant, error) {
 	args := repo.Mock.Called()
+	return args.Get(0).([]entities.Participant), args.Error(1)
+}
+
+func (repo ParticipantRepositoryMock) FindInBatches(eventID int, batchSize int, fn func([]entities.Participant) error) error {
+	args := repo.Mock.Called()
+	for _, batch := range args.Get(0).([][]entities.Participant) {
+		err := fn(batch)
+		if err != nil {
+			return err
+		}
+	}
+	return args.Error(1)
+}
+
+func (repo ParticipantRepositoryMock) FindByEventAndUser(eventID int, userID int) (entities.Participant, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.Participant), args.Error(1)
+}
+
+func (repo ParticipantRepositoryMock) CheckIn(id int, checkedInBy int, at time.Time) error {
+	args := repo.Mock.Called()
+	return args.Error(0)
+}
+
+func (repo ParticipantRepositoryMock) FindTicketByCode(code string) (entities.Ticket, error) {
+	args := repo.Mock.Called(code)
+	return args.Get(0).(entities.Ticket), args.Error(1)
+}
+
+func (repo ParticipantRepositoryMock) CheckInTicket(ticketID uint, participantID uint, checkedInBy int, at time.Time) error {
+	args := repo.Mock.Called(ticketID)
+	return args.Error(0)
+}
+
+func (repo ParticipantRepositoryMock) CountAttendance(eventID int) (int64, int64, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(int64), args.Get(1).(int64), args.Error(2)
+}
+
+func (repo ParticipantRepositoryMock) FindSchedule(userID int, from time.Time) ([]entities.Event, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).([]entities.Event), args.Error(1)
+}
+
+func (repo ParticipantRepositoryMock) Append(participant entities.Participant, answers []entities.EventAnswer) error {
+	args := repo.Mock.Called(answers)
+	return args.Error(0)
+}
+
+func (repo ParticipantRepositoryMock) UpdateStatus(id int, status string, answers []entities.EventAnswer) error {
+	args := repo.Mock.Called(status, answers)
 	return args.Error(0)
 }
 
@@ -0,0 +1,154 @@
+package question
+
+import (
+	"tupulung/entities"
+	"tupulung/entities/web"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type QuestionRepository struct {
+	db *gorm.DB
+}
+
+func NewQuestionRepository(db *gorm.DB) QuestionRepository {
+	return QuestionRepository{
+		db: db,
+	}
+}
+
+/*
+ * Find By Event
+ * -------------------------------
+ * Mengambil semua pertanyaan registrasi event sesuai urutan
+ */
+func (repo QuestionRepository) FindByEvent(eventID int) ([]entities.EventQuestion, error) {
+	questions := []entities.EventQuestion{}
+	tx := repo.db.Where("event_id = ?", eventID).Order("position ASC, id ASC").Find(&questions)
+	if tx.Error != nil {
+		return []entities.EventQuestion{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return questions, nil
+}
+
+/*
+ * Find
+ * -------------------------------
+ * Mencari pertanyaan berdasarkan ID
+ */
+func (repo QuestionRepository) Find(id int) (entities.EventQuestion, error) {
+	question := entities.EventQuestion{}
+	tx := repo.db.Find(&question, id)
+	if tx.Error != nil {
+		return entities.EventQuestion{}, web.WebError{Code: 500, Message: "server error"}
+	} else if tx.RowsAffected <= 0 {
+		return entities.EventQuestion{}, web.WebError{Code: 400, Message: "cannot get question data with specified id"}
+	}
+	return question, nil
+}
+
+/*
+ * Store
+ * -------------------------------
+ * Menambahkan pertanyaan kedalam database
+ */
+func (repo QuestionRepository) Store(question entities.EventQuestion) (entities.EventQuestion, error) {
+	tx := repo.db.Omit(clause.Associations).Create(&question)
+	if tx.Error != nil {
+		return entities.EventQuestion{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return question, nil
+}
+
+/*
+ * Update
+ * -------------------------------
+ * Mengubah data pertanyaan
+ */
+func (repo QuestionRepository) Update(question entities.EventQuestion) (entities.EventQuestion, error) {
+	tx := repo.db.Omit(clause.Associations).Save(&question)
+	if tx.Error != nil {
+		return entities.EventQuestion{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return question, nil
+}
+
+/*
+ * Delete
+ * -------------------------------
+ * Menghapus pertanyaan beserta semua jawabannya
+ */
+func (repo QuestionRepository) Delete(id int) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("question_id = ?", id).Delete(&entities.EventAnswer{}).Error
+		if err != nil {
+			return web.WebError{Code: 500, Message: err.Error()}
+		}
+		err = tx.Delete(&entities.EventQuestion{}, id).Error
+		if err != nil {
+			return web.WebError{Code: 500, Message: err.Error()}
+		}
+		return nil
+	})
+}
+
+/*
+ * Find Answers
+ * -------------------------------
+ * Mengambil jawaban participant yang masih join event,
+ * userID 0 berarti jawaban semua participant
+ */
+func (repo QuestionRepository) FindAnswers(eventID int, userID int) ([]entities.EventAnswer, error) {
+	answers := []entities.EventAnswer{}
+	builder := repo.db.Preload("User").
+		Where("event_id = ?", eventID).
+		Where("user_id IN (SELECT participants.user_id FROM participants WHERE participants.event_id = ?)", eventID)
+	if userID != 0 {
+		builder = builder.Where("user_id = ?", userID)
+	}
+	tx := builder.Order("user_id ASC, question_id ASC").Find(&answers)
+	if tx.Error != nil {
+		return []entities.EventAnswer{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return answers, nil
+}
+
+/*
+ * Find Answers By Users
+ * -------------------------------
+ * Mengambil jawaban beberapa user sekaligus pada sebuah event
+ */
+func (repo QuestionRepository) FindAnswersByUsers(eventID int, userIDs []uint) ([]entities.EventAnswer, error) {
+	answers := []entities.EventAnswer{}
+	if len(userIDs) == 0 {
+		return answers, nil
+	}
+	tx := repo.db.Where("event_id = ? AND user_id IN ?", eventID, userIDs).Find(&answers)
+	if tx.Error != nil {
+		return []entities.EventAnswer{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return answers, nil
+}
+
+/*
+ * Store Answers
+ * -------------------------------
+ * Mengganti semua jawaban user pada event dengan jawaban baru
+ */
+func (repo QuestionRepository) StoreAnswers(eventID int, userID int, answers []entities.EventAnswer) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("event_id = ? AND user_id = ?", eventID, userID).Delete(&entities.EventAnswer{}).Error
+		if err != nil {
+			return web.WebError{Code: 500, Message: err.Error()}
+		}
+		if len(answers) == 0 {
+			return nil
+		}
+		err = tx.Omit(clause.Associations).Create(&answers).Error
+		if err != nil {
+			return web.WebError{Code: 500, Message: err.Error()}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,63 @@
+package question
+
+import "tupulung/entities"
+
+type QuestionRepositoryInterface interface {
+
+	/*
+	 * Find By Event
+	 * -------------------------------
+	 * Mengambil semua pertanyaan registrasi event sesuai urutan
+	 */
+	FindByEvent(eventID int) ([]entities.EventQuestion, error)
+
+	/*
+	 * Find
+	 * -------------------------------
+	 * Mencari pertanyaan berdasarkan ID
+	 */
+	Find(id int) (entities.EventQuestion, error)
+
+	/*
+	 * Store
+	 * -------------------------------
+	 * Menambahkan pertanyaan kedalam database
+	 */
+	Store(question entities.EventQuestion) (entities.EventQuestion, error)
+
+	/*
+	 * Update
+	 * -------------------------------
+	 * Mengubah data pertanyaan
+	 */
+	Update(question entities.EventQuestion) (entities.EventQuestion, error)
+
+	/*
+	 * Delete
+	 * -------------------------------
+	 * Menghapus pertanyaan beserta semua jawabannya
+	 */
+	Delete(id int) error
+
+	/*
+	 * Find Answers
+	 * -------------------------------
+	 * Mengambil jawaban participant yang masih join event,
+	 * userID 0 berarti jawaban semua participant
+	 */
+	FindAnswers(eventID int, userID int) ([]entities.EventAnswer, error)
+
+	/*
+	 * Find Answers By Users
+	 * -------------------------------
+	 * Mengambil jawaban beberapa user sekaligus pada sebuah event
+	 */
+	FindAnswersByUsers(eventID int, userIDs []uint) ([]entities.EventAnswer, error)
+
+	/*
+	 * Store Answers
+	 * -------------------------------
+	 * Mengganti semua jawaban user pada event dengan jawaban baru
+	 */
+	StoreAnswers(eventID int, userID int, answers []entities.EventAnswer) error
+}
@@ -0,0 +1,94 @@
+package question
+
+import (
+	"time"
+	"tupulung/entities"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type QuestionRepositoryMock struct {
+	Mock *mock.Mock
+}
+
+func NewQuestionRepositoryMock(mock *mock.Mock) *QuestionRepositoryMock {
+	return &QuestionRepositoryMock{
+		Mock: mock,
+	}
+}
+
+var EventQuestionCollection = []entities.EventQuestion{
+	{
+		ID:        1,
+		EventID:   1,
+		Label:     "Dietary preference",
+		Type:      "single_choice",
+		Options:   `["None","Vegetarian","Vegan"]`,
+		Required:  true,
+		Position:  0,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	},
+	{
+		ID:        2,
+		EventID:   1,
+		Label:     "T-shirt size",
+		Type:      "multi_choice",
+		Options:   `["S","M","L"]`,
+		Required:  false,
+		Position:  1,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	},
+	{
+		ID:        3,
+		EventID:   1,
+		Label:     "Anything else?",
+		Type:      "text",
+		Options:   `[]`,
+		Required:  false,
+		Position:  2,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	},
+}
+
+func (repo QuestionRepositoryMock) FindByEvent(eventID int) ([]entities.EventQuestion, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).([]entities.EventQuestion), args.Error(1)
+}
+
+func (repo QuestionRepositoryMock) Find(id int) (entities.EventQuestion, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.EventQuestion), args.Error(1)
+}
+
+func (repo QuestionRepositoryMock) Store(question entities.EventQuestion) (entities.EventQuestion, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.EventQuestion), args.Error(1)
+}
+
+func (repo QuestionRepositoryMock) Update(question entities.EventQuestion) (entities.EventQuestion, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.EventQuestion), args.Error(1)
+}
+
+func (repo QuestionRepositoryMock) Delete(id int) error {
+	args := repo.Mock.Called()
+	return args.Error(0)
+}
+
+func (repo QuestionRepositoryMock) FindAnswers(eventID int, userID int) ([]entities.EventAnswer, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).([]entities.EventAnswer), args.Error(1)
+}
+
+func (repo QuestionRepositoryMock) FindAnswersByUsers(eventID int, userIDs []uint) ([]entities.EventAnswer, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).([]entities.EventAnswer), args.Error(1)
+}
+
+func (repo QuestionRepositoryMock) StoreAnswers(eventID int, userID int, answers []entities.EventAnswer) error {
+	args := repo.Mock.Called(answers)
+	return args.Error(0)
+}
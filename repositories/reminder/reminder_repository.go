@@ -0,0 +1,76 @@
+package reminder
+
+import (
+	"time"
+	"tupulung/entities"
+	"tupulung/entities/web"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ReminderRepository struct {
+	db *gorm.DB
+}
+
+func NewReminderRepository(db *gorm.DB) ReminderRepository {
+	return ReminderRepository{
+		db: db,
+	}
+}
+
+/*
+ * Due Reminders
+ * -------------------------------
+ * Mengambil peserta event yang dimulai dalam rentang (from, to]
+ * dan belum pernah menerima reminder jenis ini untuk waktu mulai
+ * event saat ini. Peserta not_going dan user yang opt-out dilewati
+ */
+func (repo ReminderRepository) DueReminders(from time.Time, to time.Time, reminder string, limit int) ([]entities.DueReminder, error) {
+	reminders := []entities.DueReminder{}
+	tx := repo.db.Table("participants").
+		Select("participants.event_id, participants.user_id, events.title, events.location, events.mode, events.meeting_url, events.datetime_event, users.name, users.email").
+		Joins("JOIN events ON events.id = participants.event_id AND events.deleted_at IS NULL").
+		Joins("JOIN users ON users.id = participants.user_id AND users.deleted_at IS NULL").
+		Where("events.datetime_event > ? AND events.datetime_event <= ?", from, to).
+		Where("participants.status <> ?", "not_going").
+		Where("users.reminder_opt_out = ?", false).
+		Where("NOT EXISTS (SELECT 1 FROM reminder_logs WHERE reminder_logs.event_id = participants.event_id AND reminder_logs.user_id = participants.user_id AND reminder_logs.reminder = ? AND reminder_logs.starts_at = events.datetime_event)", reminder).
+		Order("events.datetime_event ASC, participants.id ASC").
+		Limit(limit).
+		Scan(&reminders)
+	if tx.Error != nil {
+		return []entities.DueReminder{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return reminders, nil
+}
+
+/*
+ * Claim
+ * -------------------------------
+ * Mencatat reminder sebelum dikirim. Unique index idx_reminder_once
+ * memastikan hanya satu instance yang berhasil mencatat, sehingga
+ * false berarti reminder sudah diklaim instance lain
+ */
+func (repo ReminderRepository) Claim(log entities.ReminderLog) (bool, error) {
+	tx := repo.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&log)
+	if tx.Error != nil {
+		return false, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return tx.RowsAffected == 1, nil
+}
+
+/*
+ * Release
+ * -------------------------------
+ * Menghapus catatan reminder yang gagal dikirim
+ * agar dicoba lagi pada putaran berikutnya
+ */
+func (repo ReminderRepository) Release(log entities.ReminderLog) error {
+	tx := repo.db.Where("event_id = ? AND user_id = ? AND reminder = ? AND starts_at = ?", log.EventID, log.UserID, log.Reminder, log.StartsAt).
+		Delete(&entities.ReminderLog{})
+	if tx.Error != nil {
+		return web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package reminder
+
+import (
+	"time"
+	"tupulung/entities"
+)
+
+type ReminderRepositoryInterface interface {
+
+	/*
+	 * Due Reminders
+	 * -------------------------------
+	 * Mengambil peserta event yang dimulai dalam rentang (from, to]
+	 * dan belum pernah menerima reminder jenis ini
+	 */
+	DueReminders(from time.Time, to time.Time, reminder string, limit int) ([]entities.DueReminder, error)
+
+	/*
+	 * Claim
+	 * -------------------------------
+	 * Mencatat reminder sebelum dikirim, false jika sudah tercatat
+	 */
+	Claim(log entities.ReminderLog) (bool, error)
+
+	/*
+	 * Release
+	 * -------------------------------
+	 * Menghapus catatan reminder yang gagal dikirim
+	 */
+	Release(log entities.ReminderLog) error
+}
@@ -0,0 +1,54 @@
+package reminder
+
+import (
+	"time"
+	"tupulung/entities"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type ReminderRepositoryMock struct {
+	Mock *mock.Mock
+}
+
+func NewReminderRepositoryMock(mock *mock.Mock) *ReminderRepositoryMock {
+	return &ReminderRepositoryMock{
+		Mock: mock,
+	}
+}
+
+var DueReminderCollection = []entities.DueReminder{
+	{
+		EventID:       1,
+		UserID:        1,
+		Title:         "Golang Meetup",
+		Location:      "Jakarta",
+		DatetimeEvent: time.Now().Add(time.Hour),
+		Name:          "Lorem",
+		Email:         "lorem@mail.com",
+	},
+	{
+		EventID:       1,
+		UserID:        2,
+		Title:         "Golang Meetup",
+		Location:      "Jakarta",
+		DatetimeEvent: time.Now().Add(time.Hour),
+		Name:          "Ipsum",
+		Email:         "ipsum@mail.com",
+	},
+}
+
+func (repo ReminderRepositoryMock) DueReminders(from time.Time, to time.Time, reminder string, limit int) ([]entities.DueReminder, error) {
+	args := repo.Mock.Called(reminder)
+	return args.Get(0).([]entities.DueReminder), args.Error(1)
+}
+
+func (repo ReminderRepositoryMock) Claim(log entities.ReminderLog) (bool, error) {
+	args := repo.Mock.Called(log.UserID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (repo ReminderRepositoryMock) Release(log entities.ReminderLog) error {
+	args := repo.Mock.Called(log.UserID)
+	return args.Error(0)
+}
@@ -0,0 +1,126 @@
+package report
+
+import (
+	"time"
+	"tupulung/entities"
+	"tupulung/entities/web"
+
+	"gorm.io/gorm"
+)
+
+type ReportRepository struct {
+	db *gorm.DB
+}
+
+func NewReportRepository(db *gorm.DB) ReportRepository {
+	return ReportRepository{
+		db: db,
+	}
+}
+
+func (repo ReportRepository) filter(status string, targetType string) *gorm.DB {
+	builder := repo.db.Model(&entities.Report{})
+	if status != "" {
+		builder = builder.Where("status = ?", status)
+	}
+	if targetType != "" {
+		builder = builder.Where("target_type = ?", targetType)
+	}
+	return builder
+}
+
+/*
+ * Find All
+ * -------------------------------
+ * Mengambil antrian report dari yang terlama,
+ * status dan target type kosong berarti semua
+ */
+func (repo ReportRepository) FindAll(status string, targetType string, limit int, offset int) ([]entities.Report, error) {
+	reports := []entities.Report{}
+	tx := repo.filter(status, targetType).Preload("Reporter").Order("created_at ASC, id ASC").Limit(limit).Offset(offset).Find(&reports)
+	if tx.Error != nil {
+		return []entities.Report{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return reports, nil
+}
+
+/*
+ * Count All
+ * -------------------------------
+ * Menghitung jumlah report berdasarkan status dan target type
+ */
+func (repo ReportRepository) CountAll(status string, targetType string) (int64, error) {
+	var count int64
+	tx := repo.filter(status, targetType).Count(&count)
+	if tx.Error != nil {
+		return 0, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return count, nil
+}
+
+/*
+ * Find
+ * -------------------------------
+ * Mencari report tunggal berdasarkan ID
+ */
+func (repo ReportRepository) Find(id int) (entities.Report, error) {
+	report := entities.Report{}
+	tx := repo.db.Preload("Reporter").Find(&report, id)
+	if tx.Error != nil {
+		return entities.Report{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	} else if tx.RowsAffected <= 0 {
+		return entities.Report{}, web.WebError{Code: 400, Message: "cannot get report data with specified id"}
+	}
+	return report, nil
+}
+
+/*
+ * Count Open By Reporter
+ * -------------------------------
+ * Menghitung report yang masih open dari seorang user
+ * untuk target tertentu, mencegah report ganda
+ */
+func (repo ReportRepository) CountOpenByReporter(reporterID int, targetType string, targetID int) (int64, error) {
+	var count int64
+	tx := repo.db.Model(&entities.Report{}).
+		Where("reporter_id = ? AND target_type = ? AND target_id = ? AND status = ?", reporterID, targetType, targetID, "open").
+		Count(&count)
+	if tx.Error != nil {
+		return 0, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return count, nil
+}
+
+/*
+ * Store
+ * -------------------------------
+ * Menambahkan report kedalam database
+ */
+func (repo ReportRepository) Store(report entities.Report) (entities.Report, error) {
+	tx := repo.db.Create(&report)
+	if tx.Error != nil {
+		return entities.Report{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return report, nil
+}
+
+/*
+ * Resolve
+ * -------------------------------
+ * Menutup semua report open untuk target yang sama
+ * sekaligus, sehingga antrian tidak berisi report ganda
+ */
+func (repo ReportRepository) Resolve(targetType string, targetID uint, status string, resolution string, resolvedBy uint, at time.Time) error {
+	tx := repo.db.Model(&entities.Report{}).
+		Where("target_type = ? AND target_id = ? AND status = ?", targetType, targetID, "open").
+		Updates(map[string]interface{}{
+			"status":      status,
+			"resolution":  resolution,
+			"resolved_by": resolvedBy,
+			"resolved_at": at,
+		})
+	if tx.Error != nil {
+		return web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return nil
+}
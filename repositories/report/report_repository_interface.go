@@ -0,0 +1,51 @@
+package report
+
+import (
+	"time"
+	"tupulung/entities"
+)
+
+type ReportRepositoryInterface interface {
+
+	/*
+	 * Find All
+	 * -------------------------------
+	 * Mengambil antrian report dari yang terlama
+	 */
+	FindAll(status string, targetType string, limit int, offset int) ([]entities.Report, error)
+
+	/*
+	 * Count All
+	 * -------------------------------
+	 * Menghitung jumlah report berdasarkan status dan target type
+	 */
+	CountAll(status string, targetType string) (int64, error)
+
+	/*
+	 * Find
+	 * -------------------------------
+	 * Mencari report tunggal berdasarkan ID
+	 */
+	Find(id int) (entities.Report, error)
+
+	/*
+	 * Count Open By Reporter
+	 * -------------------------------
+	 * Menghitung report open dari seorang user untuk target tertentu
+	 */
+	CountOpenByReporter(reporterID int, targetType string, targetID int) (int64, error)
+
+	/*
+	 * Store
+	 * -------------------------------
+	 * Menambahkan report kedalam database
+	 */
+	Store(report entities.Report) (entities.Report, error)
+
+	/*
+	 * Resolve
+	 * -------------------------------
+	 * Menutup semua report open untuk target yang sama
+	 */
+	Resolve(targetType string, targetID uint, status string, resolution string, resolvedBy uint, at time.Time) error
+}
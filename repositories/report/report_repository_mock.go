@@ -0,0 +1,80 @@
+package report
+
+import (
+	"time"
+	"tupulung/entities"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type ReportRepositoryMock struct {
+	Mock *mock.Mock
+}
+
+func NewReportRepositoryMock(mock *mock.Mock) *ReportRepositoryMock {
+	return &ReportRepositoryMock{
+		Mock: mock,
+	}
+}
+
+var ReportCollection = []entities.Report{
+	{
+		ID:         1,
+		ReporterID: 2,
+		TargetType: "event",
+		TargetID:   1,
+		Reason:     "spam",
+		Details:    "Same event posted ten times",
+		Status:     "open",
+		CreatedAt:  time.Now(),
+	},
+	{
+		ID:         2,
+		ReporterID: 1,
+		TargetType: "comment",
+		TargetID:   1,
+		Reason:     "harassment",
+		Status:     "open",
+		CreatedAt:  time.Now(),
+	},
+	{
+		ID:         3,
+		ReporterID: 1,
+		TargetType: "user",
+		TargetID:   2,
+		Reason:     "scam",
+		Status:     "dismissed",
+		Resolution: "dismiss",
+		CreatedAt:  time.Now(),
+	},
+}
+
+func (repo ReportRepositoryMock) FindAll(status string, targetType string, limit int, offset int) ([]entities.Report, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).([]entities.Report), args.Error(1)
+}
+
+func (repo ReportRepositoryMock) CountAll(status string, targetType string) (int64, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (repo ReportRepositoryMock) Find(id int) (entities.Report, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.Report), args.Error(1)
+}
+
+func (repo ReportRepositoryMock) CountOpenByReporter(reporterID int, targetType string, targetID int) (int64, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (repo ReportRepositoryMock) Store(report entities.Report) (entities.Report, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.Report), args.Error(1)
+}
+
+func (repo ReportRepositoryMock) Resolve(targetType string, targetID uint, status string, resolution string, resolvedBy uint, at time.Time) error {
+	args := repo.Mock.Called(status)
+	return args.Error(0)
+}
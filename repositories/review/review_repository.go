@@ -0,0 +1,164 @@
+package review
+
+import (
+	"time"
+	"tupulung/entities"
+	"tupulung/entities/web"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ReviewRepository struct {
+	db *gorm.DB
+}
+
+func NewReviewRepository(db *gorm.DB) ReviewRepository {
+	return ReviewRepository{
+		db: db,
+	}
+}
+
+/*
+ * Find By Event
+ * -------------------------------
+ * Mengambil review sebuah event dari yang paling baru
+ */
+func (repo ReviewRepository) FindByEvent(eventID int, limit int, offset int) ([]entities.Review, error) {
+	reviews := []entities.Review{}
+	tx := repo.db.Preload("User").Where("event_id = ?", eventID).Order("created_at DESC, id DESC").Limit(limit).Offset(offset).Find(&reviews)
+	if tx.Error != nil {
+		return []entities.Review{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return reviews, nil
+}
+
+/*
+ * Count By Event
+ * -------------------------------
+ * Menghitung jumlah review sebuah event untuk pagination
+ */
+func (repo ReviewRepository) CountByEvent(eventID int) (int64, error) {
+	var count int64
+	tx := repo.db.Model(&entities.Review{}).Where("event_id = ?", eventID).Count(&count)
+	if tx.Error != nil {
+		return 0, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return count, nil
+}
+
+/*
+ * Find
+ * -------------------------------
+ * Mencari review berdasarkan ID
+ */
+func (repo ReviewRepository) Find(id int) (entities.Review, error) {
+	review := entities.Review{}
+	tx := repo.db.Preload("User").Find(&review, id)
+	if tx.Error != nil {
+		return entities.Review{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	} else if tx.RowsAffected <= 0 {
+		return entities.Review{}, web.WebError{Code: 400, Message: "cannot get review data with specified id"}
+	}
+	return review, nil
+}
+
+/*
+ * Find By User
+ * -------------------------------
+ * Mencari review yang ditulis user untuk sebuah event
+ */
+func (repo ReviewRepository) FindByUser(eventID int, userID int) (entities.Review, error) {
+	review := entities.Review{}
+	tx := repo.db.Where("event_id = ? AND user_id = ?", eventID, userID).Find(&review)
+	if tx.Error != nil {
+		return entities.Review{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	} else if tx.RowsAffected <= 0 {
+		return entities.Review{}, web.WebError{Code: 400, Message: "cannot get review data with specified id"}
+	}
+	return review, nil
+}
+
+/*
+ * Store
+ * -------------------------------
+ * Menyimpan review baru
+ */
+func (repo ReviewRepository) Store(review entities.Review) (entities.Review, error) {
+	tx := repo.db.Omit(clause.Associations).Create(&review)
+	if tx.Error != nil {
+		return entities.Review{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return review, nil
+}
+
+/*
+ * Update
+ * -------------------------------
+ * Menyimpan perubahan review beserta balasan host
+ */
+func (repo ReviewRepository) Update(review entities.Review) (entities.Review, error) {
+	tx := repo.db.Omit(clause.Associations).Save(&review)
+	if tx.Error != nil {
+		return entities.Review{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return review, nil
+}
+
+/*
+ * Delete
+ * -------------------------------
+ * Menghapus review
+ */
+func (repo ReviewRepository) Delete(id int) error {
+	tx := repo.db.Delete(&entities.Review{}, id)
+	if tx.Error != nil {
+		return web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return nil
+}
+
+/*
+ * Refresh Rating
+ * -------------------------------
+ * Menghitung ulang ringkasan rating event dari semua reviewnya
+ * lalu menyimpannya ke tabel event_ratings
+ */
+func (repo ReviewRepository) RefreshRating(eventID int) (entities.EventRating, error) {
+	rating := entities.EventRating{}
+	tx := repo.db.Model(&entities.Review{}).
+		Select("COUNT(*) AS count, COALESCE(AVG(rating), 0) AS average, "+
+			"COALESCE(SUM(rating = 1), 0) AS stars1, COALESCE(SUM(rating = 2), 0) AS stars2, COALESCE(SUM(rating = 3), 0) AS stars3, "+
+			"COALESCE(SUM(rating = 4), 0) AS stars4, COALESCE(SUM(rating = 5), 0) AS stars5").
+		Where("event_id = ?", eventID).
+		Scan(&rating)
+	if tx.Error != nil {
+		return entities.EventRating{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	rating.EventID = uint(eventID)
+	rating.UpdatedAt = time.Now()
+
+	tx = repo.db.Clauses(clause.OnConflict{UpdateAll: true}).Create(&rating)
+	if tx.Error != nil {
+		return entities.EventRating{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return rating, nil
+}
+
+/*
+ * Host Reputation
+ * -------------------------------
+ * Mengagregasi semua review dari event yang dimiliki host
+ */
+func (repo ReviewRepository) HostReputation(hostID int) (entities.HostReputation, error) {
+	reputation := entities.HostReputation{}
+	tx := repo.db.Model(&entities.Review{}).
+		Select("COUNT(DISTINCT reviews.event_id) AS events, COUNT(*) AS reviews, COALESCE(SUM(reviews.rating), 0) AS sum").
+		Joins("JOIN events ON events.id = reviews.event_id AND events.deleted_at IS NULL").
+		Where("events.user_id = ?", hostID).
+		Scan(&reputation)
+	if tx.Error != nil {
+		return entities.HostReputation{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return reputation, nil
+}
@@ -0,0 +1,68 @@
+package review
+
+import "tupulung/entities"
+
+type ReviewRepositoryInterface interface {
+	/*
+	 * Find By Event
+	 * -------------------------------
+	 * Mengambil review sebuah event dari yang paling baru
+	 */
+	FindByEvent(eventID int, limit int, offset int) ([]entities.Review, error)
+
+	/*
+	 * Count By Event
+	 * -------------------------------
+	 * Menghitung jumlah review sebuah event untuk pagination
+	 */
+	CountByEvent(eventID int) (int64, error)
+
+	/*
+	 * Find
+	 * -------------------------------
+	 * Mencari review berdasarkan ID
+	 */
+	Find(id int) (entities.Review, error)
+
+	/*
+	 * Find By User
+	 * -------------------------------
+	 * Mencari review yang ditulis user untuk sebuah event
+	 */
+	FindByUser(eventID int, userID int) (entities.Review, error)
+
+	/*
+	 * Store
+	 * -------------------------------
+	 * Menyimpan review baru
+	 */
+	Store(review entities.Review) (entities.Review, error)
+
+	/*
+	 * Update
+	 * -------------------------------
+	 * Menyimpan perubahan review beserta balasan host
+	 */
+	Update(review entities.Review) (entities.Review, error)
+
+	/*
+	 * Delete
+	 * -------------------------------
+	 * Menghapus review
+	 */
+	Delete(id int) error
+
+	/*
+	 * Refresh Rating
+	 * -------------------------------
+	 * Menghitung ulang ringkasan rating event dari semua reviewnya
+	 */
+	RefreshRating(eventID int) (entities.EventRating, error)
+
+	/*
+	 * Host Reputation
+	 * -------------------------------
+	 * Mengagregasi semua review dari event yang dimiliki host
+	 */
+	HostReputation(hostID int) (entities.HostReputation, error)
+}
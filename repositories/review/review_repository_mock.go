@@ -0,0 +1,93 @@
+package review
+
+import (
+	"time"
+	"tupulung/entities"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type ReviewRepositoryMock struct {
+	Mock *mock.Mock
+}
+
+func NewReviewRepositoryMock(mock *mock.Mock) *ReviewRepositoryMock {
+	return &ReviewRepositoryMock{
+		Mock: mock,
+	}
+}
+
+var ReviewCollection = []entities.Review{
+	{
+		ID:        1,
+		EventID:   1,
+		UserID:    2,
+		Rating:    5,
+		Body:      "Materinya sangat bermanfaat",
+		CreatedAt: time.Now().Add(-2 * time.Hour),
+		UpdatedAt: time.Now().Add(-2 * time.Hour),
+	},
+	{
+		ID:        2,
+		EventID:   1,
+		UserID:    3,
+		Rating:    3,
+		CreatedAt: time.Now().Add(-1 * time.Hour),
+		UpdatedAt: time.Now().Add(-1 * time.Hour),
+	},
+}
+
+var EventRatingCollection = []entities.EventRating{
+	{
+		EventID: 1,
+		Count:   2,
+		Average: 4,
+		Stars3:  1,
+		Stars5:  1,
+	},
+}
+
+func (repo ReviewRepositoryMock) FindByEvent(eventID int, limit int, offset int) ([]entities.Review, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).([]entities.Review), args.Error(1)
+}
+
+func (repo ReviewRepositoryMock) CountByEvent(eventID int) (int64, error) {
+	args := repo.Mock.Called()
+	return int64(args.Int(0)), args.Error(1)
+}
+
+func (repo ReviewRepositoryMock) Find(id int) (entities.Review, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.Review), args.Error(1)
+}
+
+func (repo ReviewRepositoryMock) FindByUser(eventID int, userID int) (entities.Review, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.Review), args.Error(1)
+}
+
+func (repo ReviewRepositoryMock) Store(review entities.Review) (entities.Review, error) {
+	args := repo.Mock.Called(review.Rating)
+	return args.Get(0).(entities.Review), args.Error(1)
+}
+
+func (repo ReviewRepositoryMock) Update(review entities.Review) (entities.Review, error) {
+	args := repo.Mock.Called(review.Rating)
+	return args.Get(0).(entities.Review), args.Error(1)
+}
+
+func (repo ReviewRepositoryMock) Delete(id int) error {
+	args := repo.Mock.Called(id)
+	return args.Error(0)
+}
+
+func (repo ReviewRepositoryMock) RefreshRating(eventID int) (entities.EventRating, error) {
+	args := repo.Mock.Called(eventID)
+	return args.Get(0).(entities.EventRating), args.Error(1)
+}
+
+func (repo ReviewRepositoryMock) HostReputation(hostID int) (entities.HostReputation, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.HostReputation), args.Error(1)
+}
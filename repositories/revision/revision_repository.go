@@ -0,0 +1,61 @@
+package revision
+
+import (
+	"time"
+	"tupulung/entities"
+	"tupulung/entities/web"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type RevisionRepository struct {
+	db *gorm.DB
+}
+
+func NewRevisionRepository(db *gorm.DB) RevisionRepository {
+	return RevisionRepository{
+		db: db,
+	}
+}
+
+/*
+ * Find By Event
+ * -------------------------------
+ * Mengambil semua revisi event dari yang paling lama
+ */
+func (repo RevisionRepository) FindByEvent(eventID int) ([]entities.EventRevision, error) {
+	revisions := []entities.EventRevision{}
+	tx := repo.db.Preload("User").Where("event_id = ?", eventID).Order("created_at ASC, id ASC").Find(&revisions)
+	if tx.Error != nil {
+		return []entities.EventRevision{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return revisions, nil
+}
+
+/*
+ * Find By Event Since
+ * -------------------------------
+ * Mengambil revisi event yang dibuat setelah waktu tertentu
+ */
+func (repo RevisionRepository) FindByEventSince(eventID int, since time.Time) ([]entities.EventRevision, error) {
+	revisions := []entities.EventRevision{}
+	tx := repo.db.Where("event_id = ? AND created_at > ?", eventID, since).Order("created_at ASC, id ASC").Find(&revisions)
+	if tx.Error != nil {
+		return []entities.EventRevision{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return revisions, nil
+}
+
+/*
+ * Store
+ * -------------------------------
+ * Menyimpan revisi event
+ */
+func (repo RevisionRepository) Store(revision entities.EventRevision) (entities.EventRevision, error) {
+	tx := repo.db.Omit(clause.Associations).Create(&revision)
+	if tx.Error != nil {
+		return entities.EventRevision{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return revision, nil
+}
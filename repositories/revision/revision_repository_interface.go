@@ -0,0 +1,29 @@
+package revision
+
+import (
+	"time"
+	"tupulung/entities"
+)
+
+type RevisionRepositoryInterface interface {
+	/*
+	 * Find By Event
+	 * -------------------------------
+	 * Mengambil semua revisi event dari yang paling lama
+	 */
+	FindByEvent(eventID int) ([]entities.EventRevision, error)
+
+	/*
+	 * Find By Event Since
+	 * -------------------------------
+	 * Mengambil revisi event yang dibuat setelah waktu tertentu
+	 */
+	FindByEventSince(eventID int, since time.Time) ([]entities.EventRevision, error)
+
+	/*
+	 * Store
+	 * -------------------------------
+	 * Menyimpan revisi event
+	 */
+	Store(revision entities.EventRevision) (entities.EventRevision, error)
+}
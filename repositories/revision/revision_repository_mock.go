@@ -0,0 +1,50 @@
+package revision
+
+import (
+	"time"
+	"tupulung/entities"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type RevisionRepositoryMock struct {
+	Mock *mock.Mock
+}
+
+func NewRevisionRepositoryMock(mock *mock.Mock) *RevisionRepositoryMock {
+	return &RevisionRepositoryMock{
+		Mock: mock,
+	}
+}
+
+var EventRevisionCollection = []entities.EventRevision{
+	{
+		ID:        1,
+		EventID:   1,
+		UserID:    1,
+		Changes:   `[{"field":"location","old":"Jakarta","new":"Bandung"},{"field":"capacity","old":"50","new":"80"}]`,
+		CreatedAt: time.Now().Add(-2 * time.Hour),
+	},
+	{
+		ID:        2,
+		EventID:   1,
+		UserID:    1,
+		Changes:   `[{"field":"location","old":"Bandung","new":"Jakarta"},{"field":"datetime_event","old":"2030-01-01T00:00:00Z","new":"2030-01-08T00:00:00Z"}]`,
+		CreatedAt: time.Now().Add(-1 * time.Hour),
+	},
+}
+
+func (repo RevisionRepositoryMock) FindByEvent(eventID int) ([]entities.EventRevision, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).([]entities.EventRevision), args.Error(1)
+}
+
+func (repo RevisionRepositoryMock) FindByEventSince(eventID int, since time.Time) ([]entities.EventRevision, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).([]entities.EventRevision), args.Error(1)
+}
+
+func (repo RevisionRepositoryMock) Store(revision entities.EventRevision) (entities.EventRevision, error) {
+	args := repo.Mock.Called(revision.Changes)
+	return args.Get(0).(entities.EventRevision), args.Error(1)
+}
@@ -0,0 +1,197 @@
+package session
+
+import (
+	"tupulung/entities"
+	"tupulung/entities/web"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type SessionRepository struct {
+	db *gorm.DB
+}
+
+func NewSessionRepository(db *gorm.DB) SessionRepository {
+	return SessionRepository{
+		db: db,
+	}
+}
+
+func orderSpeakers(db *gorm.DB) *gorm.DB {
+	return db.Order("position ASC, id ASC")
+}
+
+/*
+ * Find By Event
+ * -------------------------------
+ * Mengambil semua sesi event beserta pembicaranya sesuai jadwal
+ */
+func (repo SessionRepository) FindByEvent(eventID int) ([]entities.EventSession, error) {
+	sessions := []entities.EventSession{}
+	tx := repo.db.Preload("Speakers", orderSpeakers).Preload("Speakers.User").
+		Where("event_id = ?", eventID).
+		Order("starts_at ASC, room ASC, id ASC").
+		Find(&sessions)
+	if tx.Error != nil {
+		return []entities.EventSession{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return sessions, nil
+}
+
+/*
+ * Find
+ * -------------------------------
+ * Mencari sesi berdasarkan ID
+ */
+func (repo SessionRepository) Find(id int) (entities.EventSession, error) {
+	session := entities.EventSession{}
+	tx := repo.db.Preload("Speakers", orderSpeakers).Preload("Speakers.User").Find(&session, id)
+	if tx.Error != nil {
+		return entities.EventSession{}, web.WebError{Code: 500, Message: "server error"}
+	} else if tx.RowsAffected <= 0 {
+		return entities.EventSession{}, web.WebError{Code: 400, Message: "cannot get session data with specified id"}
+	}
+	return session, nil
+}
+
+/*
+ * Store
+ * -------------------------------
+ * Menambahkan sesi beserta pembicaranya
+ */
+func (repo SessionRepository) Store(session entities.EventSession) (entities.EventSession, error) {
+	speakers := session.Speakers
+	err := repo.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Create(&session).Error; err != nil {
+			return err
+		}
+		return storeSpeakers(tx, session.ID, speakers)
+	})
+	if err != nil {
+		return entities.EventSession{}, web.WebError{Code: 500, Message: err.Error()}
+	}
+	return session, nil
+}
+
+/*
+ * Update
+ * -------------------------------
+ * Mengubah data sesi dan mengganti semua pembicaranya
+ */
+func (repo SessionRepository) Update(session entities.EventSession) (entities.EventSession, error) {
+	speakers := session.Speakers
+	err := repo.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Save(&session).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("session_id = ?", session.ID).Delete(&entities.SessionSpeaker{}).Error; err != nil {
+			return err
+		}
+		return storeSpeakers(tx, session.ID, speakers)
+	})
+	if err != nil {
+		return entities.EventSession{}, web.WebError{Code: 500, Message: err.Error()}
+	}
+	return session, nil
+}
+
+func storeSpeakers(tx *gorm.DB, sessionID uint, speakers []entities.SessionSpeaker) error {
+	if len(speakers) == 0 {
+		return nil
+	}
+	for i := range speakers {
+		speakers[i].ID = 0
+		speakers[i].SessionID = sessionID
+		speakers[i].User = nil
+	}
+	return tx.Omit(clause.Associations).Create(&speakers).Error
+}
+
+/*
+ * Delete
+ * -------------------------------
+ * Menghapus sesi beserta pembicara dan agenda participant
+ */
+func (repo SessionRepository) Delete(id int) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("session_id = ?", id).Delete(&entities.AgendaItem{}).Error
+		if err != nil {
+			return web.WebError{Code: 500, Message: err.Error()}
+		}
+		err = tx.Where("session_id = ?", id).Delete(&entities.SessionSpeaker{}).Error
+		if err != nil {
+			return web.WebError{Code: 500, Message: err.Error()}
+		}
+		err = tx.Delete(&entities.EventSession{}, id).Error
+		if err != nil {
+			return web.WebError{Code: 500, Message: err.Error()}
+		}
+		return nil
+	})
+}
+
+/*
+ * Add To Agenda
+ * -------------------------------
+ * Menambahkan sesi ke agenda pribadi user,
+ * sesi yang sudah ada di agenda diabaikan
+ */
+func (repo SessionRepository) AddToAgenda(item entities.AgendaItem) error {
+	tx := repo.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&item)
+	if tx.Error != nil {
+		return web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return nil
+}
+
+/*
+ * Remove From Agenda
+ * -------------------------------
+ * Menghapus sesi dari agenda pribadi user
+ */
+func (repo SessionRepository) RemoveFromAgenda(sessionID int, userID int) error {
+	tx := repo.db.Where("session_id = ? AND user_id = ?", sessionID, userID).Delete(&entities.AgendaItem{})
+	if tx.Error != nil {
+		return web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return nil
+}
+
+/*
+ * Find Agenda
+ * -------------------------------
+ * Mengambil sesi di agenda pribadi user sesuai jadwal,
+ * eventID 0 berarti agenda dari semua event
+ */
+func (repo SessionRepository) FindAgenda(userID int, eventID int) ([]entities.EventSession, error) {
+	sessions := []entities.EventSession{}
+	builder := repo.db.Preload("Speakers", orderSpeakers).Preload("Speakers.User").
+		Joins("JOIN agenda_items ON agenda_items.session_id = event_sessions.id AND agenda_items.user_id = ?", userID).
+		Joins("JOIN events ON events.id = event_sessions.event_id AND events.deleted_at IS NULL")
+	if eventID != 0 {
+		builder = builder.Where("event_sessions.event_id = ?", eventID)
+	}
+	tx := builder.Order("event_sessions.starts_at ASC, event_sessions.id ASC").Find(&sessions)
+	if tx.Error != nil {
+		return []entities.EventSession{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return sessions, nil
+}
+
+/*
+ * Agenda Session IDs
+ * -------------------------------
+ * Mengambil ID sesi sebuah event yang ada di agenda pribadi user
+ */
+func (repo SessionRepository) AgendaSessionIDs(userID int, eventID int) ([]uint, error) {
+	ids := []uint{}
+	tx := repo.db.Model(&entities.AgendaItem{}).
+		Joins("JOIN event_sessions ON event_sessions.id = agenda_items.session_id").
+		Where("agenda_items.user_id = ? AND event_sessions.event_id = ?", userID, eventID).
+		Pluck("agenda_items.session_id", &ids)
+	if tx.Error != nil {
+		return []uint{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return ids, nil
+}
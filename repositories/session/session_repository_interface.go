@@ -0,0 +1,70 @@
+package session
+
+import "tupulung/entities"
+
+type SessionRepositoryInterface interface {
+
+	/*
+	 * Find By Event
+	 * -------------------------------
+	 * Mengambil semua sesi event beserta pembicaranya sesuai jadwal
+	 */
+	FindByEvent(eventID int) ([]entities.EventSession, error)
+
+	/*
+	 * Find
+	 * -------------------------------
+	 * Mencari sesi berdasarkan ID
+	 */
+	Find(id int) (entities.EventSession, error)
+
+	/*
+	 * Store
+	 * -------------------------------
+	 * Menambahkan sesi beserta pembicaranya
+	 */
+	Store(session entities.EventSession) (entities.EventSession, error)
+
+	/*
+	 * Update
+	 * -------------------------------
+	 * Mengubah data sesi dan mengganti semua pembicaranya
+	 */
+	Update(session entities.EventSession) (entities.EventSession, error)
+
+	/*
+	 * Delete
+	 * -------------------------------
+	 * Menghapus sesi beserta pembicara dan agenda participant
+	 */
+	Delete(id int) error
+
+	/*
+	 * Add To Agenda
+	 * -------------------------------
+	 * Menambahkan sesi ke agenda pribadi user
+	 */
+	AddToAgenda(item entities.AgendaItem) error
+
+	/*
+	 * Remove From Agenda
+	 * -------------------------------
+	 * Menghapus sesi dari agenda pribadi user
+	 */
+	RemoveFromAgenda(sessionID int, userID int) error
+
+	/*
+	 * Find Agenda
+	 * -------------------------------
+	 * Mengambil sesi di agenda pribadi user sesuai jadwal,
+	 * eventID 0 berarti agenda dari semua event
+	 */
+	FindAgenda(userID int, eventID int) ([]entities.EventSession, error)
+
+	/*
+	 * Agenda Session IDs
+	 * -------------------------------
+	 * Mengambil ID sesi sebuah event yang ada di agenda pribadi user
+	 */
+	AgendaSessionIDs(userID int, eventID int) ([]uint, error)
+}
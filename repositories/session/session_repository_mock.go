@@ -0,0 +1,94 @@
+package session
+
+import (
+	"time"
+	"tupulung/entities"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type SessionRepositoryMock struct {
+	Mock *mock.Mock
+}
+
+func NewSessionRepositoryMock(mock *mock.Mock) *SessionRepositoryMock {
+	return &SessionRepositoryMock{
+		Mock: mock,
+	}
+}
+
+var speakerUserID uint = 2
+
+var EventSessionCollection = []entities.EventSession{
+	{
+		ID:        1,
+		EventID:   1,
+		Title:     "Opening keynote",
+		Room:      "Main hall",
+		StartsAt:  time.Date(2022, 5, 1, 9, 0, 0, 0, time.UTC),
+		EndsAt:    time.Date(2022, 5, 1, 10, 0, 0, 0, time.UTC),
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+		Speakers: []entities.SessionSpeaker{
+			{ID: 1, SessionID: 1, UserID: &speakerUserID, Name: "", Position: 0},
+		},
+	},
+	{
+		ID:        2,
+		EventID:   1,
+		Title:     "Concurrency in Go",
+		Room:      "Room A",
+		StartsAt:  time.Date(2022, 5, 1, 10, 30, 0, 0, time.UTC),
+		EndsAt:    time.Date(2022, 5, 1, 11, 30, 0, 0, time.UTC),
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+		Speakers: []entities.SessionSpeaker{
+			{ID: 2, SessionID: 2, Name: "Guest Speaker", Position: 0},
+		},
+	},
+}
+
+func (repo SessionRepositoryMock) FindByEvent(eventID int) ([]entities.EventSession, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).([]entities.EventSession), args.Error(1)
+}
+
+func (repo SessionRepositoryMock) Find(id int) (entities.EventSession, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.EventSession), args.Error(1)
+}
+
+func (repo SessionRepositoryMock) Store(session entities.EventSession) (entities.EventSession, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.EventSession), args.Error(1)
+}
+
+func (repo SessionRepositoryMock) Update(session entities.EventSession) (entities.EventSession, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.EventSession), args.Error(1)
+}
+
+func (repo SessionRepositoryMock) Delete(id int) error {
+	args := repo.Mock.Called(id)
+	return args.Error(0)
+}
+
+func (repo SessionRepositoryMock) AddToAgenda(item entities.AgendaItem) error {
+	args := repo.Mock.Called(item.SessionID)
+	return args.Error(0)
+}
+
+func (repo SessionRepositoryMock) RemoveFromAgenda(sessionID int, userID int) error {
+	args := repo.Mock.Called(sessionID)
+	return args.Error(0)
+}
+
+func (repo SessionRepositoryMock) FindAgenda(userID int, eventID int) ([]entities.EventSession, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).([]entities.EventSession), args.Error(1)
+}
+
+func (repo SessionRepositoryMock) AgendaSessionIDs(userID int, eventID int) ([]uint, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).([]uint), args.Error(1)
+}
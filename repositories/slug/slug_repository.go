@@ -0,0 +1,108 @@
+package slug
+
+import (
+	"tupulung/entities"
+	"tupulung/entities/web"
+
+	"gorm.io/gorm"
+)
+
+type SlugRepository struct {
+	db *gorm.DB
+}
+
+func NewSlugRepository(db *gorm.DB) SlugRepository {
+	return SlugRepository{
+		db: db,
+	}
+}
+
+/*
+ * Is Taken
+ * -------------------------------
+ * Mengecek apakah slug sudah dipakai event lain, termasuk event
+ * yang sudah dihapus dan slug lama yang masih di-redirect
+ */
+func (repo SlugRepository) IsTaken(slug string, exceptEventID uint) (bool, error) {
+	var count int64
+	tx := repo.db.Unscoped().Model(&entities.Event{}).Where("slug = ? AND id <> ?", slug, exceptEventID).Count(&count)
+	if tx.Error != nil {
+		return false, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	if count > 0 {
+		return true, nil
+	}
+	tx = repo.db.Model(&entities.EventSlugRedirect{}).Where("slug = ? AND event_id <> ?", slug, exceptEventID).Count(&count)
+	if tx.Error != nil {
+		return false, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return count > 0, nil
+}
+
+/*
+ * Find Redirect
+ * -------------------------------
+ * Mengambil redirect berdasarkan slug lama
+ */
+func (repo SlugRepository) FindRedirect(slug string) (entities.EventSlugRedirect, error) {
+	redirect := entities.EventSlugRedirect{}
+	tx := repo.db.Where("slug = ?", slug).Find(&redirect)
+	if tx.Error != nil {
+		return entities.EventSlugRedirect{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	} else if tx.RowsAffected <= 0 {
+		return entities.EventSlugRedirect{}, web.WebError{Code: 400, Message: "cannot get event data with specified slug"}
+	}
+	return redirect, nil
+}
+
+/*
+ * Rename
+ * -------------------------------
+ * Mengganti slug event dan menyimpan slug lama sebagai redirect.
+ * Redirect ke event yang sama dengan slug baru dihapus agar
+ * event bisa kembali memakai slug lamanya
+ */
+func (repo SlugRepository) Rename(eventID uint, oldSlug string, newSlug string) error {
+	err := repo.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("slug = ? AND event_id = ?", newSlug, eventID).Delete(&entities.EventSlugRedirect{}).Error; err != nil {
+			return err
+		}
+		if oldSlug != "" {
+			if err := tx.Create(&entities.EventSlugRedirect{Slug: oldSlug, EventID: eventID}).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Model(&entities.Event{}).Where("id = ?", eventID).Update("slug", newSlug).Error
+	})
+	if err != nil {
+		return web.WebError{Code: 500, Message: err.Error()}
+	}
+	return nil
+}
+
+/*
+ * Find Unslugged
+ * -------------------------------
+ * Mengambil event lama yang belum memiliki slug
+ */
+func (repo SlugRepository) FindUnslugged(limit int) ([]entities.Event, error) {
+	events := []entities.Event{}
+	tx := repo.db.Where("slug IS NULL").Order("id ASC").Limit(limit).Find(&events)
+	if tx.Error != nil {
+		return []entities.Event{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return events, nil
+}
+
+/*
+ * Assign
+ * -------------------------------
+ * Mengisi slug event tanpa menyentuh field lainnya
+ */
+func (repo SlugRepository) Assign(eventID uint, slug string) error {
+	tx := repo.db.Model(&entities.Event{}).Where("id = ?", eventID).Update("slug", slug)
+	if tx.Error != nil {
+		return web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return nil
+}
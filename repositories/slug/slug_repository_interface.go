@@ -0,0 +1,41 @@
+package slug
+
+import "tupulung/entities"
+
+type SlugRepositoryInterface interface {
+	/*
+	 * Is Taken
+	 * -------------------------------
+	 * Mengecek apakah slug sudah dipakai event lain, termasuk event
+	 * yang sudah dihapus dan slug lama yang masih di-redirect
+	 */
+	IsTaken(slug string, exceptEventID uint) (bool, error)
+
+	/*
+	 * Find Redirect
+	 * -------------------------------
+	 * Mengambil redirect berdasarkan slug lama
+	 */
+	FindRedirect(slug string) (entities.EventSlugRedirect, error)
+
+	/*
+	 * Rename
+	 * -------------------------------
+	 * Mengganti slug event dan menyimpan slug lama sebagai redirect
+	 */
+	Rename(eventID uint, oldSlug string, newSlug string) error
+
+	/*
+	 * Find Unslugged
+	 * -------------------------------
+	 * Mengambil event lama yang belum memiliki slug
+	 */
+	FindUnslugged(limit int) ([]entities.Event, error)
+
+	/*
+	 * Assign
+	 * -------------------------------
+	 * Mengisi slug event tanpa menyentuh field lainnya
+	 */
+	Assign(eventID uint, slug string) error
+}
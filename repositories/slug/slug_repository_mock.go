@@ -0,0 +1,50 @@
+package slug
+
+import (
+	"tupulung/entities"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type SlugRepositoryMock struct {
+	Mock *mock.Mock
+}
+
+func NewSlugRepositoryMock(mock *mock.Mock) *SlugRepositoryMock {
+	return &SlugRepositoryMock{
+		Mock: mock,
+	}
+}
+
+var EventSlugRedirectCollection = []entities.EventSlugRedirect{
+	{
+		ID:      1,
+		Slug:    "golang-meetup",
+		EventID: 1,
+	},
+}
+
+func (repo SlugRepositoryMock) IsTaken(slug string, exceptEventID uint) (bool, error) {
+	args := repo.Mock.Called(slug)
+	return args.Bool(0), args.Error(1)
+}
+
+func (repo SlugRepositoryMock) FindRedirect(slug string) (entities.EventSlugRedirect, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.EventSlugRedirect), args.Error(1)
+}
+
+func (repo SlugRepositoryMock) Rename(eventID uint, oldSlug string, newSlug string) error {
+	args := repo.Mock.Called(oldSlug, newSlug)
+	return args.Error(0)
+}
+
+func (repo SlugRepositoryMock) FindUnslugged(limit int) ([]entities.Event, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).([]entities.Event), args.Error(1)
+}
+
+func (repo SlugRepositoryMock) Assign(eventID uint, slug string) error {
+	args := repo.Mock.Called(slug)
+	return args.Error(0)
+}
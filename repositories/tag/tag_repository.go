@@ -0,0 +1,86 @@
+package tag
+
+import (
+	"tupulung/entities"
+	"tupulung/entities/web"
+
+	"gorm.io/gorm"
+)
+
+type TagRepository struct {
+	db *gorm.DB
+}
+
+func NewTagRepository(db *gorm.DB) TagRepository {
+	return TagRepository{
+		db: db,
+	}
+}
+
+/*
+ * Sync Event
+ * -------------------------------
+ * Membuat tag yang belum ada lalu mengganti semua tag milik event
+ */
+func (repo TagRepository) SyncEvent(eventID uint, names []string) ([]entities.Tag, error) {
+	tags := []entities.Tag{}
+	err := repo.db.Transaction(func(tx *gorm.DB) error {
+		for _, name := range names {
+			tag := entities.Tag{}
+			err := tx.Where(entities.Tag{Name: name}).FirstOrCreate(&tag).Error
+			if err != nil {
+				return err
+			}
+			tags = append(tags, tag)
+		}
+		event := entities.Event{}
+		event.ID = eventID
+		return tx.Model(&event).Association("Tags").Replace(tags)
+	})
+	if err != nil {
+		return []entities.Tag{}, web.WebError{Code: 500, Message: err.Error()}
+	}
+	return tags, nil
+}
+
+/*
+ * Autocomplete
+ * -------------------------------
+ * Mencari tag berdasarkan awalan nama, diurutkan dari yang paling banyak dipakai
+ */
+func (repo TagRepository) Autocomplete(prefix string, limit int) ([]entities.TagResponse, error) {
+	tags := []entities.TagResponse{}
+	tx := repo.db.Model(&entities.Tag{}).
+		Select("tags.id, tags.name, COUNT(event_tags.event_id) AS events").
+		Joins("LEFT JOIN event_tags ON event_tags.tag_id = tags.id").
+		Where("tags.name LIKE ?", prefix+"%").
+		Group("tags.id, tags.name").
+		Order("COUNT(event_tags.event_id) DESC, tags.name ASC").
+		Limit(limit).
+		Scan(&tags)
+	if tx.Error != nil {
+		return []entities.TagResponse{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return tags, nil
+}
+
+/*
+ * Popular
+ * -------------------------------
+ * Mengambil tag yang paling banyak dipakai oleh event public
+ */
+func (repo TagRepository) Popular(limit int) ([]entities.TagResponse, error) {
+	tags := []entities.TagResponse{}
+	tx := repo.db.Model(&entities.Tag{}).
+		Select("tags.id, tags.name, COUNT(events.id) AS events").
+		Joins("JOIN event_tags ON event_tags.tag_id = tags.id").
+		Joins("JOIN events ON events.id = event_tags.event_id AND events.deleted_at IS NULL AND events.visibility = ?", "public").
+		Group("tags.id, tags.name").
+		Order("COUNT(events.id) DESC, tags.name ASC").
+		Limit(limit).
+		Scan(&tags)
+	if tx.Error != nil {
+		return []entities.TagResponse{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return tags, nil
+}
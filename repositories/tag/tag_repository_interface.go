@@ -0,0 +1,26 @@
+package tag
+
+import "tupulung/entities"
+
+type TagRepositoryInterface interface {
+	/*
+	 * Sync Event
+	 * -------------------------------
+	 * Membuat tag yang belum ada lalu mengganti semua tag milik event
+	 */
+	SyncEvent(eventID uint, names []string) ([]entities.Tag, error)
+
+	/*
+	 * Autocomplete
+	 * -------------------------------
+	 * Mencari tag berdasarkan awalan nama
+	 */
+	Autocomplete(prefix string, limit int) ([]entities.TagResponse, error)
+
+	/*
+	 * Popular
+	 * -------------------------------
+	 * Mengambil tag yang paling banyak dipakai oleh event public
+	 */
+	Popular(limit int) ([]entities.TagResponse, error)
+}
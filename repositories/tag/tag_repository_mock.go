@@ -0,0 +1,46 @@
+package tag
+
+import (
+	"time"
+	"tupulung/entities"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type TagRepositoryMock struct {
+	Mock *mock.Mock
+}
+
+func NewTagRepositoryMock(mock *mock.Mock) *TagRepositoryMock {
+	return &TagRepositoryMock{
+		Mock: mock,
+	}
+}
+
+var TagCollection = []entities.Tag{
+	{
+		ID:        1,
+		Name:      "golang",
+		CreatedAt: time.Now(),
+	},
+	{
+		ID:        2,
+		Name:      "hackathon",
+		CreatedAt: time.Now(),
+	},
+}
+
+func (repo TagRepositoryMock) SyncEvent(eventID uint, names []string) ([]entities.Tag, error) {
+	args := repo.Mock.Called(names)
+	return args.Get(0).([]entities.Tag), args.Error(1)
+}
+
+func (repo TagRepositoryMock) Autocomplete(prefix string, limit int) ([]entities.TagResponse, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).([]entities.TagResponse), args.Error(1)
+}
+
+func (repo TagRepositoryMock) Popular(limit int) ([]entities.TagResponse, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).([]entities.TagResponse), args.Error(1)
+}
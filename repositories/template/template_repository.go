@@ -0,0 +1,88 @@
+package template
+
+import (
+	"tupulung/entities"
+	"tupulung/entities/web"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type TemplateRepository struct {
+	db *gorm.DB
+}
+
+func NewTemplateRepository(db *gorm.DB) TemplateRepository {
+	return TemplateRepository{
+		db: db,
+	}
+}
+
+/*
+ * Find By User
+ * -------------------------------
+ * Mengambil semua template milik user
+ */
+func (repo TemplateRepository) FindByUser(userID int) ([]entities.EventTemplate, error) {
+	templates := []entities.EventTemplate{}
+	tx := repo.db.Where("user_id = ?", userID).Order("name ASC").Find(&templates)
+	if tx.Error != nil {
+		return []entities.EventTemplate{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return templates, nil
+}
+
+/*
+ * Find
+ * -------------------------------
+ * Mencari template berdasarkan ID
+ */
+func (repo TemplateRepository) Find(id int) (entities.EventTemplate, error) {
+	template := entities.EventTemplate{}
+	tx := repo.db.Find(&template, id)
+	if tx.Error != nil {
+		return entities.EventTemplate{}, web.WebError{Code: 500, Message: "server error"}
+	} else if tx.RowsAffected <= 0 {
+		return entities.EventTemplate{}, web.WebError{Code: 400, Message: "cannot get template data with specified id"}
+	}
+	return template, nil
+}
+
+/*
+ * Store
+ * -------------------------------
+ * Menambahkan template kedalam database
+ */
+func (repo TemplateRepository) Store(template entities.EventTemplate) (entities.EventTemplate, error) {
+	tx := repo.db.Omit(clause.Associations).Create(&template)
+	if tx.Error != nil {
+		return entities.EventTemplate{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return template, nil
+}
+
+/*
+ * Update
+ * -------------------------------
+ * Mengubah data template
+ */
+func (repo TemplateRepository) Update(template entities.EventTemplate) (entities.EventTemplate, error) {
+	tx := repo.db.Omit(clause.Associations).Save(&template)
+	if tx.Error != nil {
+		return entities.EventTemplate{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return template, nil
+}
+
+/*
+ * Delete
+ * -------------------------------
+ * Menghapus template berdasarkan ID
+ */
+func (repo TemplateRepository) Delete(id int) error {
+	tx := repo.db.Delete(&entities.EventTemplate{}, id)
+	if tx.Error != nil {
+		return web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package template
+
+import "tupulung/entities"
+
+type TemplateRepositoryInterface interface {
+	/*
+	 * Find By User
+	 * -------------------------------
+	 * Mengambil semua template milik user
+	 */
+	FindByUser(userID int) ([]entities.EventTemplate, error)
+
+	/*
+	 * Find
+	 * -------------------------------
+	 * Mencari template berdasarkan ID
+	 */
+	Find(id int) (entities.EventTemplate, error)
+
+	/*
+	 * Store
+	 * -------------------------------
+	 * Menambahkan template kedalam database
+	 */
+	Store(template entities.EventTemplate) (entities.EventTemplate, error)
+
+	/*
+	 * Update
+	 * -------------------------------
+	 * Mengubah data template
+	 */
+	Update(template entities.EventTemplate) (entities.EventTemplate, error)
+
+	/*
+	 * Delete
+	 * -------------------------------
+	 * Menghapus template berdasarkan ID
+	 */
+	Delete(id int) error
+}
@@ -0,0 +1,70 @@
+package template
+
+import (
+	"time"
+	"tupulung/entities"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type TemplateRepositoryMock struct {
+	Mock *mock.Mock
+}
+
+func NewTemplateRepositoryMock(mock *mock.Mock) *TemplateRepositoryMock {
+	return &TemplateRepositoryMock{
+		Mock: mock,
+	}
+}
+
+var EventTemplateCollection = []entities.EventTemplate{
+	{
+		ID:          1,
+		UserID:      1,
+		Name:        "Monthly meetup",
+		Title:       "Golang Meetup",
+		HostedBy:    "Gophers ID",
+		CategoryID:  1,
+		Location:    "Jakarta",
+		Description: "Monthly gathering of gophers",
+		Capacity:    50,
+		Visibility:  "public",
+		Tags:        "golang,meetup",
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	},
+	{
+		ID:         2,
+		UserID:     2,
+		Name:       "Workshop",
+		Title:      "Hands-on Workshop",
+		CategoryID: 2,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	},
+}
+
+func (repo TemplateRepositoryMock) FindByUser(userID int) ([]entities.EventTemplate, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).([]entities.EventTemplate), args.Error(1)
+}
+
+func (repo TemplateRepositoryMock) Find(id int) (entities.EventTemplate, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.EventTemplate), args.Error(1)
+}
+
+func (repo TemplateRepositoryMock) Store(template entities.EventTemplate) (entities.EventTemplate, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.EventTemplate), args.Error(1)
+}
+
+func (repo TemplateRepositoryMock) Update(template entities.EventTemplate) (entities.EventTemplate, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.EventTemplate), args.Error(1)
+}
+
+func (repo TemplateRepositoryMock) Delete(id int) error {
+	args := repo.Mock.Called()
+	return args.Error(0)
+}
@@ -0,0 +1,108 @@
+package ticket
+
+import (
+	"tupulung/entities"
+	"tupulung/entities/web"
+
+	"gorm.io/gorm"
+)
+
+type TicketRepository struct {
+	db *gorm.DB
+}
+
+func NewTicketRepository(db *gorm.DB) TicketRepository {
+	return TicketRepository{
+		db: db,
+	}
+}
+
+/*
+ * Find By Event
+ * -------------------------------
+ * Mengambil semua tipe tiket sebuah event
+ */
+func (repo TicketRepository) FindByEvent(eventID int) ([]entities.TicketType, error) {
+	ticketTypes := []entities.TicketType{}
+	tx := repo.db.Where("event_id = ?", eventID).Order("price ASC").Find(&ticketTypes)
+	if tx.Error != nil {
+		return []entities.TicketType{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return ticketTypes, nil
+}
+
+/*
+ * Find
+ * -------------------------------
+ * Mencari tipe tiket berdasarkan ID beserta event-nya
+ */
+func (repo TicketRepository) Find(id int) (entities.TicketType, error) {
+	ticketType := entities.TicketType{}
+	tx := repo.db.Preload("Event").Preload("Event.Cohosts", "status = ?", "accepted").Find(&ticketType, id)
+	if tx.Error != nil {
+		return entities.TicketType{}, web.WebError{Code: 500, Message: "server error"}
+	} else if tx.RowsAffected <= 0 {
+		return entities.TicketType{}, web.WebError{Code: 400, Message: "cannot get ticket type data with specified id"}
+	}
+	return ticketType, nil
+}
+
+/*
+ * Store
+ * -------------------------------
+ * Menambahkan tipe tiket kedalam database
+ */
+func (repo TicketRepository) Store(ticketType entities.TicketType) (entities.TicketType, error) {
+	tx := repo.db.Omit("Event").Create(&ticketType)
+	if tx.Error != nil {
+		return entities.TicketType{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return ticketType, nil
+}
+
+/*
+ * Update
+ * -------------------------------
+ * Mengupdate detail tipe tiket, jumlah terjual & reserved
+ * tidak ikut diubah
+ */
+func (repo TicketRepository) Update(ticketType entities.TicketType, id int) (entities.TicketType, error) {
+	tx := repo.db.Model(&entities.TicketType{}).Where("id = ?", id).
+		Select("name", "description", "price", "currency", "quantity", "sales_start", "sales_end").
+		Updates(&ticketType)
+	if tx.Error != nil {
+		return entities.TicketType{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return repo.Find(id)
+}
+
+/*
+ * Delete
+ * -------------------------------
+ * Menghapus tipe tiket
+ */
+func (repo TicketRepository) Delete(id int) error {
+	tx := repo.db.Delete(&entities.TicketType{}, id)
+	if tx.Error != nil {
+		return web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return nil
+}
+
+/*
+ * Reserve
+ * -------------------------------
+ * Memesan sejumlah tiket secara atomic,
+ * gagal jika sisa tiket tidak mencukupi
+ */
+func (repo TicketRepository) Reserve(id int, quantity uint) error {
+	tx := repo.db.Model(&entities.TicketType{}).
+		Where("id = ? AND sold + reserved + ? <= quantity", id, quantity).
+		UpdateColumn("reserved", gorm.Expr("reserved + ?", quantity))
+	if tx.Error != nil {
+		return web.WebError{Code: 500, Message: tx.Error.Error()}
+	} else if tx.RowsAffected <= 0 {
+		return web.WebError{Code: 409, Message: "Not enough tickets left for this ticket type"}
+	}
+	return nil
+}
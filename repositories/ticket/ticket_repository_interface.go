@@ -0,0 +1,49 @@
+package ticket
+
+import "tupulung/entities"
+
+type TicketRepositoryInterface interface {
+	/*
+	 * Find By Event
+	 * -------------------------------
+	 * Mengambil semua tipe tiket sebuah event
+	 */
+	FindByEvent(eventID int) ([]entities.TicketType, error)
+
+	/*
+	 * Find
+	 * -------------------------------
+	 * Mencari tipe tiket berdasarkan ID beserta event-nya
+	 */
+	Find(id int) (entities.TicketType, error)
+
+	/*
+	 * Store
+	 * -------------------------------
+	 * Menambahkan tipe tiket kedalam database
+	 */
+	Store(ticketType entities.TicketType) (entities.TicketType, error)
+
+	/*
+	 * Update
+	 * -------------------------------
+	 * Mengupdate detail tipe tiket, jumlah terjual & reserved
+	 * tidak ikut diubah
+	 */
+	Update(ticketType entities.TicketType, id int) (entities.TicketType, error)
+
+	/*
+	 * Delete
+	 * -------------------------------
+	 * Menghapus tipe tiket
+	 */
+	Delete(id int) error
+
+	/*
+	 * Reserve
+	 * -------------------------------
+	 * Memesan sejumlah tiket secara atomic,
+	 * gagal jika sisa tiket tidak mencukupi
+	 */
+	Reserve(id int, quantity uint) error
+}
@@ -0,0 +1,70 @@
+package ticket
+
+import (
+	"time"
+	"tupulung/entities"
+
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type TicketRepositoryMock struct {
+	Mock *mock.Mock
+}
+
+func NewTicketRepositoryMock(mock *mock.Mock) *TicketRepositoryMock {
+	return &TicketRepositoryMock{
+		Mock: mock,
+	}
+}
+
+var TicketTypeCollection = []entities.TicketType{
+	{
+		Model:    gorm.Model{ID: 1, CreatedAt: time.Now(), UpdatedAt: time.Now()},
+		EventID:  1,
+		Name:     "Regular",
+		Price:    50000,
+		Currency: "IDR",
+		Quantity: 100,
+		Sold:     10,
+		Reserved: 2,
+	},
+	{
+		Model:    gorm.Model{ID: 2, CreatedAt: time.Now(), UpdatedAt: time.Now()},
+		EventID:  1,
+		Name:     "Community",
+		Price:    0,
+		Currency: "IDR",
+		Quantity: 20,
+	},
+}
+
+func (repo TicketRepositoryMock) FindByEvent(eventID int) ([]entities.TicketType, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).([]entities.TicketType), args.Error(1)
+}
+
+func (repo TicketRepositoryMock) Find(id int) (entities.TicketType, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.TicketType), args.Error(1)
+}
+
+func (repo TicketRepositoryMock) Store(ticketType entities.TicketType) (entities.TicketType, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.TicketType), args.Error(1)
+}
+
+func (repo TicketRepositoryMock) Update(ticketType entities.TicketType, id int) (entities.TicketType, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).(entities.TicketType), args.Error(1)
+}
+
+func (repo TicketRepositoryMock) Delete(id int) error {
+	args := repo.Mock.Called()
+	return args.Error(0)
+}
+
+func (repo TicketRepositoryMock) Reserve(id int, quantity uint) error {
+	args := repo.Mock.Called()
+	return args.Error(0)
+}
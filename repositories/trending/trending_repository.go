@@ -0,0 +1,128 @@
+package trending
+
+import (
+	"time"
+	"tupulung/entities"
+	"tupulung/entities/web"
+
+	"gorm.io/gorm"
+)
+
+/*
+ * Activity sources
+ * -------------------------------
+ * Tabel interaksi yang dihitung sebagai aktivitas event
+ * [kind]: [table, kondisi tambahan]
+ */
+var activitySources = []struct {
+	kind      string
+	table     string
+	condition string
+}{
+	{kind: "like", table: "likes"},
+	{kind: "join", table: "participants", condition: "status = 'going'"},
+	{kind: "comment", table: "comments", condition: "deleted_at IS NULL"},
+	{kind: "view", table: "event_views"},
+}
+
+type TrendingRepository struct {
+	db *gorm.DB
+}
+
+func NewTrendingRepository(db *gorm.DB) TrendingRepository {
+	return TrendingRepository{
+		db: db,
+	}
+}
+
+/*
+ * Record View
+ * -------------------------------
+ * Mencatat event yang sedang dilihat, view dari user yang sama
+ * atau IP yang sama untuk pengunjung tanpa login sejak waktu
+ * tertentu tidak dicatat lagi
+ *
+ * @return 	bool		true jika view dicatat
+ */
+func (repo TrendingRepository) RecordView(view entities.EventView, since time.Time) (bool, error) {
+	builder := repo.db.Model(&entities.EventView{}).Where("event_id = ? AND created_at >= ?", view.EventID, since)
+	if view.UserID != nil {
+		builder = builder.Where("user_id = ?", *view.UserID)
+	} else {
+		builder = builder.Where("user_id IS NULL AND ip = ?", view.IP)
+	}
+	var count int64
+	if tx := builder.Count(&count); tx.Error != nil {
+		return false, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	if count > 0 {
+		return false, nil
+	}
+	tx := repo.db.Create(&view)
+	if tx.Error != nil {
+		return false, web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return true, nil
+}
+
+/*
+ * Activity
+ * -------------------------------
+ * Menghitung jumlah interaksi setiap event sejak waktu tertentu,
+ * setiap interaksi diberi bobot exponential decay berdasarkan umurnya
+ * sehingga interaksi berumur halfLife hanya bernilai setengah
+ */
+func (repo TrendingRepository) Activity(since time.Time, now time.Time, halfLife time.Duration) ([]entities.EventActivity, error) {
+	activities := []entities.EventActivity{}
+	for _, source := range activitySources {
+		rows := []entities.EventActivity{}
+		builder := repo.db.Table(source.table).
+			Select("event_id, ? AS kind, COUNT(*) AS count, SUM(EXP(-LN(2) * TIMESTAMPDIFF(SECOND, created_at, ?) / ?)) AS decayed", source.kind, now, halfLife.Seconds()).
+			Where("created_at >= ?", since).
+			Group("event_id")
+		if source.condition != "" {
+			builder = builder.Where(source.condition)
+		}
+		tx := builder.Scan(&rows)
+		if tx.Error != nil {
+			return []entities.EventActivity{}, web.WebError{Code: 500, Message: tx.Error.Error()}
+		}
+		activities = append(activities, rows...)
+	}
+	return activities, nil
+}
+
+/*
+ * Replace Scores
+ * -------------------------------
+ * Mengganti seluruh isi tabel event_scores dengan hasil perhitungan terbaru
+ */
+func (repo TrendingRepository) ReplaceScores(scores []entities.EventScore) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("1 = 1").Delete(&entities.EventScore{}).Error
+		if err != nil {
+			return web.WebError{Code: 500, Message: err.Error()}
+		}
+		if len(scores) == 0 {
+			return nil
+		}
+		err = tx.CreateInBatches(&scores, 500).Error
+		if err != nil {
+			return web.WebError{Code: 500, Message: err.Error()}
+		}
+		return nil
+	})
+}
+
+/*
+ * Prune Views
+ * -------------------------------
+ * Menghapus catatan view yang sudah tidak dihitung lagi
+ */
+func (repo TrendingRepository) PruneViews(before time.Time) error {
+	tx := repo.db.Where("created_at < ?", before).Delete(&entities.EventView{})
+	if tx.Error != nil {
+		return web.WebError{Code: 500, Message: tx.Error.Error()}
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package trending
+
+import (
+	"time"
+	"tupulung/entities"
+)
+
+type TrendingRepositoryInterface interface {
+	/*
+	 * Record View
+	 * -------------------------------
+	 * Mencatat event yang sedang dilihat, view berulang dari
+	 * user atau IP yang sama sejak waktu tertentu tidak dicatat
+	 */
+	RecordView(view entities.EventView, since time.Time) (bool, error)
+
+	/*
+	 * Activity
+	 * -------------------------------
+	 * Menghitung jumlah interaksi setiap event sejak waktu tertentu
+	 * beserta nilai time decay-nya
+	 */
+	Activity(since time.Time, now time.Time, halfLife time.Duration) ([]entities.EventActivity, error)
+
+	/*
+	 * Replace Scores
+	 * -------------------------------
+	 * Mengganti seluruh isi tabel event_scores dengan hasil perhitungan terbaru
+	 */
+	ReplaceScores(scores []entities.EventScore) error
+
+	/*
+	 * Prune Views
+	 * -------------------------------
+	 * Menghapus catatan view yang sudah tidak dihitung lagi
+	 */
+	PruneViews(before time.Time) error
+}
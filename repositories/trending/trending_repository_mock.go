@@ -0,0 +1,45 @@
+package trending
+
+import (
+	"time"
+	"tupulung/entities"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type TrendingRepositoryMock struct {
+	Mock *mock.Mock
+}
+
+func NewTrendingRepositoryMock(mock *mock.Mock) *TrendingRepositoryMock {
+	return &TrendingRepositoryMock{
+		Mock: mock,
+	}
+}
+
+var EventActivityCollection = []entities.EventActivity{
+	{EventID: 1, Kind: "like", Count: 4, Decayed: 2.5},
+	{EventID: 1, Kind: "view", Count: 20, Decayed: 12},
+	{EventID: 2, Kind: "join", Count: 6, Decayed: 5},
+	{EventID: 2, Kind: "comment", Count: 3, Decayed: 1.5},
+}
+
+func (repo TrendingRepositoryMock) RecordView(view entities.EventView, since time.Time) (bool, error) {
+	args := repo.Mock.Called(view)
+	return args.Bool(0), args.Error(1)
+}
+
+func (repo TrendingRepositoryMock) Activity(since time.Time, now time.Time, halfLife time.Duration) ([]entities.EventActivity, error) {
+	args := repo.Mock.Called()
+	return args.Get(0).([]entities.EventActivity), args.Error(1)
+}
+
+func (repo TrendingRepositoryMock) ReplaceScores(scores []entities.EventScore) error {
+	args := repo.Mock.Called(scores)
+	return args.Error(0)
+}
+
+func (repo TrendingRepositoryMock) PruneViews(before time.Time) error {
+	args := repo.Mock.Called()
+	return args.Error(0)
+}
@@ -1,24 +1,69 @@
 package main
 
 import (
+	"log"
+	"time"
 	"tupulung/config"
 	"tupulung/deliveries/handlers"
+	jwtMiddleware "tupulung/deliveries/middleware"
 	"tupulung/deliveries/routes"
 	"tupulung/utilities"
 
+	announcementRepository "tupulung/repositories/announcement"
+	auditRepository "tupulung/repositories/audit"
 	categoryRepository "tupulung/repositories/category"
+	cohostRepository "tupulung/repositories/cohost"
 	commentRepository "tupulung/repositories/comment"
 	eventRepository "tupulung/repositories/event"
+	eventImportRepository "tupulung/repositories/eventimport"
+	galleryRepository "tupulung/repositories/gallery"
+	groupRepository "tupulung/repositories/group"
+	inviteRepository "tupulung/repositories/invite"
 	likeRepository "tupulung/repositories/like"
+	notificationRepository "tupulung/repositories/notification"
+	orderRepository "tupulung/repositories/order"
 	participantRepository "tupulung/repositories/participant"
+	questionRepository "tupulung/repositories/question"
+	reminderRepository "tupulung/repositories/reminder"
+	reportRepository "tupulung/repositories/report"
+	reviewRepository "tupulung/repositories/review"
+	revisionRepository "tupulung/repositories/revision"
+	sessionRepository "tupulung/repositories/session"
+	slugRepository "tupulung/repositories/slug"
+	tagRepository "tupulung/repositories/tag"
+	templateRepository "tupulung/repositories/template"
+	ticketRepository "tupulung/repositories/ticket"
+	trendingRepository "tupulung/repositories/trending"
 	userRepository "tupulung/repositories/user"
+	announcementService "tupulung/services/announcement"
 	authService "tupulung/services/auth"
 	categoryService "tupulung/services/category"
+	cohostService "tupulung/services/cohost"
 	commentService "tupulung/services/comment"
 	eventService "tupulung/services/event"
+	eventImportService "tupulung/services/eventimport"
+	galleryService "tupulung/services/gallery"
+	groupService "tupulung/services/group"
+	inviteService "tupulung/services/invite"
 	likeService "tupulung/services/like"
+	moderationService "tupulung/services/moderation"
+	notificationService "tupulung/services/notification"
+	orderService "tupulung/services/order"
 	participantService "tupulung/services/participant"
+	questionService "tupulung/services/question"
+	reminderService "tupulung/services/reminder"
+	reviewService "tupulung/services/review"
+	revisionService "tupulung/services/revision"
+	sessionService "tupulung/services/session"
+	tagService "tupulung/services/tag"
+	templateService "tupulung/services/template"
+	ticketService "tupulung/services/ticket"
+	trendingService "tupulung/services/trending"
 	userService "tupulung/services/user"
+	mailProvider "tupulung/utilities/mail"
+	paymentProvider "tupulung/utilities/payment"
+	"tupulung/utilities/scheduler"
+	searchProvider "tupulung/utilities/search"
 	storageProvider "tupulung/utilities/storage"
 
 	"github.com/labstack/echo/v4"
@@ -27,6 +72,10 @@ import (
 
 func main() {
 	config := config.Get()
+	if config.Ticket.Secret == "" {
+		// Tanpa secret, kode tiket bisa dipalsukan siapa saja
+		log.Fatal("TICKET_SECRET must be set")
+	}
 	db := utilities.NewMysqlGorm(config)
 	utilities.Migrate(db)
 
@@ -37,6 +86,11 @@ func main() {
 		AllowMethods: []string{echo.GET, echo.POST, echo.PUT, echo.DELETE, echo.PATCH, echo.OPTIONS},
 	}))
 	s3 := storageProvider.NewS3()
+	searchIndex := searchProvider.NewBleve()
+	payment, err := paymentProvider.New(config)
+	if err != nil {
+		e.Logger.Fatal("Invalid payment configuration: " + err.Error())
+	}
 
 	// User
 	userRepository := userRepository.NewUserRepository(db)
@@ -44,20 +98,150 @@ func main() {
 	eventRepository := eventRepository.NewEventRepository(db)
 	likeRepository := likeRepository.NewLikeRepository(db)
 	participantRepository := participantRepository.NewParticipantRepository(db)
+	inviteRepository := inviteRepository.NewInviteRepository(db)
+	tagRepository := tagRepository.NewTagRepository(db)
+	templateRepository := templateRepository.NewTemplateRepository(db)
+	trendingRepository := trendingRepository.NewTrendingRepository(db)
+	revisionRepository := revisionRepository.NewRevisionRepository(db)
+	questionRepository := questionRepository.NewQuestionRepository(db)
+	slugRepository := slugRepository.NewSlugRepository(db)
+	reviewRepository := reviewRepository.NewReviewRepository(db)
 
-	userService := userService.NewUserService(userRepository, eventRepository)
+	userService := userService.NewUserService(userRepository, eventRepository, reviewRepository)
 	userHandler := handlers.NewUserHandler(userService, s3)
+	// Token user yang ditangguhkan ditolak di setiap request
+	jwtMiddleware.SuspensionCheck = userService.IsSuspended
 	routes.RegisterUserRoute(e, userHandler)
 
-	eventService := eventService.NewEventService(eventRepository, userRepository, likeRepository)
-	participantService := participantService.NewParticipantService(participantRepository, userRepository, eventRepository)
+	eventService := eventService.NewEventService(eventRepository, userRepository, likeRepository, inviteRepository, tagRepository, templateRepository, trendingRepository, revisionRepository, slugRepository)
+	participantService := participantService.NewParticipantService(participantRepository, userRepository, eventRepository, inviteRepository, questionRepository)
 	likeService := likeService.NewLikeService(likeRepository, userRepository, eventRepository)
 
-	eventHandler := handlers.NewEventHandler(eventService, s3)
+	// Build search index on first run or after the mapping version changed
+	if count, err := searchIndex.CountEvents(); err == nil && count == 0 {
+		if err := eventService.Reindex(searchIndex); err != nil {
+			e.Logger.Warn("Cannot build search index: " + err.Error())
+		}
+	}
+
+	// Give events created before slugs existed a slug
+	if err := eventService.BackfillSlugs(); err != nil {
+		e.Logger.Warn("Cannot backfill event slugs: " + err.Error())
+	}
+
+	eventHandler := handlers.NewEventHandler(eventService, s3, searchIndex)
 	participantHandler := handlers.NewParticipantHandler(participantService)
 	likeHandler := handlers.NewLikeHandler(likeService)
 	routes.RegisterEventRoute(e, eventHandler, participantHandler, likeHandler)
 
+	// Bulk event import, rows are created in the background
+	importInterval := eventImportService.Interval
+	eventImportRepository := eventImportRepository.NewEventImportRepository(db)
+	eventImportService := eventImportService.NewEventImportService(eventImportRepository, eventService)
+	eventImportHandler := handlers.NewEventImportHandler(eventImportService)
+	routes.RegisterEventImportRoute(e, eventImportHandler)
+	scheduler.Every("imports", importInterval, func() error {
+		_, err := eventImportService.Run(searchIndex)
+		return err
+	})
+
+	// Trending score, recomputed periodically
+	recomputeInterval := trendingService.RecomputeInterval
+	trendingService := trendingService.NewTrendingService(trendingRepository)
+	scheduler.Every("trending", recomputeInterval, func() error {
+		_, err := trendingService.Recompute(time.Now())
+		return err
+	})
+
+	// Revision history
+	revisionService := revisionService.NewRevisionService(revisionRepository, eventRepository, participantRepository, inviteRepository)
+	revisionHandler := handlers.NewRevisionHandler(revisionService)
+	routes.RegisterRevisionRoute(e, revisionHandler)
+
+	// Registration questions
+	questionService := questionService.NewQuestionService(questionRepository, eventRepository)
+	questionHandler := handlers.NewQuestionHandler(questionService)
+	routes.RegisterQuestionRoute(e, questionHandler)
+
+	// Notifications & scheduled event reminders
+	notificationRepository := notificationRepository.NewNotificationRepository(db)
+	notificationService := notificationService.NewNotificationService(notificationRepository)
+	notificationHandler := handlers.NewNotificationHandler(notificationService)
+	routes.RegisterNotificationRoute(e, notificationHandler)
+
+	reminderOffsets, err := reminderService.ParseOffsets(config.Reminder.Offsets)
+	if err != nil {
+		e.Logger.Fatal("Invalid REMINDER_OFFSETS: " + err.Error())
+	}
+	reminderInterval := reminderService.Interval
+	reminderRepository := reminderRepository.NewReminderRepository(db)
+	reminderService := reminderService.NewReminderService(reminderRepository, notificationRepository, mailProvider.NewSMTP(), reminderOffsets)
+	scheduler.Every("reminders", reminderInterval, func() error {
+		_, err := reminderService.Run(time.Now())
+		return err
+	})
+
+	// Host announcements
+	announcementInterval := announcementService.Interval
+	announcementRepository := announcementRepository.NewAnnouncementRepository(db)
+	announcementService := announcementService.NewAnnouncementService(announcementRepository, eventRepository, inviteRepository, mailProvider.NewSMTP())
+	announcementHandler := handlers.NewAnnouncementHandler(announcementService)
+	routes.RegisterAnnouncementRoute(e, announcementHandler)
+	scheduler.Every("announcements", announcementInterval, func() error {
+		_, err := announcementService.Deliver(time.Now())
+		return err
+	})
+	scheduler.Every("announcement-emails", announcementInterval, func() error {
+		_, err := announcementService.SendEmails(time.Now())
+		return err
+	})
+
+	// Groups hosting events on behalf of their community
+	groupRepository := groupRepository.NewGroupRepository(db)
+	groupService := groupService.NewGroupService(groupRepository, eventService, notificationRepository)
+	groupHandler := handlers.NewGroupHandler(groupService, s3, searchIndex)
+	routes.RegisterGroupRoute(e, groupHandler)
+
+	// Gallery
+	galleryRepository := galleryRepository.NewGalleryRepository(db)
+	galleryService := galleryService.NewGalleryService(galleryRepository, eventRepository, inviteRepository)
+	galleryHandler := handlers.NewGalleryHandler(galleryService, s3)
+	routes.RegisterGalleryRoute(e, galleryHandler)
+
+	// Template
+	templateService := templateService.NewTemplateService(templateRepository, eventRepository)
+	templateHandler := handlers.NewTemplateHandler(templateService)
+	routes.RegisterTemplateRoute(e, templateHandler)
+
+	// Tag
+	tagService := tagService.NewTagService(tagRepository)
+	tagHandler := handlers.NewTagHandler(tagService)
+	routes.RegisterTagRoute(e, tagHandler)
+
+	// Invite
+	inviteService := inviteService.NewInviteService(inviteRepository, eventRepository, userRepository)
+	inviteHandler := handlers.NewInviteHandler(inviteService)
+	routes.RegisterInviteRoute(e, inviteHandler)
+
+	// Co-host
+	cohostRepository := cohostRepository.NewCohostRepository(db)
+	cohostService := cohostService.NewCohostService(cohostRepository, eventRepository, userRepository)
+	cohostHandler := handlers.NewCohostHandler(cohostService, searchIndex)
+	routes.RegisterCohostRoute(e, cohostHandler)
+
+	// Ticketing
+	ticketRepository := ticketRepository.NewTicketRepository(db)
+	orderRepository := orderRepository.NewOrderRepository(db)
+	ticketService := ticketService.NewTicketService(ticketRepository, eventRepository)
+	orderService := orderService.NewOrderService(orderRepository, ticketRepository, eventRepository, userRepository, inviteRepository, questionRepository, participantRepository)
+	ticketHandler := handlers.NewTicketHandler(ticketService)
+	orderHandler := handlers.NewOrderHandler(orderService, payment)
+	routes.RegisterTicketRoute(e, ticketHandler)
+	routes.RegisterOrderRoute(e, orderHandler)
+	if config.Payment.FakeCheckout && config.Payment.Provider == "fake" {
+		routes.RegisterFakePaymentRoute(e, orderHandler)
+	}
+
 	// Authentication
 	authService := authService.NewAuthService(userRepository)
 	authHandler := handlers.NewAuthHandler(authService)
@@ -75,6 +259,24 @@ func main() {
 	commentHandler := handlers.NewCommentHandler(commentService)
 	routes.RegisterCommentRoute(e, commentHandler)
 
+	// Reviews & host reputation
+	reviewService := reviewService.NewReviewService(reviewRepository, eventRepository, inviteRepository)
+	reviewHandler := handlers.NewReviewHandler(reviewService)
+	routes.RegisterReviewRoute(e, reviewHandler)
+
+	// Agenda sessions & speakers
+	sessionRepository := sessionRepository.NewSessionRepository(db)
+	sessionService := sessionService.NewSessionService(sessionRepository, eventRepository, userRepository, inviteRepository)
+	sessionHandler := handlers.NewSessionHandler(sessionService)
+	routes.RegisterSessionRoute(e, sessionHandler)
+
+	// Reports & moderation
+	reportRepository := reportRepository.NewReportRepository(db)
+	auditRepository := auditRepository.NewAuditRepository(db)
+	moderationService := moderationService.NewModerationService(reportRepository, auditRepository, userRepository, eventRepository, commentRepository, notificationRepository, eventService, commentService, userService)
+	moderationHandler := handlers.NewModerationHandler(moderationService, s3, searchIndex)
+	routes.RegisterModerationRoute(e, moderationHandler)
+
 	// routes.RegisterParticipantRoute(e, participantHandler)
 
 	e.Logger.Fatal(e.Start(":" + config.App.Port))
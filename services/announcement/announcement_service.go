@@ -0,0 +1,224 @@
+package announcement
+
+import (
+	"strings"
+	"time"
+	"tupulung/deliveries/validations"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	announcementRepository "tupulung/repositories/announcement"
+	eventRepository "tupulung/repositories/event"
+	inviteRepository "tupulung/repositories/invite"
+	cohostService "tupulung/services/cohost"
+	participantService "tupulung/services/participant"
+	"tupulung/utilities/mail"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jinzhu/copier"
+	"github.com/labstack/gommon/log"
+)
+
+const (
+	// Interval pengecekan pengumuman yang belum dikirim
+	Interval = time.Minute
+	// Jumlah maksimal pengumuman yang dikirim dalam satu putaran
+	BatchSize = 50
+	// Jumlah maksimal email antrian yang dikirim dalam satu putaran
+	EmailBatchSize = 200
+	// Jumlah maksimal percobaan pengiriman satu email
+	MaxEmailAttempts = 3
+	// Jumlah maksimal pengumuman sebuah event dalam RateWindow
+	RateLimit = 5
+	// Rentang waktu pembatasan jumlah pengumuman
+	RateWindow = 24 * time.Hour
+)
+
+type AnnouncementService struct {
+	announcementRepo announcementRepository.AnnouncementRepositoryInterface
+	eventRepo        eventRepository.EventRepositoryInterface
+	inviteRepo       inviteRepository.InviteRepositoryInterface
+	mailer           mail.MailInterface
+	validate         *validator.Validate
+}
+
+func NewAnnouncementService(
+	announcementRepo announcementRepository.AnnouncementRepositoryInterface,
+	eventRepo eventRepository.EventRepositoryInterface,
+	inviteRepo inviteRepository.InviteRepositoryInterface,
+	mailer mail.MailInterface,
+) *AnnouncementService {
+	return &AnnouncementService{
+		announcementRepo: announcementRepo,
+		eventRepo:        eventRepo,
+		inviteRepo:       inviteRepo,
+		mailer:           mailer,
+		validate:         validator.New(),
+	}
+}
+
+/*
+ * Find All
+ * -------------------------------
+ * Mengambil pengumuman event dari yang terbaru,
+ * aturan aksesnya sama dengan detail event
+ */
+func (service AnnouncementService) FindAll(eventID int, viewerID int, inviteCode string, limit, page int) ([]entities.AnnouncementResponse, web.Pagination, error) {
+	event, err := service.eventRepo.Find(eventID)
+	if err != nil {
+		return []entities.AnnouncementResponse{}, web.Pagination{}, web.WebError{Code: 400, Message: "Event is not exist"}
+	}
+	if !participantService.CanView(service.inviteRepo, event, viewerID, inviteCode) {
+		return []entities.AnnouncementResponse{}, web.Pagination{}, web.WebError{Code: 400, Message: "cannot get event data with specified id"}
+	}
+	if limit <= 0 {
+		limit = 20
+	}
+	if page <= 0 {
+		page = 1
+	}
+
+	announcements, err := service.announcementRepo.FindByEvent(eventID, limit, (page-1)*limit)
+	if err != nil {
+		return []entities.AnnouncementResponse{}, web.Pagination{}, err
+	}
+	count, err := service.announcementRepo.CountByEvent(eventID)
+	if err != nil {
+		return []entities.AnnouncementResponse{}, web.Pagination{}, err
+	}
+	totalPages := int(count) / limit
+	if int(count)%limit > 0 || totalPages == 0 {
+		totalPages++
+	}
+
+	announcementsRes := []entities.AnnouncementResponse{}
+	copier.Copy(&announcementsRes, &announcements)
+	return announcementsRes, web.Pagination{Page: page, Limit: limit, TotalPages: totalPages}, nil
+}
+
+/*
+ * Create
+ * -------------------------------
+ * Menyimpan pengumuman host, pengumuman dikirim ke participant
+ * oleh Deliver di background. Jumlah pengumuman per event
+ * dibatasi RateLimit dalam RateWindow agar tidak menjadi spam
+ */
+func (service AnnouncementService) Create(announcementRequest entities.AnnouncementRequest, eventID int, userID int) (entities.AnnouncementResponse, error) {
+	err := validations.ValidateAnnouncementRequest(service.validate, announcementRequest)
+	if err != nil {
+		return entities.AnnouncementResponse{}, err
+	}
+	event, err := service.eventRepo.Find(eventID)
+	if err != nil {
+		return entities.AnnouncementResponse{}, web.WebError{Code: 400, Message: "Event is not exist"}
+	}
+	if !cohostService.Can(event, userID, cohostService.PermissionUpdate) {
+		return entities.AnnouncementResponse{}, web.WebError{Code: 401, Message: "Only the host can post announcements"}
+	}
+	announcement, err := service.announcementRepo.StoreLimited(entities.Announcement{
+		EventID: event.ID,
+		UserID:  uint(userID),
+		Title:   strings.TrimSpace(announcementRequest.Title),
+		Body:    strings.TrimSpace(announcementRequest.Body),
+	}, time.Now().Add(-RateWindow), RateLimit)
+	if err != nil {
+		return entities.AnnouncementResponse{}, err
+	}
+	announcementRes := entities.AnnouncementResponse{}
+	copier.Copy(&announcementRes, &announcement)
+	return announcementRes, nil
+}
+
+/*
+ * Deliver
+ * -------------------------------
+ * Mengirim pengumuman yang belum terkirim ke participant melalui
+ * notifikasi in-app. Notifikasi dan antrian email disimpan bersama
+ * tanda terkirim dalam satu transaksi agar tidak terkirim dua kali
+ * meskipun berjalan di beberapa instance, email dikirim oleh SendEmails
+ *
+ * @return 	int		jumlah pengumuman yang dikirim
+ */
+func (service AnnouncementService) Deliver(now time.Time) (int, error) {
+	announcements, err := service.announcementRepo.FindPending(BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	sent := 0
+	for _, announcement := range announcements {
+		ok, err := service.deliver(announcement, now)
+		if err != nil {
+			return sent, err
+		}
+		if ok {
+			sent++
+		}
+	}
+	return sent, nil
+}
+
+func (service AnnouncementService) deliver(announcement entities.Announcement, now time.Time) (bool, error) {
+	recipients, err := service.announcementRepo.Recipients(announcement.EventID, announcement.UserID)
+	if err != nil {
+		return false, err
+	}
+
+	eventID := announcement.EventID
+	title := announcement.Event.Title + ": " + announcement.Title
+	notifications := []entities.Notification{}
+	emails := []entities.AnnouncementEmail{}
+	for _, recipient := range recipients {
+		notifications = append(notifications, entities.Notification{
+			UserID:  recipient.ID,
+			EventID: &eventID,
+			Kind:    "announcement",
+			Title:   title,
+			Body:    announcement.Body,
+		})
+		if recipient.Email == "" {
+			continue
+		}
+		emails = append(emails, entities.AnnouncementEmail{
+			AnnouncementID: announcement.ID,
+			UserID:         recipient.ID,
+			Email:          recipient.Email,
+			Subject:        title,
+			Body:           "Hi " + recipient.Name + ",\n\n" + announcement.Body + "\n",
+		})
+	}
+	return service.announcementRepo.Deliver(announcement.ID, now, notifications, emails)
+}
+
+/*
+ * Send Emails
+ * -------------------------------
+ * Mengirim antrian email pengumuman. Email diklaim dulu agar tidak
+ * terkirim dua kali, email yang gagal dicoba lagi pada putaran
+ * berikutnya sampai MaxEmailAttempts
+ *
+ * @return 	int		jumlah email yang dikirim
+ */
+func (service AnnouncementService) SendEmails(now time.Time) (int, error) {
+	emails, err := service.announcementRepo.FindPendingEmails(MaxEmailAttempts, EmailBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	sent := 0
+	for _, email := range emails {
+		claimed, err := service.announcementRepo.ClaimEmail(email.ID, now)
+		if err != nil {
+			return sent, err
+		}
+		if !claimed {
+			continue
+		}
+		if err := service.mailer.Send(email.Email, email.Subject, email.Body); err != nil {
+			log.Warn("Cannot send announcement email: " + err.Error())
+			if err := service.announcementRepo.ReleaseEmail(email.ID); err != nil {
+				return sent, err
+			}
+			continue
+		}
+		sent++
+	}
+	return sent, nil
+}
@@ -0,0 +1,14 @@
+package announcement
+
+import (
+	"time"
+	"tupulung/entities"
+	"tupulung/entities/web"
+)
+
+type AnnouncementServiceInterface interface {
+	FindAll(eventID int, viewerID int, inviteCode string, limit, page int) ([]entities.AnnouncementResponse, web.Pagination, error)
+	Create(announcementRequest entities.AnnouncementRequest, eventID int, userID int) (entities.AnnouncementResponse, error)
+	Deliver(now time.Time) (int, error)
+	SendEmails(now time.Time) (int, error)
+}
@@ -0,0 +1,199 @@
+package announcement_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	announcementRepository "tupulung/repositories/announcement"
+	eventRepository "tupulung/repositories/event"
+	inviteRepository "tupulung/repositories/invite"
+	userRepository "tupulung/repositories/user"
+	announcementService "tupulung/services/announcement"
+	"tupulung/utilities/mail"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFindAll(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventRepository.EventCollection[0], nil)
+		announcementRepositoryMock := announcementRepository.NewAnnouncementRepositoryMock(&mock.Mock{})
+		announcementRepositoryMock.Mock.On("FindByEvent").Return(announcementRepository.AnnouncementCollection, nil)
+		announcementRepositoryMock.Mock.On("CountByEvent").Return(int64(1), nil)
+		mailMock := mail.NewMailMock(&mock.Mock{})
+
+		service := announcementService.NewAnnouncementService(announcementRepositoryMock, eventRepositoryMock, inviteRepository.NewInviteRepositoryMock(&mock.Mock{}), mailMock)
+		data, pagination, err := service.FindAll(1, 0, "", 20, 1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(data))
+		assert.Equal(t, "Venue changed", data[0].Title)
+		assert.Equal(t, web.Pagination{Page: 1, Limit: 20, TotalPages: 1}, pagination)
+	})
+	t.Run("private-event", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventSample.Visibility = "private"
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		announcementRepositoryMock := announcementRepository.NewAnnouncementRepositoryMock(&mock.Mock{})
+		mailMock := mail.NewMailMock(&mock.Mock{})
+
+		inviteRepositoryMock := inviteRepository.NewInviteRepositoryMock(&mock.Mock{})
+		inviteRepositoryMock.Mock.On("FindUsableByUser").Return(entities.EventInvite{}, web.WebError{Code: 400})
+
+		service := announcementService.NewAnnouncementService(announcementRepositoryMock, eventRepositoryMock, inviteRepositoryMock, mailMock)
+		_, _, err := service.FindAll(1, 5, "", 20, 1)
+
+		assert.Equal(t, web.WebError{Code: 400, Message: "cannot get event data with specified id"}, err)
+		announcementRepositoryMock.Mock.AssertNotCalled(t, "FindByEvent")
+	})
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		announcementRepositoryMock := announcementRepository.NewAnnouncementRepositoryMock(&mock.Mock{})
+		announcementRepositoryMock.Mock.On("StoreLimited").Return(announcementRepository.AnnouncementCollection[0], nil)
+		mailMock := mail.NewMailMock(&mock.Mock{})
+
+		service := announcementService.NewAnnouncementService(announcementRepositoryMock, eventRepositoryMock, inviteRepository.NewInviteRepositoryMock(&mock.Mock{}), mailMock)
+		data, err := service.Create(entities.AnnouncementRequest{
+			Title: "Venue changed",
+			Body:  "We moved to the second floor of the building",
+		}, int(eventSample.ID), int(eventSample.UserID))
+
+		assert.Nil(t, err)
+		assert.Equal(t, "Venue changed", data.Title)
+		assert.Nil(t, data.DeliveredAt)
+		announcementRepositoryMock.Mock.AssertNotCalled(t, "Deliver", mock.Anything, mock.Anything)
+	})
+	t.Run("not-host", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		announcementRepositoryMock := announcementRepository.NewAnnouncementRepositoryMock(&mock.Mock{})
+		mailMock := mail.NewMailMock(&mock.Mock{})
+
+		service := announcementService.NewAnnouncementService(announcementRepositoryMock, eventRepositoryMock, inviteRepository.NewInviteRepositoryMock(&mock.Mock{}), mailMock)
+		_, err := service.Create(entities.AnnouncementRequest{Title: "Hi", Body: "Hello"}, int(eventSample.ID), int(eventSample.UserID)+1)
+
+		assert.Equal(t, web.WebError{Code: 401, Message: "Only the host can post announcements"}, err)
+		announcementRepositoryMock.Mock.AssertNotCalled(t, "StoreLimited")
+	})
+	t.Run("rate-limited", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		announcementRepositoryMock := announcementRepository.NewAnnouncementRepositoryMock(&mock.Mock{})
+		announcementRepositoryMock.Mock.On("StoreLimited").Return(entities.Announcement{}, web.WebError{Code: 429, Message: "Too many announcements, please try again later"})
+		mailMock := mail.NewMailMock(&mock.Mock{})
+
+		service := announcementService.NewAnnouncementService(announcementRepositoryMock, eventRepositoryMock, inviteRepository.NewInviteRepositoryMock(&mock.Mock{}), mailMock)
+		_, err := service.Create(entities.AnnouncementRequest{Title: "Hi", Body: "Hello"}, int(eventSample.ID), int(eventSample.UserID))
+
+		assert.Equal(t, web.WebError{Code: 429, Message: "Too many announcements, please try again later"}, err)
+		announcementRepositoryMock.Mock.AssertCalled(t, "StoreLimited")
+	})
+	t.Run("validation-error", func(t *testing.T) {
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		announcementRepositoryMock := announcementRepository.NewAnnouncementRepositoryMock(&mock.Mock{})
+		mailMock := mail.NewMailMock(&mock.Mock{})
+
+		service := announcementService.NewAnnouncementService(announcementRepositoryMock, eventRepositoryMock, inviteRepository.NewInviteRepositoryMock(&mock.Mock{}), mailMock)
+		_, err := service.Create(entities.AnnouncementRequest{Title: "Hi"}, 1, 1)
+
+		assert.Equal(t, web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors:  []web.ValidationErrorItem{{Field: "body", Error: "body field must be filled"}},
+		}, err)
+	})
+}
+
+func TestDeliver(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		announcementRepositoryMock := announcementRepository.NewAnnouncementRepositoryMock(&mock.Mock{})
+		announcementRepositoryMock.Mock.On("FindPending").Return(announcementRepository.AnnouncementCollection, nil)
+		announcementRepositoryMock.Mock.On("Recipients").Return(userRepository.UserCollection, nil)
+		announcementRepositoryMock.Mock.On("Deliver", 2, 2).Return(true, nil)
+		mailMock := mail.NewMailMock(&mock.Mock{})
+
+		service := announcementService.NewAnnouncementService(announcementRepositoryMock, eventRepositoryMock, inviteRepository.NewInviteRepositoryMock(&mock.Mock{}), mailMock)
+		sent, err := service.Deliver(time.Now())
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, sent)
+		announcementRepositoryMock.Mock.AssertCalled(t, "Deliver", 2, 2)
+		mailMock.Mock.AssertNotCalled(t, "Send", mock.Anything)
+	})
+	t.Run("already-delivered", func(t *testing.T) {
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		announcementRepositoryMock := announcementRepository.NewAnnouncementRepositoryMock(&mock.Mock{})
+		announcementRepositoryMock.Mock.On("FindPending").Return(announcementRepository.AnnouncementCollection, nil)
+		announcementRepositoryMock.Mock.On("Recipients").Return(userRepository.UserCollection, nil)
+		announcementRepositoryMock.Mock.On("Deliver", 2, 2).Return(false, nil)
+		mailMock := mail.NewMailMock(&mock.Mock{})
+
+		service := announcementService.NewAnnouncementService(announcementRepositoryMock, eventRepositoryMock, inviteRepository.NewInviteRepositoryMock(&mock.Mock{}), mailMock)
+		sent, err := service.Deliver(time.Now())
+
+		assert.Nil(t, err)
+		assert.Equal(t, 0, sent)
+	})
+	t.Run("deliver-fail", func(t *testing.T) {
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		announcementRepositoryMock := announcementRepository.NewAnnouncementRepositoryMock(&mock.Mock{})
+		announcementRepositoryMock.Mock.On("FindPending").Return(announcementRepository.AnnouncementCollection, nil)
+		announcementRepositoryMock.Mock.On("Recipients").Return(userRepository.UserCollection, nil)
+		announcementRepositoryMock.Mock.On("Deliver", 2, 2).Return(false, web.WebError{Code: 500, Message: "server error"})
+		mailMock := mail.NewMailMock(&mock.Mock{})
+
+		service := announcementService.NewAnnouncementService(announcementRepositoryMock, eventRepositoryMock, inviteRepository.NewInviteRepositoryMock(&mock.Mock{}), mailMock)
+		sent, err := service.Deliver(time.Now())
+
+		assert.Equal(t, web.WebError{Code: 500, Message: "server error"}, err)
+		assert.Equal(t, 0, sent)
+	})
+}
+
+func TestSendEmails(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		announcementRepositoryMock := announcementRepository.NewAnnouncementRepositoryMock(&mock.Mock{})
+		announcementRepositoryMock.Mock.On("FindPendingEmails").Return(announcementRepository.AnnouncementEmailCollection, nil)
+		announcementRepositoryMock.Mock.On("ClaimEmail", uint(1)).Return(true, nil)
+		announcementRepositoryMock.Mock.On("ClaimEmail", uint(2)).Return(true, nil)
+		announcementRepositoryMock.Mock.On("ReleaseEmail", uint(2)).Return(nil)
+		mailMock := mail.NewMailMock(&mock.Mock{})
+		mailMock.Mock.On("Send", "test1@mail.com").Return(nil)
+		mailMock.Mock.On("Send", "test2@mail.com").Return(errors.New("smtp down"))
+
+		service := announcementService.NewAnnouncementService(announcementRepositoryMock, eventRepository.NewEventRepositoryMock(&mock.Mock{}), inviteRepository.NewInviteRepositoryMock(&mock.Mock{}), mailMock)
+		sent, err := service.SendEmails(time.Now())
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, sent)
+		mailMock.Mock.AssertNumberOfCalls(t, "Send", 2)
+		announcementRepositoryMock.Mock.AssertCalled(t, "ReleaseEmail", uint(2))
+		announcementRepositoryMock.Mock.AssertNotCalled(t, "ReleaseEmail", uint(1))
+	})
+	t.Run("already-claimed", func(t *testing.T) {
+		announcementRepositoryMock := announcementRepository.NewAnnouncementRepositoryMock(&mock.Mock{})
+		announcementRepositoryMock.Mock.On("FindPendingEmails").Return(announcementRepository.AnnouncementEmailCollection, nil)
+		announcementRepositoryMock.Mock.On("ClaimEmail", mock.Anything).Return(false, nil)
+		mailMock := mail.NewMailMock(&mock.Mock{})
+
+		service := announcementService.NewAnnouncementService(announcementRepositoryMock, eventRepository.NewEventRepositoryMock(&mock.Mock{}), inviteRepository.NewInviteRepositoryMock(&mock.Mock{}), mailMock)
+		sent, err := service.SendEmails(time.Now())
+
+		assert.Nil(t, err)
+		assert.Equal(t, 0, sent)
+		mailMock.Mock.AssertNotCalled(t, "Send", mock.Anything)
+	})
+}
@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"time"
 	"tupulung/deliveries/middleware"
 	userRepository "tupulung/repositories/user"
 
@@ -41,8 +42,13 @@ func (service AuthService) Login(authReq entities.AuthRequest) (entities.AuthRes
 		return entities.AuthResponse{}, web.WebError{Code: 401, Message: "Invalid password"}
 	}
 
+	// User yang sedang ditangguhkan moderator tidak bisa login
+	if user.SuspendedUntil != nil && user.SuspendedUntil.After(time.Now()) {
+		return entities.AuthResponse{}, web.WebError{Code: 403, Message: "Account is suspended until " + user.SuspendedUntil.Format("2006-01-02 15:04")}
+	}
+
 	// Konversi menjadi user response
-	userRes := entities.UserResponse{}
+	userRes := entities.UserProfileResponse{}
 	copier.Copy(&userRes, &user)
 
 	// Create token
@@ -67,7 +73,7 @@ func (service AuthService) Me(ID int, token interface{}) (interface{}, error) {
 	userJWT := token.(*jwt.Token)
 	// Get userdata via repository
 	user, err := service.userRepo.Find(ID)
-	userRes := entities.UserResponse{}
+	userRes := entities.UserProfileResponse{}
 	copier.Copy(&userRes, &user)
 
 	// Bentuk auth response
@@ -2,6 +2,7 @@ package auth_test
 
 import (
 	"testing"
+	"time"
 	"tupulung/entities"
 	web "tupulung/entities/web"
 	_userRepository "tupulung/repositories/user"
@@ -56,6 +57,23 @@ func TestLogin(t *testing.T) {
 		assert.Nil(t, err)
 		assert.NotEqual(t, "", actual.Token)
 	})
+	t.Run("suspended", func(t *testing.T) {
+		userSample := _userRepository.UserCollection[0]
+		userSample.Password = "$2a$12$iu2L7bKpW4Rpe5yPGt3KPOm5N229fSuMlkHYu5l25dIwgvvW6oQYO" // pass: password
+		suspendedUntil := time.Now().Add(24 * time.Hour)
+		userSample.SuspendedUntil = &suspendedUntil
+		userRepositoryMock := _userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("FindBy").Return(userSample, nil)
+
+		authService := _authService.NewAuthService(userRepositoryMock)
+		actual, err := authService.Login(entities.AuthRequest{
+			Email:    userSample.Email,
+			Password: "password",
+		})
+
+		assert.Equal(t, 403, err.(web.WebError).Code)
+		assert.Equal(t, "", actual.Token)
+	})
 }
 
 func TestMe(t *testing.T) {
@@ -0,0 +1,71 @@
+package cohost
+
+import "tupulung/entities"
+
+const (
+	PermissionUpdate   = "update"
+	PermissionModerate = "moderate"
+	PermissionDelete   = "delete"
+	PermissionTransfer = "transfer"
+	PermissionTeam     = "team"
+)
+
+/*
+ * Role Permissions
+ * -------------------------------
+ * Daftar aksi yang boleh dilakukan setiap role co-host
+ * [role]: [permission...]
+ */
+var rolePermissions = map[string][]string{
+	"owner":     {PermissionUpdate, PermissionModerate, PermissionDelete, PermissionTransfer, PermissionTeam},
+	"editor":    {PermissionUpdate},
+	"moderator": {PermissionModerate},
+}
+
+/*
+ * Can
+ * -------------------------------
+ * Mengecek apakah user boleh melakukan aksi pada event.
+ * Pembuat event selalu boleh, co-host hanya jika sudah accepted
+ * dan role-nya memiliki permission tersebut.
+ * Event harus di-load beserta Cohosts yang accepted
+ */
+func Can(event entities.Event, userID int, permission string) bool {
+	if userID == 0 {
+		return false
+	}
+	if int(event.UserID) == userID {
+		return true
+	}
+	for _, cohost := range event.Cohosts {
+		if int(cohost.UserID) != userID || cohost.Status != "accepted" {
+			continue
+		}
+		for _, allowed := range rolePermissions[cohost.Role] {
+			if allowed == permission {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+/*
+ * Is Team Member
+ * -------------------------------
+ * Pembuat event dan semua co-host yang sudah accepted
+ */
+func IsTeamMember(event entities.Event, userID int) bool {
+	if userID == 0 {
+		return false
+	}
+	if int(event.UserID) == userID {
+		return true
+	}
+	for _, cohost := range event.Cohosts {
+		if int(cohost.UserID) == userID && cohost.Status == "accepted" {
+			return true
+		}
+	}
+	return false
+}
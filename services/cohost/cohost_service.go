@@ -0,0 +1,247 @@
+package cohost
+
+import (
+	"strconv"
+	"tupulung/deliveries/validations"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	cohostRepository "tupulung/repositories/cohost"
+	eventRepository "tupulung/repositories/event"
+	userRepository "tupulung/repositories/user"
+	searchProvider "tupulung/utilities/search"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jinzhu/copier"
+	"github.com/labstack/gommon/log"
+)
+
+type CohostService struct {
+	cohostRepo cohostRepository.CohostRepositoryInterface
+	eventRepo  eventRepository.EventRepositoryInterface
+	userRepo   userRepository.UserRepositoryInterface
+	validate   *validator.Validate
+}
+
+func NewCohostService(cohostRepo cohostRepository.CohostRepositoryInterface, eventRepo eventRepository.EventRepositoryInterface, userRepo userRepository.UserRepositoryInterface) *CohostService {
+	return &CohostService{
+		cohostRepo: cohostRepo,
+		eventRepo:  eventRepo,
+		userRepo:   userRepo,
+		validate:   validator.New(),
+	}
+}
+
+/*
+ * Find All
+ * -------------------------------
+ * Mengambil tim co-host event. Undangan yang masih pending
+ * hanya terlihat oleh anggota tim
+ */
+func (service CohostService) FindAll(eventID int, userID int) ([]entities.EventCohostResponse, error) {
+	event, err := service.eventRepo.Find(eventID)
+	if err != nil {
+		return []entities.EventCohostResponse{}, web.WebError{Code: 400, Message: "Event is not exist"}
+	}
+	cohosts, err := service.cohostRepo.FindByEvent(eventID)
+	if err != nil {
+		return []entities.EventCohostResponse{}, err
+	}
+
+	isTeamMember := IsTeamMember(event, userID)
+	cohostsRes := []entities.EventCohostResponse{}
+	for _, cohost := range cohosts {
+		if cohost.Status != "accepted" && !isTeamMember {
+			continue
+		}
+		cohostRes := entities.EventCohostResponse{}
+		copier.Copy(&cohostRes, &cohost)
+		cohostsRes = append(cohostsRes, cohostRes)
+	}
+	return cohostsRes, nil
+}
+
+/*
+ * Find Invitations
+ * -------------------------------
+ * Mengambil undangan co-host milik user yang belum diterima
+ */
+func (service CohostService) FindInvitations(userID int) ([]entities.EventCohostResponse, error) {
+	cohosts, err := service.cohostRepo.FindPendingByUser(userID)
+	if err != nil {
+		return []entities.EventCohostResponse{}, err
+	}
+	cohostsRes := []entities.EventCohostResponse{}
+	copier.Copy(&cohostsRes, &cohosts)
+	return cohostsRes, nil
+}
+
+/*
+ * Invite
+ * -------------------------------
+ * Mengundang user menjadi co-host event, hanya owner yang dapat mengundang.
+ * Undangan berstatus pending sampai diterima oleh user tersebut
+ */
+func (service CohostService) Invite(cohostRequest entities.EventCohostRequest, eventID int, userID int) (entities.EventCohostResponse, error) {
+	// Validation
+	err := validations.ValidateCohostRequest(service.validate, cohostRequest)
+	if err != nil {
+		return entities.EventCohostResponse{}, err
+	}
+
+	event, err := service.eventRepo.Find(eventID)
+	if err != nil {
+		return entities.EventCohostResponse{}, web.WebError{Code: 400, Message: "Event is not exist"}
+	}
+	if !Can(event, userID, PermissionTeam) {
+		return entities.EventCohostResponse{}, web.WebError{Code: 401, Message: "Only event owners can manage co-hosts"}
+	}
+
+	invitee, err := service.userRepo.Find(int(cohostRequest.UserID))
+	if err != nil {
+		return entities.EventCohostResponse{}, web.WebError{Code: 400, Message: "Invited user is not exist"}
+	}
+	if invitee.ID == event.UserID {
+		return entities.EventCohostResponse{}, web.WebError{Code: 400, Message: "User is already the host of this event"}
+	}
+	if _, err := service.cohostRepo.FindByEventAndUser(eventID, int(invitee.ID)); err == nil {
+		return entities.EventCohostResponse{}, web.WebError{Code: 400, Message: "User is already a co-host of this event"}
+	}
+
+	// Repository action
+	cohost, err := service.cohostRepo.Store(entities.EventCohost{
+		EventID:   event.ID,
+		UserID:    invitee.ID,
+		Role:      cohostRequest.Role,
+		Status:    "pending",
+		InvitedBy: uint(userID),
+	})
+	if err != nil {
+		return entities.EventCohostResponse{}, err
+	}
+	cohost.User = invitee
+
+	cohostRes := entities.EventCohostResponse{}
+	copier.Copy(&cohostRes, &cohost)
+	return cohostRes, nil
+}
+
+/*
+ * Accept
+ * -------------------------------
+ * Menerima undangan co-host, hanya user yang diundang yang dapat menerima
+ */
+func (service CohostService) Accept(id int, userID int) (entities.EventCohostResponse, error) {
+	cohost, err := service.cohostRepo.Find(id)
+	if err != nil {
+		return entities.EventCohostResponse{}, web.WebError{Code: 400, Message: "The requested ID doesn't match with any record"}
+	}
+	if int(cohost.UserID) != userID {
+		return entities.EventCohostResponse{}, web.WebError{Code: 401, Message: "Cannot accept invitation that belongs to someone else"}
+	}
+	if cohost.Status == "accepted" {
+		return entities.EventCohostResponse{}, web.WebError{Code: 400, Message: "Invitation is already accepted"}
+	}
+
+	cohost.Status = "accepted"
+	cohost, err = service.cohostRepo.Update(cohost, id)
+	if err != nil {
+		return entities.EventCohostResponse{}, err
+	}
+
+	cohostRes := entities.EventCohostResponse{}
+	copier.Copy(&cohostRes, &cohost)
+	return cohostRes, nil
+}
+
+/*
+ * Update Role
+ * -------------------------------
+ * Mengubah role co-host, hanya owner yang dapat mengubah
+ */
+func (service CohostService) UpdateRole(role string, id int, userID int) (entities.EventCohostResponse, error) {
+	cohost, err := service.cohostRepo.Find(id)
+	if err != nil {
+		return entities.EventCohostResponse{}, web.WebError{Code: 400, Message: "The requested ID doesn't match with any record"}
+	}
+
+	// Validation
+	err = validations.ValidateCohostRequest(service.validate, entities.EventCohostRequest{UserID: cohost.UserID, Role: role})
+	if err != nil {
+		return entities.EventCohostResponse{}, err
+	}
+	if !Can(cohost.Event, userID, PermissionTeam) {
+		return entities.EventCohostResponse{}, web.WebError{Code: 401, Message: "Only event owners can manage co-hosts"}
+	}
+
+	cohost.Role = role
+	cohost, err = service.cohostRepo.Update(cohost, id)
+	if err != nil {
+		return entities.EventCohostResponse{}, err
+	}
+
+	cohostRes := entities.EventCohostResponse{}
+	copier.Copy(&cohostRes, &cohost)
+	return cohostRes, nil
+}
+
+/*
+ * Remove
+ * -------------------------------
+ * Menghapus co-host dari event. Owner dapat menghapus siapa saja,
+ * user yang diundang dapat menolak undangan atau keluar dari tim
+ */
+func (service CohostService) Remove(id int, userID int) error {
+	cohost, err := service.cohostRepo.Find(id)
+	if err != nil {
+		return web.WebError{Code: 400, Message: "The requested ID doesn't match with any record"}
+	}
+	if int(cohost.UserID) != userID && !Can(cohost.Event, userID, PermissionTeam) {
+		return web.WebError{Code: 401, Message: "Only event owners can manage co-hosts"}
+	}
+	return service.cohostRepo.Delete(id)
+}
+
+/*
+ * Transfer
+ * -------------------------------
+ * Memindahkan kepemilikan event ke co-host yang sudah accepted,
+ * pemilik lama tetap berada di tim sebagai owner
+ */
+func (service CohostService) Transfer(transferRequest entities.EventTransferRequest, eventID int, userID int, searchProvider searchProvider.SearchInterface) error {
+	// Validation
+	err := validations.ValidateCohostRequest(service.validate, transferRequest)
+	if err != nil {
+		return err
+	}
+
+	event, err := service.eventRepo.Find(eventID)
+	if err != nil {
+		return web.WebError{Code: 400, Message: "Event is not exist"}
+	}
+	if !Can(event, userID, PermissionTransfer) {
+		return web.WebError{Code: 401, Message: "Only event owners can transfer this event"}
+	}
+	if transferRequest.UserID == event.UserID {
+		return web.WebError{Code: 400, Message: "User is already the host of this event"}
+	}
+	cohost, err := service.cohostRepo.FindByEventAndUser(eventID, int(transferRequest.UserID))
+	if err != nil || cohost.Status != "accepted" {
+		return web.WebError{Code: 400, Message: "Event can only be transferred to an accepted co-host"}
+	}
+
+	// Repository action
+	err = service.cohostRepo.Transfer(eventID, int(event.UserID), int(transferRequest.UserID))
+	if err != nil {
+		return err
+	}
+
+	// Host is part of the search index
+	event, err = service.eventRepo.Find(eventID)
+	if err == nil {
+		err = searchProvider.IndexEvent(event)
+	}
+	if err != nil {
+		log.Warn("Cannot index event " + strconv.Itoa(eventID) + ": " + err.Error())
+	}
+	return nil
+}
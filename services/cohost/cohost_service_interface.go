@@ -0,0 +1,16 @@
+package cohost
+
+import (
+	"tupulung/entities"
+	searchProvider "tupulung/utilities/search"
+)
+
+type CohostServiceInterface interface {
+	FindAll(eventID int, userID int) ([]entities.EventCohostResponse, error)
+	FindInvitations(userID int) ([]entities.EventCohostResponse, error)
+	Invite(cohostRequest entities.EventCohostRequest, eventID int, userID int) (entities.EventCohostResponse, error)
+	Accept(id int, userID int) (entities.EventCohostResponse, error)
+	UpdateRole(role string, id int, userID int) (entities.EventCohostResponse, error)
+	Remove(id int, userID int) error
+	Transfer(transferRequest entities.EventTransferRequest, eventID int, userID int, searchProvider searchProvider.SearchInterface) error
+}
@@ -0,0 +1,219 @@
+package cohost_test
+
+import (
+	"testing"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	cohostRepository "tupulung/repositories/cohost"
+	eventRepository "tupulung/repositories/event"
+	userRepository "tupulung/repositories/user"
+	cohostService "tupulung/services/cohost"
+	_searchProvider "tupulung/utilities/search"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCan(t *testing.T) {
+	eventSample := eventRepository.EventCollection[0]
+	eventSample.Cohosts = []entities.EventCohost{
+		{EventID: 1, UserID: 2, Role: "editor", Status: "accepted"},
+		{EventID: 1, UserID: 3, Role: "owner", Status: "pending"},
+		{EventID: 1, UserID: 4, Role: "moderator", Status: "accepted"},
+	}
+
+	assert.True(t, cohostService.Can(eventSample, int(eventSample.UserID), cohostService.PermissionDelete))
+	assert.True(t, cohostService.Can(eventSample, 2, cohostService.PermissionUpdate))
+	assert.False(t, cohostService.Can(eventSample, 2, cohostService.PermissionDelete))
+	assert.False(t, cohostService.Can(eventSample, 3, cohostService.PermissionUpdate))
+	assert.True(t, cohostService.Can(eventSample, 4, cohostService.PermissionModerate))
+	assert.False(t, cohostService.Can(eventSample, 4, cohostService.PermissionUpdate))
+	assert.False(t, cohostService.Can(eventSample, 0, cohostService.PermissionUpdate))
+}
+
+func TestFindAll(t *testing.T) {
+	t.Run("public-view", func(t *testing.T) {
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventRepository.EventCollection[0], nil)
+		cohostRepositoryMock := cohostRepository.NewCohostRepositoryMock(&mock.Mock{})
+		cohostRepositoryMock.Mock.On("FindByEvent").Return([]entities.EventCohost{
+			{EventID: 1, UserID: 2, Role: "editor", Status: "accepted"},
+			{EventID: 1, UserID: 3, Role: "owner", Status: "pending"},
+		}, nil)
+
+		service := cohostService.NewCohostService(cohostRepositoryMock, eventRepositoryMock, userRepository.NewUserRepositoryMock(&mock.Mock{}))
+		data, err := service.FindAll(1, 0)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(data))
+	})
+	t.Run("team-view", func(t *testing.T) {
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventRepository.EventCollection[0], nil)
+		cohostRepositoryMock := cohostRepository.NewCohostRepositoryMock(&mock.Mock{})
+		cohostRepositoryMock.Mock.On("FindByEvent").Return([]entities.EventCohost{
+			{EventID: 1, UserID: 2, Role: "editor", Status: "accepted"},
+			{EventID: 1, UserID: 3, Role: "owner", Status: "pending"},
+		}, nil)
+
+		service := cohostService.NewCohostService(cohostRepositoryMock, eventRepositoryMock, userRepository.NewUserRepositoryMock(&mock.Mock{}))
+		data, err := service.FindAll(1, int(eventRepository.EventCollection[0].UserID))
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(data))
+	})
+}
+
+func TestInvite(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventRepository.EventCollection[0], nil)
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(userRepository.UserCollection[1], nil)
+		cohostRepositoryMock := cohostRepository.NewCohostRepositoryMock(&mock.Mock{})
+		cohostRepositoryMock.Mock.On("FindByEventAndUser").Return(entities.EventCohost{}, web.WebError{Code: 400})
+		cohostRepositoryMock.Mock.On("Store").Return(cohostRepository.CohostCollection[0], nil)
+
+		service := cohostService.NewCohostService(cohostRepositoryMock, eventRepositoryMock, userRepositoryMock)
+		data, err := service.Invite(entities.EventCohostRequest{UserID: 2, Role: "editor"}, 1, 1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, cohostRepository.CohostCollection[0].ID, data.ID)
+		assert.Equal(t, userRepository.UserCollection[1].ID, data.User.ID)
+	})
+	t.Run("validation-fail", func(t *testing.T) {
+		cohostRepositoryMock := cohostRepository.NewCohostRepositoryMock(&mock.Mock{})
+
+		service := cohostService.NewCohostService(cohostRepositoryMock, eventRepository.NewEventRepositoryMock(&mock.Mock{}), userRepository.NewUserRepositoryMock(&mock.Mock{}))
+		_, err := service.Invite(entities.EventCohostRequest{UserID: 2, Role: "admin"}, 1, 1)
+
+		assert.Equal(t, 1, len(err.(web.ValidationError).Errors))
+		cohostRepositoryMock.Mock.AssertNotCalled(t, "Store")
+	})
+	t.Run("editor-cannot-invite", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventSample.Cohosts = []entities.EventCohost{cohostRepository.CohostCollection[0]}
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		cohostRepositoryMock := cohostRepository.NewCohostRepositoryMock(&mock.Mock{})
+
+		service := cohostService.NewCohostService(cohostRepositoryMock, eventRepositoryMock, userRepository.NewUserRepositoryMock(&mock.Mock{}))
+		_, err := service.Invite(entities.EventCohostRequest{UserID: 3, Role: "editor"}, 1, int(cohostRepository.CohostCollection[0].UserID))
+
+		assert.Error(t, err)
+		cohostRepositoryMock.Mock.AssertNotCalled(t, "Store")
+	})
+	t.Run("already-cohost", func(t *testing.T) {
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventRepository.EventCollection[0], nil)
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(userRepository.UserCollection[1], nil)
+		cohostRepositoryMock := cohostRepository.NewCohostRepositoryMock(&mock.Mock{})
+		cohostRepositoryMock.Mock.On("FindByEventAndUser").Return(cohostRepository.CohostCollection[0], nil)
+
+		service := cohostService.NewCohostService(cohostRepositoryMock, eventRepositoryMock, userRepositoryMock)
+		_, err := service.Invite(entities.EventCohostRequest{UserID: 2, Role: "editor"}, 1, 1)
+
+		assert.Equal(t, web.WebError{Code: 400, Message: "User is already a co-host of this event"}, err)
+		cohostRepositoryMock.Mock.AssertNotCalled(t, "Store")
+	})
+}
+
+func TestAccept(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		cohostSample := cohostRepository.CohostCollection[1]
+		accepted := cohostSample
+		accepted.Status = "accepted"
+		cohostRepositoryMock := cohostRepository.NewCohostRepositoryMock(&mock.Mock{})
+		cohostRepositoryMock.Mock.On("Find").Return(cohostSample, nil)
+		cohostRepositoryMock.Mock.On("Update").Return(accepted, nil)
+
+		service := cohostService.NewCohostService(cohostRepositoryMock, eventRepository.NewEventRepositoryMock(&mock.Mock{}), userRepository.NewUserRepositoryMock(&mock.Mock{}))
+		data, err := service.Accept(int(cohostSample.ID), int(cohostSample.UserID))
+
+		assert.Nil(t, err)
+		assert.Equal(t, "accepted", data.Status)
+	})
+	t.Run("someone-else", func(t *testing.T) {
+		cohostSample := cohostRepository.CohostCollection[1]
+		cohostRepositoryMock := cohostRepository.NewCohostRepositoryMock(&mock.Mock{})
+		cohostRepositoryMock.Mock.On("Find").Return(cohostSample, nil)
+
+		service := cohostService.NewCohostService(cohostRepositoryMock, eventRepository.NewEventRepositoryMock(&mock.Mock{}), userRepository.NewUserRepositoryMock(&mock.Mock{}))
+		_, err := service.Accept(int(cohostSample.ID), 2)
+
+		assert.Error(t, err)
+		cohostRepositoryMock.Mock.AssertNotCalled(t, "Update")
+	})
+}
+
+func TestRemove(t *testing.T) {
+	t.Run("self-leave", func(t *testing.T) {
+		cohostSample := cohostRepository.CohostCollection[0]
+		cohostRepositoryMock := cohostRepository.NewCohostRepositoryMock(&mock.Mock{})
+		cohostRepositoryMock.Mock.On("Find").Return(cohostSample, nil)
+		cohostRepositoryMock.Mock.On("Delete").Return(nil)
+
+		service := cohostService.NewCohostService(cohostRepositoryMock, eventRepository.NewEventRepositoryMock(&mock.Mock{}), userRepository.NewUserRepositoryMock(&mock.Mock{}))
+		err := service.Remove(int(cohostSample.ID), int(cohostSample.UserID))
+
+		assert.Nil(t, err)
+	})
+	t.Run("not-owner", func(t *testing.T) {
+		cohostSample := cohostRepository.CohostCollection[0]
+		cohostSample.Event = eventRepository.EventCollection[0]
+		cohostRepositoryMock := cohostRepository.NewCohostRepositoryMock(&mock.Mock{})
+		cohostRepositoryMock.Mock.On("Find").Return(cohostSample, nil)
+
+		service := cohostService.NewCohostService(cohostRepositoryMock, eventRepository.NewEventRepositoryMock(&mock.Mock{}), userRepository.NewUserRepositoryMock(&mock.Mock{}))
+		err := service.Remove(int(cohostSample.ID), 3)
+
+		assert.Error(t, err)
+		cohostRepositoryMock.Mock.AssertNotCalled(t, "Delete")
+	})
+}
+
+func TestTransfer(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventRepository.EventCollection[0], nil)
+		cohostRepositoryMock := cohostRepository.NewCohostRepositoryMock(&mock.Mock{})
+		cohostRepositoryMock.Mock.On("FindByEventAndUser").Return(cohostRepository.CohostCollection[0], nil)
+		cohostRepositoryMock.Mock.On("Transfer").Return(nil)
+		searchProvider := _searchProvider.NewSearchMock(&mock.Mock{})
+		searchProvider.Mock.On("IndexEvent").Return(nil)
+
+		service := cohostService.NewCohostService(cohostRepositoryMock, eventRepositoryMock, userRepository.NewUserRepositoryMock(&mock.Mock{}))
+		err := service.Transfer(entities.EventTransferRequest{UserID: 2}, 1, 1, searchProvider)
+
+		assert.Nil(t, err)
+		searchProvider.Mock.AssertCalled(t, "IndexEvent")
+	})
+	t.Run("pending-cohost", func(t *testing.T) {
+		pending := cohostRepository.CohostCollection[0]
+		pending.Status = "pending"
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventRepository.EventCollection[0], nil)
+		cohostRepositoryMock := cohostRepository.NewCohostRepositoryMock(&mock.Mock{})
+		cohostRepositoryMock.Mock.On("FindByEventAndUser").Return(pending, nil)
+
+		service := cohostService.NewCohostService(cohostRepositoryMock, eventRepositoryMock, userRepository.NewUserRepositoryMock(&mock.Mock{}))
+		err := service.Transfer(entities.EventTransferRequest{UserID: 2}, 1, 1, _searchProvider.NewSearchMock(&mock.Mock{}))
+
+		assert.Error(t, err)
+		cohostRepositoryMock.Mock.AssertNotCalled(t, "Transfer")
+	})
+	t.Run("editor-cannot-transfer", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventSample.Cohosts = []entities.EventCohost{cohostRepository.CohostCollection[0]}
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		cohostRepositoryMock := cohostRepository.NewCohostRepositoryMock(&mock.Mock{})
+
+		service := cohostService.NewCohostService(cohostRepositoryMock, eventRepositoryMock, userRepository.NewUserRepositoryMock(&mock.Mock{}))
+		err := service.Transfer(entities.EventTransferRequest{UserID: 2}, 1, 2, _searchProvider.NewSearchMock(&mock.Mock{}))
+
+		assert.Error(t, err)
+		cohostRepositoryMock.Mock.AssertNotCalled(t, "Transfer")
+	})
+}
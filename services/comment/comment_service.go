@@ -1,11 +1,13 @@
 package comment
 
 import (
+	"time"
 	"tupulung/deliveries/validations"
 	"tupulung/entities"
 	"tupulung/entities/web"
 	commentRepo "tupulung/repositories/comment"
 	userRepo "tupulung/repositories/user"
+	cohostService "tupulung/services/cohost"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/jinzhu/copier"
@@ -148,7 +150,8 @@ func (service CommentService) Update(commentRequest entities.CommentRequest, id
 /*
  * Delete Comment
  * -------------------------------
- * Hapus komentar user, hanya pemilik komentar yang dapat mengedit
+ * Hapus komentar user, hanya pemilik komentar
+ * atau moderator event yang dapat menghapus
  */
 func (service CommentService) Delete(id int, userID int) error {
 	// Find comment
@@ -157,8 +160,8 @@ func (service CommentService) Delete(id int, userID int) error {
 		return web.WebError{Code: 400, Message: "The requested ID doesn't match with any record"}
 	}
 
-	// Match comment with authenticated userid
-	if userID != int(comment.UserID) {
+	// Match comment with authenticated userid or event moderator
+	if userID != int(comment.UserID) && !cohostService.Can(comment.Event, userID, cohostService.PermissionModerate) {
 		return web.WebError{Code: 401, Message: "Unauthorized user, cannot Delete someone else's comment"}
 	}
 
@@ -166,3 +169,38 @@ func (service CommentService) Delete(id int, userID int) error {
 	err = service.commentRepo.Delete(id)
 	return err
 }
+
+/*
+ * Hide Comment
+ * -------------------------------
+ * Menyembunyikan komentar dari list oleh moderator,
+ * hak akses moderator dicek oleh service moderation
+ */
+func (service CommentService) Hide(id int, hidden bool) error {
+	comment, err := service.commentRepo.Find(id)
+	if err != nil || comment.ID == 0 {
+		return web.WebError{Code: 400, Message: "The requested ID doesn't match with any record"}
+	}
+	comment.HiddenAt = nil
+	if hidden {
+		now := time.Now()
+		comment.HiddenAt = &now
+	}
+	comment.Event = entities.Event{}
+	comment.User = entities.User{}
+	_, err = service.commentRepo.Update(comment, id)
+	return err
+}
+
+/*
+ * Remove Comment
+ * -------------------------------
+ * Menghapus komentar oleh moderator tanpa pengecekan pemilik
+ */
+func (service CommentService) Remove(id int) error {
+	comment, err := service.commentRepo.Find(id)
+	if err != nil || comment.ID == 0 {
+		return web.WebError{Code: 400, Message: "The requested ID doesn't match with any record"}
+	}
+	return service.commentRepo.Delete(id)
+}
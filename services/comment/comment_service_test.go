@@ -334,4 +334,36 @@ func TestDelete(t *testing.T) {
 		err := Service.Delete(int(sampleComment.ID), int(userSample.ID))
 		assert.Error(t, err)
 	})
+	t.Run("event-moderator", func(t *testing.T) {
+		sampleComment := commentRepository.CommentCollection[0]
+		sampleComment.Event = entities.Event{UserID: 5, Cohosts: []entities.EventCohost{
+			{UserID: 9, Role: "moderator", Status: "accepted"},
+		}}
+		commentRepositoryMock := commentRepository.NewCommentRepositoryMock(&mock.Mock{})
+		commentRepositoryMock.Mock.On("Find").Return(sampleComment, nil)
+		commentRepositoryMock.Mock.On("Delete").Return(nil)
+
+		Service := commentService.NewCommentService(
+			commentRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+		)
+		err := Service.Delete(int(sampleComment.ID), 9)
+		assert.Nil(t, err)
+	})
+	t.Run("event-editor", func(t *testing.T) {
+		sampleComment := commentRepository.CommentCollection[0]
+		sampleComment.Event = entities.Event{UserID: 5, Cohosts: []entities.EventCohost{
+			{UserID: 9, Role: "editor", Status: "accepted"},
+		}}
+		commentRepositoryMock := commentRepository.NewCommentRepositoryMock(&mock.Mock{})
+		commentRepositoryMock.Mock.On("Find").Return(sampleComment, nil)
+
+		Service := commentService.NewCommentService(
+			commentRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+		)
+		err := Service.Delete(int(sampleComment.ID), 9)
+		assert.Error(t, err)
+		commentRepositoryMock.Mock.AssertNotCalled(t, "Delete")
+	})
 }
@@ -0,0 +1,30 @@
+package event
+
+import (
+	"tupulung/entities"
+)
+
+const (
+	ModeInPerson = "in_person"
+	ModeOnline   = "online"
+	ModeHybrid   = "hybrid"
+)
+
+/*
+ * Redact Details
+ * -------------------------------
+ * Menghapus detail khusus participant dari response event,
+ * alamat hanya dihapus jika host memilih menyembunyikannya
+ */
+func RedactDetails(eventRes *entities.EventResponse) {
+	if eventRes.MeetingURL == "" && eventRes.DialIn == "" && !eventRes.HideAddress {
+		return
+	}
+	eventRes.MeetingURL = ""
+	eventRes.DialIn = ""
+	if eventRes.HideAddress {
+		eventRes.Location = ""
+		delete(eventRes.Highlights, "location")
+	}
+	eventRes.Redacted = true
+}
@@ -0,0 +1,19 @@
+package event
+
+import (
+	"tupulung/entities"
+	reviewService "tupulung/services/review"
+)
+
+/*
+ * Apply Rating
+ * -------------------------------
+ * Mengisi ringkasan rating event yang sudah memiliki review
+ */
+func applyRating(eventRes *entities.EventResponse, event entities.Event) {
+	eventRes.Rating = nil
+	if event.Rating != nil && event.Rating.Count > 0 {
+		rating := reviewService.Summarize(event.Rating)
+		eventRes.Rating = &rating
+	}
+}
@@ -0,0 +1,239 @@
+package event
+
+import (
+	"sort"
+	"strings"
+	"tupulung/entities"
+	web "tupulung/entities/web"
+
+	"github.com/jinzhu/copier"
+)
+
+// Jumlah maksimal event yang akan datang yang dinilai untuk rekomendasi
+const maxRecommendationCandidates = 200
+
+/*
+ * Recommendation weights
+ * -------------------------------
+ * Bobot setiap sinyal minat user pada skor rekomendasi
+ */
+const (
+	recommendHostWeight     = 4.0
+	recommendCategoryWeight = 3.0
+	recommendLocationWeight = 2.0
+	recommendTagWeight      = 1.0
+)
+
+/*
+ * Interest profile
+ * -------------------------------
+ * Ringkasan minat user yang dibangun dari event yang di join dan di like
+ */
+type interestProfile struct {
+	categories    map[uint]int
+	categoryNames map[uint]string
+	hosts         map[uint]string
+	locations     []string
+	tags          map[string]bool
+	seen          map[uint]bool
+}
+
+/*
+ * Recommended
+ * -------------------------------
+ * Meranking event public yang akan datang berdasarkan kategori
+ * event yang di join, event yang di like, host yang pernah
+ * berinteraksi dengan user dan lokasi. User tanpa riwayat
+ * mendapatkan event trending
+ */
+func (service EventService) Recommended(userID int, limit int) ([]entities.EventResponse, error) {
+	user, err := service.userRepo.Find(userID)
+	if err != nil {
+		return []entities.EventResponse{}, web.WebError{Code: 400, Message: "No user matched with this authenticated user"}
+	}
+	joined, err := service.userRepo.GetJoinedEvents(userID)
+	if err != nil {
+		return []entities.EventResponse{}, err
+	}
+	liked, err := service.likeRepo.FindLikedEvents(userID)
+	if err != nil {
+		return []entities.EventResponse{}, err
+	}
+
+	profile := buildInterestProfile(user, append(joined, liked...))
+	if len(profile.seen) == 0 {
+		return service.recommendTrending(limit)
+	}
+
+	filters, sorts, err := service.BuildListFilters(entities.EventListQuery{Date: "upcoming", Sort: "trending"})
+	if err != nil {
+		return []entities.EventResponse{}, err
+	}
+	candidates, err := service.eventRepo.FindAll(maxRecommendationCandidates, 0, filters, sorts)
+	if err != nil {
+		return []entities.EventResponse{}, err
+	}
+
+	// Kandidat sudah terurut berdasarkan trending, sehingga skor yang sama tetap mengikuti trending
+	events := []entities.Event{}
+	scores := map[uint]float64{}
+	reasons := map[uint]string{}
+	for _, candidate := range candidates {
+		if profile.seen[candidate.ID] || int(candidate.UserID) == userID {
+			continue
+		}
+		score, reason := profile.score(candidate)
+		if score <= 0 {
+			continue
+		}
+		events = append(events, candidate)
+		scores[candidate.ID] = score
+		reasons[candidate.ID] = reason
+	}
+	if len(events) == 0 {
+		return service.recommendTrending(limit)
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return scores[events[i].ID] > scores[events[j].ID]
+	})
+	if len(events) > limit {
+		events = events[:limit]
+	}
+
+	eventsRes := []entities.EventResponse{}
+	copier.Copy(&eventsRes, &events)
+	for i, event := range events {
+		count, err := service.likeRepo.CountLikeByEvent(int(event.ID))
+		if err != nil {
+			count = 0
+		}
+		eventsRes[i].Likes = uint(count)
+		applyRSVP(&eventsRes[i], event)
+		applyRating(&eventsRes[i], event)
+		RedactDetails(&eventsRes[i])
+		eventsRes[i].Reason = reasons[event.ID]
+		if event.Trending != nil {
+			eventsRes[i].TrendingScore = event.Trending.Score
+		}
+	}
+	return eventsRes, nil
+}
+
+/*
+ * Recommend trending
+ * -------------------------------
+ * Rekomendasi untuk user tanpa riwayat, jika belum ada event
+ * trending maka diambil event yang akan datang dengan peserta terbanyak
+ */
+func (service EventService) recommendTrending(limit int) ([]entities.EventResponse, error) {
+	eventsRes, _, err := service.Trending("", limit, 1)
+	if err != nil {
+		return []entities.EventResponse{}, err
+	}
+	reason := "Trending now"
+	if len(eventsRes) == 0 {
+		filters, sorts, err := service.BuildListFilters(entities.EventListQuery{Date: "upcoming", Sort: "most_joined"})
+		if err != nil {
+			return []entities.EventResponse{}, err
+		}
+		eventsRes, err = service.FindAll(limit, 1, filters, sorts)
+		if err != nil {
+			return []entities.EventResponse{}, err
+		}
+		reason = "Popular upcoming event"
+	}
+	for i := range eventsRes {
+		eventsRes[i].Reason = reason
+	}
+	return eventsRes, nil
+}
+
+func buildInterestProfile(user entities.User, events []entities.Event) interestProfile {
+	profile := interestProfile{
+		categories:    map[uint]int{},
+		categoryNames: map[uint]string{},
+		hosts:         map[uint]string{},
+		locations:     []string{},
+		tags:          map[string]bool{},
+		seen:          map[uint]bool{},
+	}
+	addLocation := func(location string) {
+		location = strings.ToLower(strings.TrimSpace(location))
+		if location == "" {
+			return
+		}
+		for _, existing := range profile.locations {
+			if existing == location {
+				return
+			}
+		}
+		profile.locations = append(profile.locations, location)
+	}
+
+	addLocation(user.Address)
+	for _, event := range events {
+		profile.seen[event.ID] = true
+		profile.categories[event.CategoryID]++
+		profile.categoryNames[event.CategoryID] = event.Category.Title
+		if event.UserID != user.ID {
+			profile.hosts[event.UserID] = event.User.Name
+		}
+		addLocation(event.Location)
+		for _, tag := range event.Tags {
+			profile.tags[tag.Name] = true
+		}
+	}
+	return profile
+}
+
+/*
+ * Score
+ * -------------------------------
+ * Menilai kecocokan event dengan minat user, alasan
+ * rekomendasi diambil dari sinyal dengan bobot terbesar
+ */
+func (profile interestProfile) score(event entities.Event) (float64, string) {
+	score := 0.0
+	best := 0.0
+	reason := ""
+	add := func(weight float64, why string) {
+		score += weight
+		if weight > best {
+			best = weight
+			reason = why
+		}
+	}
+
+	if name, ok := profile.hosts[event.UserID]; ok {
+		add(recommendHostWeight, "Hosted by "+name+", whose events you have joined or liked")
+	}
+	if count := profile.categories[event.CategoryID]; count > 0 {
+		// Kategori yang sering diikuti mendapat bobot tambahan hingga dua kali lipat
+		boost := float64(count)
+		if boost > 3 {
+			boost = 3
+		}
+		add(recommendCategoryWeight*(1+(boost-1)/2), "Because you joined or liked events in "+profile.categoryNames[event.CategoryID])
+	}
+	// Alamat yang disembunyikan tidak boleh muncul pada alasan rekomendasi
+	location := ""
+	if !event.HideAddress {
+		location = strings.ToLower(event.Location)
+	}
+	for _, known := range profile.locations {
+		if location != "" && (strings.Contains(location, known) || strings.Contains(known, location)) {
+			add(recommendLocationWeight, "Near "+event.Location+", where you attend events")
+			break
+		}
+	}
+	sharedTags := []string{}
+	for _, tag := range event.Tags {
+		if profile.tags[tag.Name] && len(sharedTags) < 3 {
+			sharedTags = append(sharedTags, tag.Name)
+		}
+	}
+	if len(sharedTags) > 0 {
+		add(recommendTagWeight*float64(len(sharedTags)), "Matches your interests: "+strings.Join(sharedTags, ", "))
+	}
+	return score, reason
+}
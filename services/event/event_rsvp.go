@@ -0,0 +1,45 @@
+package event
+
+import (
+	"tupulung/entities"
+	participantService "tupulung/services/participant"
+)
+
+/*
+ * Apply RSVP
+ * -------------------------------
+ * Mengisi jumlah participant per status RSVP dan menyaring
+ * daftar participant pada response agar hanya berisi user yang going,
+ * email host, co-host dan participant yang disembunyikan dikosongkan
+ */
+func applyRSVP(eventRes *entities.EventResponse, event entities.Event) {
+	eventRes.RSVP = participantService.CountRSVP(event.RSVPs)
+	eventRes.User.Email = participantService.VisibleEmail(event.User)
+	for i, cohost := range event.Cohosts {
+		if i < len(eventRes.Cohosts) {
+			eventRes.Cohosts[i].User.Email = participantService.VisibleEmail(cohost.User)
+		}
+	}
+	if len(eventRes.Participants) == 0 {
+		return
+	}
+
+	notGoing := map[uint]bool{}
+	for _, participant := range event.RSVPs {
+		if participant.Status == participantService.RSVPMaybe || participant.Status == participantService.RSVPNotGoing {
+			notGoing[participant.UserID] = true
+		}
+	}
+	emails := map[uint]string{}
+	for _, user := range event.Participants {
+		emails[user.ID] = participantService.VisibleEmail(user)
+	}
+	going := []entities.UserResponse{}
+	for _, participant := range eventRes.Participants {
+		if !notGoing[participant.ID] {
+			participant.Email = emails[participant.ID]
+			going = append(going, participant)
+		}
+	}
+	eventRes.Participants = going
+}
@@ -3,35 +3,64 @@ package event
 import (
 	"mime/multipart"
 	"net/url"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 	"tupulung/deliveries/validations"
 	"tupulung/entities"
+	cohostService "tupulung/services/cohost"
+	participantService "tupulung/services/participant"
+	revisionService "tupulung/services/revision"
+	tagService "tupulung/services/tag"
+	searchProvider "tupulung/utilities/search"
 	storageProvider "tupulung/utilities/storage"
 
 	web "tupulung/entities/web"
 	eventRepository "tupulung/repositories/event"
+	inviteRepository "tupulung/repositories/invite"
 	likeRepository "tupulung/repositories/like"
+	revisionRepository "tupulung/repositories/revision"
+	slugRepository "tupulung/repositories/slug"
+	tagRepository "tupulung/repositories/tag"
+	templateRepository "tupulung/repositories/template"
+	trendingRepository "tupulung/repositories/trending"
 	userRepository "tupulung/repositories/user"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
 	"github.com/jinzhu/copier"
+	"github.com/labstack/gommon/log"
 )
 
+// Jumlah hasil search index yang diambil per batch dalam satu pencarian
+const maxSearchHits = 500
+
 type EventService struct {
-	eventRepo eventRepository.EventRepositoryInterface
-	userRepo  userRepository.UserRepositoryInterface
-	likeRepo  likeRepository.LikeRepositoryInterface
-	validate  *validator.Validate
+	eventRepo    eventRepository.EventRepositoryInterface
+	userRepo     userRepository.UserRepositoryInterface
+	likeRepo     likeRepository.LikeRepositoryInterface
+	inviteRepo   inviteRepository.InviteRepositoryInterface
+	tagRepo      tagRepository.TagRepositoryInterface
+	templateRepo templateRepository.TemplateRepositoryInterface
+	trendingRepo trendingRepository.TrendingRepositoryInterface
+	revisionRepo revisionRepository.RevisionRepositoryInterface
+	slugRepo     slugRepository.SlugRepositoryInterface
+	validate     *validator.Validate
 }
 
-func NewEventService(repository eventRepository.EventRepositoryInterface, userRepository userRepository.UserRepositoryInterface, likeRepo likeRepository.LikeRepositoryInterface) *EventService {
+func NewEventService(repository eventRepository.EventRepositoryInterface, userRepository userRepository.UserRepositoryInterface, likeRepo likeRepository.LikeRepositoryInterface, inviteRepo inviteRepository.InviteRepositoryInterface, tagRepo tagRepository.TagRepositoryInterface, templateRepo templateRepository.TemplateRepositoryInterface, trendingRepo trendingRepository.TrendingRepositoryInterface, revisionRepo revisionRepository.RevisionRepositoryInterface, slugRepo slugRepository.SlugRepositoryInterface) *EventService {
 	return &EventService{
-		eventRepo: repository,
-		userRepo:  userRepository,
-		likeRepo:  likeRepo,
-		validate:  validator.New(),
+		eventRepo:    repository,
+		userRepo:     userRepository,
+		likeRepo:     likeRepo,
+		inviteRepo:   inviteRepo,
+		tagRepo:      tagRepo,
+		templateRepo: templateRepo,
+		trendingRepo: trendingRepo,
+		revisionRepo: revisionRepo,
+		slugRepo:     slugRepo,
+		validate:     validator.New(),
 	}
 }
 
@@ -54,11 +83,124 @@ func (service EventService) FindAll(limit, page int, filters []map[string]string
 			count = 0
 		}
 		eventsRes[i].Likes = uint(count)
+		applyRSVP(&eventsRes[i], event)
+		applyRating(&eventsRes[i], event)
+		RedactDetails(&eventsRes[i])
+		if event.Trending != nil {
+			eventsRes[i].TrendingScore = event.Trending.Score
+		}
 	}
 
 	return eventsRes, err
 }
 
+/*
+ * --------------------------
+ * Translate list query to filters and sorts
+ * --------------------------
+ * Query divalidasi terlebih dahulu, lalu diterjemahkan menjadi
+ * filters & sorts yang field-nya ada di whitelist repository
+ */
+func (service EventService) BuildListFilters(query entities.EventListQuery) ([]map[string]string, []map[string]interface{}, error) {
+	filters := []map[string]string{}
+	sorts := []map[string]interface{}{}
+
+	err := validations.ValidateEventListQuery(service.validate, query)
+	if err != nil {
+		return filters, sorts, err
+	}
+
+	if query.CategoryID != "" {
+		ids := []string{}
+		for _, id := range strings.Split(query.CategoryID, ",") {
+			ids = append(ids, strings.TrimSpace(id))
+		}
+		filters = append(filters, map[string]string{"field": "category_id", "operator": "IN", "value": strings.Join(ids, ",")})
+	}
+	if query.HostID != 0 {
+		filters = append(filters, map[string]string{"field": "user_id", "operator": "=", "value": strconv.Itoa(int(query.HostID))})
+	}
+	if query.GroupID != 0 {
+		filters = append(filters, map[string]string{"field": "group_id", "operator": "=", "value": strconv.Itoa(int(query.GroupID))})
+	}
+	if query.Mode != "" {
+		filters = append(filters, map[string]string{"field": "mode", "operator": "=", "value": query.Mode})
+	}
+	if query.Location != "" {
+		filters = append(filters, map[string]string{"field": "location", "operator": "LIKE", "value": "%" + query.Location + "%"})
+		// Alamat yang disembunyikan tidak bisa ditebak lewat filter
+		filters = append(filters, map[string]string{"field": "hide_address", "operator": "=", "value": "0"})
+	}
+
+	// Date range, preset dihitung dari awal hari ini
+	const layout = "2006-01-02 15:04:05"
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	switch query.Date {
+	case "today":
+		filters = append(filters,
+			map[string]string{"field": "datetime_event", "operator": ">=", "value": today.Format(layout)},
+			map[string]string{"field": "datetime_event", "operator": "<", "value": today.AddDate(0, 0, 1).Format(layout)},
+		)
+	case "this_weekend":
+		saturday := today.AddDate(0, 0, int(time.Saturday-today.Weekday()))
+		if today.Weekday() == time.Sunday {
+			saturday = today.AddDate(0, 0, -1)
+		}
+		filters = append(filters,
+			map[string]string{"field": "datetime_event", "operator": ">=", "value": saturday.Format(layout)},
+			map[string]string{"field": "datetime_event", "operator": "<", "value": saturday.AddDate(0, 0, 2).Format(layout)},
+		)
+	case "upcoming":
+		filters = append(filters, map[string]string{"field": "datetime_event", "operator": ">=", "value": today.Format(layout)})
+	case "past":
+		filters = append(filters, map[string]string{"field": "datetime_event", "operator": "<", "value": today.Format(layout)})
+	}
+	if query.DateFrom != "" {
+		filters = append(filters, map[string]string{"field": "datetime_event", "operator": ">=", "value": query.DateFrom + " 00:00:00"})
+	}
+	if query.DateTo != "" {
+		filters = append(filters, map[string]string{"field": "datetime_event", "operator": "<=", "value": query.DateTo + " 23:59:59"})
+	}
+
+	if query.HasFreeSeats {
+		filters = append(filters, map[string]string{"field": "free_seats", "operator": ">", "value": "0"})
+	}
+	if query.MinLikes > 0 {
+		filters = append(filters, map[string]string{"field": "likes", "operator": ">=", "value": strconv.Itoa(int(query.MinLikes))})
+	}
+	if query.Tags != "" {
+		names, err := tagService.NormalizeTags([]string{query.Tags})
+		if err != nil {
+			return filters, sorts, err
+		}
+		operator := "IN"
+		if query.TagsMode == "all" {
+			operator = "ALL"
+		}
+		if len(names) > 0 {
+			filters = append(filters, map[string]string{"field": "tags", "operator": operator, "value": strings.Join(names, ",")})
+		}
+	}
+
+	// Event unlisted & private tidak pernah tampil di list
+	filters = append(filters, map[string]string{"field": "visibility", "operator": "=", "value": "public"})
+
+	switch query.Sort {
+	case "date":
+		sorts = append(sorts, map[string]interface{}{"field": "datetime_event", "desc": false})
+	case "newest":
+		sorts = append(sorts, map[string]interface{}{"field": "created_at", "desc": true})
+	case "most_liked":
+		sorts = append(sorts, map[string]interface{}{"field": "likes", "desc": true})
+	case "most_joined":
+		sorts = append(sorts, map[string]interface{}{"field": "participants", "desc": true})
+	case "trending":
+		sorts = append(sorts, map[string]interface{}{"field": "trending", "desc": true})
+	}
+	return filters, sorts, nil
+}
+
 /*
  * --------------------------
  * Load pagination data
@@ -84,6 +226,183 @@ func (service EventService) GetPagination(limit, page int, filters []map[string]
 	}, nil
 }
 
+/*
+ * --------------------------
+ * Search events by relevance
+ * --------------------------
+ * Semua hasil search index diambil per batch, lalu setiap batch
+ * difilter dengan filters yang sama seperti FindAll sehingga total
+ * halaman sesuai jumlah event yang cocok. Jika tidak ada sorts,
+ * event diurutkan berdasarkan skor relevansi
+ */
+func (service EventService) Search(keyword string, limit, page int, filters []map[string]string, sorts []map[string]interface{}, searchProvider searchProvider.SearchInterface) ([]entities.EventResponse, web.Pagination, error) {
+	if limit <= 0 {
+		limit = 1
+	}
+	pagination := web.Pagination{Page: page, Limit: limit}
+
+	// Restrict repository filters to the matched ids, batch by batch
+	hitsByID := map[uint]entities.EventSearchHit{}
+	events := []entities.Event{}
+	for hitOffset := 0; ; hitOffset += maxSearchHits {
+		hits, err := searchProvider.SearchEvents(keyword, maxSearchHits, hitOffset)
+		if err != nil {
+			return []entities.EventResponse{}, web.Pagination{}, err
+		}
+		if len(hits) == 0 {
+			break
+		}
+		ids := []string{}
+		for _, hit := range hits {
+			hitsByID[hit.ID] = hit
+			ids = append(ids, strconv.Itoa(int(hit.ID)))
+		}
+		batch, err := service.eventRepo.FindAll(len(hits), 0, searchIDFilters(filters, ids), []map[string]interface{}{})
+		if err != nil {
+			return []entities.EventResponse{}, web.Pagination{}, err
+		}
+		events = append(events, batch...)
+		if len(hits) < maxSearchHits {
+			break
+		}
+	}
+
+	// Paginate
+	totalPages := len(events) / limit
+	if len(events)%limit > 0 {
+		totalPages++
+	}
+	pagination.TotalPages = totalPages
+	offset := (page - 1) * limit
+	if offset < 0 || offset >= len(events) {
+		return []entities.EventResponse{}, pagination, nil
+	}
+	if len(sorts) == 0 {
+		sort.SliceStable(events, func(i, j int) bool {
+			return hitsByID[events[i].ID].Score > hitsByID[events[j].ID].Score
+		})
+		end := offset + limit
+		if end > len(events) {
+			end = len(events)
+		}
+		events = events[offset:end]
+	} else {
+		// Sorts berlaku untuk seluruh event yang cocok, bukan per batch
+		ids := []string{}
+		for _, event := range events {
+			ids = append(ids, strconv.Itoa(int(event.ID)))
+		}
+		var err error
+		events, err = service.eventRepo.FindAll(limit, offset, searchIDFilters(filters, ids), sorts)
+		if err != nil {
+			return []entities.EventResponse{}, web.Pagination{}, err
+		}
+	}
+
+	eventsRes := []entities.EventResponse{}
+	copier.Copy(&eventsRes, &events)
+	for i, event := range events {
+		count, err := service.likeRepo.CountLikeByEvent(int(event.ID))
+		if err != nil {
+			count = 0
+		}
+		eventsRes[i].Likes = uint(count)
+		applyRSVP(&eventsRes[i], event)
+		applyRating(&eventsRes[i], event)
+		eventsRes[i].Score = hitsByID[event.ID].Score
+		eventsRes[i].Highlights = hitsByID[event.ID].Highlights
+		RedactDetails(&eventsRes[i])
+		if event.Trending != nil {
+			eventsRes[i].TrendingScore = event.Trending.Score
+		}
+	}
+	return eventsRes, pagination, nil
+}
+
+/*
+ * --------------------------
+ * Restrict filters to search hits
+ * --------------------------
+ */
+func searchIDFilters(filters []map[string]string, ids []string) []map[string]string {
+	searchFilters := append([]map[string]string{}, filters...)
+	return append(searchFilters, map[string]string{
+		"field":    "id",
+		"operator": "IN",
+		"value":    strings.Join(ids, ","),
+	})
+}
+
+/*
+ * --------------------------
+ * Autocomplete event title
+ * --------------------------
+ */
+func (service EventService) Suggest(prefix string, limit int, searchProvider searchProvider.SearchInterface) ([]entities.EventSuggestionResponse, error) {
+	if limit <= 0 {
+		limit = 10
+	}
+	return searchProvider.SuggestEvents(prefix, limit)
+}
+
+/*
+ * --------------------------
+ * Rebuild search index
+ * --------------------------
+ * Mengindex ulang semua event, digunakan saat search index masih kosong
+ */
+func (service EventService) Reindex(searchProvider searchProvider.SearchInterface) error {
+	events, err := service.eventRepo.FindAll(-1, -1, []map[string]string{}, []map[string]interface{}{})
+	if err != nil {
+		return err
+	}
+	for _, event := range events {
+		err := searchProvider.IndexEvent(event)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+ * Reindex Group
+ * -------------------------------
+ * Memperbarui search index semua event milik group,
+ * misalnya setelah nama group (HostedBy) berubah
+ */
+func (service EventService) ReindexGroup(groupID uint, searchProvider searchProvider.SearchInterface) error {
+	filters := []map[string]string{{"field": "group_id", "operator": "=", "value": strconv.Itoa(int(groupID))}}
+	events, err := service.eventRepo.FindAll(-1, -1, filters, []map[string]interface{}{})
+	if err != nil {
+		return err
+	}
+	for _, event := range events {
+		err := searchProvider.IndexEvent(event)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+ * --------------------------
+ * Sync event to search index
+ * --------------------------
+ * Kegagalan index tidak menggagalkan request, hanya dicatat di log
+ */
+func (service EventService) syncSearchIndex(id int, searchProvider searchProvider.SearchInterface) {
+	event, err := service.eventRepo.Find(id)
+	if err != nil {
+		return
+	}
+	err = searchProvider.IndexEvent(event)
+	if err != nil {
+		log.Warn("Cannot index event " + strconv.Itoa(id) + ": " + err.Error())
+	}
+}
+
 /*
  * --------------------------
  * Get single event data based on ID
@@ -95,6 +414,16 @@ func (service EventService) Find(id int) (entities.EventResponse, error) {
 	if err != nil {
 		return entities.EventResponse{}, err
 	}
+	return service.toEventResponse(event), nil
+}
+
+/*
+ * --------------------------
+ * Build single event response
+ * --------------------------
+ * Menyalin event ke response beserta jumlah like dan RSVP
+ */
+func (service EventService) toEventResponse(event entities.Event) entities.EventResponse {
 	eventRes := entities.EventResponse{}
 	copier.Copy(&eventRes, &event)
 
@@ -104,8 +433,35 @@ func (service EventService) Find(id int) (entities.EventResponse, error) {
 		count = 0
 	}
 	eventRes.Likes = uint(count)
+	applyRSVP(&eventRes, event)
+	applyRating(&eventRes, event)
 
-	return eventRes, err
+	return eventRes
+}
+
+/*
+ * --------------------------
+ * Get single event data for a viewer
+ * --------------------------
+ * Event private hanya bisa dilihat oleh host, participant,
+ * user yang diundang, atau pemilik kode invite yang masih berlaku.
+ * Selain itu event dianggap tidak ada, begitu juga event yang
+ * disembunyikan moderator. Detail khusus participant
+ * disembunyikan dari user yang belum join
+ */
+func (service EventService) FindForViewer(id int, viewerID int, inviteCode string) (entities.EventResponse, error) {
+	event, err := service.eventRepo.Find(id)
+	if err != nil {
+		return entities.EventResponse{}, err
+	}
+	if !participantService.CanView(service.inviteRepo, event, viewerID, inviteCode) {
+		return entities.EventResponse{}, web.WebError{Code: 400, Message: "cannot get event data with specified id"}
+	}
+	eventRes := service.toEventResponse(event)
+	if !participantService.CanViewDetails(event, viewerID) {
+		RedactDetails(&eventRes)
+	}
+	return eventRes, nil
 }
 
 /*
@@ -113,7 +469,22 @@ func (service EventService) Find(id int) (entities.EventResponse, error) {
  * Create event resource
  * --------------------------
  */
-func (service EventService) Create(eventRequest entities.EventRequest, userID int, cover *multipart.FileHeader, storageProvider storageProvider.StorageInterface) (entities.EventResponse, error) {
+func (service EventService) Create(eventRequest entities.EventRequest, userID int, cover *multipart.FileHeader, storageProvider storageProvider.StorageInterface, searchProvider searchProvider.SearchInterface) (entities.EventResponse, error) {
+	return service.create(eventRequest, userID, nil, cover, storageProvider, searchProvider)
+}
+
+/*
+ * Create For Group
+ * -------------------------------
+ * Membuat event atas nama group, group dan HostedBy
+ * langsung tersimpan bersama event
+ */
+func (service EventService) CreateForGroup(eventRequest entities.EventRequest, userID int, group entities.Group, cover *multipart.FileHeader, storageProvider storageProvider.StorageInterface, searchProvider searchProvider.SearchInterface) (entities.EventResponse, error) {
+	eventRequest.HostedBy = group.Name
+	return service.create(eventRequest, userID, &group, cover, storageProvider, searchProvider)
+}
+
+func (service EventService) create(eventRequest entities.EventRequest, userID int, group *entities.Group, cover *multipart.FileHeader, storageProvider storageProvider.StorageInterface, searchProvider searchProvider.SearchInterface) (entities.EventResponse, error) {
 	// Validation
 	eventFiles := []*multipart.FileHeader{}
 	if cover != nil {
@@ -123,10 +494,35 @@ func (service EventService) Create(eventRequest entities.EventRequest, userID in
 	if err != nil {
 		return entities.EventResponse{}, err
 	}
+	tagNames, err := tagService.NormalizeTags(eventRequest.Tags)
+	if err != nil {
+		return entities.EventResponse{}, err
+	}
 
 	// convert event to entities entities
 	event := entities.Event{}
 	copier.Copy(&event, &eventRequest)
+	if group != nil {
+		event.GroupID = &group.ID
+	}
+	if event.Mode == "" {
+		event.Mode = ModeInPerson
+	}
+	if eventRequest.HideAddress != nil {
+		event.HideAddress = *eventRequest.HideAddress
+	}
+
+	// Slug dibuat dari judul kecuali host memilih slug sendiri
+	slug := eventRequest.Slug
+	if slug != "" {
+		err = service.checkSlug(slug, 0)
+	} else {
+		slug, err = service.uniqueSlug(Slugify(eventRequest.Title), 0)
+	}
+	if err != nil {
+		return entities.EventResponse{}, err
+	}
+	event.Slug = &slug
 
 	// get user data
 	user, err := service.userRepo.Find(userID)
@@ -143,6 +539,13 @@ func (service EventService) Create(eventRequest entities.EventRequest, userID in
 		}
 		event.DatetimeEvent = datetime
 	}
+	if eventRequest.DatetimeEventEnd != "" {
+		datetimeEnd, err := time.Parse("2006-01-02", eventRequest.DatetimeEventEnd)
+		if err != nil {
+			return entities.EventResponse{}, web.WebError{Code: 400, Message: "date time event end format is invalid"}
+		}
+		event.DatetimeEventEnd = &datetimeEnd
+	}
 
 	if cover != nil {
 
@@ -155,10 +558,18 @@ func (service EventService) Create(eventRequest entities.EventRequest, userID in
 		event.Cover = coverURL
 	}
 
+	event.Tags = nil
 	event, err = service.eventRepo.Store(event)
 	if err != nil {
 		return entities.EventResponse{}, err
 	}
+	if len(tagNames) > 0 {
+		_, err = service.tagRepo.SyncEvent(event.ID, tagNames)
+		if err != nil {
+			return entities.EventResponse{}, err
+		}
+	}
+	service.syncSearchIndex(int(event.ID), searchProvider)
 
 	// get event data
 	eventRes, err := service.Find(int(event.ID))
@@ -174,13 +585,17 @@ func (service EventService) Create(eventRequest entities.EventRequest, userID in
  * Update event resource
  * --------------------------
  */
-func (service EventService) Update(eventRequest entities.EventRequest, id int, userID int, cover *multipart.FileHeader, storageProvider storageProvider.StorageInterface) (entities.EventResponse, error) {
+func (service EventService) Update(eventRequest entities.EventRequest, id int, userID int, cover *multipart.FileHeader, storageProvider storageProvider.StorageInterface, searchProvider searchProvider.SearchInterface) (entities.EventResponse, error) {
 	// Validation
 	eventFiles := []*multipart.FileHeader{}
 	if cover != nil {
 		eventFiles = append(eventFiles, cover)
 	}
-	err := validations.ValidateUpdateEventRequest(eventFiles)
+	err := validations.ValidateUpdateEventRequest(service.validate, eventRequest, eventFiles)
+	if err != nil {
+		return entities.EventResponse{}, err
+	}
+	tagNames, err := tagService.NormalizeTags(eventRequest.Tags)
 	if err != nil {
 		return entities.EventResponse{}, err
 	}
@@ -196,9 +611,38 @@ func (service EventService) Update(eventRequest entities.EventRequest, id int, u
 	if err != nil {
 		return entities.EventResponse{}, web.WebError{Code: 400, Message: "No user matched with this authenticated user"}
 	}
-	if event.UserID != user.ID {
+	if !cohostService.Can(event, int(user.ID), cohostService.PermissionUpdate) {
 		return entities.EventResponse{}, web.WebError{Code: 401, Message: "Cannot update event that belongs to someone else"}
 	}
+
+	// Event yang berubah dari online membutuhkan lokasi
+	mode, location := event.Mode, event.Location
+	if eventRequest.Mode != "" {
+		mode = eventRequest.Mode
+	}
+	if eventRequest.Location != "" {
+		location = eventRequest.Location
+	}
+	if mode != ModeOnline && location == "" {
+		return entities.EventResponse{}, web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors:  []web.ValidationErrorItem{{Field: "location", Error: "Location field must be filled for in-person and hybrid events"}},
+		}
+	}
+
+	// Slug tidak ikut berubah saat judul diganti, hanya jika dikirim.
+	// Slug baru dicek di sini, tetapi baru diganti setelah event berhasil disimpan
+	newSlug := eventRequest.Slug
+	eventRequest.Slug = ""
+	renameSlug := newSlug != "" && (event.Slug == nil || *event.Slug != newSlug)
+	if renameSlug {
+		if err := service.checkSlug(newSlug, event.ID); err != nil {
+			return entities.EventResponse{}, err
+		}
+	}
+	tags := eventTagNames(event)
+	before := revisionService.Snapshot(event, tags)
 	if eventRequest.DatetimeEvent != "" {
 		datetime, err := time.Parse("2006-01-02", eventRequest.DatetimeEvent)
 		if err != nil {
@@ -206,10 +650,24 @@ func (service EventService) Update(eventRequest entities.EventRequest, id int, u
 		}
 		event.DatetimeEvent = datetime
 	}
+	if eventRequest.DatetimeEventEnd != "" {
+		datetimeEnd, err := time.Parse("2006-01-02", eventRequest.DatetimeEventEnd)
+		if err != nil {
+			return entities.EventResponse{}, web.WebError{Code: 400, Message: "date time event end format is invalid"}
+		}
+		event.DatetimeEventEnd = &datetimeEnd
+	}
+	if event.DatetimeEventEnd != nil && event.DatetimeEventEnd.Before(event.DatetimeEvent) {
+		return entities.EventResponse{}, web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors:  []web.ValidationErrorItem{{Field: "datetime_event_end", Error: "datetime_event_end cannot be before datetime_event"}},
+		}
+	}
 	if cover != nil {
 
-		// Delete previous cover
-		if event.Cover != "" {
+		// Delete previous cover, unless it is one of the gallery images
+		if event.Cover != "" && !isGalleryImage(event, event.Cover) {
 			u, _ := url.Parse(event.Cover)
 			objectPathS3 := strings.TrimPrefix(u.Path, "/")
 			storageProvider.Delete(objectPathS3)
@@ -225,14 +683,43 @@ func (service EventService) Update(eventRequest entities.EventRequest, id int, u
 	}
 	// Copy request to found event
 	copier.CopyWithOption(&event, &eventRequest, copier.Option{IgnoreEmpty: true, DeepCopy: true})
+	if eventRequest.HideAddress != nil {
+		event.HideAddress = *eventRequest.HideAddress
+	}
+	if eventRequest.Tags != nil {
+		tags = tagNames
+	}
+	after := revisionService.Snapshot(event, tags)
 
 	// repository action
 	event.Participants = nil
+	event.RSVPs = nil
+	event.Cohosts = nil
+	event.Tags = nil
+	event.TicketTypes = nil
+	event.Images = nil
+	event.Rating = nil
+	event.Group = nil
 
 	event, err = service.eventRepo.Update(event, id)
 	if err != nil {
 		return entities.EventResponse{}, err
 	}
+	if renameSlug {
+		if err := service.changeSlug(&event, newSlug); err != nil {
+			return entities.EventResponse{}, err
+		}
+	}
+
+	// Tags diganti hanya jika field tags dikirim, "tags=" menghapus semua tag
+	if eventRequest.Tags != nil {
+		_, err = service.tagRepo.SyncEvent(event.ID, tagNames)
+		if err != nil {
+			return entities.EventResponse{}, err
+		}
+	}
+	service.syncSearchIndex(int(event.ID), searchProvider)
+	service.storeRevision(event.ID, user.ID, before, after)
 
 	// get event data
 	eventRes, err := service.Find(int(event.ID))
@@ -248,7 +735,7 @@ func (service EventService) Update(eventRequest entities.EventRequest, id int, u
  * Delete resource data
  * --------------------------
  */
-func (service EventService) Delete(id int, userID int, storageProvider storageProvider.StorageInterface) error {
+func (service EventService) Delete(id int, userID int, storageProvider storageProvider.StorageInterface, searchProvider searchProvider.SearchInterface) error {
 	// Find event
 	event, err := service.eventRepo.Find(id)
 	if err != nil {
@@ -260,18 +747,130 @@ func (service EventService) Delete(id int, userID int, storageProvider storagePr
 	if err != nil {
 		return web.WebError{Code: 400, Message: "No user matched with this authenticated user"}
 	}
-	if event.UserID != user.ID {
-		return web.WebError{Code: 401, Message: "Cannot update event that belongs to someone else"}
+	if !cohostService.Can(event, int(user.ID), cohostService.PermissionDelete) {
+		return web.WebError{Code: 401, Message: "Cannot delete event that belongs to someone else"}
+	}
+	return service.remove(event, storageProvider, searchProvider)
+}
+
+/*
+ * --------------------------
+ * Remove event by moderator
+ * --------------------------
+ * Menghapus event tanpa pengecekan kepemilikan,
+ * hak akses moderator dicek oleh service moderation
+ */
+func (service EventService) Remove(id int, storageProvider storageProvider.StorageInterface, searchProvider searchProvider.SearchInterface) error {
+	event, err := service.eventRepo.Find(id)
+	if err != nil {
+		return web.WebError{Code: 400, Message: "The requested ID doesn't match with any record"}
+	}
+	return service.remove(event, storageProvider, searchProvider)
+}
+
+func (service EventService) remove(event entities.Event, storageProvider storageProvider.StorageInterface, searchProvider searchProvider.SearchInterface) error {
+	id := int(event.ID)
+	for _, ticketType := range event.TicketTypes {
+		if ticketType.Sold+ticketType.Reserved > 0 {
+			return web.WebError{Code: 400, Message: "Cannot delete event that already has ticket orders, refund them first"}
+		}
 	}
 
 	// Delete previous cover
-	if event.Cover != "" {
+	if event.Cover != "" && !isGalleryImage(event, event.Cover) {
 		u, _ := url.Parse(event.Cover)
 		objectPathS3 := strings.TrimPrefix(u.Path, "/")
 		storageProvider.Delete(objectPathS3)
 	}
 
+	// Delete gallery images
+	for _, image := range event.Images {
+		u, _ := url.Parse(image.URL)
+		storageProvider.Delete(strings.TrimPrefix(u.Path, "/"))
+	}
+
 	// Repository action
-	err = service.eventRepo.Delete(id)
-	return err
+	err := service.eventRepo.Delete(id)
+	if err != nil {
+		return err
+	}
+
+	// Remove from search index
+	err = searchProvider.DeleteEvent(uint(id))
+	if err != nil {
+		log.Warn("Cannot remove event " + strconv.Itoa(id) + " from search index: " + err.Error())
+	}
+	return nil
+}
+
+/*
+ * --------------------------
+ * Hide event by moderator
+ * --------------------------
+ * Event yang disembunyikan tidak tampil di list, pencarian
+ * maupun detail, kecuali untuk tim host event tersebut
+ */
+func (service EventService) Hide(id int, hidden bool, searchProvider searchProvider.SearchInterface) error {
+	event, err := service.eventRepo.Find(id)
+	if err != nil {
+		return web.WebError{Code: 400, Message: "The requested ID doesn't match with any record"}
+	}
+	event.HiddenAt = nil
+	if hidden {
+		now := time.Now()
+		event.HiddenAt = &now
+	}
+
+	event.Participants = nil
+	event.RSVPs = nil
+	event.Cohosts = nil
+	event.Tags = nil
+	event.TicketTypes = nil
+	event.Images = nil
+	event.Rating = nil
+	event.Group = nil
+	_, err = service.eventRepo.Update(event, id)
+	if err != nil {
+		return err
+	}
+	service.syncSearchIndex(id, searchProvider)
+	return nil
+}
+
+func isGalleryImage(event entities.Event, imageURL string) bool {
+	for _, image := range event.Images {
+		if image.URL == imageURL {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+ * Store revision
+ * -------------------------------
+ * Menyimpan field yang berubah pada update event, kegagalan
+ * hanya dicatat ke log karena event sudah berhasil diupdate
+ */
+func (service EventService) storeRevision(eventID uint, userID uint, before, after map[string]string) {
+	changes := revisionService.Diff(before, after)
+	if len(changes) == 0 {
+		return
+	}
+	_, err := service.revisionRepo.Store(entities.EventRevision{
+		EventID: eventID,
+		UserID:  userID,
+		Changes: revisionService.EncodeChanges(changes),
+	})
+	if err != nil {
+		log.Warn("Cannot store revision of event " + strconv.Itoa(int(eventID)) + ": " + err.Error())
+	}
+}
+
+func eventTagNames(event entities.Event) []string {
+	names := []string{}
+	for _, tag := range event.Tags {
+		names = append(names, tag.Name)
+	}
+	return names
 }
@@ -4,14 +4,27 @@ import (
 	"mime/multipart"
 	"tupulung/entities"
 	web "tupulung/entities/web"
+	searchProvider "tupulung/utilities/search"
 	storageProvider "tupulung/utilities/storage"
 )
 
 type EventServiceInterface interface {
 	FindAll(limit, page int, filters []map[string]string, sorts []map[string]interface{}) ([]entities.EventResponse, error)
+	BuildListFilters(query entities.EventListQuery) ([]map[string]string, []map[string]interface{}, error)
 	GetPagination(limit, page int, filters []map[string]string) (web.Pagination, error)
+	Search(keyword string, limit, page int, filters []map[string]string, sorts []map[string]interface{}, searchProvider searchProvider.SearchInterface) ([]entities.EventResponse, web.Pagination, error)
+	Suggest(prefix string, limit int, searchProvider searchProvider.SearchInterface) ([]entities.EventSuggestionResponse, error)
+	Reindex(searchProvider searchProvider.SearchInterface) error
+	Trending(categoryID string, limit, page int) ([]entities.EventResponse, web.Pagination, error)
+	RecordView(eventID int, viewerID int, ip string) error
+	Recommended(userID int, limit int) ([]entities.EventResponse, error)
 	Find(id int) (entities.EventResponse, error)
-	Create(eventRequest entities.EventRequest, userID int, cover *multipart.FileHeader, storageProvider storageProvider.StorageInterface) (entities.EventResponse, error)
-	Update(eventRequest entities.EventRequest, id int, userID int, cover *multipart.FileHeader, storageProvider storageProvider.StorageInterface) (entities.EventResponse, error)
-	Delete(id int, userID int, storageProvider storageProvider.StorageInterface) error
+	FindForViewer(id int, viewerID int, inviteCode string) (entities.EventResponse, error)
+	FindBySlug(slug string, viewerID int, inviteCode string) (entities.EventResponse, string, error)
+	BackfillSlugs() error
+	Create(eventRequest entities.EventRequest, userID int, cover *multipart.FileHeader, storageProvider storageProvider.StorageInterface, searchProvider searchProvider.SearchInterface) (entities.EventResponse, error)
+	Update(eventRequest entities.EventRequest, id int, userID int, cover *multipart.FileHeader, storageProvider storageProvider.StorageInterface, searchProvider searchProvider.SearchInterface) (entities.EventResponse, error)
+	Duplicate(duplicateRequest entities.EventDuplicateRequest, id int, userID int, cover *multipart.FileHeader, storageProvider storageProvider.StorageInterface, searchProvider searchProvider.SearchInterface) (entities.EventResponse, error)
+	CreateFromTemplate(eventRequest entities.EventRequest, templateID int, userID int, cover *multipart.FileHeader, storageProvider storageProvider.StorageInterface, searchProvider searchProvider.SearchInterface) (entities.EventResponse, error)
+	Delete(id int, userID int, storageProvider storageProvider.StorageInterface, searchProvider searchProvider.SearchInterface) error
 }
@@ -3,18 +3,28 @@ package event_test
 import (
 	"mime/multipart"
 	"net/textproto"
+	"strings"
 	"testing"
+	"time"
 	"tupulung/entities"
 	web "tupulung/entities/web"
 	eventRepository "tupulung/repositories/event"
+	inviteRepository "tupulung/repositories/invite"
 	likeRepository "tupulung/repositories/like"
+	revisionRepository "tupulung/repositories/revision"
+	slugRepository "tupulung/repositories/slug"
+	tagRepository "tupulung/repositories/tag"
+	templateRepository "tupulung/repositories/template"
+	trendingRepository "tupulung/repositories/trending"
 	userRepository "tupulung/repositories/user"
 	eventService "tupulung/services/event"
+	_searchProvider "tupulung/utilities/search"
 	_storageProvider "tupulung/utilities/storage"
 
 	"github.com/jinzhu/copier"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
 )
 
 func TestFindAll(t *testing.T) {
@@ -34,6 +44,12 @@ func TestFindAll(t *testing.T) {
 			eventRepositoryMock,
 			userRepository.NewUserRepositoryMock(&mock.Mock{}),
 			likeRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
 		)
 		data, err := service.FindAll(0, 0, []map[string]string{}, []map[string]interface{}{})
 
@@ -59,6 +75,12 @@ func TestFindAll(t *testing.T) {
 			eventRepositoryMock,
 			userRepository.NewUserRepositoryMock(&mock.Mock{}),
 			likeRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
 		)
 		data, err := service.FindAll(0, 0, []map[string]string{}, []map[string]interface{}{})
 
@@ -70,6 +92,93 @@ func TestFindAll(t *testing.T) {
 	})
 }
 
+func TestBuildListFilters(t *testing.T) {
+	newService := func() *eventService.EventService {
+		return eventService.NewEventService(
+			eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			likeRepository.NewLikeRepositoryMock(&mock.Mock{}),
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
+		)
+	}
+	t.Run("success", func(t *testing.T) {
+		filters, sorts, err := newService().BuildListFilters(entities.EventListQuery{
+			CategoryID:   "1, 2",
+			HostID:       3,
+			DateFrom:     "2022-01-01",
+			DateTo:       "2022-01-31",
+			HasFreeSeats: true,
+			MinLikes:     5,
+			Sort:         "most_liked",
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, []map[string]string{
+			{"field": "category_id", "operator": "IN", "value": "1,2"},
+			{"field": "user_id", "operator": "=", "value": "3"},
+			{"field": "datetime_event", "operator": ">=", "value": "2022-01-01 00:00:00"},
+			{"field": "datetime_event", "operator": "<=", "value": "2022-01-31 23:59:59"},
+			{"field": "free_seats", "operator": ">", "value": "0"},
+			{"field": "likes", "operator": ">=", "value": "5"},
+			{"field": "visibility", "operator": "=", "value": "public"},
+		}, filters)
+		assert.Equal(t, []map[string]interface{}{{"field": "likes", "desc": true}}, sorts)
+	})
+	t.Run("date-preset", func(t *testing.T) {
+		filters, _, err := newService().BuildListFilters(entities.EventListQuery{Date: "this_weekend"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, 3, len(filters))
+		from, _ := time.ParseInLocation("2006-01-02 15:04:05", filters[0]["value"], time.Local)
+		to, _ := time.ParseInLocation("2006-01-02 15:04:05", filters[1]["value"], time.Local)
+		assert.Equal(t, time.Saturday, from.Weekday())
+		assert.Equal(t, time.Monday, to.Weekday())
+	})
+	t.Run("tags", func(t *testing.T) {
+		filters, _, err := newService().BuildListFilters(entities.EventListQuery{Tags: "Go, Hackathon,go", TagsMode: "all"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]string{"field": "tags", "operator": "ALL", "value": "go,hackathon"}, filters[0])
+
+		filters, _, err = newService().BuildListFilters(entities.EventListQuery{Tags: "golang"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]string{"field": "tags", "operator": "IN", "value": "golang"}, filters[0])
+	})
+	t.Run("mode-location", func(t *testing.T) {
+		filters, _, err := newService().BuildListFilters(entities.EventListQuery{Mode: "hybrid", Location: "Jakarta"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, []map[string]string{
+			{"field": "mode", "operator": "=", "value": "hybrid"},
+			{"field": "location", "operator": "LIKE", "value": "%Jakarta%"},
+			{"field": "hide_address", "operator": "=", "value": "0"},
+			{"field": "visibility", "operator": "=", "value": "public"},
+		}, filters)
+	})
+	t.Run("trending", func(t *testing.T) {
+		_, sorts, err := newService().BuildListFilters(entities.EventListQuery{Sort: "trending"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, []map[string]interface{}{{"field": "trending", "desc": true}}, sorts)
+	})
+	t.Run("validation-fail", func(t *testing.T) {
+		_, _, err := newService().BuildListFilters(entities.EventListQuery{
+			CategoryID: "1,drop table",
+			Date:       "tomorrow",
+			Sort:       "title; DROP TABLE events",
+		})
+
+		assert.Error(t, err)
+		assert.Equal(t, 3, len(err.(web.ValidationError).Errors))
+	})
+}
+
 func TestGetPagination(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
@@ -79,6 +188,12 @@ func TestGetPagination(t *testing.T) {
 			eventRepositoryMock,
 			userRepository.NewUserRepositoryMock(&mock.Mock{}),
 			likeRepository.NewLikeRepositoryMock(&mock.Mock{}),
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
 		)
 		actual, err := Service.GetPagination(5, 1, []map[string]string{})
 
@@ -99,6 +214,12 @@ func TestGetPagination(t *testing.T) {
 			eventRepositoryMock,
 			userRepository.NewUserRepositoryMock(&mock.Mock{}),
 			likeRepository.NewLikeRepositoryMock(&mock.Mock{}),
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
 		)
 		actual, err := Service.GetPagination(5, 1, []map[string]string{})
 		assert.Error(t, err)
@@ -112,6 +233,12 @@ func TestGetPagination(t *testing.T) {
 			eventRepositoryMock,
 			userRepository.NewUserRepositoryMock(&mock.Mock{}),
 			likeRepository.NewLikeRepositoryMock(&mock.Mock{}),
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
 		)
 		actual, err := Service.GetPagination(1, 1, []map[string]string{})
 
@@ -131,6 +258,12 @@ func TestGetPagination(t *testing.T) {
 			eventRepositoryMock,
 			userRepository.NewUserRepositoryMock(&mock.Mock{}),
 			likeRepository.NewLikeRepositoryMock(&mock.Mock{}),
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
 		)
 		actual, err := Service.GetPagination(1, 0, []map[string]string{})
 
@@ -150,6 +283,12 @@ func TestGetPagination(t *testing.T) {
 			eventRepositoryMock,
 			userRepository.NewUserRepositoryMock(&mock.Mock{}),
 			likeRepository.NewLikeRepositoryMock(&mock.Mock{}),
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
 		)
 		actual, err := Service.GetPagination(1, 5, []map[string]string{})
 
@@ -163,6 +302,231 @@ func TestGetPagination(t *testing.T) {
 	})
 }
 
+func TestSearch(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		likeRepositoryMock := likeRepository.NewLikeRepositoryMock(&mock.Mock{})
+		likeRepositoryMock.Mock.On("CountLikeByEvent").Return(0, nil)
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On(
+			"FindAll",
+			2, 0,
+			[]map[string]string{{"field": "id", "operator": "IN", "value": "2,1"}},
+			[]map[string]interface{}{},
+		).Return(append([]entities.Event{}, eventRepository.EventCollection...), nil)
+		searchProvider := _searchProvider.NewSearchMock(&mock.Mock{})
+		searchProvider.Mock.On("SearchEvents", "teknologi", 0).Return([]entities.EventSearchHit{
+			{ID: 2, Score: 0.8, Highlights: map[string][]string{"title": {"Seminar <mark>Teknologi</mark>"}}},
+			{ID: 1, Score: 0.1},
+		}, nil)
+
+		service := eventService.NewEventService(
+			eventRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			likeRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
+		)
+		data, pagination, err := service.Search("teknologi", 10, 1, []map[string]string{}, []map[string]interface{}{}, searchProvider)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(data))
+		assert.Equal(t, uint(2), data[0].ID)
+		assert.Equal(t, 0.8, data[0].Score)
+		assert.Equal(t, []string{"Seminar <mark>Teknologi</mark>"}, data[0].Highlights["title"])
+		assert.Equal(t, web.Pagination{Page: 1, Limit: 10, TotalPages: 1}, pagination)
+	})
+	t.Run("no-hits", func(t *testing.T) {
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		searchProvider := _searchProvider.NewSearchMock(&mock.Mock{})
+		searchProvider.Mock.On("SearchEvents", "nothing", 0).Return([]entities.EventSearchHit{}, nil)
+
+		service := eventService.NewEventService(
+			eventRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			likeRepository.NewLikeRepositoryMock(&mock.Mock{}),
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
+		)
+		data, pagination, err := service.Search("nothing", 10, 1, []map[string]string{}, []map[string]interface{}{}, searchProvider)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []entities.EventResponse{}, data)
+		assert.Equal(t, 0, pagination.TotalPages)
+		eventRepositoryMock.Mock.AssertNotCalled(t, "FindAll")
+	})
+	t.Run("search-fail", func(t *testing.T) {
+		searchProvider := _searchProvider.NewSearchMock(&mock.Mock{})
+		searchProvider.Mock.On("SearchEvents", "seminar", 0).Return([]entities.EventSearchHit{}, web.WebError{Code: 500})
+
+		service := eventService.NewEventService(
+			eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			likeRepository.NewLikeRepositoryMock(&mock.Mock{}),
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
+		)
+		data, _, err := service.Search("seminar", 10, 1, []map[string]string{}, []map[string]interface{}{}, searchProvider)
+
+		assert.Error(t, err)
+		assert.Equal(t, []entities.EventResponse{}, data)
+	})
+	t.Run("page-out-of-range", func(t *testing.T) {
+		likeRepositoryMock := likeRepository.NewLikeRepositoryMock(&mock.Mock{})
+		likeRepositoryMock.Mock.On("CountLikeByEvent").Return(0, nil)
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("FindAll", 1, 0, mock.Anything, mock.Anything).Return(eventRepository.EventCollection[:1], nil)
+		searchProvider := _searchProvider.NewSearchMock(&mock.Mock{})
+		searchProvider.Mock.On("SearchEvents", "seminar", 0).Return([]entities.EventSearchHit{{ID: 1, Score: 1}}, nil)
+
+		service := eventService.NewEventService(
+			eventRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			likeRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
+		)
+		data, pagination, err := service.Search("seminar", 10, 3, []map[string]string{}, []map[string]interface{}{}, searchProvider)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []entities.EventResponse{}, data)
+		assert.Equal(t, 1, pagination.TotalPages)
+	})
+	t.Run("multiple-batches", func(t *testing.T) {
+		likeRepositoryMock := likeRepository.NewLikeRepositoryMock(&mock.Mock{})
+		likeRepositoryMock.Mock.On("CountLikeByEvent").Return(0, nil)
+		firstBatch := []entities.EventSearchHit{}
+		for i := 0; i < 500; i++ {
+			firstBatch = append(firstBatch, entities.EventSearchHit{ID: uint(i + 100), Score: 1})
+		}
+		secondEvent := eventRepository.EventCollection[1]
+		filters := []map[string]string{{"field": "category_id", "operator": "=", "value": "1"}}
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("FindAll", 500, 0, mock.Anything, mock.Anything).Return(eventRepository.EventCollection[:1], nil)
+		eventRepositoryMock.Mock.On(
+			"FindAll",
+			1, 0,
+			[]map[string]string{filters[0], {"field": "id", "operator": "IN", "value": "2"}},
+			[]map[string]interface{}{},
+		).Return([]entities.Event{secondEvent}, nil)
+		searchProvider := _searchProvider.NewSearchMock(&mock.Mock{})
+		searchProvider.Mock.On("SearchEvents", "seminar", 0).Return(firstBatch, nil)
+		searchProvider.Mock.On("SearchEvents", "seminar", 500).Return([]entities.EventSearchHit{{ID: 2, Score: 2}}, nil)
+
+		service := eventService.NewEventService(
+			eventRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			likeRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
+		)
+		data, pagination, err := service.Search("seminar", 1, 1, filters, []map[string]interface{}{}, searchProvider)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(data))
+		assert.Equal(t, secondEvent.ID, data[0].ID)
+		assert.Equal(t, 2, pagination.TotalPages)
+	})
+	t.Run("sorted", func(t *testing.T) {
+		likeRepositoryMock := likeRepository.NewLikeRepositoryMock(&mock.Mock{})
+		likeRepositoryMock.Mock.On("CountLikeByEvent").Return(0, nil)
+		sorts := []map[string]interface{}{{"field": "datetime_event", "desc": false}}
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("FindAll", 2, 0, mock.Anything, []map[string]interface{}{}).Return(append([]entities.Event{}, eventRepository.EventCollection...), nil)
+		eventRepositoryMock.Mock.On(
+			"FindAll",
+			1, 1,
+			[]map[string]string{{"field": "id", "operator": "IN", "value": "1,2"}},
+			sorts,
+		).Return(eventRepository.EventCollection[1:], nil)
+		searchProvider := _searchProvider.NewSearchMock(&mock.Mock{})
+		searchProvider.Mock.On("SearchEvents", "seminar", 0).Return([]entities.EventSearchHit{{ID: 2, Score: 2}, {ID: 1, Score: 1}}, nil)
+
+		service := eventService.NewEventService(
+			eventRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			likeRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
+		)
+		data, pagination, err := service.Search("seminar", 1, 2, []map[string]string{}, sorts, searchProvider)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(data))
+		assert.Equal(t, eventRepository.EventCollection[1].ID, data[0].ID)
+		assert.Equal(t, 2, pagination.TotalPages)
+	})
+}
+
+func TestReindex(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("FindAll", -1, -1, mock.Anything, mock.Anything).Return(eventRepository.EventCollection, nil)
+		searchProvider := _searchProvider.NewSearchMock(&mock.Mock{})
+		searchProvider.Mock.On("IndexEvent").Return(nil)
+
+		service := eventService.NewEventService(
+			eventRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			likeRepository.NewLikeRepositoryMock(&mock.Mock{}),
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
+		)
+		err := service.Reindex(searchProvider)
+
+		assert.Nil(t, err)
+		searchProvider.Mock.AssertNumberOfCalls(t, "IndexEvent", len(eventRepository.EventCollection))
+	})
+	t.Run("index-fail", func(t *testing.T) {
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("FindAll", -1, -1, mock.Anything, mock.Anything).Return(eventRepository.EventCollection, nil)
+		searchProvider := _searchProvider.NewSearchMock(&mock.Mock{})
+		searchProvider.Mock.On("IndexEvent").Return(web.WebError{Code: 500})
+
+		service := eventService.NewEventService(
+			eventRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			likeRepository.NewLikeRepositoryMock(&mock.Mock{}),
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
+		)
+		err := service.Reindex(searchProvider)
+
+		assert.Error(t, err)
+	})
+}
+
 func TestFind(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		likeRepositoryMock := likeRepository.NewLikeRepositoryMock(&mock.Mock{})
@@ -176,11 +540,79 @@ func TestFind(t *testing.T) {
 			eventRepositoryMock,
 			userRepositoryMock,
 			likeRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
 		)
 		_, err := Service.Find(int(eventSample.ID))
 
 		assert.Nil(t, err)
 	})
+	t.Run("rsvp-counts", func(t *testing.T) {
+		likeRepositoryMock := likeRepository.NewLikeRepositoryMock(&mock.Mock{})
+		likeRepositoryMock.Mock.On("CountLikeByEvent").Return(0, nil)
+		eventSample := eventRepository.EventCollection[0]
+		eventSample.Participants = []entities.User{userRepository.UserCollection[0], userRepository.UserCollection[1]}
+		eventSample.RSVPs = []entities.Participant{
+			{ID: 1, EventID: eventSample.ID, UserID: userRepository.UserCollection[0].ID, Status: "going"},
+			{ID: 2, EventID: eventSample.ID, UserID: userRepository.UserCollection[1].ID, Status: "maybe"},
+		}
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+
+		Service := eventService.NewEventService(
+			eventRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			likeRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
+		)
+		data, err := Service.Find(int(eventSample.ID))
+
+		assert.Nil(t, err)
+		assert.Equal(t, entities.RSVPCountResponse{Going: 1, Maybe: 1}, data.RSVP)
+		assert.Equal(t, 1, len(data.Participants))
+		assert.Equal(t, userRepository.UserCollection[0].ID, data.Participants[0].ID)
+	})
+	t.Run("hidden-email", func(t *testing.T) {
+		likeRepositoryMock := likeRepository.NewLikeRepositoryMock(&mock.Mock{})
+		likeRepositoryMock.Mock.On("CountLikeByEvent").Return(0, nil)
+		hiddenUser := userRepository.UserCollection[1]
+		hiddenUser.HideEmail = true
+		eventSample := eventRepository.EventCollection[0]
+		eventSample.Participants = []entities.User{userRepository.UserCollection[0], hiddenUser}
+		eventSample.User = hiddenUser
+		eventSample.Cohosts = []entities.EventCohost{{EventID: eventSample.ID, UserID: hiddenUser.ID, Role: "owner", Status: "accepted", User: hiddenUser}}
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+
+		Service := eventService.NewEventService(
+			eventRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			likeRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
+		)
+		data, err := Service.Find(int(eventSample.ID))
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(data.Participants))
+		assert.Equal(t, userRepository.UserCollection[0].Email, data.Participants[0].Email)
+		assert.Equal(t, "", data.Participants[1].Email)
+		assert.Equal(t, "", data.User.Email)
+		assert.Equal(t, "", data.Cohosts[0].User.Email)
+	})
 	t.Run("failed", func(t *testing.T) {
 		likeRepositoryMock := likeRepository.NewLikeRepositoryMock(&mock.Mock{})
 		likeRepositoryMock.Mock.On("CountLikeByEvent").Return(0, nil)
@@ -193,6 +625,12 @@ func TestFind(t *testing.T) {
 			eventRepositoryMock,
 			userRepositoryMock,
 			likeRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
 		)
 		_, err := Service.Find(int(eventSample.ID))
 
@@ -200,6 +638,127 @@ func TestFind(t *testing.T) {
 	})
 }
 
+func TestFindForViewer(t *testing.T) {
+	newService := func(event entities.Event, inviteRepositoryMock *inviteRepository.InviteRepositoryMock) *eventService.EventService {
+		likeRepositoryMock := likeRepository.NewLikeRepositoryMock(&mock.Mock{})
+		likeRepositoryMock.Mock.On("CountLikeByEvent").Return(0, nil)
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(event, nil)
+		return eventService.NewEventService(
+			eventRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			likeRepositoryMock,
+			inviteRepositoryMock,
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
+		)
+	}
+	t.Run("public", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventSample.Visibility = "public"
+		inviteRepositoryMock := inviteRepository.NewInviteRepositoryMock(&mock.Mock{})
+
+		data, err := newService(eventSample, inviteRepositoryMock).FindForViewer(int(eventSample.ID), 0, "")
+
+		assert.Nil(t, err)
+		assert.Equal(t, eventSample.ID, data.ID)
+		inviteRepositoryMock.Mock.AssertNotCalled(t, "FindUsableByUser")
+	})
+	t.Run("private-host", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventSample.Visibility = "private"
+		inviteRepositoryMock := inviteRepository.NewInviteRepositoryMock(&mock.Mock{})
+
+		data, err := newService(eventSample, inviteRepositoryMock).FindForViewer(int(eventSample.ID), int(eventSample.UserID), "")
+
+		assert.Nil(t, err)
+		assert.Equal(t, eventSample.ID, data.ID)
+	})
+	t.Run("private-invite-code", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventSample.Visibility = "private"
+		inviteRepositoryMock := inviteRepository.NewInviteRepositoryMock(&mock.Mock{})
+		inviteRepositoryMock.Mock.On("FindUsableByCode").Return(inviteRepository.InviteCollection[1], nil)
+
+		data, err := newService(eventSample, inviteRepositoryMock).FindForViewer(int(eventSample.ID), 0, inviteRepository.InviteCollection[1].Code)
+
+		assert.Nil(t, err)
+		assert.Equal(t, eventSample.ID, data.ID)
+	})
+	t.Run("private-not-invited", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventSample.Visibility = "private"
+		inviteRepositoryMock := inviteRepository.NewInviteRepositoryMock(&mock.Mock{})
+		inviteRepositoryMock.Mock.On("FindUsableByCode").Return(entities.EventInvite{}, web.WebError{Code: 400})
+		inviteRepositoryMock.Mock.On("FindUsableByUser").Return(entities.EventInvite{}, web.WebError{Code: 400})
+
+		data, err := newService(eventSample, inviteRepositoryMock).FindForViewer(int(eventSample.ID), 2, "revoked-code")
+
+		assert.Equal(t, web.WebError{Code: 400, Message: "cannot get event data with specified id"}, err)
+		assert.Equal(t, entities.EventResponse{}, data)
+	})
+	onlineSample := func() entities.Event {
+		eventSample := eventRepository.EventCollection[0]
+		eventSample.Visibility = "public"
+		eventSample.Mode = "hybrid"
+		eventSample.MeetingURL = "https://meet.example.com/seminar"
+		eventSample.DialIn = "+62 21 555 0100, PIN 1234"
+		eventSample.HideAddress = true
+		eventSample.RSVPs = []entities.Participant{{EventID: eventSample.ID, UserID: 3, Status: "maybe"}}
+		return eventSample
+	}
+	t.Run("redacted-for-guest", func(t *testing.T) {
+		eventSample := onlineSample()
+		inviteRepositoryMock := inviteRepository.NewInviteRepositoryMock(&mock.Mock{})
+
+		data, err := newService(eventSample, inviteRepositoryMock).FindForViewer(int(eventSample.ID), 2, "")
+
+		assert.Nil(t, err)
+		assert.True(t, data.Redacted)
+		assert.Equal(t, "", data.MeetingURL)
+		assert.Equal(t, "", data.DialIn)
+		assert.Equal(t, "", data.Location)
+		assert.Equal(t, "hybrid", data.Mode)
+	})
+	t.Run("revealed-for-participant", func(t *testing.T) {
+		eventSample := onlineSample()
+		inviteRepositoryMock := inviteRepository.NewInviteRepositoryMock(&mock.Mock{})
+
+		data, err := newService(eventSample, inviteRepositoryMock).FindForViewer(int(eventSample.ID), 3, "")
+
+		assert.Nil(t, err)
+		assert.False(t, data.Redacted)
+		assert.Equal(t, eventSample.MeetingURL, data.MeetingURL)
+		assert.Equal(t, eventSample.DialIn, data.DialIn)
+		assert.Equal(t, eventSample.Location, data.Location)
+	})
+	t.Run("revealed-for-host", func(t *testing.T) {
+		eventSample := onlineSample()
+		inviteRepositoryMock := inviteRepository.NewInviteRepositoryMock(&mock.Mock{})
+
+		data, err := newService(eventSample, inviteRepositoryMock).FindForViewer(int(eventSample.ID), int(eventSample.UserID), "")
+
+		assert.Nil(t, err)
+		assert.False(t, data.Redacted)
+		assert.Equal(t, eventSample.MeetingURL, data.MeetingURL)
+	})
+	t.Run("address-visible", func(t *testing.T) {
+		eventSample := onlineSample()
+		eventSample.HideAddress = false
+		inviteRepositoryMock := inviteRepository.NewInviteRepositoryMock(&mock.Mock{})
+
+		data, err := newService(eventSample, inviteRepositoryMock).FindForViewer(int(eventSample.ID), 0, "")
+
+		assert.Nil(t, err)
+		assert.True(t, data.Redacted)
+		assert.Equal(t, "", data.MeetingURL)
+		assert.Equal(t, eventSample.Location, data.Location)
+	})
+}
+
 func TestCreate(t *testing.T) {
 	sampleCentral := eventRepository.EventCollection[0]
 	sampleUser := userRepository.UserCollection[0]
@@ -226,6 +785,9 @@ func TestCreate(t *testing.T) {
 		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
 		userRepositoryMock.Mock.On("Find").Return(sampleUser, nil)
 		storageProvider := _storageProvider.NewStorageMock(&mock.Mock{})
+		searchProvider := _searchProvider.NewSearchMock(&mock.Mock{})
+		searchProvider.Mock.On("IndexEvent").Return(nil)
+		searchProvider.Mock.On("DeleteEvent").Return(nil)
 		storageProvider.Mock.On("UploadFromRequest").Return("example.com/images.png", nil)
 		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
 		eventRepositoryMock.Mock.On("Store").Return(sampleEvent, nil)
@@ -233,12 +795,21 @@ func TestCreate(t *testing.T) {
 		likeRepositoryMock := likeRepository.NewLikeRepositoryMock(&mock.Mock{})
 		likeRepositoryMock.Mock.On("CountLikeByEvent").Return(0, nil)
 
+		slugRepositoryMock := slugRepository.NewSlugRepositoryMock(&mock.Mock{})
+		slugRepositoryMock.Mock.On("IsTaken", mock.Anything).Return(false, nil)
+
 		Service := eventService.NewEventService(
 			eventRepositoryMock,
 			userRepositoryMock,
 			likeRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepositoryMock,
 		)
-		actual, err := Service.Create(sampleRequest, int(sampleUser.ID), sampleFileRequest, storageProvider)
+		actual, err := Service.Create(sampleRequest, int(sampleUser.ID), sampleFileRequest, storageProvider, searchProvider)
 
 		expected := entities.EventResponse{}
 		copier.Copy(&expected, &sampleEvent)
@@ -246,6 +817,66 @@ func TestCreate(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, expected.ID, actual.ID)
 	})
+	t.Run("with-tags", func(t *testing.T) {
+		sampleEvent := sampleCentral
+		sampleRequest := sampleRequestCentral
+		sampleRequest.Tags = []string{"Golang, Meetup", "golang"}
+
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(sampleUser, nil)
+		searchProvider := _searchProvider.NewSearchMock(&mock.Mock{})
+		searchProvider.Mock.On("IndexEvent").Return(nil)
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Store").Return(sampleEvent, nil)
+		eventRepositoryMock.Mock.On("Find").Return(sampleEvent, nil)
+		likeRepositoryMock := likeRepository.NewLikeRepositoryMock(&mock.Mock{})
+		likeRepositoryMock.Mock.On("CountLikeByEvent").Return(0, nil)
+		tagRepositoryMock := tagRepository.NewTagRepositoryMock(&mock.Mock{})
+		tagRepositoryMock.Mock.On("SyncEvent", []string{"golang", "meetup"}).Return(tagRepository.TagCollection, nil)
+
+		slugRepositoryMock := slugRepository.NewSlugRepositoryMock(&mock.Mock{})
+		slugRepositoryMock.Mock.On("IsTaken", mock.Anything).Return(false, nil)
+
+		Service := eventService.NewEventService(
+			eventRepositoryMock,
+			userRepositoryMock,
+			likeRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepositoryMock,
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepositoryMock,
+		)
+		_, err := Service.Create(sampleRequest, int(sampleUser.ID), nil, _storageProvider.NewStorageMock(&mock.Mock{}), searchProvider)
+
+		assert.Nil(t, err)
+		tagRepositoryMock.Mock.AssertCalled(t, "SyncEvent", []string{"golang", "meetup"})
+	})
+	t.Run("too-many-tags", func(t *testing.T) {
+		sampleRequest := sampleRequestCentral
+		sampleRequest.Tags = []string{"a,b,c,d,e,f,g,h,i,j,k"}
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+
+		slugRepositoryMock := slugRepository.NewSlugRepositoryMock(&mock.Mock{})
+		slugRepositoryMock.Mock.On("IsTaken", mock.Anything).Return(false, nil)
+
+		Service := eventService.NewEventService(
+			eventRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			likeRepository.NewLikeRepositoryMock(&mock.Mock{}),
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepositoryMock,
+		)
+		_, err := Service.Create(sampleRequest, int(sampleUser.ID), nil, _storageProvider.NewStorageMock(&mock.Mock{}), _searchProvider.NewSearchMock(&mock.Mock{}))
+
+		assert.Equal(t, web.WebError{Code: 400, Message: "An event can have at most 10 tags"}, err)
+		eventRepositoryMock.Mock.AssertNotCalled(t, "Store")
+	})
 	t.Run("validation-fail", func(t *testing.T) {
 		sampleEvent := sampleCentral
 		sampleRequest := sampleRequestCentral
@@ -253,6 +884,9 @@ func TestCreate(t *testing.T) {
 
 		sampleRequest.Title = ""
 		storageProvider := _storageProvider.NewStorageMock(&mock.Mock{})
+		searchProvider := _searchProvider.NewSearchMock(&mock.Mock{})
+		searchProvider.Mock.On("IndexEvent").Return(nil)
+		searchProvider.Mock.On("DeleteEvent").Return(nil)
 		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
 		userRepositoryMock.Mock.On("Find").Return(sampleUser, nil)
 		storageProvider.Mock.On("UploadFromRequest").Return("example.com/images.png", nil)
@@ -262,12 +896,21 @@ func TestCreate(t *testing.T) {
 		likeRepositoryMock := likeRepository.NewLikeRepositoryMock(&mock.Mock{})
 		likeRepositoryMock.Mock.On("CountLikeByEvent").Return(0, nil)
 
+		slugRepositoryMock := slugRepository.NewSlugRepositoryMock(&mock.Mock{})
+		slugRepositoryMock.Mock.On("IsTaken", mock.Anything).Return(false, nil)
+
 		Service := eventService.NewEventService(
 			eventRepositoryMock,
 			userRepositoryMock,
 			likeRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepositoryMock,
 		)
-		actual, err := Service.Create(sampleRequest, int(sampleUser.ID), sampleFileRequest, storageProvider)
+		actual, err := Service.Create(sampleRequest, int(sampleUser.ID), sampleFileRequest, storageProvider, searchProvider)
 
 		expected := entities.EventResponse{}
 		copier.Copy(&expected, &sampleEvent)
@@ -284,6 +927,9 @@ func TestCreate(t *testing.T) {
 		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
 		userRepositoryMock.Mock.On("Find").Return(sampleUser, nil)
 		storageProvider := _storageProvider.NewStorageMock(&mock.Mock{})
+		searchProvider := _searchProvider.NewSearchMock(&mock.Mock{})
+		searchProvider.Mock.On("IndexEvent").Return(nil)
+		searchProvider.Mock.On("DeleteEvent").Return(nil)
 		storageProvider.Mock.On("UploadFromRequest").Return("example.com/images.png", nil)
 		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
 		eventRepositoryMock.Mock.On("Store").Return(sampleEvent, nil)
@@ -291,12 +937,21 @@ func TestCreate(t *testing.T) {
 		likeRepositoryMock := likeRepository.NewLikeRepositoryMock(&mock.Mock{})
 		likeRepositoryMock.Mock.On("CountLikeByEvent").Return(0, nil)
 
+		slugRepositoryMock := slugRepository.NewSlugRepositoryMock(&mock.Mock{})
+		slugRepositoryMock.Mock.On("IsTaken", mock.Anything).Return(false, nil)
+
 		Service := eventService.NewEventService(
 			eventRepositoryMock,
 			userRepositoryMock,
 			likeRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepositoryMock,
 		)
-		actual, err := Service.Create(sampleRequest, int(sampleUser.ID), sampleFileRequest, storageProvider)
+		actual, err := Service.Create(sampleRequest, int(sampleUser.ID), sampleFileRequest, storageProvider, searchProvider)
 
 		expected := entities.EventResponse{}
 		copier.Copy(&expected, &sampleEvent)
@@ -311,6 +966,9 @@ func TestCreate(t *testing.T) {
 		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
 		userRepositoryMock.Mock.On("Find").Return(sampleUser, nil)
 		storageProvider := _storageProvider.NewStorageMock(&mock.Mock{})
+		searchProvider := _searchProvider.NewSearchMock(&mock.Mock{})
+		searchProvider.Mock.On("IndexEvent").Return(nil)
+		searchProvider.Mock.On("DeleteEvent").Return(nil)
 		storageProvider.Mock.On("UploadFromRequest").Return("", web.WebError{})
 		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
 		eventRepositoryMock.Mock.On("Store").Return(sampleEvent, nil)
@@ -318,12 +976,21 @@ func TestCreate(t *testing.T) {
 		likeRepositoryMock := likeRepository.NewLikeRepositoryMock(&mock.Mock{})
 		likeRepositoryMock.Mock.On("CountLikeByEvent").Return(0, nil)
 
+		slugRepositoryMock := slugRepository.NewSlugRepositoryMock(&mock.Mock{})
+		slugRepositoryMock.Mock.On("IsTaken", mock.Anything).Return(false, nil)
+
 		Service := eventService.NewEventService(
 			eventRepositoryMock,
 			userRepositoryMock,
 			likeRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepositoryMock,
 		)
-		actual, err := Service.Create(sampleRequest, int(sampleUser.ID), sampleFileRequest, storageProvider)
+		actual, err := Service.Create(sampleRequest, int(sampleUser.ID), sampleFileRequest, storageProvider, searchProvider)
 
 		expected := entities.EventResponse{}
 		assert.Error(t, err)
@@ -335,6 +1002,9 @@ func TestCreate(t *testing.T) {
 		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
 		userRepositoryMock.Mock.On("Find").Return(sampleUser, nil)
 		storageProvider := _storageProvider.NewStorageMock(&mock.Mock{})
+		searchProvider := _searchProvider.NewSearchMock(&mock.Mock{})
+		searchProvider.Mock.On("IndexEvent").Return(nil)
+		searchProvider.Mock.On("DeleteEvent").Return(nil)
 		storageProvider.Mock.On("UploadFromRequest").Return("domain.com/images.jpg", nil)
 		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
 		eventRepositoryMock.Mock.On("Store").Return(entities.Event{}, web.WebError{})
@@ -342,12 +1012,21 @@ func TestCreate(t *testing.T) {
 		likeRepositoryMock := likeRepository.NewLikeRepositoryMock(&mock.Mock{})
 		likeRepositoryMock.Mock.On("CountLikeByEvent").Return(0, nil)
 
+		slugRepositoryMock := slugRepository.NewSlugRepositoryMock(&mock.Mock{})
+		slugRepositoryMock.Mock.On("IsTaken", mock.Anything).Return(false, nil)
+
 		Service := eventService.NewEventService(
 			eventRepositoryMock,
 			userRepositoryMock,
 			likeRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepositoryMock,
 		)
-		actual, err := Service.Create(sampleRequest, int(sampleUser.ID), sampleFileRequest, storageProvider)
+		actual, err := Service.Create(sampleRequest, int(sampleUser.ID), sampleFileRequest, storageProvider, searchProvider)
 
 		expected := entities.EventResponse{}
 		assert.Error(t, err)
@@ -359,6 +1038,9 @@ func TestCreate(t *testing.T) {
 		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
 		userRepositoryMock.Mock.On("Find").Return(entities.User{}, web.WebError{})
 		storageProvider := _storageProvider.NewStorageMock(&mock.Mock{})
+		searchProvider := _searchProvider.NewSearchMock(&mock.Mock{})
+		searchProvider.Mock.On("IndexEvent").Return(nil)
+		searchProvider.Mock.On("DeleteEvent").Return(nil)
 		storageProvider.Mock.On("UploadFromRequest").Return("domain.com/images.jpg", nil)
 		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
 		eventRepositoryMock.Mock.On("Store").Return(entities.Event{}, web.WebError{})
@@ -366,12 +1048,21 @@ func TestCreate(t *testing.T) {
 		likeRepositoryMock := likeRepository.NewLikeRepositoryMock(&mock.Mock{})
 		likeRepositoryMock.Mock.On("CountLikeByEvent").Return(0, nil)
 
+		slugRepositoryMock := slugRepository.NewSlugRepositoryMock(&mock.Mock{})
+		slugRepositoryMock.Mock.On("IsTaken", mock.Anything).Return(false, nil)
+
 		Service := eventService.NewEventService(
 			eventRepositoryMock,
 			userRepositoryMock,
 			likeRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepositoryMock,
 		)
-		actual, err := Service.Create(sampleRequest, int(sampleUser.ID), sampleFileRequest, storageProvider)
+		actual, err := Service.Create(sampleRequest, int(sampleUser.ID), sampleFileRequest, storageProvider, searchProvider)
 
 		expected := entities.EventResponse{}
 		assert.Error(t, err)
@@ -383,6 +1074,9 @@ func TestCreate(t *testing.T) {
 		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
 		userRepositoryMock.Mock.On("Find").Return(sampleUser, nil)
 		storageProvider := _storageProvider.NewStorageMock(&mock.Mock{})
+		searchProvider := _searchProvider.NewSearchMock(&mock.Mock{})
+		searchProvider.Mock.On("IndexEvent").Return(nil)
+		searchProvider.Mock.On("DeleteEvent").Return(nil)
 		storageProvider.Mock.On("UploadFromRequest").Return("domain.com/images.jpg", nil)
 		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
 		eventRepositoryMock.Mock.On("Store").Return(entities.Event{}, nil)
@@ -390,12 +1084,21 @@ func TestCreate(t *testing.T) {
 		likeRepositoryMock := likeRepository.NewLikeRepositoryMock(&mock.Mock{})
 		likeRepositoryMock.Mock.On("CountLikeByEvent").Return(0, nil)
 
+		slugRepositoryMock := slugRepository.NewSlugRepositoryMock(&mock.Mock{})
+		slugRepositoryMock.Mock.On("IsTaken", mock.Anything).Return(false, nil)
+
 		Service := eventService.NewEventService(
 			eventRepositoryMock,
 			userRepositoryMock,
 			likeRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepositoryMock,
 		)
-		actual, err := Service.Create(sampleRequest, int(sampleUser.ID), sampleFileRequest, storageProvider)
+		actual, err := Service.Create(sampleRequest, int(sampleUser.ID), sampleFileRequest, storageProvider, searchProvider)
 
 		expected := entities.EventResponse{}
 		assert.Error(t, err)
@@ -430,39 +1133,108 @@ func TestUpdate(t *testing.T) {
 		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
 		userRepositoryMock.Mock.On("Find").Return(sampleUser, nil)
 		storageProvider := _storageProvider.NewStorageMock(&mock.Mock{})
+		searchProvider := _searchProvider.NewSearchMock(&mock.Mock{})
+		searchProvider.Mock.On("IndexEvent").Return(nil)
+		searchProvider.Mock.On("DeleteEvent").Return(nil)
 		storageProvider.Mock.On("Delete").Return(nil)
 		storageProvider.Mock.On("UploadFromRequest").Return("example.com/images.png", nil)
 		eventRepositoryMock.Mock.On("Update").Return(sampleEvent, nil)
 		eventRepositoryMock.Mock.On("Find").Return(sampleEvent, nil)
 		likeRepositoryMock := likeRepository.NewLikeRepositoryMock(&mock.Mock{})
 		likeRepositoryMock.Mock.On("CountLikeByEvent").Return(0, nil)
+		revisionRepositoryMock := revisionRepository.NewRevisionRepositoryMock(&mock.Mock{})
+		revisionRepositoryMock.Mock.On("Store", mock.Anything).Return(entities.EventRevision{}, nil)
 
 		Service := eventService.NewEventService(
 			eventRepositoryMock,
 			userRepositoryMock,
 			likeRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepositoryMock,
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
 		)
-		actual, err := Service.Update(sampleRequest, 1, int(sampleUser.ID), sampleFileRequest, storageProvider)
+		actual, err := Service.Update(sampleRequest, 1, int(sampleUser.ID), sampleFileRequest, storageProvider, searchProvider)
 
 		expected := entities.EventResponse{}
 		copier.Copy(&expected, &sampleEvent)
 
 		assert.Nil(t, err)
 		assert.Equal(t, expected.ID, actual.ID)
+		changes := revisionRepositoryMock.Mock.Calls[0].Arguments.String(0)
+		assert.Contains(t, changes, `"field":"datetime_event"`)
 	})
-	t.Run("validation-fail", func(t *testing.T) {
+	t.Run("cohost-editor", func(t *testing.T) {
 		sampleEvent := sampleCentral
-		sampleRequest := sampleRequestCentral
-		sampleFileRequest := &multipart.FileHeader{
-			Filename: "cover.jpg",
-			Header: textproto.MIMEHeader{
-				"Content-Disposition": []string{
-					"form-data; name=\"cover\"; filename=\"cover.png\"",
-				},
-				"Content-Type": []string{
-					"image/png",
-				},
-			},
+		sampleEvent.Cohosts = []entities.EventCohost{{UserID: 2, Role: "editor", Status: "accepted"}}
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(sampleEvent, nil)
+		eventRepositoryMock.Mock.On("Update").Return(sampleEvent, nil)
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(userRepository.UserCollection[1], nil)
+		searchProvider := _searchProvider.NewSearchMock(&mock.Mock{})
+		searchProvider.Mock.On("IndexEvent").Return(nil)
+		likeRepositoryMock := likeRepository.NewLikeRepositoryMock(&mock.Mock{})
+		likeRepositoryMock.Mock.On("CountLikeByEvent").Return(0, nil)
+
+		revisionRepositoryMock := revisionRepository.NewRevisionRepositoryMock(&mock.Mock{})
+		revisionRepositoryMock.Mock.On("Store", mock.Anything).Return(entities.EventRevision{}, nil)
+
+		Service := eventService.NewEventService(
+			eventRepositoryMock,
+			userRepositoryMock,
+			likeRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepositoryMock,
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
+		)
+		_, err := Service.Update(sampleRequestCentral, 1, 2, nil, _storageProvider.NewStorageMock(&mock.Mock{}), searchProvider)
+
+		assert.Nil(t, err)
+		eventRepositoryMock.Mock.AssertCalled(t, "Update")
+	})
+	t.Run("cohost-moderator", func(t *testing.T) {
+		sampleEvent := sampleCentral
+		sampleEvent.Cohosts = []entities.EventCohost{{UserID: 2, Role: "moderator", Status: "accepted"}}
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(sampleEvent, nil)
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(userRepository.UserCollection[1], nil)
+
+		Service := eventService.NewEventService(
+			eventRepositoryMock,
+			userRepositoryMock,
+			likeRepository.NewLikeRepositoryMock(&mock.Mock{}),
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
+		)
+		_, err := Service.Update(sampleRequestCentral, 1, 2, nil, _storageProvider.NewStorageMock(&mock.Mock{}), _searchProvider.NewSearchMock(&mock.Mock{}))
+
+		assert.Equal(t, web.WebError{Code: 401, Message: "Cannot update event that belongs to someone else"}, err)
+		eventRepositoryMock.Mock.AssertNotCalled(t, "Update")
+	})
+	t.Run("validation-fail", func(t *testing.T) {
+		sampleEvent := sampleCentral
+		sampleRequest := sampleRequestCentral
+		sampleFileRequest := &multipart.FileHeader{
+			Filename: "cover.jpg",
+			Header: textproto.MIMEHeader{
+				"Content-Disposition": []string{
+					"form-data; name=\"cover\"; filename=\"cover.png\"",
+				},
+				"Content-Type": []string{
+					"image/png",
+				},
+			},
 			Size: 2024 * 2024,
 		}
 
@@ -470,6 +1242,9 @@ func TestUpdate(t *testing.T) {
 		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
 		userRepositoryMock.Mock.On("Find").Return(sampleUser, nil)
 		storageProvider := _storageProvider.NewStorageMock(&mock.Mock{})
+		searchProvider := _searchProvider.NewSearchMock(&mock.Mock{})
+		searchProvider.Mock.On("IndexEvent").Return(nil)
+		searchProvider.Mock.On("DeleteEvent").Return(nil)
 		storageProvider.Mock.On("Delete").Return(nil)
 		storageProvider.Mock.On("UploadFromRequest").Return("example.com/images.png", nil)
 		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
@@ -482,8 +1257,14 @@ func TestUpdate(t *testing.T) {
 			eventRepositoryMock,
 			userRepositoryMock,
 			likeRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
 		)
-		actual, err := Service.Update(sampleRequest, 1, int(sampleUser.ID), sampleFileRequest, storageProvider)
+		actual, err := Service.Update(sampleRequest, 1, int(sampleUser.ID), sampleFileRequest, storageProvider, searchProvider)
 
 		expected := entities.EventResponse{}
 		copier.Copy(&expected, &sampleEvent)
@@ -500,6 +1281,9 @@ func TestUpdate(t *testing.T) {
 		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
 		userRepositoryMock.Mock.On("Find").Return(sampleUser, nil)
 		storageProvider := _storageProvider.NewStorageMock(&mock.Mock{})
+		searchProvider := _searchProvider.NewSearchMock(&mock.Mock{})
+		searchProvider.Mock.On("IndexEvent").Return(nil)
+		searchProvider.Mock.On("DeleteEvent").Return(nil)
 		storageProvider.Mock.On("Delete").Return(nil)
 		storageProvider.Mock.On("UploadFromRequest").Return("example.com/images.png", nil)
 		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
@@ -512,8 +1296,14 @@ func TestUpdate(t *testing.T) {
 			eventRepositoryMock,
 			userRepositoryMock,
 			likeRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
 		)
-		actual, err := Service.Update(sampleRequest, 1, int(sampleUser.ID), sampleFileRequest, storageProvider)
+		actual, err := Service.Update(sampleRequest, 1, int(sampleUser.ID), sampleFileRequest, storageProvider, searchProvider)
 
 		expected := entities.EventResponse{}
 		copier.Copy(&expected, &sampleEvent)
@@ -528,6 +1318,9 @@ func TestUpdate(t *testing.T) {
 		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
 		userRepositoryMock.Mock.On("Find").Return(sampleUser, nil)
 		storageProvider := _storageProvider.NewStorageMock(&mock.Mock{})
+		searchProvider := _searchProvider.NewSearchMock(&mock.Mock{})
+		searchProvider.Mock.On("IndexEvent").Return(nil)
+		searchProvider.Mock.On("DeleteEvent").Return(nil)
 		storageProvider.Mock.On("Delete").Return(nil)
 		storageProvider.Mock.On("UploadFromRequest").Return("", web.WebError{})
 		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
@@ -540,8 +1333,14 @@ func TestUpdate(t *testing.T) {
 			eventRepositoryMock,
 			userRepositoryMock,
 			likeRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
 		)
-		actual, err := Service.Update(sampleRequest, 1, int(sampleUser.ID), sampleFileRequest, storageProvider)
+		actual, err := Service.Update(sampleRequest, 1, int(sampleUser.ID), sampleFileRequest, storageProvider, searchProvider)
 
 		expected := entities.EventResponse{}
 		assert.Error(t, err)
@@ -553,6 +1352,9 @@ func TestUpdate(t *testing.T) {
 		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
 		userRepositoryMock.Mock.On("Find").Return(sampleUser, nil)
 		storageProvider := _storageProvider.NewStorageMock(&mock.Mock{})
+		searchProvider := _searchProvider.NewSearchMock(&mock.Mock{})
+		searchProvider.Mock.On("IndexEvent").Return(nil)
+		searchProvider.Mock.On("DeleteEvent").Return(nil)
 		storageProvider.Mock.On("Delete").Return(nil)
 		storageProvider.Mock.On("UploadFromRequest").Return("domain.com/images.jpg", nil)
 		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
@@ -565,8 +1367,14 @@ func TestUpdate(t *testing.T) {
 			eventRepositoryMock,
 			userRepositoryMock,
 			likeRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
 		)
-		actual, err := Service.Update(sampleRequest, 1, int(sampleUser.ID), sampleFileRequest, storageProvider)
+		actual, err := Service.Update(sampleRequest, 1, int(sampleUser.ID), sampleFileRequest, storageProvider, searchProvider)
 
 		expected := entities.EventResponse{}
 		assert.Error(t, err)
@@ -578,6 +1386,9 @@ func TestUpdate(t *testing.T) {
 		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
 		userRepositoryMock.Mock.On("Find").Return(entities.User{}, web.WebError{})
 		storageProvider := _storageProvider.NewStorageMock(&mock.Mock{})
+		searchProvider := _searchProvider.NewSearchMock(&mock.Mock{})
+		searchProvider.Mock.On("IndexEvent").Return(nil)
+		searchProvider.Mock.On("DeleteEvent").Return(nil)
 		storageProvider.Mock.On("Delete").Return(nil)
 		storageProvider.Mock.On("UploadFromRequest").Return("domain.com/images.jpg", nil)
 		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
@@ -590,8 +1401,14 @@ func TestUpdate(t *testing.T) {
 			eventRepositoryMock,
 			userRepositoryMock,
 			likeRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
 		)
-		actual, err := Service.Update(sampleRequest, 1, int(sampleUser.ID), sampleFileRequest, storageProvider)
+		actual, err := Service.Update(sampleRequest, 1, int(sampleUser.ID), sampleFileRequest, storageProvider, searchProvider)
 
 		expected := entities.EventResponse{}
 		assert.Error(t, err)
@@ -603,6 +1420,9 @@ func TestUpdate(t *testing.T) {
 		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
 		userRepositoryMock.Mock.On("Find").Return(sampleUser, nil)
 		storageProvider := _storageProvider.NewStorageMock(&mock.Mock{})
+		searchProvider := _searchProvider.NewSearchMock(&mock.Mock{})
+		searchProvider.Mock.On("IndexEvent").Return(nil)
+		searchProvider.Mock.On("DeleteEvent").Return(nil)
 		storageProvider.Mock.On("Delete").Return(nil)
 		storageProvider.Mock.On("UploadFromRequest").Return("domain.com/images.jpg", nil)
 		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
@@ -615,13 +1435,48 @@ func TestUpdate(t *testing.T) {
 			eventRepositoryMock,
 			userRepositoryMock,
 			likeRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
 		)
-		actual, err := Service.Update(sampleRequest, 1, int(sampleUser.ID), sampleFileRequest, storageProvider)
+		actual, err := Service.Update(sampleRequest, 1, int(sampleUser.ID), sampleFileRequest, storageProvider, searchProvider)
 
 		expected := entities.EventResponse{}
 		assert.Error(t, err)
 		assert.Equal(t, expected, actual)
 	})
+	t.Run("end-before-start", func(t *testing.T) {
+		sampleEvent := sampleCentral
+		sampleEvent.DatetimeEvent = time.Date(2030, 1, 20, 0, 0, 0, 0, time.UTC)
+		sampleRequest := entities.EventRequest{DatetimeEventEnd: "2030-01-12"}
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(sampleEvent, nil)
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(sampleUser, nil)
+
+		Service := eventService.NewEventService(
+			eventRepositoryMock,
+			userRepositoryMock,
+			likeRepository.NewLikeRepositoryMock(&mock.Mock{}),
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
+		)
+		_, err := Service.Update(sampleRequest, 1, int(sampleUser.ID), nil, _storageProvider.NewStorageMock(&mock.Mock{}), _searchProvider.NewSearchMock(&mock.Mock{}))
+
+		assert.Equal(t, web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors:  []web.ValidationErrorItem{{Field: "datetime_event_end", Error: "datetime_event_end cannot be before datetime_event"}},
+		}, err)
+		eventRepositoryMock.Mock.AssertNotCalled(t, "Update")
+	})
 }
 
 func TestDelete(t *testing.T) {
@@ -631,6 +1486,9 @@ func TestDelete(t *testing.T) {
 		eventRepositoryMock.Mock.On("Find").Return(sampleEvent, nil)
 
 		storageProvider := _storageProvider.NewStorageMock(&mock.Mock{})
+		searchProvider := _searchProvider.NewSearchMock(&mock.Mock{})
+		searchProvider.Mock.On("IndexEvent").Return(nil)
+		searchProvider.Mock.On("DeleteEvent").Return(nil)
 		storageProvider.Mock.On("Delete").Return(nil)
 
 		sampleUser := userRepository.UserCollection[0]
@@ -646,8 +1504,14 @@ func TestDelete(t *testing.T) {
 			eventRepositoryMock,
 			userRepositoryMock,
 			likeRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
 		)
-		err := Service.Delete(int(sampleEvent.ID), int(sampleUser.ID), storageProvider)
+		err := Service.Delete(int(sampleEvent.ID), int(sampleUser.ID), storageProvider, searchProvider)
 		assert.Nil(t, err)
 	})
 	t.Run("repo-fail", func(t *testing.T) {
@@ -656,6 +1520,9 @@ func TestDelete(t *testing.T) {
 		eventRepositoryMock.Mock.On("Find").Return(entities.Event{}, web.WebError{})
 
 		storageProvider := _storageProvider.NewStorageMock(&mock.Mock{})
+		searchProvider := _searchProvider.NewSearchMock(&mock.Mock{})
+		searchProvider.Mock.On("IndexEvent").Return(nil)
+		searchProvider.Mock.On("DeleteEvent").Return(nil)
 		storageProvider.Mock.On("Delete").Return(nil)
 
 		sampleUser := userRepository.UserCollection[0]
@@ -670,8 +1537,14 @@ func TestDelete(t *testing.T) {
 			eventRepositoryMock,
 			userRepositoryMock,
 			likeRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
 		)
-		err := Service.Delete(int(sampleEvent.ID), int(sampleUser.ID), storageProvider)
+		err := Service.Delete(int(sampleEvent.ID), int(sampleUser.ID), storageProvider, searchProvider)
 		assert.Error(t, err)
 	})
 	t.Run("fail", func(t *testing.T) {
@@ -680,6 +1553,9 @@ func TestDelete(t *testing.T) {
 		eventRepositoryMock.Mock.On("Find").Return(sampleEvent, nil)
 
 		storageProvider := _storageProvider.NewStorageMock(&mock.Mock{})
+		searchProvider := _searchProvider.NewSearchMock(&mock.Mock{})
+		searchProvider.Mock.On("IndexEvent").Return(nil)
+		searchProvider.Mock.On("DeleteEvent").Return(nil)
 		storageProvider.Mock.On("Delete").Return(nil)
 
 		sampleUser := userRepository.UserCollection[0]
@@ -695,8 +1571,14 @@ func TestDelete(t *testing.T) {
 			eventRepositoryMock,
 			userRepositoryMock,
 			likeRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
 		)
-		err := Service.Delete(int(sampleEvent.ID), int(sampleUser.ID), storageProvider)
+		err := Service.Delete(int(sampleEvent.ID), int(sampleUser.ID), storageProvider, searchProvider)
 		assert.Error(t, err)
 	})
 
@@ -706,6 +1588,9 @@ func TestDelete(t *testing.T) {
 		eventRepositoryMock.Mock.On("Find").Return(sampleEvent, nil)
 
 		storageProvider := _storageProvider.NewStorageMock(&mock.Mock{})
+		searchProvider := _searchProvider.NewSearchMock(&mock.Mock{})
+		searchProvider.Mock.On("IndexEvent").Return(nil)
+		searchProvider.Mock.On("DeleteEvent").Return(nil)
 		storageProvider.Mock.On("Delete").Return(nil)
 
 		sampleUser := userRepository.UserCollection[0]
@@ -721,8 +1606,540 @@ func TestDelete(t *testing.T) {
 			eventRepositoryMock,
 			userRepositoryMock,
 			likeRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
 		)
-		err := Service.Delete(int(sampleEvent.ID), int(sampleUser.ID), storageProvider)
+		err := Service.Delete(int(sampleEvent.ID), int(sampleUser.ID), storageProvider, searchProvider)
 		assert.Error(t, err)
 	})
 }
+
+func TestDuplicate(t *testing.T) {
+	t.Run("reuse-cover", func(t *testing.T) {
+		sampleEvent := eventRepository.EventCollection[0]
+		sampleUser := userRepository.UserCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(sampleEvent, nil)
+		eventRepositoryMock.Mock.On("Store").Return(sampleEvent, nil)
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(sampleUser, nil)
+		likeRepositoryMock := likeRepository.NewLikeRepositoryMock(&mock.Mock{})
+		likeRepositoryMock.Mock.On("CountLikeByEvent").Return(0, nil)
+		storageProvider := _storageProvider.NewStorageMock(&mock.Mock{})
+		storageProvider.Mock.On("Copy").Return("https://tupulung.s3.amazonaws.com/event/cover/copy.png", nil)
+		searchProvider := _searchProvider.NewSearchMock(&mock.Mock{})
+		searchProvider.Mock.On("IndexEvent").Return(nil)
+
+		slugRepositoryMock := slugRepository.NewSlugRepositoryMock(&mock.Mock{})
+		slugRepositoryMock.Mock.On("IsTaken", mock.Anything).Return(false, nil)
+
+		Service := eventService.NewEventService(
+			eventRepositoryMock,
+			userRepositoryMock,
+			likeRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepositoryMock,
+		)
+		duplicateRequest := entities.EventDuplicateRequest{DatetimeEvent: "2030-01-01", ReuseCover: true}
+		_, err := Service.Duplicate(duplicateRequest, int(sampleEvent.ID), int(sampleEvent.UserID), nil, storageProvider, searchProvider)
+
+		assert.Nil(t, err)
+		storageProvider.Mock.AssertNumberOfCalls(t, "Copy", 1)
+	})
+	t.Run("missing-date", func(t *testing.T) {
+		sampleEvent := eventRepository.EventCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(sampleEvent, nil)
+		storageProvider := _storageProvider.NewStorageMock(&mock.Mock{})
+
+		slugRepositoryMock := slugRepository.NewSlugRepositoryMock(&mock.Mock{})
+		slugRepositoryMock.Mock.On("IsTaken", mock.Anything).Return(false, nil)
+
+		Service := eventService.NewEventService(
+			eventRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			likeRepository.NewLikeRepositoryMock(&mock.Mock{}),
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepositoryMock,
+		)
+		_, err := Service.Duplicate(entities.EventDuplicateRequest{ReuseCover: true}, int(sampleEvent.ID), int(sampleEvent.UserID), nil, storageProvider, _searchProvider.NewSearchMock(&mock.Mock{}))
+
+		_, ok := err.(web.ValidationError)
+		assert.True(t, ok)
+		storageProvider.Mock.AssertNotCalled(t, "Copy")
+	})
+	t.Run("not-host", func(t *testing.T) {
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventRepository.EventCollection[0], nil)
+
+		slugRepositoryMock := slugRepository.NewSlugRepositoryMock(&mock.Mock{})
+		slugRepositoryMock.Mock.On("IsTaken", mock.Anything).Return(false, nil)
+
+		Service := eventService.NewEventService(
+			eventRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			likeRepository.NewLikeRepositoryMock(&mock.Mock{}),
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepositoryMock,
+		)
+		_, err := Service.Duplicate(entities.EventDuplicateRequest{DatetimeEvent: "2030-01-01"}, 1, 99, nil, _storageProvider.NewStorageMock(&mock.Mock{}), _searchProvider.NewSearchMock(&mock.Mock{}))
+
+		assert.Equal(t, 401, err.(web.WebError).Code)
+	})
+}
+
+func TestCreateFromTemplate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		sampleEvent := eventRepository.EventCollection[0]
+		sampleUser := userRepository.UserCollection[0]
+		sampleTemplate := templateRepository.EventTemplateCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(sampleEvent, nil)
+		eventRepositoryMock.Mock.On("Store").Return(sampleEvent, nil)
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(sampleUser, nil)
+		likeRepositoryMock := likeRepository.NewLikeRepositoryMock(&mock.Mock{})
+		likeRepositoryMock.Mock.On("CountLikeByEvent").Return(0, nil)
+		tagRepositoryMock := tagRepository.NewTagRepositoryMock(&mock.Mock{})
+		tagRepositoryMock.Mock.On("SyncEvent", []string{"golang", "meetup"}).Return(tagRepository.TagCollection, nil)
+		templateRepositoryMock := templateRepository.NewTemplateRepositoryMock(&mock.Mock{})
+		templateRepositoryMock.Mock.On("Find").Return(sampleTemplate, nil)
+		searchProvider := _searchProvider.NewSearchMock(&mock.Mock{})
+		searchProvider.Mock.On("IndexEvent").Return(nil)
+
+		slugRepositoryMock := slugRepository.NewSlugRepositoryMock(&mock.Mock{})
+		slugRepositoryMock.Mock.On("IsTaken", mock.Anything).Return(false, nil)
+
+		Service := eventService.NewEventService(
+			eventRepositoryMock,
+			userRepositoryMock,
+			likeRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepositoryMock,
+			templateRepositoryMock,
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepositoryMock,
+		)
+		_, err := Service.CreateFromTemplate(entities.EventRequest{DatetimeEvent: "2030-01-01"}, int(sampleTemplate.ID), int(sampleTemplate.UserID), nil, _storageProvider.NewStorageMock(&mock.Mock{}), searchProvider)
+
+		assert.Nil(t, err)
+		tagRepositoryMock.Mock.AssertCalled(t, "SyncEvent", []string{"golang", "meetup"})
+	})
+	t.Run("other-user-template", func(t *testing.T) {
+		templateRepositoryMock := templateRepository.NewTemplateRepositoryMock(&mock.Mock{})
+		templateRepositoryMock.Mock.On("Find").Return(templateRepository.EventTemplateCollection[1], nil)
+
+		slugRepositoryMock := slugRepository.NewSlugRepositoryMock(&mock.Mock{})
+		slugRepositoryMock.Mock.On("IsTaken", mock.Anything).Return(false, nil)
+
+		Service := eventService.NewEventService(
+			eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			likeRepository.NewLikeRepositoryMock(&mock.Mock{}),
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepositoryMock,
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepositoryMock,
+		)
+		_, err := Service.CreateFromTemplate(entities.EventRequest{DatetimeEvent: "2030-01-01"}, 2, 1, nil, _storageProvider.NewStorageMock(&mock.Mock{}), _searchProvider.NewSearchMock(&mock.Mock{}))
+
+		assert.Equal(t, 400, err.(web.WebError).Code)
+	})
+}
+
+func TestTrending(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventSample.Trending = &entities.EventScore{EventID: eventSample.ID, Score: 42.5}
+		likeRepositoryMock := likeRepository.NewLikeRepositoryMock(&mock.Mock{})
+		likeRepositoryMock.Mock.On("CountLikeByEvent").Return(0, nil)
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("FindAll", 10, 0, mock.Anything, []map[string]interface{}{{"field": "trending", "desc": true}}).Return([]entities.Event{eventSample}, nil)
+		eventRepositoryMock.Mock.On("CountAll", mock.Anything).Return(1, nil)
+
+		service := eventService.NewEventService(
+			eventRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			likeRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
+		)
+		data, pagination, err := service.Trending("2", 10, 1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 42.5, data[0].TrendingScore)
+		assert.Equal(t, 1, pagination.TotalPages)
+		filters := eventRepositoryMock.Mock.Calls[0].Arguments.Get(2).([]map[string]string)
+		assert.Equal(t, map[string]string{"field": "category_id", "operator": "IN", "value": "2"}, filters[0])
+		assert.Contains(t, filters, map[string]string{"field": "trending", "operator": ">", "value": "0"})
+		assert.Contains(t, filters, map[string]string{"field": "visibility", "operator": "=", "value": "public"})
+	})
+}
+
+func TestRecordView(t *testing.T) {
+	newService := func(trendingRepositoryMock *trendingRepository.TrendingRepositoryMock) *eventService.EventService {
+		return eventService.NewEventService(
+			eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			likeRepository.NewLikeRepositoryMock(&mock.Mock{}),
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepositoryMock,
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
+		)
+	}
+	t.Run("guest-by-ip", func(t *testing.T) {
+		trendingRepositoryMock := trendingRepository.NewTrendingRepositoryMock(&mock.Mock{})
+		trendingRepositoryMock.Mock.On("RecordView", entities.EventView{EventID: 1, IP: "10.0.0.1"}).Return(true, nil)
+
+		err := newService(trendingRepositoryMock).RecordView(1, 0, "10.0.0.1")
+
+		assert.Nil(t, err)
+		trendingRepositoryMock.Mock.AssertNumberOfCalls(t, "RecordView", 1)
+	})
+	t.Run("user-without-ip", func(t *testing.T) {
+		userID := uint(2)
+		trendingRepositoryMock := trendingRepository.NewTrendingRepositoryMock(&mock.Mock{})
+		trendingRepositoryMock.Mock.On("RecordView", entities.EventView{EventID: 1, UserID: &userID}).Return(false, nil)
+
+		err := newService(trendingRepositoryMock).RecordView(1, 2, "10.0.0.1")
+
+		assert.Nil(t, err)
+		trendingRepositoryMock.Mock.AssertNumberOfCalls(t, "RecordView", 1)
+	})
+}
+
+func TestRecommended(t *testing.T) {
+	sampleUser := userRepository.UserCollection[0]
+	newService := func(eventRepositoryMock *eventRepository.EventRepositoryMock, userRepositoryMock *userRepository.UserRepositoryMock, likeRepositoryMock *likeRepository.LikeRepositoryMock) *eventService.EventService {
+		return eventService.NewEventService(
+			eventRepositoryMock,
+			userRepositoryMock,
+			likeRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
+		)
+	}
+	t.Run("success", func(t *testing.T) {
+		joined := []entities.Event{{
+			Model:      gorm.Model{ID: 10},
+			UserID:     5,
+			User:       entities.User{Name: "Gophers ID"},
+			CategoryID: 1,
+			Category:   entities.Category{Title: "Technology"},
+			Location:   "Bandung",
+		}}
+		liked := []entities.Event{{
+			Model:      gorm.Model{ID: 11},
+			UserID:     6,
+			CategoryID: 2,
+			Tags:       []entities.Tag{{Name: "golang"}},
+		}}
+		candidates := []entities.Event{
+			{Model: gorm.Model{ID: 20}, UserID: 7, CategoryID: 3, Location: "Surabaya"},
+			{Model: gorm.Model{ID: 21}, UserID: 7, CategoryID: 1, Location: "Jakarta"},
+			{Model: gorm.Model{ID: 10}, UserID: 5, CategoryID: 1},
+			{Model: gorm.Model{ID: 22}, UserID: 5, CategoryID: 1, Location: "Bandung"},
+			{Model: gorm.Model{ID: 23}, UserID: 8, CategoryID: 4, Tags: []entities.Tag{{Name: "golang"}}},
+		}
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(sampleUser, nil)
+		userRepositoryMock.Mock.On("GetJoinedEvents").Return(joined, nil)
+		likeRepositoryMock := likeRepository.NewLikeRepositoryMock(&mock.Mock{})
+		likeRepositoryMock.Mock.On("FindLikedEvents").Return(liked, nil)
+		likeRepositoryMock.Mock.On("CountLikeByEvent").Return(0, nil)
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("FindAll", 200, 0, mock.Anything, mock.Anything).Return(candidates, nil)
+
+		data, err := newService(eventRepositoryMock, userRepositoryMock, likeRepositoryMock).Recommended(int(sampleUser.ID), 10)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 3, len(data))
+		assert.Equal(t, uint(22), data[0].ID)
+		assert.Equal(t, "Hosted by Gophers ID, whose events you have joined or liked", data[0].Reason)
+		assert.Equal(t, uint(21), data[1].ID)
+		assert.Equal(t, "Because you joined or liked events in Technology", data[1].Reason)
+		assert.Equal(t, uint(23), data[2].ID)
+		assert.Equal(t, "Matches your interests: golang", data[2].Reason)
+	})
+	t.Run("cold-start", func(t *testing.T) {
+		trendingEvent := eventRepository.EventCollection[1]
+		trendingEvent.Trending = &entities.EventScore{EventID: trendingEvent.ID, Score: 10}
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(sampleUser, nil)
+		userRepositoryMock.Mock.On("GetJoinedEvents").Return([]entities.Event{}, nil)
+		likeRepositoryMock := likeRepository.NewLikeRepositoryMock(&mock.Mock{})
+		likeRepositoryMock.Mock.On("FindLikedEvents").Return([]entities.Event{}, nil)
+		likeRepositoryMock.Mock.On("CountLikeByEvent").Return(0, nil)
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("FindAll", 5, 0, mock.Anything, []map[string]interface{}{{"field": "trending", "desc": true}}).Return([]entities.Event{trendingEvent}, nil)
+		eventRepositoryMock.Mock.On("CountAll", mock.Anything).Return(1, nil)
+
+		data, err := newService(eventRepositoryMock, userRepositoryMock, likeRepositoryMock).Recommended(int(sampleUser.ID), 5)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(data))
+		assert.Equal(t, "Trending now", data[0].Reason)
+	})
+	t.Run("cold-start-without-trending", func(t *testing.T) {
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(sampleUser, nil)
+		userRepositoryMock.Mock.On("GetJoinedEvents").Return([]entities.Event{}, nil)
+		likeRepositoryMock := likeRepository.NewLikeRepositoryMock(&mock.Mock{})
+		likeRepositoryMock.Mock.On("FindLikedEvents").Return([]entities.Event{}, nil)
+		likeRepositoryMock.Mock.On("CountLikeByEvent").Return(0, nil)
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("FindAll", 5, 0, mock.Anything, []map[string]interface{}{{"field": "trending", "desc": true}}).Return([]entities.Event{}, nil)
+		eventRepositoryMock.Mock.On("FindAll", 5, 0, mock.Anything, []map[string]interface{}{{"field": "participants", "desc": true}}).Return(eventRepository.EventCollection, nil)
+		eventRepositoryMock.Mock.On("CountAll", mock.Anything).Return(0, nil)
+
+		data, err := newService(eventRepositoryMock, userRepositoryMock, likeRepositoryMock).Recommended(int(sampleUser.ID), 5)
+
+		assert.Nil(t, err)
+		assert.Equal(t, len(eventRepository.EventCollection), len(data))
+		assert.Equal(t, "Popular upcoming event", data[0].Reason)
+	})
+}
+
+func TestSlugify(t *testing.T) {
+	cases := map[string]string{
+		"Seminar Pendidikan":            "seminar-pendidikan",
+		"  Café & Crème -- Meetup 2030": "cafe-and-creme-meetup-2030",
+		"Übung: Go!!":                   "ubung-go",
+		"!!!":                           "event",
+	}
+	for title, expected := range cases {
+		assert.Equal(t, expected, eventService.Slugify(title))
+	}
+	assert.Len(t, eventService.Slugify(strings.Repeat("panjang ", 30)), 79)
+}
+
+func TestCreateSlug(t *testing.T) {
+	sampleEvent := eventRepository.EventCollection[0]
+	sampleUser := userRepository.UserCollection[0]
+	sampleRequestCentral := entities.EventRequest{}
+	copier.Copy(&sampleRequestCentral, &sampleEvent)
+	sampleRequestCentral.DatetimeEvent = "1999-12-12"
+	newService := func(slugRepositoryMock *slugRepository.SlugRepositoryMock) (*eventService.EventService, *eventRepository.EventRepositoryMock) {
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(sampleUser, nil)
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Store").Return(sampleEvent, nil)
+		eventRepositoryMock.Mock.On("Find").Return(sampleEvent, nil)
+		likeRepositoryMock := likeRepository.NewLikeRepositoryMock(&mock.Mock{})
+		likeRepositoryMock.Mock.On("CountLikeByEvent").Return(0, nil)
+		return eventService.NewEventService(
+			eventRepositoryMock,
+			userRepositoryMock,
+			likeRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepositoryMock,
+		), eventRepositoryMock
+	}
+	t.Run("generated-with-suffix", func(t *testing.T) {
+		slugRepositoryMock := slugRepository.NewSlugRepositoryMock(&mock.Mock{})
+		slugRepositoryMock.Mock.On("IsTaken", "seminar-pendidikan").Return(true, nil)
+		slugRepositoryMock.Mock.On("IsTaken", "seminar-pendidikan-2").Return(true, nil)
+		slugRepositoryMock.Mock.On("IsTaken", "seminar-pendidikan-3").Return(false, nil)
+		searchProvider := _searchProvider.NewSearchMock(&mock.Mock{})
+		searchProvider.Mock.On("IndexEvent").Return(nil)
+		service, _ := newService(slugRepositoryMock)
+
+		_, err := service.Create(sampleRequestCentral, int(sampleUser.ID), nil, _storageProvider.NewStorageMock(&mock.Mock{}), searchProvider)
+
+		assert.Nil(t, err)
+		slugRepositoryMock.Mock.AssertNumberOfCalls(t, "IsTaken", 3)
+	})
+	t.Run("custom-taken", func(t *testing.T) {
+		sampleRequest := sampleRequestCentral
+		sampleRequest.Slug = "seminar"
+		slugRepositoryMock := slugRepository.NewSlugRepositoryMock(&mock.Mock{})
+		slugRepositoryMock.Mock.On("IsTaken", "seminar").Return(true, nil)
+		service, eventRepositoryMock := newService(slugRepositoryMock)
+
+		_, err := service.Create(sampleRequest, int(sampleUser.ID), nil, _storageProvider.NewStorageMock(&mock.Mock{}), _searchProvider.NewSearchMock(&mock.Mock{}))
+
+		assert.Equal(t, web.WebError{Code: 400, Message: "Slug is already taken"}, err)
+		eventRepositoryMock.Mock.AssertNotCalled(t, "Store")
+	})
+	t.Run("custom-invalid", func(t *testing.T) {
+		sampleRequest := sampleRequestCentral
+		sampleRequest.Slug = "Seminar Besar"
+		slugRepositoryMock := slugRepository.NewSlugRepositoryMock(&mock.Mock{})
+		service, _ := newService(slugRepositoryMock)
+
+		_, err := service.Create(sampleRequest, int(sampleUser.ID), nil, _storageProvider.NewStorageMock(&mock.Mock{}), _searchProvider.NewSearchMock(&mock.Mock{}))
+
+		assert.IsType(t, web.ValidationError{}, err)
+		slugRepositoryMock.Mock.AssertNotCalled(t, "IsTaken", mock.Anything)
+	})
+}
+
+func TestUpdateSlug(t *testing.T) {
+	slug := "seminar-pendidikan"
+	sampleEvent := eventRepository.EventCollection[0]
+	sampleEvent.Slug = &slug
+	sampleUser := userRepository.UserCollection[0]
+	sampleRequestCentral := entities.EventRequest{Title: "Kelas Pendidikan"}
+	newService := func(slugRepositoryMock *slugRepository.SlugRepositoryMock) *eventService.EventService {
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(sampleEvent, nil)
+		eventRepositoryMock.Mock.On("Update").Return(sampleEvent, nil)
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(sampleUser, nil)
+		likeRepositoryMock := likeRepository.NewLikeRepositoryMock(&mock.Mock{})
+		likeRepositoryMock.Mock.On("CountLikeByEvent").Return(0, nil)
+		revisionRepositoryMock := revisionRepository.NewRevisionRepositoryMock(&mock.Mock{})
+		revisionRepositoryMock.Mock.On("Store", mock.Anything).Return(entities.EventRevision{}, nil)
+		return eventService.NewEventService(
+			eventRepositoryMock,
+			userRepositoryMock,
+			likeRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepositoryMock,
+			slugRepositoryMock,
+		)
+	}
+	t.Run("rename-keeps-slug", func(t *testing.T) {
+		slugRepositoryMock := slugRepository.NewSlugRepositoryMock(&mock.Mock{})
+		searchProvider := _searchProvider.NewSearchMock(&mock.Mock{})
+		searchProvider.Mock.On("IndexEvent").Return(nil)
+
+		_, err := newService(slugRepositoryMock).Update(sampleRequestCentral, 1, int(sampleUser.ID), nil, _storageProvider.NewStorageMock(&mock.Mock{}), searchProvider)
+
+		assert.Nil(t, err)
+		slugRepositoryMock.Mock.AssertNotCalled(t, "Rename", mock.Anything, mock.Anything)
+	})
+	t.Run("change-slug", func(t *testing.T) {
+		sampleRequest := sampleRequestCentral
+		sampleRequest.Slug = "kelas-pendidikan"
+		slugRepositoryMock := slugRepository.NewSlugRepositoryMock(&mock.Mock{})
+		slugRepositoryMock.Mock.On("IsTaken", "kelas-pendidikan").Return(false, nil)
+		slugRepositoryMock.Mock.On("Rename", "seminar-pendidikan", "kelas-pendidikan").Return(nil)
+		searchProvider := _searchProvider.NewSearchMock(&mock.Mock{})
+		searchProvider.Mock.On("IndexEvent").Return(nil)
+
+		_, err := newService(slugRepositoryMock).Update(sampleRequest, 1, int(sampleUser.ID), nil, _storageProvider.NewStorageMock(&mock.Mock{}), searchProvider)
+
+		assert.Nil(t, err)
+		slugRepositoryMock.Mock.AssertCalled(t, "Rename", "seminar-pendidikan", "kelas-pendidikan")
+	})
+	t.Run("slug-taken", func(t *testing.T) {
+		sampleRequest := sampleRequestCentral
+		sampleRequest.Slug = "seminar-teknologi"
+		slugRepositoryMock := slugRepository.NewSlugRepositoryMock(&mock.Mock{})
+		slugRepositoryMock.Mock.On("IsTaken", "seminar-teknologi").Return(true, nil)
+
+		_, err := newService(slugRepositoryMock).Update(sampleRequest, 1, int(sampleUser.ID), nil, _storageProvider.NewStorageMock(&mock.Mock{}), _searchProvider.NewSearchMock(&mock.Mock{}))
+
+		assert.Equal(t, web.WebError{Code: 400, Message: "Slug is already taken"}, err)
+		slugRepositoryMock.Mock.AssertNotCalled(t, "Rename", mock.Anything, mock.Anything)
+	})
+	t.Run("invalid-dates-keep-slug", func(t *testing.T) {
+		sampleRequest := sampleRequestCentral
+		sampleRequest.Slug = "kelas-pendidikan"
+		sampleRequest.DatetimeEvent = "2022-05-10"
+		sampleRequest.DatetimeEventEnd = "2022-05-01"
+		slugRepositoryMock := slugRepository.NewSlugRepositoryMock(&mock.Mock{})
+		slugRepositoryMock.Mock.On("IsTaken", "kelas-pendidikan").Return(false, nil)
+
+		_, err := newService(slugRepositoryMock).Update(sampleRequest, 1, int(sampleUser.ID), nil, _storageProvider.NewStorageMock(&mock.Mock{}), _searchProvider.NewSearchMock(&mock.Mock{}))
+
+		assert.IsType(t, web.ValidationError{}, err)
+		slugRepositoryMock.Mock.AssertNotCalled(t, "Rename", mock.Anything, mock.Anything)
+	})
+}
+
+func TestFindBySlug(t *testing.T) {
+	slug := "kelas-pendidikan"
+	sampleEvent := eventRepository.EventCollection[0]
+	sampleEvent.Visibility = "public"
+	sampleEvent.Slug = &slug
+	newService := func(eventRepositoryMock *eventRepository.EventRepositoryMock, slugRepositoryMock *slugRepository.SlugRepositoryMock) *eventService.EventService {
+		likeRepositoryMock := likeRepository.NewLikeRepositoryMock(&mock.Mock{})
+		likeRepositoryMock.Mock.On("CountLikeByEvent").Return(0, nil)
+		return eventService.NewEventService(
+			eventRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			likeRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+			templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+			trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+			revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+			slugRepositoryMock,
+		)
+	}
+	t.Run("current-slug", func(t *testing.T) {
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("FindBy").Return(sampleEvent, nil)
+		eventRepositoryMock.Mock.On("Find").Return(sampleEvent, nil)
+		slugRepositoryMock := slugRepository.NewSlugRepositoryMock(&mock.Mock{})
+
+		data, current, err := newService(eventRepositoryMock, slugRepositoryMock).FindBySlug(slug, 0, "")
+
+		assert.Nil(t, err)
+		assert.Equal(t, "", current)
+		assert.Equal(t, slug, data.Slug)
+		slugRepositoryMock.Mock.AssertNotCalled(t, "FindRedirect")
+	})
+	t.Run("old-slug", func(t *testing.T) {
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("FindBy").Return(entities.Event{}, web.WebError{Code: 400, Message: "The requested ID doesn't match with any record"})
+		eventRepositoryMock.Mock.On("Find").Return(sampleEvent, nil)
+		slugRepositoryMock := slugRepository.NewSlugRepositoryMock(&mock.Mock{})
+		slugRepositoryMock.Mock.On("FindRedirect").Return(slugRepository.EventSlugRedirectCollection[0], nil)
+
+		data, current, err := newService(eventRepositoryMock, slugRepositoryMock).FindBySlug("golang-meetup", 0, "")
+
+		assert.Nil(t, err)
+		assert.Equal(t, slug, current)
+		assert.Equal(t, sampleEvent.ID, data.ID)
+	})
+	t.Run("not-found", func(t *testing.T) {
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("FindBy").Return(entities.Event{}, web.WebError{Code: 400, Message: "The requested ID doesn't match with any record"})
+		slugRepositoryMock := slugRepository.NewSlugRepositoryMock(&mock.Mock{})
+		slugRepositoryMock.Mock.On("FindRedirect").Return(entities.EventSlugRedirect{}, web.WebError{Code: 400, Message: "cannot get event data with specified slug"})
+
+		_, _, err := newService(eventRepositoryMock, slugRepositoryMock).FindBySlug("unknown", 0, "")
+
+		assert.Equal(t, web.WebError{Code: 400, Message: "cannot get event data with specified slug"}, err)
+	})
+}
@@ -0,0 +1,176 @@
+package event
+
+import (
+	"strconv"
+	"strings"
+	"tupulung/entities"
+	web "tupulung/entities/web"
+)
+
+// Panjang maksimal slug, sama dengan batas field slug pada request
+const maxSlugLength = 80
+
+// Jumlah event yang diisi slug dalam satu putaran backfill
+const backfillBatchSize = 200
+
+// Huruf latin beraksen yang diganti dengan huruf dasarnya
+var slugReplacer = strings.NewReplacer(
+	"à", "a", "á", "a", "â", "a", "ã", "a", "ä", "a", "å", "a", "æ", "ae",
+	"ç", "c", "è", "e", "é", "e", "ê", "e", "ë", "e",
+	"ì", "i", "í", "i", "î", "i", "ï", "i", "ñ", "n",
+	"ò", "o", "ó", "o", "ô", "o", "õ", "o", "ö", "o", "ø", "o", "œ", "oe",
+	"ù", "u", "ú", "u", "û", "u", "ü", "u", "ý", "y", "ÿ", "y", "ß", "ss",
+	"&", " and ",
+)
+
+/*
+ * Slugify
+ * -------------------------------
+ * Mengubah judul menjadi slug: huruf kecil, aksen dihapus,
+ * karakter selain huruf dan angka diganti tanda hubung.
+ * Judul yang tidak menghasilkan slug memakai "event"
+ */
+func Slugify(title string) string {
+	title = slugReplacer.Replace(strings.ToLower(title))
+
+	var builder strings.Builder
+	dash := false
+	for _, r := range title {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			builder.WriteRune(r)
+			dash = false
+		} else if !dash && builder.Len() > 0 {
+			builder.WriteByte('-')
+			dash = true
+		}
+	}
+	slug := strings.TrimSuffix(builder.String(), "-")
+	if len(slug) > maxSlugLength {
+		slug = strings.TrimSuffix(slug[:maxSlugLength], "-")
+	}
+	if slug == "" {
+		return "event"
+	}
+	return slug
+}
+
+/*
+ * Unique Slug
+ * -------------------------------
+ * Mencari slug yang belum dipakai dengan menambahkan
+ * angka di belakang slug dasar: judul, judul-2, judul-3 dst
+ */
+func (service EventService) uniqueSlug(base string, exceptEventID uint) (string, error) {
+	slug := base
+	for i := 2; ; i++ {
+		taken, err := service.slugRepo.IsTaken(slug, exceptEventID)
+		if err != nil {
+			return "", err
+		}
+		if !taken {
+			return slug, nil
+		}
+		suffix := "-" + strconv.Itoa(i)
+		if len(base)+len(suffix) > maxSlugLength {
+			base = strings.TrimSuffix(base[:maxSlugLength-len(suffix)], "-")
+		}
+		slug = base + suffix
+	}
+}
+
+/*
+ * Check Slug
+ * -------------------------------
+ * Slug pilihan host harus sudah dalam bentuk slug
+ * dan belum dipakai event lain
+ */
+func (service EventService) checkSlug(slug string, eventID uint) error {
+	if Slugify(slug) != slug {
+		return web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors:  []web.ValidationErrorItem{{Field: "slug", Error: "Slug may only contain lowercase letters, numbers and single dashes"}},
+		}
+	}
+	taken, err := service.slugRepo.IsTaken(slug, eventID)
+	if err != nil {
+		return err
+	}
+	if taken {
+		return web.WebError{Code: 400, Message: "Slug is already taken"}
+	}
+	return nil
+}
+
+/*
+ * Change Slug
+ * -------------------------------
+ * Mengganti slug event atas permintaan host,
+ * slug lama tetap bisa diakses dan diarahkan ke slug baru.
+ * Slug harus sudah dicek dengan checkSlug sebelumnya
+ */
+func (service EventService) changeSlug(event *entities.Event, slug string) error {
+	oldSlug := ""
+	if event.Slug != nil {
+		oldSlug = *event.Slug
+	}
+	if oldSlug == slug {
+		return nil
+	}
+	if err := service.slugRepo.Rename(event.ID, oldSlug, slug); err != nil {
+		return err
+	}
+	event.Slug = &slug
+	return nil
+}
+
+/*
+ * Find By Slug
+ * -------------------------------
+ * Mengambil event berdasarkan slug dengan aturan akses yang sama
+ * seperti FindForViewer. Jika slug sudah diganti, slug terbaru
+ * dikembalikan sebagai nilai kedua agar handler bisa redirect
+ */
+func (service EventService) FindBySlug(slug string, viewerID int, inviteCode string) (entities.EventResponse, string, error) {
+	event, err := service.eventRepo.FindBy("slug", slug)
+	if err == nil {
+		eventRes, err := service.FindForViewer(int(event.ID), viewerID, inviteCode)
+		return eventRes, "", err
+	}
+
+	redirect, err := service.slugRepo.FindRedirect(slug)
+	if err != nil {
+		return entities.EventResponse{}, "", web.WebError{Code: 400, Message: "cannot get event data with specified slug"}
+	}
+	eventRes, err := service.FindForViewer(int(redirect.EventID), viewerID, inviteCode)
+	if err != nil {
+		return entities.EventResponse{}, "", err
+	}
+	return eventRes, eventRes.Slug, nil
+}
+
+/*
+ * Backfill Slugs
+ * -------------------------------
+ * Mengisi slug untuk event yang dibuat sebelum slug ada
+ */
+func (service EventService) BackfillSlugs() error {
+	for {
+		events, err := service.slugRepo.FindUnslugged(backfillBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			slug, err := service.uniqueSlug(Slugify(event.Title), event.ID)
+			if err != nil {
+				return err
+			}
+			if err := service.slugRepo.Assign(event.ID, slug); err != nil {
+				return err
+			}
+		}
+		if len(events) < backfillBatchSize {
+			return nil
+		}
+	}
+}
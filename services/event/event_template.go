@@ -0,0 +1,119 @@
+package event
+
+import (
+	"mime/multipart"
+	"net/url"
+	"path"
+	"strings"
+	"tupulung/entities"
+	web "tupulung/entities/web"
+	cohostService "tupulung/services/cohost"
+	templateService "tupulung/services/template"
+	searchProvider "tupulung/utilities/search"
+	storageProvider "tupulung/utilities/storage"
+
+	"github.com/google/uuid"
+)
+
+/*
+ * Duplicate
+ * -------------------------------
+ * Membuat event baru dari event yang sudah ada. Yang disalin hanya
+ * detail event dan tag, tanggal wajib diisi ulang sedangkan participant,
+ * like, komentar, tiket dan gallery tidak ikut disalin.
+ * Cover lama disalin ke object baru jika reuse_cover diisi,
+ * sehingga menghapus salah satu event tidak merusak cover event lainnya
+ */
+func (service EventService) Duplicate(duplicateRequest entities.EventDuplicateRequest, id int, userID int, cover *multipart.FileHeader, storageProvider storageProvider.StorageInterface, searchProvider searchProvider.SearchInterface) (entities.EventResponse, error) {
+	event, err := service.eventRepo.Find(id)
+	if err != nil {
+		return entities.EventResponse{}, web.WebError{Code: 400, Message: "Event is not exist"}
+	}
+	if !cohostService.Can(event, userID, cohostService.PermissionUpdate) {
+		return entities.EventResponse{}, web.WebError{Code: 401, Message: "Cannot duplicate event that belongs to someone else"}
+	}
+
+	eventRequest := entities.EventRequest{
+		Title:         event.Title,
+		HostedBy:      event.HostedBy,
+		DatetimeEvent: duplicateRequest.DatetimeEvent,
+		CategoryID:    event.CategoryID,
+		Location:      event.Location,
+		Description:   event.Description,
+		Capacity:      event.Capacity,
+		Visibility:    event.Visibility,
+		Tags:          []string{},
+	}
+	if duplicateRequest.Title != "" {
+		eventRequest.Title = duplicateRequest.Title
+	}
+	for _, tag := range event.Tags {
+		eventRequest.Tags = append(eventRequest.Tags, tag.Name)
+	}
+
+	// Cover baru dari request lebih diutamakan dibanding cover lama
+	copiedCover := ""
+	if cover == nil && duplicateRequest.ReuseCover && event.Cover != "" && duplicateRequest.DatetimeEvent != "" {
+		u, _ := url.Parse(event.Cover)
+		sourcePath := strings.TrimPrefix(u.Path, "/")
+		coverURL, err := storageProvider.Copy(sourcePath, "event/cover/"+uuid.New().String()+path.Base(sourcePath))
+		if err != nil {
+			return entities.EventResponse{}, web.WebError{Code: 500, Message: err.Error()}
+		}
+		copiedCover = coverURL
+		eventRequest.Cover = coverURL
+	}
+
+	eventRes, err := service.Create(eventRequest, userID, cover, storageProvider, searchProvider)
+	if err != nil {
+		if copiedCover != "" {
+			u, _ := url.Parse(copiedCover)
+			storageProvider.Delete(strings.TrimPrefix(u.Path, "/"))
+		}
+		return entities.EventResponse{}, err
+	}
+	return eventRes, nil
+}
+
+/*
+ * Create From Template
+ * -------------------------------
+ * Membuat event baru dari template milik user. Field request
+ * yang kosong diisi dari template, lalu divalidasi seperti
+ * pembuatan event biasa
+ */
+func (service EventService) CreateFromTemplate(eventRequest entities.EventRequest, templateID int, userID int, cover *multipart.FileHeader, storageProvider storageProvider.StorageInterface, searchProvider searchProvider.SearchInterface) (entities.EventResponse, error) {
+	template, err := service.templateRepo.Find(templateID)
+	if err != nil {
+		return entities.EventResponse{}, err
+	}
+	if int(template.UserID) != userID {
+		return entities.EventResponse{}, web.WebError{Code: 400, Message: "cannot get template data with specified id"}
+	}
+
+	if eventRequest.Title == "" {
+		eventRequest.Title = template.Title
+	}
+	if eventRequest.HostedBy == "" {
+		eventRequest.HostedBy = template.HostedBy
+	}
+	if eventRequest.CategoryID == 0 {
+		eventRequest.CategoryID = template.CategoryID
+	}
+	if eventRequest.Location == "" {
+		eventRequest.Location = template.Location
+	}
+	if eventRequest.Description == "" {
+		eventRequest.Description = template.Description
+	}
+	if eventRequest.Capacity == 0 {
+		eventRequest.Capacity = template.Capacity
+	}
+	if eventRequest.Visibility == "" {
+		eventRequest.Visibility = template.Visibility
+	}
+	if eventRequest.Tags == nil {
+		eventRequest.Tags = templateService.TemplateTags(template)
+	}
+	return service.Create(eventRequest, userID, cover, storageProvider, searchProvider)
+}
@@ -0,0 +1,72 @@
+package event
+
+import (
+	"time"
+	"tupulung/entities"
+	web "tupulung/entities/web"
+)
+
+// View dari user atau IP yang sama dalam rentang ini hanya dihitung sekali
+const ViewDedupeWindow = 30 * time.Minute
+
+/*
+ * Trending
+ * -------------------------------
+ * Mengambil event public yang akan datang dengan skor trending
+ * tertinggi, dapat dibatasi per kategori
+ */
+func (service EventService) Trending(categoryID string, limit, page int) ([]entities.EventResponse, web.Pagination, error) {
+	filters, sorts, err := service.BuildListFilters(entities.EventListQuery{
+		CategoryID: categoryID,
+		Date:       "upcoming",
+		Sort:       "trending",
+	})
+	if err != nil {
+		return []entities.EventResponse{}, web.Pagination{}, err
+	}
+
+	// Hanya event yang memiliki aktivitas, filter visibility tetap paling akhir
+	trendingFilter := map[string]string{"field": "trending", "operator": ">", "value": "0"}
+	filters = insertBefore(filters, "visibility", trendingFilter)
+
+	eventsRes, err := service.FindAll(limit, page, filters, sorts)
+	if err != nil {
+		return []entities.EventResponse{}, web.Pagination{}, err
+	}
+	pagination, err := service.GetPagination(limit, page, filters)
+	if err != nil {
+		return []entities.EventResponse{}, web.Pagination{}, err
+	}
+	return eventsRes, pagination, nil
+}
+
+// Menyisipkan filter sebelum filter dengan field tertentu, atau di akhir jika tidak ada
+func insertBefore(filters []map[string]string, field string, filter map[string]string) []map[string]string {
+	for i, existing := range filters {
+		if existing["field"] == field {
+			inserted := append([]map[string]string{}, filters[:i]...)
+			inserted = append(inserted, filter)
+			return append(inserted, filters[i:]...)
+		}
+	}
+	return append(filters, filter)
+}
+
+/*
+ * Record View
+ * -------------------------------
+ * Mencatat event yang dilihat untuk perhitungan skor trending,
+ * viewerID 0 berarti pengunjung tanpa login yang dibedakan dari IP.
+ * View berulang dalam ViewDedupeWindow hanya dihitung sekali
+ */
+func (service EventService) RecordView(eventID int, viewerID int, ip string) error {
+	view := entities.EventView{EventID: uint(eventID)}
+	if viewerID != 0 {
+		userID := uint(viewerID)
+		view.UserID = &userID
+	} else {
+		view.IP = ip
+	}
+	_, err := service.trendingRepo.RecordView(view, time.Now().Add(-ViewDedupeWindow))
+	return err
+}
@@ -0,0 +1,239 @@
+package eventimport
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+	"tupulung/entities/web"
+)
+
+// Field event yang bisa diisi dari file import, sama dengan form tag EventRequest
+var importFields = []string{
+	"title", "slug", "hosted_by", "datetime_event", "datetime_event_end", "category_id",
+	"location", "mode", "meeting_url", "dial_in", "hide_address", "description",
+	"capacity", "visibility", "tags",
+}
+
+// Karakter escape pada property text ICS
+var icsUnescaper = strings.NewReplacer(`\n`, "\n", `\N`, "\n", `\,`, ",", `\;`, ";", `\\`, `\`)
+
+// Satu baris file sebelum diubah menjadi request event
+type rawRow struct {
+	Row    int
+	Values map[string]string
+}
+
+/*
+ * Parse Mapping
+ * -------------------------------
+ * Membaca mapping kolom CSV dalam bentuk JSON, contoh:
+ * {"title": "Event Name", "datetime_event": "Date"}.
+ * Field yang tidak dipetakan memakai kolom dengan nama field itu sendiri
+ */
+func parseMapping(value string) (map[string]string, error) {
+	mapping := map[string]string{}
+	if strings.TrimSpace(value) == "" {
+		return mapping, nil
+	}
+	if err := json.Unmarshal([]byte(value), &mapping); err != nil {
+		return map[string]string{}, mappingError("mapping must be a JSON object of field to column name")
+	}
+	for field := range mapping {
+		if !isImportField(field) {
+			return map[string]string{}, mappingError("mapping contains unknown field " + field)
+		}
+	}
+	return mapping, nil
+}
+
+/*
+ * Parse CSV
+ * -------------------------------
+ * Membaca file CSV dengan baris pertama sebagai header,
+ * nomor baris sama dengan nomor baris di spreadsheet
+ */
+func parseCSV(reader io.Reader, mapping map[string]string) ([]rawRow, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = -1
+	csvReader.TrimLeadingSpace = true
+
+	header, err := csvReader.Read()
+	if err == io.EOF {
+		return []rawRow{}, nil
+	} else if err != nil {
+		return []rawRow{}, fileError("file is not a valid CSV: " + err.Error())
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\ufeff")
+		}
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	indexes := map[string]int{}
+	for _, field := range importFields {
+		column, mapped := mapping[field]
+		if !mapped {
+			column = field
+		}
+		index, ok := columns[strings.ToLower(strings.TrimSpace(column))]
+		if !ok {
+			if mapped {
+				return []rawRow{}, mappingError("mapping column " + column + " is not in the file")
+			}
+			continue
+		}
+		indexes[field] = index
+	}
+
+	rows := []rawRow{}
+	for {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return []rawRow{}, fileError("file is not a valid CSV: " + err.Error())
+		}
+		line, _ := csvReader.FieldPos(0)
+		values := map[string]string{}
+		blank := true
+		for field, index := range indexes {
+			if index < len(record) {
+				values[field] = strings.TrimSpace(record[index])
+				blank = blank && values[field] == ""
+			}
+		}
+		if !blank {
+			rows = append(rows, rawRow{Row: line, Values: values})
+		}
+	}
+	return rows, nil
+}
+
+/*
+ * Parse ICS
+ * -------------------------------
+ * Membaca setiap VEVENT pada file kalender, nomor baris
+ * adalah urutan VEVENT. Tanggal diambil dari DTSTART dan DTEND
+ * apa adanya karena event Tupulung hanya menyimpan tanggal
+ */
+func parseICS(reader io.Reader) ([]rawRow, error) {
+	lines := []string{}
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		// Baris yang diawali spasi atau tab adalah lanjutan baris sebelumnya
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return []rawRow{}, fileError("file is not a valid ICS: " + err.Error())
+	}
+
+	rows := []rawRow{}
+	var current map[string]string
+	var allDayEnd bool
+	for _, line := range lines {
+		switch strings.ToUpper(line) {
+		case "BEGIN:VEVENT":
+			current = map[string]string{}
+			allDayEnd = false
+			continue
+		case "END:VEVENT":
+			if current != nil {
+				if allDayEnd {
+					current["datetime_event_end"] = previousDay(current["datetime_event_end"])
+				}
+				if current["datetime_event_end"] == current["datetime_event"] {
+					delete(current, "datetime_event_end")
+				}
+				rows = append(rows, rawRow{Row: len(rows) + 1, Values: current})
+			}
+			current = nil
+			continue
+		}
+		if current == nil {
+			continue
+		}
+
+		colon := strings.Index(line, ":")
+		if colon < 0 {
+			continue
+		}
+		params := strings.Split(line[:colon], ";")
+		name := strings.ToUpper(params[0])
+		value := line[colon+1:]
+		switch name {
+		case "SUMMARY":
+			current["title"] = strings.TrimSpace(icsUnescaper.Replace(value))
+		case "DESCRIPTION":
+			current["description"] = strings.TrimSpace(icsUnescaper.Replace(value))
+		case "LOCATION":
+			current["location"] = strings.TrimSpace(icsUnescaper.Replace(value))
+		case "URL":
+			current["meeting_url"] = strings.TrimSpace(value)
+		case "CATEGORIES":
+			current["tags"] = icsUnescaper.Replace(value)
+		case "DTSTART":
+			current["datetime_event"] = icsDate(value)
+		case "DTEND":
+			current["datetime_event_end"] = icsDate(value)
+			// DTEND tanggal saja bersifat eksklusif, hari terakhir event adalah sehari sebelumnya
+			allDayEnd = len(strings.TrimSpace(value)) == len("20060102")
+		}
+	}
+	return rows, nil
+}
+
+func icsDate(value string) string {
+	value = strings.TrimSpace(value)
+	if len(value) < len("20060102") {
+		return value
+	}
+	date, err := time.Parse("20060102", value[:8])
+	if err != nil {
+		return value
+	}
+	return date.Format("2006-01-02")
+}
+
+func previousDay(value string) string {
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return value
+	}
+	return date.AddDate(0, 0, -1).Format("2006-01-02")
+}
+
+func isImportField(field string) bool {
+	for _, importField := range importFields {
+		if importField == field {
+			return true
+		}
+	}
+	return false
+}
+
+func mappingError(message string) error {
+	return web.ValidationError{
+		Code:    400,
+		Message: "Validation error",
+		Errors:  []web.ValidationErrorItem{{Field: "mapping", Error: message}},
+	}
+}
+
+func fileError(message string) error {
+	return web.ValidationError{
+		Code:    400,
+		Message: "Validation error",
+		Errors:  []web.ValidationErrorItem{{Field: "file", Error: message}},
+	}
+}
@@ -0,0 +1,414 @@
+package eventimport
+
+import (
+	"encoding/json"
+	"math"
+	"mime/multipart"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+	"tupulung/deliveries/validations"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	eventImportRepository "tupulung/repositories/eventimport"
+	eventService "tupulung/services/event"
+	tagService "tupulung/services/tag"
+	searchProvider "tupulung/utilities/search"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jinzhu/copier"
+)
+
+const (
+	// Interval pengecekan import yang belum selesai
+	Interval = 10 * time.Second
+	// Jumlah import yang diproses dalam satu putaran
+	BatchSize = 10
+	// Jumlah baris yang diklaim dan dicatat progresnya sekaligus
+	ChunkSize = 25
+	// Jumlah maksimal baris dalam satu file import
+	MaxRows = 1000
+	// Batas waktu chunk yang diklaim belum dicatat sebelum dianggap terhenti
+	ClaimTimeout = 10 * time.Minute
+)
+
+const (
+	StatusPending   = "pending"
+	StatusRunning   = "running"
+	StatusCompleted = "completed"
+)
+
+type EventImportService struct {
+	importRepo   eventImportRepository.EventImportRepositoryInterface
+	eventService *eventService.EventService
+	validate     *validator.Validate
+}
+
+func NewEventImportService(importRepo eventImportRepository.EventImportRepositoryInterface, eventService *eventService.EventService) *EventImportService {
+	return &EventImportService{
+		importRepo:   importRepo,
+		eventService: eventService,
+		validate:     validator.New(),
+	}
+}
+
+/*
+ * Dry Run
+ * -------------------------------
+ * Memvalidasi setiap baris file import tanpa membuat event,
+ * error dikembalikan per baris
+ */
+func (service EventImportService) DryRun(importRequest entities.EventImportRequest, file *multipart.FileHeader) (entities.EventImportReportResponse, error) {
+	_, rows, importErrors, err := service.parse(importRequest, file)
+	if err != nil {
+		return entities.EventImportReportResponse{}, err
+	}
+	invalid := countInvalid(rows)
+	return entities.EventImportReportResponse{
+		Total:   len(rows),
+		Valid:   len(rows) - invalid,
+		Invalid: invalid,
+		Errors:  toErrorResponses(importErrors),
+	}, nil
+}
+
+/*
+ * Create
+ * -------------------------------
+ * Menyimpan import untuk diproses di background oleh Run,
+ * baris yang tidak valid langsung dicatat sebagai gagal
+ */
+func (service EventImportService) Create(importRequest entities.EventImportRequest, file *multipart.FileHeader, userID int) (entities.EventImportResponse, error) {
+	format, rows, importErrors, err := service.parse(importRequest, file)
+	if err != nil {
+		return entities.EventImportResponse{}, err
+	}
+	payload, err := json.Marshal(rows)
+	if err != nil {
+		return entities.EventImportResponse{}, web.WebError{Code: 500, Message: err.Error()}
+	}
+
+	eventImport := entities.EventImport{
+		UserID:   uint(userID),
+		Format:   format,
+		Filename: file.Filename,
+		Status:   StatusPending,
+		Total:    len(rows),
+		Failed:   countInvalid(rows),
+		Payload:  string(payload),
+	}
+	// Tidak ada baris valid yang perlu dibuat di background
+	if eventImport.Failed == eventImport.Total {
+		now := time.Now()
+		eventImport.Status = StatusCompleted
+		eventImport.Processed = eventImport.Total
+		eventImport.Recorded = eventImport.Total
+		eventImport.FinishedAt = &now
+	}
+
+	eventImport, err = service.importRepo.Store(eventImport, importErrors)
+	if err != nil {
+		return entities.EventImportResponse{}, err
+	}
+	return toImportResponse(eventImport, importErrors), nil
+}
+
+/*
+ * Find
+ * -------------------------------
+ * Mengambil progres import beserta error setiap baris,
+ * hanya user yang melakukan import yang dapat melihat
+ */
+func (service EventImportService) Find(id int, userID int) (entities.EventImportResponse, error) {
+	eventImport, err := service.importRepo.Find(id)
+	if err != nil {
+		return entities.EventImportResponse{}, err
+	}
+	if int(eventImport.UserID) != userID {
+		return entities.EventImportResponse{}, web.WebError{Code: 401, Message: "Only the importer can see this import"}
+	}
+	importErrors, err := service.importRepo.FindErrors(id)
+	if err != nil {
+		return entities.EventImportResponse{}, err
+	}
+	return toImportResponse(eventImport, importErrors), nil
+}
+
+/*
+ * Run
+ * -------------------------------
+ * Membuat event dari import yang belum selesai. Baris diklaim per
+ * ChunkSize sebelum dibuat agar tidak dibuat dua kali meskipun
+ * berjalan di beberapa instance, progres dicatat setiap chunk.
+ * Chunk yang diklaim tetapi tidak dicatat dalam ClaimTimeout,
+ * misalnya karena proses berhenti, dicatat gagal
+ *
+ * @return 	int		jumlah event yang dibuat
+ */
+func (service EventImportService) Run(searchProvider searchProvider.SearchInterface) (int, error) {
+	imports, err := service.importRepo.FindPending(BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	created := 0
+	for _, eventImport := range imports {
+		count, err := service.process(eventImport, searchProvider)
+		created += count
+		if err != nil {
+			return created, err
+		}
+	}
+	return created, nil
+}
+
+func (service EventImportService) process(eventImport entities.EventImport, searchProvider searchProvider.SearchInterface) (int, error) {
+	rows := []entities.EventImportRow{}
+	if err := json.Unmarshal([]byte(eventImport.Payload), &rows); err != nil {
+		return 0, web.WebError{Code: 500, Message: "Import " + strconv.Itoa(int(eventImport.ID)) + " has invalid payload"}
+	}
+
+	total := 0
+	from := eventImport.Recorded
+	if eventImport.Processed > from {
+		// Chunk masih diproses oleh instance lain
+		if eventImport.ClaimedAt != nil && time.Since(*eventImport.ClaimedAt) < ClaimTimeout {
+			return 0, nil
+		}
+		failed, importErrors := interruptedRows(rows[from:eventImport.Processed])
+		if err := service.importRepo.Record(eventImport.ID, from, eventImport.Processed, 0, failed, importErrors, finishedAt(eventImport.Processed, rows)); err != nil {
+			return 0, err
+		}
+		from = eventImport.Processed
+		if from >= len(rows) {
+			return 0, nil
+		}
+	}
+	if from >= len(rows) {
+		now := time.Now()
+		return 0, service.importRepo.Record(eventImport.ID, from, from, 0, 0, nil, &now)
+	}
+	for from < len(rows) {
+		to := from + ChunkSize
+		if to > len(rows) {
+			to = len(rows)
+		}
+		claimed, err := service.importRepo.Claim(eventImport.ID, from, to)
+		if err != nil || !claimed {
+			return total, err
+		}
+
+		created, failed := 0, 0
+		importErrors := []entities.EventImportError{}
+		for _, row := range rows[from:to] {
+			// Baris tidak valid sudah dicatat gagal saat import dibuat
+			if row.Request == nil {
+				continue
+			}
+			_, err := service.eventService.Create(*row.Request, int(eventImport.UserID), nil, nil, searchProvider)
+			if err != nil {
+				failed++
+				importErrors = append(importErrors, rowErrors(row.Row, err)...)
+				continue
+			}
+			created++
+		}
+
+		if err := service.importRepo.Record(eventImport.ID, from, to, created, failed, importErrors, finishedAt(to, rows)); err != nil {
+			return total + created, err
+		}
+		total += created
+		from = to
+	}
+	return total, nil
+}
+
+/*
+ * Parse
+ * -------------------------------
+ * Membaca file import menjadi request event dan memvalidasi
+ * setiap baris dengan aturan yang sama seperti membuat event
+ */
+func (service EventImportService) parse(importRequest entities.EventImportRequest, file *multipart.FileHeader) (string, []entities.EventImportRow, []entities.EventImportError, error) {
+	err := validations.ValidateEventImportRequest(service.validate, importRequest, file)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	format := importRequest.Format
+	if format == "" {
+		format = strings.ToLower(strings.TrimPrefix(filepath.Ext(file.Filename), "."))
+	}
+	mapping, err := parseMapping(importRequest.Mapping)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		return "", nil, nil, web.WebError{Code: 500, Message: "Cannot read the uploaded file"}
+	}
+	defer src.Close()
+
+	var rawRows []rawRow
+	if format == "ics" {
+		rawRows, err = parseICS(src)
+	} else {
+		rawRows, err = parseCSV(src, mapping)
+	}
+	if err != nil {
+		return "", nil, nil, err
+	}
+	if len(rawRows) == 0 {
+		return "", nil, nil, fileError("file does not contain any event")
+	}
+	if len(rawRows) > MaxRows {
+		return "", nil, nil, fileError("file cannot contain more than " + strconv.Itoa(MaxRows) + " events")
+	}
+
+	rows := []entities.EventImportRow{}
+	importErrors := []entities.EventImportError{}
+	for _, raw := range rawRows {
+		eventRequest, items := service.buildRequest(raw.Values, importRequest)
+		row := entities.EventImportRow{Row: raw.Row, Title: eventRequest.Title}
+		if len(items) == 0 {
+			row.Request = &eventRequest
+		}
+		for _, item := range items {
+			importErrors = append(importErrors, entities.EventImportError{Row: raw.Row, Field: item.Field, Error: item.Error})
+		}
+		rows = append(rows, row)
+	}
+	return format, rows, importErrors, nil
+}
+
+/*
+ * Build Request
+ * -------------------------------
+ * Mengubah nilai satu baris menjadi request event, field yang
+ * kosong memakai nilai default dari request import
+ */
+func (service EventImportService) buildRequest(values map[string]string, defaults entities.EventImportRequest) (entities.EventRequest, []web.ValidationErrorItem) {
+	items := []web.ValidationErrorItem{}
+	eventRequest := entities.EventRequest{
+		Title:            values["title"],
+		Slug:             values["slug"],
+		HostedBy:         valueOr(values["hosted_by"], defaults.HostedBy),
+		DatetimeEvent:    values["datetime_event"],
+		DatetimeEventEnd: values["datetime_event_end"],
+		CategoryID:       defaults.CategoryID,
+		Location:         values["location"],
+		Mode:             valueOr(values["mode"], defaults.Mode),
+		MeetingURL:       values["meeting_url"],
+		DialIn:           values["dial_in"],
+		Description:      values["description"],
+		Visibility:       valueOr(values["visibility"], defaults.Visibility),
+	}
+	if values["category_id"] != "" {
+		categoryID, err := strconv.ParseUint(values["category_id"], 10, 32)
+		if err != nil {
+			items = append(items, web.ValidationErrorItem{Field: "category_id", Error: "category_id must be a number"})
+		}
+		eventRequest.CategoryID = uint(categoryID)
+	}
+	if values["capacity"] != "" {
+		capacity, err := strconv.ParseUint(values["capacity"], 10, 32)
+		if err != nil {
+			items = append(items, web.ValidationErrorItem{Field: "capacity", Error: "capacity must be a number"})
+		}
+		eventRequest.Capacity = uint(capacity)
+	}
+	if values["hide_address"] != "" {
+		hideAddress, err := strconv.ParseBool(values["hide_address"])
+		if err != nil {
+			items = append(items, web.ValidationErrorItem{Field: "hide_address", Error: "hide_address must be true or false"})
+		}
+		eventRequest.HideAddress = &hideAddress
+	}
+	if values["tags"] != "" {
+		eventRequest.Tags = []string{values["tags"]}
+	}
+
+	err := validations.ValidateCreateEventRequest(service.validate, eventRequest, nil)
+	if err != nil {
+		items = append(items, err.(web.ValidationError).Errors...)
+	}
+	if eventRequest.DatetimeEvent != "" {
+		if _, err := time.Parse("2006-01-02", eventRequest.DatetimeEvent); err != nil {
+			items = append(items, web.ValidationErrorItem{Field: "datetime_event", Error: "date time event format is invalid"})
+		}
+	}
+	if _, err := tagService.NormalizeTags(eventRequest.Tags); err != nil {
+		items = append(items, web.ValidationErrorItem{Field: "tags", Error: err.Error()})
+	}
+	return eventRequest, items
+}
+
+func rowErrors(row int, err error) []entities.EventImportError {
+	if valErr, ok := err.(web.ValidationError); ok {
+		importErrors := []entities.EventImportError{}
+		for _, item := range valErr.Errors {
+			importErrors = append(importErrors, entities.EventImportError{Row: row, Field: item.Field, Error: item.Error})
+		}
+		return importErrors
+	}
+	return []entities.EventImportError{{Row: row, Error: err.Error()}}
+}
+
+// Import selesai jika baris terakhir sudah dicatat
+func finishedAt(to int, rows []entities.EventImportRow) *time.Time {
+	if to < len(rows) {
+		return nil
+	}
+	now := time.Now()
+	return &now
+}
+
+// Baris valid dari chunk yang terhenti tidak diketahui hasilnya
+func interruptedRows(rows []entities.EventImportRow) (int, []entities.EventImportError) {
+	failed := 0
+	importErrors := []entities.EventImportError{}
+	for _, row := range rows {
+		if row.Request == nil {
+			continue
+		}
+		failed++
+		importErrors = append(importErrors, entities.EventImportError{Row: row.Row, Error: "import stopped while processing this row, the event may not have been created"})
+	}
+	return failed, importErrors
+}
+
+func countInvalid(rows []entities.EventImportRow) int {
+	invalid := 0
+	for _, row := range rows {
+		if row.Request == nil {
+			invalid++
+		}
+	}
+	return invalid
+}
+
+func toErrorResponses(importErrors []entities.EventImportError) []entities.EventImportErrorResponse {
+	errorsRes := []entities.EventImportErrorResponse{}
+	copier.Copy(&errorsRes, &importErrors)
+	return errorsRes
+}
+
+func toImportResponse(eventImport entities.EventImport, importErrors []entities.EventImportError) entities.EventImportResponse {
+	importRes := entities.EventImportResponse{}
+	copier.Copy(&importRes, &eventImport)
+	// Baris yang diklaim tetapi belum dicatat belum dihitung sebagai progres
+	importRes.Processed = eventImport.Recorded
+	importRes.Progress = 100
+	if eventImport.Total > 0 {
+		importRes.Progress = math.Round(float64(eventImport.Recorded)*1000/float64(eventImport.Total)) / 10
+	}
+	importRes.Errors = toErrorResponses(importErrors)
+	return importRes
+}
+
+func valueOr(value string, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
@@ -0,0 +1,14 @@
+package eventimport
+
+import (
+	"mime/multipart"
+	"tupulung/entities"
+	searchProvider "tupulung/utilities/search"
+)
+
+type EventImportServiceInterface interface {
+	DryRun(importRequest entities.EventImportRequest, file *multipart.FileHeader) (entities.EventImportReportResponse, error)
+	Create(importRequest entities.EventImportRequest, file *multipart.FileHeader, userID int) (entities.EventImportResponse, error)
+	Find(id int, userID int) (entities.EventImportResponse, error)
+	Run(searchProvider searchProvider.SearchInterface) (int, error)
+}
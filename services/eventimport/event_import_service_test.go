@@ -0,0 +1,282 @@
+package eventimport_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"testing"
+	"time"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	eventRepository "tupulung/repositories/event"
+	eventImportRepository "tupulung/repositories/eventimport"
+	inviteRepository "tupulung/repositories/invite"
+	likeRepository "tupulung/repositories/like"
+	revisionRepository "tupulung/repositories/revision"
+	slugRepository "tupulung/repositories/slug"
+	tagRepository "tupulung/repositories/tag"
+	templateRepository "tupulung/repositories/template"
+	trendingRepository "tupulung/repositories/trending"
+	userRepository "tupulung/repositories/user"
+	eventService "tupulung/services/event"
+	eventImportService "tupulung/services/eventimport"
+	searchProvider "tupulung/utilities/search"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type fixture struct {
+	importRepo *eventImportRepository.EventImportRepositoryMock
+	eventRepo  *eventRepository.EventRepositoryMock
+	userRepo   *userRepository.UserRepositoryMock
+	likeRepo   *likeRepository.LikeRepositoryMock
+	slugRepo   *slugRepository.SlugRepositoryMock
+}
+
+func newFixture() fixture {
+	return fixture{
+		importRepo: eventImportRepository.NewEventImportRepositoryMock(&mock.Mock{}),
+		eventRepo:  eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+		userRepo:   userRepository.NewUserRepositoryMock(&mock.Mock{}),
+		likeRepo:   likeRepository.NewLikeRepositoryMock(&mock.Mock{}),
+		slugRepo:   slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
+	}
+}
+
+func (f fixture) service() *eventImportService.EventImportService {
+	events := eventService.NewEventService(
+		f.eventRepo,
+		f.userRepo,
+		f.likeRepo,
+		inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+		tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+		templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+		trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+		revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+		f.slugRepo,
+	)
+	return eventImportService.NewEventImportService(f.importRepo, events)
+}
+
+func uploadFile(t *testing.T, filename string, content string) *multipart.FileHeader {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("file", filename)
+	assert.Nil(t, err)
+	part.Write([]byte(content))
+	writer.Close()
+
+	form, err := multipart.NewReader(body, writer.Boundary()).ReadForm(1 << 20)
+	assert.Nil(t, err)
+	return form.File["file"][0]
+}
+
+const sampleCSV = "Event Name,Date,Venue,Summary,Labels\n" +
+	"Golang Meetup,2022-05-01,Jakarta,Monthly meetup,\"golang, meetup\"\n" +
+	",2022-05-02,Bandung,No title,\n" +
+	"\n" +
+	"Rust Meetup,01/05/2022,Surabaya,Wrong date,\n"
+
+const sampleMapping = `{"title":"Event Name","datetime_event":"Date","location":"Venue","description":"Summary","tags":"Labels"}`
+
+func TestDryRun(t *testing.T) {
+	t.Run("csv-with-mapping", func(t *testing.T) {
+		f := newFixture()
+
+		data, err := f.service().DryRun(entities.EventImportRequest{
+			Mapping:    sampleMapping,
+			HostedBy:   "Gophers",
+			CategoryID: 1,
+		}, uploadFile(t, "events.csv", sampleCSV))
+
+		assert.Nil(t, err)
+		assert.Equal(t, 3, data.Total)
+		assert.Equal(t, 1, data.Valid)
+		assert.Equal(t, 2, data.Invalid)
+		assert.Equal(t, []entities.EventImportErrorResponse{
+			{Row: 3, Field: "title", Error: "Title field must be filled"},
+			{Row: 5, Field: "datetime_event", Error: "date time event format is invalid"},
+		}, data.Errors)
+	})
+	t.Run("ics", func(t *testing.T) {
+		f := newFixture()
+		ics := "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n" +
+			"BEGIN:VEVENT\r\nSUMMARY:Golang Meetup\r\nDTSTART;VALUE=DATE:20220501\r\nDTEND;VALUE=DATE:20220503\r\n" +
+			"LOCATION:Jakarta\\, Indonesia\r\nDESCRIPTION:Monthly meetup\\nwith pizza and a very long line that\r\n  continues here\r\nEND:VEVENT\r\n" +
+			"BEGIN:VEVENT\r\nSUMMARY:Broken\r\nDTSTART:20220510T090000Z\r\nDTEND:20220509T100000Z\r\n" +
+			"LOCATION:Bandung\r\nDESCRIPTION:Ends before it starts\r\nEND:VEVENT\r\n" +
+			"END:VCALENDAR\r\n"
+
+		data, err := f.service().DryRun(entities.EventImportRequest{HostedBy: "Gophers", CategoryID: 1}, uploadFile(t, "calendar.ics", ics))
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, data.Total)
+		assert.Equal(t, 1, data.Valid)
+		assert.Equal(t, []entities.EventImportErrorResponse{
+			{Row: 2, Field: "datetime_event_end", Error: "datetime_event_end cannot be before datetime_event"},
+		}, data.Errors)
+	})
+	t.Run("unknown-mapping-field", func(t *testing.T) {
+		f := newFixture()
+
+		_, err := f.service().DryRun(entities.EventImportRequest{Mapping: `{"name":"Event Name"}`}, uploadFile(t, "events.csv", sampleCSV))
+
+		assert.Equal(t, web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors:  []web.ValidationErrorItem{{Field: "mapping", Error: "mapping contains unknown field name"}},
+		}, err)
+	})
+	t.Run("missing-mapped-column", func(t *testing.T) {
+		f := newFixture()
+
+		_, err := f.service().DryRun(entities.EventImportRequest{Mapping: `{"title":"Name"}`}, uploadFile(t, "events.csv", sampleCSV))
+
+		assert.Equal(t, web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors:  []web.ValidationErrorItem{{Field: "mapping", Error: "mapping column Name is not in the file"}},
+		}, err)
+	})
+	t.Run("unsupported-file", func(t *testing.T) {
+		f := newFixture()
+
+		_, err := f.service().DryRun(entities.EventImportRequest{}, uploadFile(t, "events.xlsx", "binary"))
+
+		assert.Equal(t, web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors:  []web.ValidationErrorItem{{Field: "file", Error: "file extension must be one of csv or ics"}},
+		}, err)
+	})
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		f := newFixture()
+		stored := eventImportRepository.EventImportCollection[0]
+		stored.Status, stored.Processed, stored.Recorded, stored.Total, stored.Created, stored.Failed = "pending", 0, 0, 3, 0, 2
+		f.importRepo.Mock.On("Store", 2).Return(stored, nil)
+
+		data, err := f.service().Create(entities.EventImportRequest{
+			Mapping:    sampleMapping,
+			HostedBy:   "Gophers",
+			CategoryID: 1,
+		}, uploadFile(t, "events.csv", sampleCSV), 1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "pending", data.Status)
+		assert.Equal(t, float64(0), data.Progress)
+		assert.Equal(t, 2, len(data.Errors))
+		f.importRepo.Mock.AssertCalled(t, "Store", 2)
+	})
+}
+
+func TestFind(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		f := newFixture()
+		f.importRepo.Mock.On("Find").Return(eventImportRepository.EventImportCollection[0], nil)
+		f.importRepo.Mock.On("FindErrors").Return(eventImportRepository.EventImportErrorCollection, nil)
+
+		data, err := f.service().Find(1, 1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, float64(50), data.Progress)
+		assert.Equal(t, []entities.EventImportErrorResponse{{Row: 3, Field: "title", Error: "title field must be filled"}}, data.Errors)
+	})
+	t.Run("not-owner", func(t *testing.T) {
+		f := newFixture()
+		f.importRepo.Mock.On("Find").Return(eventImportRepository.EventImportCollection[0], nil)
+
+		_, err := f.service().Find(1, 2)
+
+		assert.Equal(t, web.WebError{Code: 401, Message: "Only the importer can see this import"}, err)
+	})
+}
+
+func TestRun(t *testing.T) {
+	pendingImport := func(t *testing.T) entities.EventImport {
+		payload, err := json.Marshal([]entities.EventImportRow{
+			{Row: 2, Title: "Golang Meetup", Request: &entities.EventRequest{
+				Title:         "Golang Meetup",
+				HostedBy:      "Gophers",
+				DatetimeEvent: "2022-05-01",
+				CategoryID:    1,
+				Location:      "Jakarta",
+				Description:   "Monthly meetup",
+			}},
+			{Row: 3, Title: ""},
+		})
+		assert.Nil(t, err)
+		eventImport := eventImportRepository.EventImportCollection[0]
+		eventImport.Status, eventImport.Processed, eventImport.Recorded, eventImport.Total, eventImport.Created, eventImport.Failed = "pending", 0, 0, 2, 0, 1
+		eventImport.Payload = string(payload)
+		return eventImport
+	}
+
+	t.Run("success", func(t *testing.T) {
+		f := newFixture()
+		f.importRepo.Mock.On("FindPending").Return([]entities.EventImport{pendingImport(t)}, nil)
+		f.importRepo.Mock.On("Claim", 0, 2).Return(true, nil)
+		f.importRepo.Mock.On("Record", 1, 0).Return(nil)
+		f.userRepo.Mock.On("Find").Return(userRepository.UserCollection[0], nil)
+		f.eventRepo.Mock.On("Store").Return(eventRepository.EventCollection[0], nil)
+		f.eventRepo.Mock.On("Find").Return(eventRepository.EventCollection[0], nil)
+		f.likeRepo.Mock.On("CountLikeByEvent").Return(0, nil)
+		f.slugRepo.Mock.On("IsTaken", mock.Anything).Return(false, nil)
+		search := searchProvider.NewSearchMock(&mock.Mock{})
+		search.Mock.On("IndexEvent").Return(nil)
+
+		created, err := f.service().Run(search)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, created)
+		f.eventRepo.Mock.AssertNumberOfCalls(t, "Store", 1)
+		f.importRepo.Mock.AssertCalled(t, "Record", 1, 0)
+	})
+	t.Run("already-claimed", func(t *testing.T) {
+		f := newFixture()
+		f.importRepo.Mock.On("FindPending").Return([]entities.EventImport{pendingImport(t)}, nil)
+		f.importRepo.Mock.On("Claim", 0, 2).Return(false, nil)
+		search := searchProvider.NewSearchMock(&mock.Mock{})
+
+		created, err := f.service().Run(search)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 0, created)
+		f.eventRepo.Mock.AssertNotCalled(t, "Store")
+		f.importRepo.Mock.AssertNotCalled(t, "Record", mock.Anything, mock.Anything)
+	})
+	t.Run("interrupted-chunk", func(t *testing.T) {
+		claimedAt := time.Now().Add(-2 * eventImportService.ClaimTimeout)
+		eventImport := pendingImport(t)
+		eventImport.Status, eventImport.Processed, eventImport.ClaimedAt = "running", 2, &claimedAt
+		f := newFixture()
+		f.importRepo.Mock.On("FindPending").Return([]entities.EventImport{eventImport}, nil)
+		f.importRepo.Mock.On("Record", 0, 1).Return(nil)
+		search := searchProvider.NewSearchMock(&mock.Mock{})
+
+		created, err := f.service().Run(search)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 0, created)
+		f.eventRepo.Mock.AssertNotCalled(t, "Store")
+		f.importRepo.Mock.AssertNotCalled(t, "Claim", mock.Anything, mock.Anything)
+		f.importRepo.Mock.AssertCalled(t, "Record", 0, 1)
+	})
+	t.Run("chunk-still-running", func(t *testing.T) {
+		claimedAt := time.Now()
+		eventImport := pendingImport(t)
+		eventImport.Status, eventImport.Processed, eventImport.ClaimedAt = "running", 2, &claimedAt
+		f := newFixture()
+		f.importRepo.Mock.On("FindPending").Return([]entities.EventImport{eventImport}, nil)
+		search := searchProvider.NewSearchMock(&mock.Mock{})
+
+		created, err := f.service().Run(search)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 0, created)
+		f.importRepo.Mock.AssertNotCalled(t, "Record", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,282 @@
+package gallery
+
+import (
+	"mime/multipart"
+	"net/url"
+	"strconv"
+	"strings"
+	"tupulung/deliveries/validations"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	eventRepository "tupulung/repositories/event"
+	galleryRepository "tupulung/repositories/gallery"
+	inviteRepository "tupulung/repositories/invite"
+	cohostService "tupulung/services/cohost"
+	participantService "tupulung/services/participant"
+	storageProvider "tupulung/utilities/storage"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/jinzhu/copier"
+)
+
+// Jumlah maksimal gambar gallery untuk satu event
+const MaxImagesPerEvent = 20
+
+type GalleryService struct {
+	galleryRepo galleryRepository.GalleryRepositoryInterface
+	eventRepo   eventRepository.EventRepositoryInterface
+	inviteRepo  inviteRepository.InviteRepositoryInterface
+	validate    *validator.Validate
+}
+
+func NewGalleryService(galleryRepo galleryRepository.GalleryRepositoryInterface, eventRepo eventRepository.EventRepositoryInterface, inviteRepo inviteRepository.InviteRepositoryInterface) *GalleryService {
+	return &GalleryService{
+		galleryRepo: galleryRepo,
+		eventRepo:   eventRepo,
+		inviteRepo:  inviteRepo,
+		validate:    validator.New(),
+	}
+}
+
+/*
+ * Find All
+ * -------------------------------
+ * Mengambil gallery event sesuai urutan,
+ * aturan aksesnya sama dengan detail event
+ */
+func (service GalleryService) FindAll(eventID, viewerID int, inviteCode string) ([]entities.EventImageResponse, error) {
+	event, err := service.eventRepo.Find(eventID)
+	if err != nil {
+		return []entities.EventImageResponse{}, err
+	}
+	if !participantService.CanView(service.inviteRepo, event, viewerID, inviteCode) {
+		return []entities.EventImageResponse{}, web.WebError{Code: 400, Message: "cannot get event data with specified id"}
+	}
+
+	images, err := service.galleryRepo.FindByEvent(eventID)
+	if err != nil {
+		return []entities.EventImageResponse{}, err
+	}
+	return toImageResponses(images, event.Cover), nil
+}
+
+/*
+ * Upload
+ * -------------------------------
+ * Mengupload satu atau beberapa gambar ke akhir gallery,
+ * hanya host dan co-host editor yang dapat mengupload
+ */
+func (service GalleryService) Upload(imageRequest entities.EventImageRequest, eventID, userID int, files []*multipart.FileHeader, storageProvider storageProvider.StorageInterface) ([]entities.EventImageResponse, error) {
+	// Validation
+	err := validations.ValidateEventImageRequest(service.validate, imageRequest, files)
+	if err != nil {
+		return []entities.EventImageResponse{}, err
+	}
+
+	event, err := service.eventRepo.Find(eventID)
+	if err != nil {
+		return []entities.EventImageResponse{}, web.WebError{Code: 400, Message: "Event is not exist"}
+	}
+	if !cohostService.Can(event, userID, cohostService.PermissionUpdate) {
+		return []entities.EventImageResponse{}, web.WebError{Code: 401, Message: "Cannot update gallery of event that belongs to someone else"}
+	}
+	if len(event.Images)+len(files) > MaxImagesPerEvent {
+		return []entities.EventImageResponse{}, web.WebError{Code: 400, Message: "An event can have at most " + strconv.Itoa(MaxImagesPerEvent) + " images"}
+	}
+
+	// Upload images to S3, uploaded objects are removed again when one of them fails
+	position := 0
+	for _, image := range event.Images {
+		if image.Position >= position {
+			position = image.Position + 1
+		}
+	}
+	images := []entities.EventImage{}
+	for i, file := range files {
+		imageURL, err := storageProvider.UploadFromRequest("event/gallery/"+uuid.New().String()+file.Filename, file)
+		if err != nil {
+			deleteImages(images, storageProvider)
+			return []entities.EventImageResponse{}, web.WebError{Code: 500, Message: err.Error()}
+		}
+		images = append(images, entities.EventImage{
+			EventID:  event.ID,
+			URL:      imageURL,
+			Caption:  imageRequest.Caption,
+			Position: position + i,
+		})
+	}
+
+	// Repository action
+	storedImages, err := service.galleryRepo.Store(images)
+	if err != nil {
+		deleteImages(images, storageProvider)
+		return []entities.EventImageResponse{}, err
+	}
+	return toImageResponses(storedImages, event.Cover), nil
+}
+
+/*
+ * Update
+ * -------------------------------
+ * Mengubah caption gambar
+ */
+func (service GalleryService) Update(imageRequest entities.EventImageRequest, id, userID int) (entities.EventImageResponse, error) {
+	err := service.validate.Struct(imageRequest)
+	if err != nil {
+		return entities.EventImageResponse{}, web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors:  []web.ValidationErrorItem{{Field: "caption", Error: "caption cannot be more than 255 characters"}},
+		}
+	}
+
+	image, err := service.findManageable(id, userID)
+	if err != nil {
+		return entities.EventImageResponse{}, err
+	}
+	image.Caption = imageRequest.Caption
+
+	image, err = service.galleryRepo.Update(image)
+	if err != nil {
+		return entities.EventImageResponse{}, err
+	}
+	return toImageResponses([]entities.EventImage{image}, image.Event.Cover)[0], nil
+}
+
+/*
+ * Reorder
+ * -------------------------------
+ * Mengurutkan ulang gallery, ids harus berisi semua gambar event
+ */
+func (service GalleryService) Reorder(orderRequest entities.EventImageOrderRequest, eventID, userID int) ([]entities.EventImageResponse, error) {
+	event, err := service.eventRepo.Find(eventID)
+	if err != nil {
+		return []entities.EventImageResponse{}, web.WebError{Code: 400, Message: "Event is not exist"}
+	}
+	if !cohostService.Can(event, userID, cohostService.PermissionUpdate) {
+		return []entities.EventImageResponse{}, web.WebError{Code: 401, Message: "Cannot update gallery of event that belongs to someone else"}
+	}
+
+	images, err := service.galleryRepo.FindByEvent(eventID)
+	if err != nil {
+		return []entities.EventImageResponse{}, err
+	}
+	remaining := map[uint]bool{}
+	for _, image := range images {
+		remaining[image.ID] = true
+	}
+	for _, id := range orderRequest.IDs {
+		if !remaining[id] {
+			return []entities.EventImageResponse{}, web.WebError{Code: 400, Message: "ids must contain every image of the event exactly once"}
+		}
+		delete(remaining, id)
+	}
+	if len(remaining) > 0 {
+		return []entities.EventImageResponse{}, web.WebError{Code: 400, Message: "ids must contain every image of the event exactly once"}
+	}
+
+	// Repository action
+	err = service.galleryRepo.Reorder(eventID, orderRequest.IDs)
+	if err != nil {
+		return []entities.EventImageResponse{}, err
+	}
+	return service.FindAll(eventID, userID, "")
+}
+
+/*
+ * Set Cover
+ * -------------------------------
+ * Menjadikan gambar gallery sebagai cover event.
+ * Cover lama yang bukan bagian dari gallery ikut dihapus
+ */
+func (service GalleryService) SetCover(id, userID int, storageProvider storageProvider.StorageInterface) (entities.EventImageResponse, error) {
+	image, err := service.findManageable(id, userID)
+	if err != nil {
+		return entities.EventImageResponse{}, err
+	}
+
+	images, err := service.galleryRepo.FindByEvent(int(image.EventID))
+	if err != nil {
+		return entities.EventImageResponse{}, err
+	}
+	previousCover := image.Event.Cover
+
+	err = service.galleryRepo.SetCover(int(image.EventID), image.URL)
+	if err != nil {
+		return entities.EventImageResponse{}, err
+	}
+	if previousCover != "" && previousCover != image.URL && !containsURL(images, previousCover) {
+		storageProvider.Delete(objectPath(previousCover))
+	}
+	return toImageResponses([]entities.EventImage{image}, image.URL)[0], nil
+}
+
+/*
+ * Delete
+ * -------------------------------
+ * Menghapus gambar dari gallery dan storage,
+ * cover event dikosongkan jika gambar tersebut adalah cover
+ */
+func (service GalleryService) Delete(id, userID int, storageProvider storageProvider.StorageInterface) error {
+	image, err := service.findManageable(id, userID)
+	if err != nil {
+		return err
+	}
+
+	err = service.galleryRepo.Delete(id)
+	if err != nil {
+		return err
+	}
+	if image.Event.Cover == image.URL {
+		err = service.galleryRepo.SetCover(int(image.EventID), "")
+		if err != nil {
+			return err
+		}
+	}
+	storageProvider.Delete(objectPath(image.URL))
+	return nil
+}
+
+func (service GalleryService) findManageable(id, userID int) (entities.EventImage, error) {
+	image, err := service.galleryRepo.Find(id)
+	if err != nil {
+		return entities.EventImage{}, err
+	}
+	if !cohostService.Can(image.Event, userID, cohostService.PermissionUpdate) {
+		return entities.EventImage{}, web.WebError{Code: 401, Message: "Cannot update gallery of event that belongs to someone else"}
+	}
+	return image, nil
+}
+
+func toImageResponses(images []entities.EventImage, cover string) []entities.EventImageResponse {
+	imagesRes := []entities.EventImageResponse{}
+	copier.Copy(&imagesRes, &images)
+	for i := range imagesRes {
+		imagesRes[i].IsCover = cover != "" && imagesRes[i].URL == cover
+	}
+	return imagesRes
+}
+
+func containsURL(images []entities.EventImage, imageURL string) bool {
+	for _, image := range images {
+		if image.URL == imageURL {
+			return true
+		}
+	}
+	return false
+}
+
+func deleteImages(images []entities.EventImage, storageProvider storageProvider.StorageInterface) {
+	for _, image := range images {
+		storageProvider.Delete(objectPath(image.URL))
+	}
+}
+
+func objectPath(imageURL string) string {
+	u, err := url.Parse(imageURL)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimPrefix(u.Path, "/")
+}
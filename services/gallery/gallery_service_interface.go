@@ -0,0 +1,16 @@
+package gallery
+
+import (
+	"mime/multipart"
+	"tupulung/entities"
+	storageProvider "tupulung/utilities/storage"
+)
+
+type GalleryServiceInterface interface {
+	FindAll(eventID, viewerID int, inviteCode string) ([]entities.EventImageResponse, error)
+	Upload(imageRequest entities.EventImageRequest, eventID, userID int, files []*multipart.FileHeader, storageProvider storageProvider.StorageInterface) ([]entities.EventImageResponse, error)
+	Update(imageRequest entities.EventImageRequest, id, userID int) (entities.EventImageResponse, error)
+	Reorder(orderRequest entities.EventImageOrderRequest, eventID, userID int) ([]entities.EventImageResponse, error)
+	SetCover(id, userID int, storageProvider storageProvider.StorageInterface) (entities.EventImageResponse, error)
+	Delete(id, userID int, storageProvider storageProvider.StorageInterface) error
+}
@@ -0,0 +1,169 @@
+package gallery_test
+
+import (
+	"mime/multipart"
+	"net/textproto"
+	"testing"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	eventRepository "tupulung/repositories/event"
+	galleryRepository "tupulung/repositories/gallery"
+	inviteRepository "tupulung/repositories/invite"
+	galleryService "tupulung/services/gallery"
+	_storageProvider "tupulung/utilities/storage"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func sampleImageFile() *multipart.FileHeader {
+	return &multipart.FileHeader{
+		Filename: "venue.png",
+		Header: textproto.MIMEHeader{
+			"Content-Disposition": []string{
+				"form-data; name=\"image\"; filename=\"venue.png\"",
+			},
+			"Content-Type": []string{
+				"image/png",
+			},
+		},
+		Size: 155 * 1024,
+	}
+}
+
+func TestUpload(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventSample.Images = galleryRepository.EventImageCollection
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		galleryRepositoryMock := galleryRepository.NewGalleryRepositoryMock(&mock.Mock{})
+		stored := []entities.EventImage{{ID: 3, EventID: 1, URL: "https://tupulung.s3.amazonaws.com/event/gallery/new.png", Position: 2}}
+		galleryRepositoryMock.Mock.On("Store").Return(stored, nil)
+		storageProvider := _storageProvider.NewStorageMock(&mock.Mock{})
+		storageProvider.Mock.On("UploadFromRequest").Return(stored[0].URL, nil)
+
+		service := galleryService.NewGalleryService(galleryRepositoryMock, eventRepositoryMock, inviteRepository.NewInviteRepositoryMock(&mock.Mock{}))
+		data, err := service.Upload(entities.EventImageRequest{Caption: "Stage"}, 1, int(eventSample.UserID), []*multipart.FileHeader{sampleImageFile()}, storageProvider)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(data))
+		assert.Equal(t, 2, data[0].Position)
+	})
+	t.Run("no-files", func(t *testing.T) {
+		service := galleryService.NewGalleryService(galleryRepository.NewGalleryRepositoryMock(&mock.Mock{}), eventRepository.NewEventRepositoryMock(&mock.Mock{}), inviteRepository.NewInviteRepositoryMock(&mock.Mock{}))
+		_, err := service.Upload(entities.EventImageRequest{}, 1, 1, nil, _storageProvider.NewStorageMock(&mock.Mock{}))
+
+		assert.Error(t, err)
+		_, ok := err.(web.ValidationError)
+		assert.True(t, ok)
+	})
+	t.Run("not-host", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+
+		service := galleryService.NewGalleryService(galleryRepository.NewGalleryRepositoryMock(&mock.Mock{}), eventRepositoryMock, inviteRepository.NewInviteRepositoryMock(&mock.Mock{}))
+		_, err := service.Upload(entities.EventImageRequest{}, 1, 99, []*multipart.FileHeader{sampleImageFile()}, _storageProvider.NewStorageMock(&mock.Mock{}))
+
+		assert.Equal(t, 401, err.(web.WebError).Code)
+	})
+	t.Run("over-limit", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventSample.Images = make([]entities.EventImage, galleryService.MaxImagesPerEvent)
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+
+		service := galleryService.NewGalleryService(galleryRepository.NewGalleryRepositoryMock(&mock.Mock{}), eventRepositoryMock, inviteRepository.NewInviteRepositoryMock(&mock.Mock{}))
+		_, err := service.Upload(entities.EventImageRequest{}, 1, int(eventSample.UserID), []*multipart.FileHeader{sampleImageFile()}, _storageProvider.NewStorageMock(&mock.Mock{}))
+
+		assert.Equal(t, 400, err.(web.WebError).Code)
+	})
+	t.Run("store-failed", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		galleryRepositoryMock := galleryRepository.NewGalleryRepositoryMock(&mock.Mock{})
+		galleryRepositoryMock.Mock.On("Store").Return([]entities.EventImage{}, web.WebError{Code: 500, Message: "server error"})
+		storageProvider := _storageProvider.NewStorageMock(&mock.Mock{})
+		storageProvider.Mock.On("UploadFromRequest").Return("https://tupulung.s3.amazonaws.com/event/gallery/new.png", nil)
+		storageProvider.Mock.On("Delete").Return(nil)
+
+		service := galleryService.NewGalleryService(galleryRepositoryMock, eventRepositoryMock, inviteRepository.NewInviteRepositoryMock(&mock.Mock{}))
+		_, err := service.Upload(entities.EventImageRequest{}, 1, int(eventSample.UserID), []*multipart.FileHeader{sampleImageFile()}, storageProvider)
+
+		assert.Error(t, err)
+		storageProvider.Mock.AssertNumberOfCalls(t, "Delete", 1)
+	})
+}
+
+func TestReorder(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		galleryRepositoryMock := galleryRepository.NewGalleryRepositoryMock(&mock.Mock{})
+		galleryRepositoryMock.Mock.On("FindByEvent").Return(galleryRepository.EventImageCollection, nil)
+		galleryRepositoryMock.Mock.On("Reorder", []uint{2, 1}).Return(nil)
+
+		service := galleryService.NewGalleryService(galleryRepositoryMock, eventRepositoryMock, inviteRepository.NewInviteRepositoryMock(&mock.Mock{}))
+		_, err := service.Reorder(entities.EventImageOrderRequest{IDs: []uint{2, 1}}, 1, int(eventSample.UserID))
+
+		assert.Nil(t, err)
+		galleryRepositoryMock.Mock.AssertCalled(t, "Reorder", []uint{2, 1})
+	})
+	t.Run("missing-image", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		galleryRepositoryMock := galleryRepository.NewGalleryRepositoryMock(&mock.Mock{})
+		galleryRepositoryMock.Mock.On("FindByEvent").Return(galleryRepository.EventImageCollection, nil)
+
+		service := galleryService.NewGalleryService(galleryRepositoryMock, eventRepositoryMock, inviteRepository.NewInviteRepositoryMock(&mock.Mock{}))
+		_, err := service.Reorder(entities.EventImageOrderRequest{IDs: []uint{2, 2}}, 1, int(eventSample.UserID))
+
+		assert.Equal(t, 400, err.(web.WebError).Code)
+	})
+}
+
+func TestSetCover(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		image := galleryRepository.EventImageCollection[1]
+		image.Event = eventRepository.EventCollection[0]
+		image.Event.Cover = "https://tupulung.s3.amazonaws.com/event/cover.png"
+		galleryRepositoryMock := galleryRepository.NewGalleryRepositoryMock(&mock.Mock{})
+		galleryRepositoryMock.Mock.On("Find").Return(image, nil)
+		galleryRepositoryMock.Mock.On("FindByEvent").Return(galleryRepository.EventImageCollection, nil)
+		galleryRepositoryMock.Mock.On("SetCover", image.URL).Return(nil)
+		storageProvider := _storageProvider.NewStorageMock(&mock.Mock{})
+		storageProvider.Mock.On("Delete").Return(nil)
+
+		service := galleryService.NewGalleryService(galleryRepositoryMock, eventRepository.NewEventRepositoryMock(&mock.Mock{}), inviteRepository.NewInviteRepositoryMock(&mock.Mock{}))
+		data, err := service.SetCover(int(image.ID), int(image.Event.UserID), storageProvider)
+
+		assert.Nil(t, err)
+		assert.True(t, data.IsCover)
+		storageProvider.Mock.AssertNumberOfCalls(t, "Delete", 1)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	t.Run("cover-image", func(t *testing.T) {
+		image := galleryRepository.EventImageCollection[0]
+		image.Event = eventRepository.EventCollection[0]
+		image.Event.Cover = image.URL
+		galleryRepositoryMock := galleryRepository.NewGalleryRepositoryMock(&mock.Mock{})
+		galleryRepositoryMock.Mock.On("Find").Return(image, nil)
+		galleryRepositoryMock.Mock.On("Delete").Return(nil)
+		galleryRepositoryMock.Mock.On("SetCover", "").Return(nil)
+		storageProvider := _storageProvider.NewStorageMock(&mock.Mock{})
+		storageProvider.Mock.On("Delete").Return(nil)
+
+		service := galleryService.NewGalleryService(galleryRepositoryMock, eventRepository.NewEventRepositoryMock(&mock.Mock{}), inviteRepository.NewInviteRepositoryMock(&mock.Mock{}))
+		err := service.Delete(int(image.ID), int(image.Event.UserID), storageProvider)
+
+		assert.Nil(t, err)
+		galleryRepositoryMock.Mock.AssertCalled(t, "SetCover", "")
+		storageProvider.Mock.AssertNumberOfCalls(t, "Delete", 1)
+	})
+}
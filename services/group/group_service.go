@@ -0,0 +1,519 @@
+package group
+
+import (
+	"mime/multipart"
+	"net/url"
+	"strings"
+	"tupulung/deliveries/validations"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	groupRepository "tupulung/repositories/group"
+	notificationRepository "tupulung/repositories/notification"
+	eventService "tupulung/services/event"
+	searchProvider "tupulung/utilities/search"
+	storageProvider "tupulung/utilities/storage"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/jinzhu/copier"
+	"github.com/labstack/gommon/log"
+)
+
+const (
+	RoleOrganizer = "organizer"
+	RoleMember    = "member"
+
+	StatusPending  = "pending"
+	StatusApproved = "approved"
+
+	JoinOpen     = "open"
+	JoinApproval = "approval"
+)
+
+type GroupService struct {
+	groupRepo        groupRepository.GroupRepositoryInterface
+	eventService     *eventService.EventService
+	notificationRepo notificationRepository.NotificationRepositoryInterface
+	validate         *validator.Validate
+}
+
+func NewGroupService(
+	groupRepo groupRepository.GroupRepositoryInterface,
+	eventService *eventService.EventService,
+	notificationRepo notificationRepository.NotificationRepositoryInterface,
+) *GroupService {
+	return &GroupService{
+		groupRepo:        groupRepo,
+		eventService:     eventService,
+		notificationRepo: notificationRepo,
+		validate:         validator.New(),
+	}
+}
+
+/*
+ * Find All
+ * -------------------------------
+ * Mengambil daftar group dari yang terbaru beserta jumlah anggotanya
+ */
+func (service GroupService) FindAll(keyword string, limit, page int) ([]entities.GroupResponse, web.Pagination, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+	if page <= 0 {
+		page = 1
+	}
+	groups, err := service.groupRepo.FindAll(keyword, limit, (page-1)*limit)
+	if err != nil {
+		return []entities.GroupResponse{}, web.Pagination{}, err
+	}
+	count, err := service.groupRepo.CountAll(keyword)
+	if err != nil {
+		return []entities.GroupResponse{}, web.Pagination{}, err
+	}
+	totalPages := int(count) / limit
+	if int(count)%limit > 0 || totalPages == 0 {
+		totalPages++
+	}
+
+	groupsRes := []entities.GroupResponse{}
+	for _, group := range groups {
+		groupRes, err := service.toGroupResponse(group, 0)
+		if err != nil {
+			return []entities.GroupResponse{}, web.Pagination{}, err
+		}
+		groupsRes = append(groupsRes, groupRes)
+	}
+	return groupsRes, web.Pagination{Page: page, Limit: limit, TotalPages: totalPages}, nil
+}
+
+/*
+ * Find
+ * -------------------------------
+ * Mengambil profil group, keanggotaan viewer
+ * ikut ditampilkan jika viewer login
+ */
+func (service GroupService) Find(id int, viewerID int) (entities.GroupResponse, error) {
+	group, err := service.groupRepo.Find(id)
+	if err != nil {
+		return entities.GroupResponse{}, err
+	}
+	return service.toGroupResponse(group, viewerID)
+}
+
+/*
+ * Create
+ * -------------------------------
+ * Membuat group baru, pembuat group otomatis menjadi organizer
+ */
+func (service GroupService) Create(groupRequest entities.GroupRequest, userID int, cover *multipart.FileHeader, storageProvider storageProvider.StorageInterface) (entities.GroupResponse, error) {
+	groupFiles := []*multipart.FileHeader{}
+	if cover != nil {
+		groupFiles = append(groupFiles, cover)
+	}
+	err := validations.ValidateGroupRequest(service.validate, groupRequest, groupFiles)
+	if err != nil {
+		return entities.GroupResponse{}, err
+	}
+
+	group := entities.Group{
+		Name:        strings.TrimSpace(groupRequest.Name),
+		Description: groupRequest.Description,
+		JoinPolicy:  groupRequest.JoinPolicy,
+		UserID:      uint(userID),
+	}
+	if group.JoinPolicy == "" {
+		group.JoinPolicy = JoinApproval
+	}
+	if cover != nil {
+		group.Cover, err = uploadCover(cover, storageProvider)
+		if err != nil {
+			return entities.GroupResponse{}, err
+		}
+	}
+
+	group, err = service.groupRepo.Store(group)
+	if err != nil {
+		return entities.GroupResponse{}, err
+	}
+	return service.Find(int(group.ID), userID)
+}
+
+/*
+ * Update
+ * -------------------------------
+ * Mengubah profil group, hanya organizer yang dapat mengubah
+ */
+func (service GroupService) Update(groupRequest entities.GroupRequest, id int, userID int, cover *multipart.FileHeader, storageProvider storageProvider.StorageInterface, searchProvider searchProvider.SearchInterface) (entities.GroupResponse, error) {
+	groupFiles := []*multipart.FileHeader{}
+	if cover != nil {
+		groupFiles = append(groupFiles, cover)
+	}
+	err := validations.ValidateGroupRequest(service.validate, groupRequest, groupFiles)
+	if err != nil {
+		return entities.GroupResponse{}, err
+	}
+	group, err := service.groupRepo.Find(id)
+	if err != nil {
+		return entities.GroupResponse{}, err
+	}
+	if !service.isOrganizer(id, userID) {
+		return entities.GroupResponse{}, web.WebError{Code: 401, Message: "Only organizers can manage the group"}
+	}
+
+	oldName := group.Name
+	group.Name = strings.TrimSpace(groupRequest.Name)
+	group.Description = groupRequest.Description
+	if groupRequest.JoinPolicy != "" {
+		group.JoinPolicy = groupRequest.JoinPolicy
+	}
+	if cover != nil {
+		// Hapus cover sebelumnya
+		if group.Cover != "" {
+			deleteCover(group.Cover, storageProvider)
+		}
+		group.Cover, err = uploadCover(cover, storageProvider)
+		if err != nil {
+			return entities.GroupResponse{}, err
+		}
+	}
+
+	group, err = service.groupRepo.Update(group)
+	if err != nil {
+		return entities.GroupResponse{}, err
+	}
+	// HostedBy event group ikut berubah, search index diperbarui secara best-effort
+	if group.Name != oldName {
+		if err := service.eventService.ReindexGroup(group.ID, searchProvider); err != nil {
+			log.Warn("Cannot reindex group events: " + err.Error())
+		}
+	}
+	return service.Find(int(group.ID), userID)
+}
+
+/*
+ * Delete
+ * -------------------------------
+ * Menghapus group, event yang pernah diselenggarakan group tetap ada
+ */
+func (service GroupService) Delete(id int, userID int, storageProvider storageProvider.StorageInterface) error {
+	group, err := service.groupRepo.Find(id)
+	if err != nil {
+		return err
+	}
+	if !service.isOrganizer(id, userID) {
+		return web.WebError{Code: 401, Message: "Only organizers can manage the group"}
+	}
+	err = service.groupRepo.Delete(id)
+	if err != nil {
+		return err
+	}
+	if group.Cover != "" {
+		deleteCover(group.Cover, storageProvider)
+	}
+	return nil
+}
+
+/*
+ * Members
+ * -------------------------------
+ * Mengambil anggota group, permintaan bergabung
+ * (status pending) hanya dapat dilihat organizer
+ */
+func (service GroupService) Members(id int, viewerID int, status string, limit, page int) ([]entities.GroupMemberResponse, web.Pagination, error) {
+	if _, err := service.groupRepo.Find(id); err != nil {
+		return []entities.GroupMemberResponse{}, web.Pagination{}, err
+	}
+	if status == "" {
+		status = StatusApproved
+	}
+	if status != StatusApproved && status != StatusPending {
+		return []entities.GroupMemberResponse{}, web.Pagination{}, web.WebError{Code: 400, Message: "status must be approved or pending"}
+	}
+	if status == StatusPending && !service.isOrganizer(id, viewerID) {
+		return []entities.GroupMemberResponse{}, web.Pagination{}, web.WebError{Code: 401, Message: "Only organizers can see join requests"}
+	}
+	if limit <= 0 {
+		limit = 20
+	}
+	if page <= 0 {
+		page = 1
+	}
+
+	members, err := service.groupRepo.FindMembers(id, status, limit, (page-1)*limit)
+	if err != nil {
+		return []entities.GroupMemberResponse{}, web.Pagination{}, err
+	}
+	count, err := service.groupRepo.CountMembers(id, status, "")
+	if err != nil {
+		return []entities.GroupMemberResponse{}, web.Pagination{}, err
+	}
+	totalPages := int(count) / limit
+	if int(count)%limit > 0 || totalPages == 0 {
+		totalPages++
+	}
+
+	membersRes := []entities.GroupMemberResponse{}
+	copier.Copy(&membersRes, &members)
+	return membersRes, web.Pagination{Page: page, Limit: limit, TotalPages: totalPages}, nil
+}
+
+/*
+ * Join
+ * -------------------------------
+ * Bergabung ke group, group dengan join policy open langsung
+ * menyetujui anggota baru, selain itu menunggu persetujuan organizer
+ */
+func (service GroupService) Join(id int, userID int) (entities.GroupMemberResponse, error) {
+	group, err := service.groupRepo.Find(id)
+	if err != nil {
+		return entities.GroupMemberResponse{}, err
+	}
+	if member, err := service.groupRepo.FindMember(id, userID); err == nil {
+		if member.Status == StatusPending {
+			return entities.GroupMemberResponse{}, web.WebError{Code: 400, Message: "Join request is already waiting for approval"}
+		}
+		return entities.GroupMemberResponse{}, web.WebError{Code: 400, Message: "User is already a member of this group"}
+	}
+
+	member := entities.GroupMember{
+		GroupID: group.ID,
+		UserID:  uint(userID),
+		Role:    RoleMember,
+		Status:  StatusPending,
+	}
+	if group.JoinPolicy == JoinOpen {
+		member.Status = StatusApproved
+	}
+	member, err = service.groupRepo.StoreMember(member)
+	if err != nil {
+		return entities.GroupMemberResponse{}, err
+	}
+	if member.Status == StatusPending {
+		service.notify([]uint{group.UserID}, nil, "group_join_request", group.Name+": new join request", "A user is waiting for your approval to join "+group.Name)
+	}
+
+	memberRes := entities.GroupMemberResponse{}
+	copier.Copy(&memberRes, &member)
+	return memberRes, nil
+}
+
+/*
+ * Leave
+ * -------------------------------
+ * Keluar dari group atau membatalkan permintaan bergabung,
+ * organizer terakhir tidak dapat meninggalkan group
+ */
+func (service GroupService) Leave(id int, userID int) error {
+	member, err := service.groupRepo.FindMember(id, userID)
+	if err != nil {
+		return err
+	}
+	if member.Role == RoleOrganizer && member.Status == StatusApproved {
+		organizers, err := service.groupRepo.CountMembers(id, StatusApproved, RoleOrganizer)
+		if err != nil {
+			return err
+		}
+		if organizers <= 1 {
+			return web.WebError{Code: 400, Message: "The last organizer cannot leave the group"}
+		}
+	}
+	return service.groupRepo.DeleteMember(id, userID)
+}
+
+/*
+ * Approve
+ * -------------------------------
+ * Menyetujui permintaan bergabung, hanya untuk organizer
+ */
+func (service GroupService) Approve(id int, userID int, memberID int) (entities.GroupMemberResponse, error) {
+	group, err := service.groupRepo.Find(id)
+	if err != nil {
+		return entities.GroupMemberResponse{}, err
+	}
+	if !service.isOrganizer(id, userID) {
+		return entities.GroupMemberResponse{}, web.WebError{Code: 401, Message: "Only organizers can manage the group"}
+	}
+	member, err := service.groupRepo.FindMember(id, memberID)
+	if err != nil {
+		return entities.GroupMemberResponse{}, err
+	}
+	if member.Status != StatusPending {
+		return entities.GroupMemberResponse{}, web.WebError{Code: 400, Message: "User is already a member of this group"}
+	}
+	member.Status = StatusApproved
+	member, err = service.groupRepo.UpdateMember(member)
+	if err != nil {
+		return entities.GroupMemberResponse{}, err
+	}
+	service.notify([]uint{member.UserID}, nil, "group_join_approved", group.Name+": join request approved", "You are now a member of "+group.Name)
+
+	memberRes := entities.GroupMemberResponse{}
+	copier.Copy(&memberRes, &member)
+	return memberRes, nil
+}
+
+/*
+ * Remove Member
+ * -------------------------------
+ * Menolak permintaan bergabung atau mengeluarkan anggota,
+ * organizer keluar dari group melalui Leave
+ */
+func (service GroupService) RemoveMember(id int, userID int, memberID int) error {
+	if _, err := service.groupRepo.Find(id); err != nil {
+		return err
+	}
+	if !service.isOrganizer(id, userID) {
+		return web.WebError{Code: 401, Message: "Only organizers can manage the group"}
+	}
+	if userID == memberID {
+		return web.WebError{Code: 400, Message: "Use leave to remove yourself from the group"}
+	}
+	if _, err := service.groupRepo.FindMember(id, memberID); err != nil {
+		return err
+	}
+	return service.groupRepo.DeleteMember(id, memberID)
+}
+
+/*
+ * Set Role
+ * -------------------------------
+ * Mengubah role anggota group, group harus tetap
+ * memiliki minimal satu organizer
+ */
+func (service GroupService) SetRole(roleRequest entities.GroupMemberRoleRequest, id int, userID int, memberID int) (entities.GroupMemberResponse, error) {
+	err := validations.ValidateGroupMemberRoleRequest(service.validate, roleRequest)
+	if err != nil {
+		return entities.GroupMemberResponse{}, err
+	}
+	if _, err := service.groupRepo.Find(id); err != nil {
+		return entities.GroupMemberResponse{}, err
+	}
+	if !service.isOrganizer(id, userID) {
+		return entities.GroupMemberResponse{}, web.WebError{Code: 401, Message: "Only organizers can manage the group"}
+	}
+	member, err := service.groupRepo.FindMember(id, memberID)
+	if err != nil {
+		return entities.GroupMemberResponse{}, err
+	}
+	if member.Status != StatusApproved {
+		return entities.GroupMemberResponse{}, web.WebError{Code: 400, Message: "Join request must be approved first"}
+	}
+	if member.Role == RoleOrganizer && roleRequest.Role == RoleMember {
+		organizers, err := service.groupRepo.CountMembers(id, StatusApproved, RoleOrganizer)
+		if err != nil {
+			return entities.GroupMemberResponse{}, err
+		}
+		if organizers <= 1 {
+			return entities.GroupMemberResponse{}, web.WebError{Code: 400, Message: "The group must have at least one organizer"}
+		}
+	}
+	member.Role = roleRequest.Role
+	member, err = service.groupRepo.UpdateMember(member)
+	if err != nil {
+		return entities.GroupMemberResponse{}, err
+	}
+
+	memberRes := entities.GroupMemberResponse{}
+	copier.Copy(&memberRes, &member)
+	return memberRes, nil
+}
+
+/*
+ * Create Event
+ * -------------------------------
+ * Membuat event atas nama group, HostedBy diisi nama group
+ * dan seluruh anggota group diberi notifikasi event baru
+ */
+func (service GroupService) CreateEvent(eventRequest entities.EventRequest, id int, userID int, cover *multipart.FileHeader, storageProvider storageProvider.StorageInterface, searchProvider searchProvider.SearchInterface) (entities.EventResponse, error) {
+	group, err := service.groupRepo.Find(id)
+	if err != nil {
+		return entities.EventResponse{}, err
+	}
+	if !service.isOrganizer(id, userID) {
+		return entities.EventResponse{}, web.WebError{Code: 401, Message: "Only organizers can host events for the group"}
+	}
+
+	eventRes, err := service.eventService.CreateForGroup(eventRequest, userID, group, cover, storageProvider, searchProvider)
+	if err != nil {
+		return entities.EventResponse{}, err
+	}
+
+	// Notifikasi bersifat best-effort, event sudah tersimpan
+	memberIDs, err := service.groupRepo.MemberIDs(id)
+	if err != nil {
+		log.Warn("Cannot get group members: " + err.Error())
+	} else {
+		recipients := []uint{}
+		for _, memberID := range memberIDs {
+			if memberID != uint(userID) {
+				recipients = append(recipients, memberID)
+			}
+		}
+		eventID := eventRes.ID
+		service.notify(recipients, &eventID, "group_event", group.Name+": "+eventRes.Title, group.Name+" is hosting a new event on "+eventRes.DatetimeEvent.Format("2006-01-02"))
+	}
+
+	return service.eventService.Find(int(eventRes.ID))
+}
+
+func (service GroupService) isOrganizer(groupID int, userID int) bool {
+	if userID == 0 {
+		return false
+	}
+	member, err := service.groupRepo.FindMember(groupID, userID)
+	if err != nil {
+		return false
+	}
+	return member.Role == RoleOrganizer && member.Status == StatusApproved
+}
+
+func (service GroupService) notify(userIDs []uint, eventID *uint, kind string, title string, body string) {
+	if len(userIDs) == 0 {
+		return
+	}
+	notifications := []entities.Notification{}
+	for _, userID := range userIDs {
+		notifications = append(notifications, entities.Notification{
+			UserID:  userID,
+			EventID: eventID,
+			Kind:    kind,
+			Title:   title,
+			Body:    body,
+		})
+	}
+	if err := service.notificationRepo.StoreMany(notifications); err != nil {
+		log.Warn("Cannot store group notification: " + err.Error())
+	}
+}
+
+func (service GroupService) toGroupResponse(group entities.Group, viewerID int) (entities.GroupResponse, error) {
+	groupRes := entities.GroupResponse{}
+	copier.Copy(&groupRes, &group)
+	members, err := service.groupRepo.CountMembers(int(group.ID), StatusApproved, "")
+	if err != nil {
+		return entities.GroupResponse{}, err
+	}
+	groupRes.Members = members
+	if viewerID != 0 {
+		if member, err := service.groupRepo.FindMember(int(group.ID), viewerID); err == nil {
+			memberRes := entities.GroupMemberResponse{}
+			copier.Copy(&memberRes, &member)
+			groupRes.Membership = &memberRes
+		}
+	}
+	return groupRes, nil
+}
+
+func uploadCover(cover *multipart.FileHeader, storageProvider storageProvider.StorageInterface) (string, error) {
+	filename := uuid.New().String() + cover.Filename
+	coverURL, err := storageProvider.UploadFromRequest("group/cover/"+filename, cover)
+	if err != nil {
+		return "", web.WebError{Code: 500, Message: err.Error()}
+	}
+	return coverURL, nil
+}
+
+func deleteCover(cover string, storageProvider storageProvider.StorageInterface) {
+	u, _ := url.Parse(cover)
+	storageProvider.Delete(strings.TrimPrefix(u.Path, "/"))
+}
@@ -0,0 +1,24 @@
+package group
+
+import (
+	"mime/multipart"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	searchProvider "tupulung/utilities/search"
+	storageProvider "tupulung/utilities/storage"
+)
+
+type GroupServiceInterface interface {
+	FindAll(keyword string, limit, page int) ([]entities.GroupResponse, web.Pagination, error)
+	Find(id int, viewerID int) (entities.GroupResponse, error)
+	Create(groupRequest entities.GroupRequest, userID int, cover *multipart.FileHeader, storageProvider storageProvider.StorageInterface) (entities.GroupResponse, error)
+	Update(groupRequest entities.GroupRequest, id int, userID int, cover *multipart.FileHeader, storageProvider storageProvider.StorageInterface, searchProvider searchProvider.SearchInterface) (entities.GroupResponse, error)
+	Delete(id int, userID int, storageProvider storageProvider.StorageInterface) error
+	Members(id int, viewerID int, status string, limit, page int) ([]entities.GroupMemberResponse, web.Pagination, error)
+	Join(id int, userID int) (entities.GroupMemberResponse, error)
+	Leave(id int, userID int) error
+	Approve(id int, userID int, memberID int) (entities.GroupMemberResponse, error)
+	RemoveMember(id int, userID int, memberID int) error
+	SetRole(roleRequest entities.GroupMemberRoleRequest, id int, userID int, memberID int) (entities.GroupMemberResponse, error)
+	CreateEvent(eventRequest entities.EventRequest, id int, userID int, cover *multipart.FileHeader, storageProvider storageProvider.StorageInterface, searchProvider searchProvider.SearchInterface) (entities.EventResponse, error)
+}
@@ -0,0 +1,315 @@
+package group_test
+
+import (
+	"testing"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	eventRepository "tupulung/repositories/event"
+	groupRepository "tupulung/repositories/group"
+	inviteRepository "tupulung/repositories/invite"
+	likeRepository "tupulung/repositories/like"
+	notificationRepository "tupulung/repositories/notification"
+	revisionRepository "tupulung/repositories/revision"
+	slugRepository "tupulung/repositories/slug"
+	tagRepository "tupulung/repositories/tag"
+	templateRepository "tupulung/repositories/template"
+	trendingRepository "tupulung/repositories/trending"
+	userRepository "tupulung/repositories/user"
+	eventService "tupulung/services/event"
+	groupService "tupulung/services/group"
+	searchProvider "tupulung/utilities/search"
+	storageProvider "tupulung/utilities/storage"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type fixture struct {
+	groupRepo        *groupRepository.GroupRepositoryMock
+	notificationRepo *notificationRepository.NotificationRepositoryMock
+	eventRepo        *eventRepository.EventRepositoryMock
+	userRepo         *userRepository.UserRepositoryMock
+	likeRepo         *likeRepository.LikeRepositoryMock
+	slugRepo         *slugRepository.SlugRepositoryMock
+}
+
+func newFixture() fixture {
+	return fixture{
+		groupRepo:        groupRepository.NewGroupRepositoryMock(&mock.Mock{}),
+		notificationRepo: notificationRepository.NewNotificationRepositoryMock(&mock.Mock{}),
+		eventRepo:        eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+		userRepo:         userRepository.NewUserRepositoryMock(&mock.Mock{}),
+		likeRepo:         likeRepository.NewLikeRepositoryMock(&mock.Mock{}),
+		slugRepo:         slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
+	}
+}
+
+func (f fixture) service() *groupService.GroupService {
+	events := eventService.NewEventService(
+		f.eventRepo,
+		f.userRepo,
+		f.likeRepo,
+		inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+		tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+		templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+		trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+		revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+		f.slugRepo,
+	)
+	return groupService.NewGroupService(f.groupRepo, events, f.notificationRepo)
+}
+
+var notMember = web.WebError{Code: 400, Message: "user is not a member of this group"}
+
+func TestCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		f := newFixture()
+		f.groupRepo.Mock.On("Store").Return(groupRepository.GroupCollection[0], nil)
+		f.groupRepo.Mock.On("Find").Return(groupRepository.GroupCollection[0], nil)
+		f.groupRepo.Mock.On("CountMembers", "approved", "").Return(int64(1), nil)
+		f.groupRepo.Mock.On("FindMember", 1).Return(groupRepository.GroupMemberCollection[0], nil)
+		storage := storageProvider.NewStorageMock(&mock.Mock{})
+
+		data, err := f.service().Create(entities.GroupRequest{Name: "Jakarta Gophers"}, 1, nil, storage)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "Jakarta Gophers", data.Name)
+		assert.Equal(t, int64(1), data.Members)
+		assert.Equal(t, "organizer", data.Membership.Role)
+	})
+	t.Run("validation-error", func(t *testing.T) {
+		f := newFixture()
+		storage := storageProvider.NewStorageMock(&mock.Mock{})
+
+		_, err := f.service().Create(entities.GroupRequest{JoinPolicy: "invite"}, 1, nil, storage)
+
+		assert.Equal(t, web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors: []web.ValidationErrorItem{
+				{Field: "name", Error: "name field must be filled"},
+				{Field: "join_policy", Error: "join_policy must be open or approval"},
+			},
+		}, err)
+		f.groupRepo.Mock.AssertNotCalled(t, "Store")
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	t.Run("rename-reindexes-events", func(t *testing.T) {
+		f := newFixture()
+		f.groupRepo.Mock.On("Find").Return(groupRepository.GroupCollection[0], nil)
+		f.groupRepo.Mock.On("FindMember", 1).Return(groupRepository.GroupMemberCollection[0], nil)
+		renamed := groupRepository.GroupCollection[0]
+		renamed.Name = "Renamed"
+		f.groupRepo.Mock.On("Update").Return(renamed, nil)
+		f.groupRepo.Mock.On("CountMembers", mock.Anything, mock.Anything).Return(int64(2), nil)
+		filters := []map[string]string{{"field": "group_id", "operator": "=", "value": "1"}}
+		f.eventRepo.Mock.On("FindAll", -1, -1, filters, mock.Anything).Return(eventRepository.EventCollection[:1], nil)
+		search := searchProvider.NewSearchMock(&mock.Mock{})
+		search.Mock.On("IndexEvent").Return(nil)
+		storage := storageProvider.NewStorageMock(&mock.Mock{})
+
+		_, err := f.service().Update(entities.GroupRequest{Name: "Renamed"}, 1, 1, nil, storage, search)
+
+		assert.Nil(t, err)
+		search.Mock.AssertNumberOfCalls(t, "IndexEvent", 1)
+	})
+	t.Run("not-organizer", func(t *testing.T) {
+		f := newFixture()
+		f.groupRepo.Mock.On("Find").Return(groupRepository.GroupCollection[0], nil)
+		f.groupRepo.Mock.On("FindMember", 2).Return(groupRepository.GroupMemberCollection[1], nil)
+		storage := storageProvider.NewStorageMock(&mock.Mock{})
+
+		_, err := f.service().Update(entities.GroupRequest{Name: "Renamed"}, 1, 2, nil, storage, searchProvider.NewSearchMock(&mock.Mock{}))
+
+		assert.Equal(t, web.WebError{Code: 401, Message: "Only organizers can manage the group"}, err)
+		f.groupRepo.Mock.AssertNotCalled(t, "Update")
+	})
+}
+
+func TestJoin(t *testing.T) {
+	t.Run("approval-group", func(t *testing.T) {
+		f := newFixture()
+		f.groupRepo.Mock.On("Find").Return(groupRepository.GroupCollection[0], nil)
+		f.groupRepo.Mock.On("FindMember", 4).Return(entities.GroupMember{}, notMember)
+		f.groupRepo.Mock.On("StoreMember").Return(entities.GroupMember{GroupID: 1, UserID: 4, Role: "member", Status: "pending"}, nil)
+		f.notificationRepo.Mock.On("StoreMany", 1).Return(nil)
+
+		data, err := f.service().Join(1, 4)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "pending", data.Status)
+		f.notificationRepo.Mock.AssertCalled(t, "StoreMany", 1)
+	})
+	t.Run("open-group", func(t *testing.T) {
+		f := newFixture()
+		f.groupRepo.Mock.On("Find").Return(groupRepository.GroupCollection[1], nil)
+		f.groupRepo.Mock.On("FindMember", 4).Return(entities.GroupMember{}, notMember)
+		f.groupRepo.Mock.On("StoreMember").Return(entities.GroupMember{GroupID: 2, UserID: 4, Role: "member", Status: "approved"}, nil)
+
+		data, err := f.service().Join(2, 4)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "approved", data.Status)
+		f.notificationRepo.Mock.AssertNotCalled(t, "StoreMany", mock.Anything)
+	})
+	t.Run("pending-request", func(t *testing.T) {
+		f := newFixture()
+		f.groupRepo.Mock.On("Find").Return(groupRepository.GroupCollection[0], nil)
+		f.groupRepo.Mock.On("FindMember", 3).Return(groupRepository.GroupMemberCollection[2], nil)
+
+		_, err := f.service().Join(1, 3)
+
+		assert.Equal(t, web.WebError{Code: 400, Message: "Join request is already waiting for approval"}, err)
+		f.groupRepo.Mock.AssertNotCalled(t, "StoreMember")
+	})
+}
+
+func TestLeave(t *testing.T) {
+	t.Run("last-organizer", func(t *testing.T) {
+		f := newFixture()
+		f.groupRepo.Mock.On("FindMember", 1).Return(groupRepository.GroupMemberCollection[0], nil)
+		f.groupRepo.Mock.On("CountMembers", "approved", "organizer").Return(int64(1), nil)
+
+		err := f.service().Leave(1, 1)
+
+		assert.Equal(t, web.WebError{Code: 400, Message: "The last organizer cannot leave the group"}, err)
+		f.groupRepo.Mock.AssertNotCalled(t, "DeleteMember", 1)
+	})
+	t.Run("member", func(t *testing.T) {
+		f := newFixture()
+		f.groupRepo.Mock.On("FindMember", 2).Return(groupRepository.GroupMemberCollection[1], nil)
+		f.groupRepo.Mock.On("DeleteMember", 2).Return(nil)
+
+		err := f.service().Leave(1, 2)
+
+		assert.Nil(t, err)
+		f.groupRepo.Mock.AssertCalled(t, "DeleteMember", 2)
+	})
+}
+
+func TestApprove(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		f := newFixture()
+		approved := groupRepository.GroupMemberCollection[2]
+		approved.Status = "approved"
+		f.groupRepo.Mock.On("Find").Return(groupRepository.GroupCollection[0], nil)
+		f.groupRepo.Mock.On("FindMember", 1).Return(groupRepository.GroupMemberCollection[0], nil)
+		f.groupRepo.Mock.On("FindMember", 3).Return(groupRepository.GroupMemberCollection[2], nil)
+		f.groupRepo.Mock.On("UpdateMember").Return(approved, nil)
+		f.notificationRepo.Mock.On("StoreMany", 1).Return(nil)
+
+		data, err := f.service().Approve(1, 1, 3)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "approved", data.Status)
+	})
+	t.Run("not-organizer", func(t *testing.T) {
+		f := newFixture()
+		f.groupRepo.Mock.On("Find").Return(groupRepository.GroupCollection[0], nil)
+		f.groupRepo.Mock.On("FindMember", 2).Return(groupRepository.GroupMemberCollection[1], nil)
+
+		_, err := f.service().Approve(1, 2, 3)
+
+		assert.Equal(t, web.WebError{Code: 401, Message: "Only organizers can manage the group"}, err)
+		f.groupRepo.Mock.AssertNotCalled(t, "UpdateMember")
+	})
+}
+
+func TestSetRole(t *testing.T) {
+	t.Run("last-organizer", func(t *testing.T) {
+		f := newFixture()
+		f.groupRepo.Mock.On("Find").Return(groupRepository.GroupCollection[0], nil)
+		f.groupRepo.Mock.On("FindMember", 1).Return(groupRepository.GroupMemberCollection[0], nil)
+		f.groupRepo.Mock.On("CountMembers", "approved", "organizer").Return(int64(1), nil)
+
+		_, err := f.service().SetRole(entities.GroupMemberRoleRequest{Role: "member"}, 1, 1, 1)
+
+		assert.Equal(t, web.WebError{Code: 400, Message: "The group must have at least one organizer"}, err)
+	})
+	t.Run("promote", func(t *testing.T) {
+		f := newFixture()
+		promoted := groupRepository.GroupMemberCollection[1]
+		promoted.Role = "organizer"
+		f.groupRepo.Mock.On("Find").Return(groupRepository.GroupCollection[0], nil)
+		f.groupRepo.Mock.On("FindMember", 1).Return(groupRepository.GroupMemberCollection[0], nil)
+		f.groupRepo.Mock.On("FindMember", 2).Return(groupRepository.GroupMemberCollection[1], nil)
+		f.groupRepo.Mock.On("UpdateMember").Return(promoted, nil)
+
+		data, err := f.service().SetRole(entities.GroupMemberRoleRequest{Role: "organizer"}, 1, 1, 2)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "organizer", data.Role)
+	})
+}
+
+func TestMembers(t *testing.T) {
+	t.Run("pending-hidden-from-members", func(t *testing.T) {
+		f := newFixture()
+		f.groupRepo.Mock.On("Find").Return(groupRepository.GroupCollection[0], nil)
+		f.groupRepo.Mock.On("FindMember", 2).Return(groupRepository.GroupMemberCollection[1], nil)
+
+		_, _, err := f.service().Members(1, 2, "pending", 20, 1)
+
+		assert.Equal(t, web.WebError{Code: 401, Message: "Only organizers can see join requests"}, err)
+	})
+	t.Run("approved", func(t *testing.T) {
+		f := newFixture()
+		f.groupRepo.Mock.On("Find").Return(groupRepository.GroupCollection[0], nil)
+		f.groupRepo.Mock.On("FindMembers", "approved").Return(groupRepository.GroupMemberCollection[:2], nil)
+		f.groupRepo.Mock.On("CountMembers", "approved", "").Return(int64(2), nil)
+
+		data, pagination, err := f.service().Members(1, 0, "", 20, 1)
+
+		assert.Nil(t, err)
+		assert.Len(t, data, 2)
+		assert.Equal(t, 1, pagination.TotalPages)
+	})
+}
+
+func TestCreateEvent(t *testing.T) {
+	eventReq := entities.EventRequest{
+		Title:         "Golang Meetup",
+		HostedBy:      "Someone else",
+		DatetimeEvent: "2022-05-01",
+		CategoryID:    1,
+		Location:      "Jakarta",
+		Description:   "Monthly meetup",
+	}
+
+	t.Run("success", func(t *testing.T) {
+		f := newFixture()
+		f.groupRepo.Mock.On("Find").Return(groupRepository.GroupCollection[0], nil)
+		f.groupRepo.Mock.On("FindMember", 1).Return(groupRepository.GroupMemberCollection[0], nil)
+		f.groupRepo.Mock.On("MemberIDs").Return([]uint{1, 2}, nil)
+		f.notificationRepo.Mock.On("StoreMany", 1).Return(nil)
+		f.userRepo.Mock.On("Find").Return(userRepository.UserCollection[0], nil)
+		f.eventRepo.Mock.On("Store").Return(eventRepository.EventCollection[0], nil)
+		f.eventRepo.Mock.On("Find").Return(eventRepository.EventCollection[0], nil)
+		f.likeRepo.Mock.On("CountLikeByEvent").Return(0, nil)
+		f.slugRepo.Mock.On("IsTaken", mock.Anything).Return(false, nil)
+		search := searchProvider.NewSearchMock(&mock.Mock{})
+		search.Mock.On("IndexEvent").Return(nil)
+		storage := storageProvider.NewStorageMock(&mock.Mock{})
+
+		_, err := f.service().CreateEvent(eventReq, 1, 1, nil, storage, search)
+
+		assert.Nil(t, err)
+		f.eventRepo.Mock.AssertCalled(t, "Store")
+		// Pembuat event tidak diberi notifikasi
+		f.notificationRepo.Mock.AssertCalled(t, "StoreMany", 1)
+	})
+	t.Run("not-organizer", func(t *testing.T) {
+		f := newFixture()
+		f.groupRepo.Mock.On("Find").Return(groupRepository.GroupCollection[0], nil)
+		f.groupRepo.Mock.On("FindMember", 2).Return(groupRepository.GroupMemberCollection[1], nil)
+		search := searchProvider.NewSearchMock(&mock.Mock{})
+		storage := storageProvider.NewStorageMock(&mock.Mock{})
+
+		_, err := f.service().CreateEvent(eventReq, 1, 2, nil, storage, search)
+
+		assert.Equal(t, web.WebError{Code: 401, Message: "Only organizers can host events for the group"}, err)
+		f.eventRepo.Mock.AssertNotCalled(t, "Store")
+	})
+}
@@ -0,0 +1,158 @@
+package invite
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"strconv"
+	"time"
+	"tupulung/config"
+	"tupulung/deliveries/validations"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	eventRepository "tupulung/repositories/event"
+	inviteRepository "tupulung/repositories/invite"
+	userRepository "tupulung/repositories/user"
+	cohostService "tupulung/services/cohost"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jinzhu/copier"
+)
+
+type InviteService struct {
+	inviteRepo inviteRepository.InviteRepositoryInterface
+	eventRepo  eventRepository.EventRepositoryInterface
+	userRepo   userRepository.UserRepositoryInterface
+	validate   *validator.Validate
+}
+
+func NewInviteService(inviteRepo inviteRepository.InviteRepositoryInterface, eventRepo eventRepository.EventRepositoryInterface, userRepo userRepository.UserRepositoryInterface) *InviteService {
+	return &InviteService{
+		inviteRepo: inviteRepo,
+		eventRepo:  eventRepo,
+		userRepo:   userRepo,
+		validate:   validator.New(),
+	}
+}
+
+/*
+ * Find All
+ * -------------------------------
+ * Mengambil semua invite sebuah event, hanya host dan co-host moderator yang dapat melihat
+ */
+func (service InviteService) FindAll(eventID int, userID int) ([]entities.EventInviteResponse, error) {
+	event, err := service.eventRepo.Find(eventID)
+	if err != nil {
+		return []entities.EventInviteResponse{}, web.WebError{Code: 400, Message: "Event is not exist"}
+	}
+	if !cohostService.Can(event, userID, cohostService.PermissionModerate) {
+		return []entities.EventInviteResponse{}, web.WebError{Code: 401, Message: "Only the host can manage invites of this event"}
+	}
+
+	invites, err := service.inviteRepo.FindByEvent(eventID)
+	if err != nil {
+		return []entities.EventInviteResponse{}, err
+	}
+	invitesRes := []entities.EventInviteResponse{}
+	for _, invite := range invites {
+		invitesRes = append(invitesRes, toInviteResponse(invite))
+	}
+	return invitesRes, nil
+}
+
+/*
+ * Create
+ * -------------------------------
+ * Membuat invite baru untuk event. Jika user_id diisi,
+ * invite menjadi undangan personal untuk user tersebut
+ */
+func (service InviteService) Create(inviteRequest entities.EventInviteRequest, eventID int, userID int) (entities.EventInviteResponse, error) {
+	// Validation
+	err := validations.ValidateCreateInviteRequest(service.validate, inviteRequest)
+	if err != nil {
+		return entities.EventInviteResponse{}, err
+	}
+
+	event, err := service.eventRepo.Find(eventID)
+	if err != nil {
+		return entities.EventInviteResponse{}, web.WebError{Code: 400, Message: "Event is not exist"}
+	}
+	if !cohostService.Can(event, userID, cohostService.PermissionModerate) {
+		return entities.EventInviteResponse{}, web.WebError{Code: 401, Message: "Only the host can manage invites of this event"}
+	}
+
+	invite := entities.EventInvite{
+		EventID: event.ID,
+		MaxUses: inviteRequest.MaxUses,
+	}
+	if inviteRequest.UserID != 0 {
+		invitee, err := service.userRepo.Find(int(inviteRequest.UserID))
+		if err != nil {
+			return entities.EventInviteResponse{}, web.WebError{Code: 400, Message: "Invited user is not exist"}
+		}
+		invite.UserID = invitee.ID
+	}
+	if inviteRequest.ExpiresAt != "" {
+		expiresAt, err := time.ParseInLocation("2006-01-02 15:04", inviteRequest.ExpiresAt, time.Local)
+		if err != nil {
+			return entities.EventInviteResponse{}, web.WebError{Code: 400, Message: "expires_at format is invalid"}
+		}
+		if expiresAt.Before(time.Now()) {
+			return entities.EventInviteResponse{}, web.WebError{Code: 400, Message: "expires_at must be in the future"}
+		}
+		invite.ExpiresAt = &expiresAt
+	}
+	invite.Code, err = generateInviteCode()
+	if err != nil {
+		return entities.EventInviteResponse{}, web.WebError{Code: 500, Message: "Cannot generate invite code"}
+	}
+
+	// Repository action
+	invite, err = service.inviteRepo.Store(invite)
+	if err != nil {
+		return entities.EventInviteResponse{}, err
+	}
+	return toInviteResponse(invite), nil
+}
+
+/*
+ * Revoke
+ * -------------------------------
+ * Mencabut invite sehingga kode & link tidak dapat digunakan lagi,
+ * participant yang sudah join tetap tercatat
+ */
+func (service InviteService) Revoke(id int, userID int) (entities.EventInviteResponse, error) {
+	invite, err := service.inviteRepo.Find(id)
+	if err != nil {
+		return entities.EventInviteResponse{}, web.WebError{Code: 400, Message: "The requested ID doesn't match with any record"}
+	}
+	if !cohostService.Can(invite.Event, userID, cohostService.PermissionModerate) {
+		return entities.EventInviteResponse{}, web.WebError{Code: 401, Message: "Only the host can manage invites of this event"}
+	}
+	if invite.RevokedAt != nil {
+		return entities.EventInviteResponse{}, web.WebError{Code: 400, Message: "Invite is already revoked"}
+	}
+
+	now := time.Now()
+	invite.RevokedAt = &now
+	invite, err = service.inviteRepo.Update(invite, id)
+	if err != nil {
+		return entities.EventInviteResponse{}, err
+	}
+	return toInviteResponse(invite), nil
+}
+
+func toInviteResponse(invite entities.EventInvite) entities.EventInviteResponse {
+	inviteRes := entities.EventInviteResponse{}
+	copier.Copy(&inviteRes, &invite)
+	inviteRes.Link = config.Get().App.BaseURL + "/api/events/" + strconv.Itoa(int(invite.EventID)) + "?invite=" + invite.Code
+	return inviteRes
+}
+
+func generateInviteCode() (string, error) {
+	bytes := make([]byte, 12)
+	_, err := rand.Read(bytes)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bytes), nil
+}
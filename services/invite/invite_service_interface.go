@@ -0,0 +1,9 @@
+package invite
+
+import "tupulung/entities"
+
+type InviteServiceInterface interface {
+	FindAll(eventID int, userID int) ([]entities.EventInviteResponse, error)
+	Create(inviteRequest entities.EventInviteRequest, eventID int, userID int) (entities.EventInviteResponse, error)
+	Revoke(id int, userID int) (entities.EventInviteResponse, error)
+}
@@ -0,0 +1,176 @@
+package invite_test
+
+import (
+	"testing"
+	"time"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	eventRepository "tupulung/repositories/event"
+	inviteRepository "tupulung/repositories/invite"
+	userRepository "tupulung/repositories/user"
+	inviteService "tupulung/services/invite"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFindAll(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		inviteRepositoryMock := inviteRepository.NewInviteRepositoryMock(&mock.Mock{})
+		inviteRepositoryMock.Mock.On("FindByEvent").Return(inviteRepository.InviteCollection, nil)
+
+		service := inviteService.NewInviteService(
+			inviteRepositoryMock,
+			eventRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+		)
+		data, err := service.FindAll(int(eventSample.ID), int(eventSample.UserID))
+
+		assert.Nil(t, err)
+		assert.Equal(t, len(inviteRepository.InviteCollection), len(data))
+		assert.Contains(t, data[0].Link, "?invite="+inviteRepository.InviteCollection[0].Code)
+	})
+	t.Run("not-host", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		inviteRepositoryMock := inviteRepository.NewInviteRepositoryMock(&mock.Mock{})
+
+		service := inviteService.NewInviteService(
+			inviteRepositoryMock,
+			eventRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+		)
+		_, err := service.FindAll(int(eventSample.ID), 2)
+
+		assert.Error(t, err)
+		inviteRepositoryMock.Mock.AssertNotCalled(t, "FindByEvent")
+	})
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(userRepository.UserCollection[1], nil)
+		inviteRepositoryMock := inviteRepository.NewInviteRepositoryMock(&mock.Mock{})
+		inviteRepositoryMock.Mock.On("Store").Return(inviteRepository.InviteCollection[0], nil)
+
+		service := inviteService.NewInviteService(inviteRepositoryMock, eventRepositoryMock, userRepositoryMock)
+		data, err := service.Create(entities.EventInviteRequest{
+			UserID:    2,
+			MaxUses:   1,
+			ExpiresAt: time.Now().AddDate(0, 0, 7).Format("2006-01-02 15:04"),
+		}, int(eventSample.ID), int(eventSample.UserID))
+
+		assert.Nil(t, err)
+		assert.Equal(t, inviteRepository.InviteCollection[0].Code, data.Code)
+	})
+	t.Run("validation-fail", func(t *testing.T) {
+		inviteRepositoryMock := inviteRepository.NewInviteRepositoryMock(&mock.Mock{})
+
+		service := inviteService.NewInviteService(
+			inviteRepositoryMock,
+			eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+		)
+		_, err := service.Create(entities.EventInviteRequest{ExpiresAt: "next week"}, 1, 1)
+
+		assert.Equal(t, 1, len(err.(web.ValidationError).Errors))
+		inviteRepositoryMock.Mock.AssertNotCalled(t, "Store")
+	})
+	t.Run("expired", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		inviteRepositoryMock := inviteRepository.NewInviteRepositoryMock(&mock.Mock{})
+
+		service := inviteService.NewInviteService(
+			inviteRepositoryMock,
+			eventRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+		)
+		_, err := service.Create(entities.EventInviteRequest{ExpiresAt: "2020-01-01 10:00"}, int(eventSample.ID), int(eventSample.UserID))
+
+		assert.Equal(t, web.WebError{Code: 400, Message: "expires_at must be in the future"}, err)
+		inviteRepositoryMock.Mock.AssertNotCalled(t, "Store")
+	})
+	t.Run("not-host", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		inviteRepositoryMock := inviteRepository.NewInviteRepositoryMock(&mock.Mock{})
+
+		service := inviteService.NewInviteService(
+			inviteRepositoryMock,
+			eventRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+		)
+		_, err := service.Create(entities.EventInviteRequest{}, int(eventSample.ID), 2)
+
+		assert.Error(t, err)
+		inviteRepositoryMock.Mock.AssertNotCalled(t, "Store")
+	})
+}
+
+func TestRevoke(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		inviteSample := inviteRepository.InviteCollection[1]
+		inviteSample.Event = eventRepository.EventCollection[0]
+		inviteRepositoryMock := inviteRepository.NewInviteRepositoryMock(&mock.Mock{})
+		inviteRepositoryMock.Mock.On("Find").Return(inviteSample, nil)
+		revoked := inviteSample
+		now := time.Now()
+		revoked.RevokedAt = &now
+		inviteRepositoryMock.Mock.On("Update").Return(revoked, nil)
+
+		service := inviteService.NewInviteService(
+			inviteRepositoryMock,
+			eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+		)
+		data, err := service.Revoke(int(inviteSample.ID), int(inviteSample.Event.UserID))
+
+		assert.Nil(t, err)
+		assert.NotNil(t, data.RevokedAt)
+	})
+	t.Run("already-revoked", func(t *testing.T) {
+		inviteSample := inviteRepository.InviteCollection[1]
+		inviteSample.Event = eventRepository.EventCollection[0]
+		now := time.Now()
+		inviteSample.RevokedAt = &now
+		inviteRepositoryMock := inviteRepository.NewInviteRepositoryMock(&mock.Mock{})
+		inviteRepositoryMock.Mock.On("Find").Return(inviteSample, nil)
+
+		service := inviteService.NewInviteService(
+			inviteRepositoryMock,
+			eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+		)
+		_, err := service.Revoke(int(inviteSample.ID), int(inviteSample.Event.UserID))
+
+		assert.Error(t, err)
+		inviteRepositoryMock.Mock.AssertNotCalled(t, "Update")
+	})
+	t.Run("not-host", func(t *testing.T) {
+		inviteSample := inviteRepository.InviteCollection[1]
+		inviteSample.Event = eventRepository.EventCollection[0]
+		inviteRepositoryMock := inviteRepository.NewInviteRepositoryMock(&mock.Mock{})
+		inviteRepositoryMock.Mock.On("Find").Return(inviteSample, nil)
+
+		service := inviteService.NewInviteService(
+			inviteRepositoryMock,
+			eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+		)
+		_, err := service.Revoke(int(inviteSample.ID), 2)
+
+		assert.Error(t, err)
+		inviteRepositoryMock.Mock.AssertNotCalled(t, "Update")
+	})
+}
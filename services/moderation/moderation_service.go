@@ -0,0 +1,357 @@
+package moderation
+
+import (
+	"strings"
+	"time"
+	"tupulung/deliveries/validations"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	auditRepository "tupulung/repositories/audit"
+	commentRepository "tupulung/repositories/comment"
+	eventRepository "tupulung/repositories/event"
+	notificationRepository "tupulung/repositories/notification"
+	reportRepository "tupulung/repositories/report"
+	userRepository "tupulung/repositories/user"
+	commentService "tupulung/services/comment"
+	eventService "tupulung/services/event"
+	userService "tupulung/services/user"
+	searchProvider "tupulung/utilities/search"
+	storageProvider "tupulung/utilities/storage"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jinzhu/copier"
+)
+
+const (
+	TargetEvent   = "event"
+	TargetComment = "comment"
+	TargetUser    = "user"
+
+	StatusOpen      = "open"
+	StatusActioned  = "actioned"
+	StatusDismissed = "dismissed"
+
+	ActionDismiss = "dismiss"
+	ActionHide    = "hide"
+	ActionDelete  = "delete"
+	ActionWarn    = "warn"
+	ActionSuspend = "suspend"
+
+	RoleModerator = "moderator"
+	RoleAdmin     = "admin"
+
+	// Lama suspend jika moderator tidak mengisi days
+	DefaultSuspendDays = 7
+)
+
+type ModerationService struct {
+	reportRepo       reportRepository.ReportRepositoryInterface
+	auditRepo        auditRepository.AuditRepositoryInterface
+	userRepo         userRepository.UserRepositoryInterface
+	eventRepo        eventRepository.EventRepositoryInterface
+	commentRepo      commentRepository.CommentRepositoryInterface
+	notificationRepo notificationRepository.NotificationRepositoryInterface
+	eventService     *eventService.EventService
+	commentService   *commentService.CommentService
+	userService      *userService.UserService
+	validate         *validator.Validate
+}
+
+func NewModerationService(
+	reportRepo reportRepository.ReportRepositoryInterface,
+	auditRepo auditRepository.AuditRepositoryInterface,
+	userRepo userRepository.UserRepositoryInterface,
+	eventRepo eventRepository.EventRepositoryInterface,
+	commentRepo commentRepository.CommentRepositoryInterface,
+	notificationRepo notificationRepository.NotificationRepositoryInterface,
+	eventService *eventService.EventService,
+	commentService *commentService.CommentService,
+	userService *userService.UserService,
+) *ModerationService {
+	return &ModerationService{
+		reportRepo:       reportRepo,
+		auditRepo:        auditRepo,
+		userRepo:         userRepo,
+		eventRepo:        eventRepo,
+		commentRepo:      commentRepo,
+		notificationRepo: notificationRepo,
+		eventService:     eventService,
+		commentService:   commentService,
+		userService:      userService,
+		validate:         validator.New(),
+	}
+}
+
+/*
+ * Report
+ * -------------------------------
+ * Melaporkan event, komentar atau user ke antrian moderator.
+ * User tidak bisa melaporkan dirinya atau kontennya sendiri
+ * dan hanya boleh memiliki satu report open per target
+ */
+func (service ModerationService) Report(targetType string, targetID int, reporterID int, reportRequest entities.ReportRequest) (entities.ReportResponse, error) {
+	err := validations.ValidateReportRequest(service.validate, reportRequest)
+	if err != nil {
+		return entities.ReportResponse{}, err
+	}
+	ownerID, err := service.targetOwner(targetType, targetID)
+	if err != nil {
+		return entities.ReportResponse{}, err
+	}
+	if int(ownerID) == reporterID {
+		return entities.ReportResponse{}, web.WebError{Code: 400, Message: "Cannot report yourself or your own content"}
+	}
+	count, err := service.reportRepo.CountOpenByReporter(reporterID, targetType, targetID)
+	if err != nil {
+		return entities.ReportResponse{}, err
+	}
+	if count > 0 {
+		return entities.ReportResponse{}, web.WebError{Code: 400, Message: "You have already reported this " + targetType}
+	}
+
+	report, err := service.reportRepo.Store(entities.Report{
+		ReporterID: uint(reporterID),
+		TargetType: targetType,
+		TargetID:   uint(targetID),
+		Reason:     reportRequest.Reason,
+		Details:    strings.TrimSpace(reportRequest.Details),
+		Status:     StatusOpen,
+	})
+	if err != nil {
+		return entities.ReportResponse{}, err
+	}
+	reportRes := entities.ReportResponse{}
+	copier.Copy(&reportRes, &report)
+	return reportRes, nil
+}
+
+/*
+ * Find All
+ * -------------------------------
+ * Antrian report untuk moderator, dari yang terlama
+ */
+func (service ModerationService) FindAll(moderatorID int, status string, targetType string, limit, page int) ([]entities.ReportResponse, web.Pagination, error) {
+	if err := service.authorize(moderatorID); err != nil {
+		return []entities.ReportResponse{}, web.Pagination{}, err
+	}
+	if status != "" && status != StatusOpen && status != StatusActioned && status != StatusDismissed {
+		return []entities.ReportResponse{}, web.Pagination{}, web.WebError{Code: 400, Message: "status must be one of open, actioned or dismissed"}
+	}
+	if targetType != "" && targetType != TargetEvent && targetType != TargetComment && targetType != TargetUser {
+		return []entities.ReportResponse{}, web.Pagination{}, web.WebError{Code: 400, Message: "target_type must be one of event, comment or user"}
+	}
+	if limit <= 0 {
+		limit = 20
+	}
+	if page <= 0 {
+		page = 1
+	}
+
+	reports, err := service.reportRepo.FindAll(status, targetType, limit, (page-1)*limit)
+	if err != nil {
+		return []entities.ReportResponse{}, web.Pagination{}, err
+	}
+	count, err := service.reportRepo.CountAll(status, targetType)
+	if err != nil {
+		return []entities.ReportResponse{}, web.Pagination{}, err
+	}
+	totalPages := int(count) / limit
+	if int(count)%limit > 0 || totalPages == 0 {
+		totalPages++
+	}
+
+	reportsRes := []entities.ReportResponse{}
+	copier.Copy(&reportsRes, &reports)
+	return reportsRes, web.Pagination{Page: page, Limit: limit, TotalPages: totalPages}, nil
+}
+
+/*
+ * Find
+ * -------------------------------
+ * Detail report tunggal untuk moderator
+ */
+func (service ModerationService) Find(moderatorID int, id int) (entities.ReportResponse, error) {
+	if err := service.authorize(moderatorID); err != nil {
+		return entities.ReportResponse{}, err
+	}
+	report, err := service.reportRepo.Find(id)
+	if err != nil {
+		return entities.ReportResponse{}, err
+	}
+	reportRes := entities.ReportResponse{}
+	copier.Copy(&reportRes, &report)
+	return reportRes, nil
+}
+
+/*
+ * Act
+ * -------------------------------
+ * Menindaklanjuti report melalui service yang sudah ada:
+ * hide dan delete untuk event atau komentar, warn dan suspend
+ * untuk pemilik konten. Semua report open pada target yang sama
+ * ikut ditutup dan aksi dicatat ke audit trail
+ */
+func (service ModerationService) Act(moderatorID int, reportID int, actionRequest entities.ModerationActionRequest, storageProvider storageProvider.StorageInterface, searchProvider searchProvider.SearchInterface) (entities.ReportResponse, error) {
+	if err := service.authorize(moderatorID); err != nil {
+		return entities.ReportResponse{}, err
+	}
+	err := validations.ValidateModerationActionRequest(service.validate, actionRequest)
+	if err != nil {
+		return entities.ReportResponse{}, err
+	}
+	report, err := service.reportRepo.Find(reportID)
+	if err != nil {
+		return entities.ReportResponse{}, err
+	}
+	if report.Status != StatusOpen {
+		return entities.ReportResponse{}, web.WebError{Code: 400, Message: "Report has already been " + report.Status}
+	}
+
+	status := StatusActioned
+	targetID := int(report.TargetID)
+	switch actionRequest.Action {
+	case ActionDismiss:
+		status = StatusDismissed
+	case ActionHide, ActionDelete:
+		err = service.applyContentAction(report.TargetType, targetID, actionRequest.Action, storageProvider, searchProvider)
+	case ActionWarn, ActionSuspend:
+		var ownerID uint
+		ownerID, err = service.targetOwner(report.TargetType, targetID)
+		if err != nil {
+			break
+		}
+		if int(ownerID) == moderatorID {
+			return entities.ReportResponse{}, web.WebError{Code: 400, Message: "Cannot " + actionRequest.Action + " yourself"}
+		}
+		if actionRequest.Action == ActionWarn {
+			err = service.warn(ownerID, report, actionRequest.Note)
+		} else {
+			days := actionRequest.Days
+			if days == 0 {
+				days = DefaultSuspendDays
+			}
+			err = service.userService.Suspend(int(ownerID), time.Now().AddDate(0, 0, days))
+		}
+	}
+	if err != nil {
+		return entities.ReportResponse{}, err
+	}
+
+	err = service.reportRepo.Resolve(report.TargetType, report.TargetID, status, actionRequest.Action, uint(moderatorID), time.Now())
+	if err != nil {
+		return entities.ReportResponse{}, err
+	}
+	reportIDRef := report.ID
+	_, err = service.auditRepo.Store(entities.AuditLog{
+		ActorID:    uint(moderatorID),
+		Action:     actionRequest.Action,
+		TargetType: report.TargetType,
+		TargetID:   report.TargetID,
+		ReportID:   &reportIDRef,
+		Note:       strings.TrimSpace(actionRequest.Note),
+	})
+	if err != nil {
+		return entities.ReportResponse{}, err
+	}
+
+	return service.Find(moderatorID, reportID)
+}
+
+/*
+ * Audit Trail
+ * -------------------------------
+ * Riwayat aksi moderator, dapat dibatasi per target
+ */
+func (service ModerationService) AuditTrail(moderatorID int, targetType string, targetID int, limit, page int) ([]entities.AuditLogResponse, error) {
+	if err := service.authorize(moderatorID); err != nil {
+		return []entities.AuditLogResponse{}, err
+	}
+	if limit <= 0 {
+		limit = 50
+	}
+	if page <= 0 {
+		page = 1
+	}
+	logs, err := service.auditRepo.FindAll(targetType, targetID, limit, (page-1)*limit)
+	if err != nil {
+		return []entities.AuditLogResponse{}, err
+	}
+	logsRes := []entities.AuditLogResponse{}
+	copier.Copy(&logsRes, &logs)
+	return logsRes, nil
+}
+
+/*
+ * Is Moderator
+ * -------------------------------
+ * Moderator dan admin boleh mengelola antrian report
+ */
+func IsModerator(user entities.User) bool {
+	return user.Role == RoleModerator || user.Role == RoleAdmin
+}
+
+func (service ModerationService) authorize(userID int) error {
+	user, err := service.userRepo.Find(userID)
+	if err != nil || !IsModerator(user) {
+		return web.WebError{Code: 403, Message: "Only moderators can manage reports"}
+	}
+	return nil
+}
+
+/*
+ * Target Owner
+ * -------------------------------
+ * Mencari user pemilik target report,
+ * sekaligus memastikan target masih ada
+ */
+func (service ModerationService) targetOwner(targetType string, targetID int) (uint, error) {
+	switch targetType {
+	case TargetEvent:
+		event, err := service.eventRepo.FindWithTeam(targetID)
+		if err != nil {
+			return 0, web.WebError{Code: 400, Message: "cannot get event data with specified id"}
+		}
+		return event.UserID, nil
+	case TargetComment:
+		comment, err := service.commentRepo.Find(targetID)
+		if err != nil || comment.ID == 0 {
+			return 0, web.WebError{Code: 400, Message: "cannot get comment data with specified id"}
+		}
+		return comment.UserID, nil
+	case TargetUser:
+		user, err := service.userRepo.Find(targetID)
+		if err != nil {
+			return 0, web.WebError{Code: 400, Message: "cannot get user data with specified id"}
+		}
+		return user.ID, nil
+	}
+	return 0, web.WebError{Code: 400, Message: "target must be one of event, comment or user"}
+}
+
+func (service ModerationService) applyContentAction(targetType string, targetID int, action string, storageProvider storageProvider.StorageInterface, searchProvider searchProvider.SearchInterface) error {
+	switch {
+	case targetType == TargetEvent && action == ActionHide:
+		return service.eventService.Hide(targetID, true, searchProvider)
+	case targetType == TargetEvent && action == ActionDelete:
+		return service.eventService.Remove(targetID, storageProvider, searchProvider)
+	case targetType == TargetComment && action == ActionHide:
+		return service.commentService.Hide(targetID, true)
+	case targetType == TargetComment && action == ActionDelete:
+		return service.commentService.Remove(targetID)
+	}
+	return web.WebError{Code: 400, Message: "Cannot " + action + " a " + targetType + ", warn or suspend the user instead"}
+}
+
+func (service ModerationService) warn(userID uint, report entities.Report, note string) error {
+	body := "Your " + report.TargetType + " was reported for " + report.Reason + " and reviewed by a moderator."
+	if note = strings.TrimSpace(note); note != "" {
+		body += " " + note
+	}
+	_, err := service.notificationRepo.Store(entities.Notification{
+		UserID: userID,
+		Kind:   "moderation_warning",
+		Title:  "Warning from moderators",
+		Body:   body,
+	})
+	return err
+}
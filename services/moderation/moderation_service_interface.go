@@ -0,0 +1,16 @@
+package moderation
+
+import (
+	"tupulung/entities"
+	"tupulung/entities/web"
+	searchProvider "tupulung/utilities/search"
+	storageProvider "tupulung/utilities/storage"
+)
+
+type ModerationServiceInterface interface {
+	Report(targetType string, targetID int, reporterID int, reportRequest entities.ReportRequest) (entities.ReportResponse, error)
+	FindAll(moderatorID int, status string, targetType string, limit, page int) ([]entities.ReportResponse, web.Pagination, error)
+	Find(moderatorID int, id int) (entities.ReportResponse, error)
+	Act(moderatorID int, reportID int, actionRequest entities.ModerationActionRequest, storageProvider storageProvider.StorageInterface, searchProvider searchProvider.SearchInterface) (entities.ReportResponse, error)
+	AuditTrail(moderatorID int, targetType string, targetID int, limit, page int) ([]entities.AuditLogResponse, error)
+}
@@ -0,0 +1,278 @@
+package moderation_test
+
+import (
+	"testing"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	auditRepository "tupulung/repositories/audit"
+	commentRepository "tupulung/repositories/comment"
+	eventRepository "tupulung/repositories/event"
+	inviteRepository "tupulung/repositories/invite"
+	likeRepository "tupulung/repositories/like"
+	notificationRepository "tupulung/repositories/notification"
+	reportRepository "tupulung/repositories/report"
+	reviewRepository "tupulung/repositories/review"
+	revisionRepository "tupulung/repositories/revision"
+	slugRepository "tupulung/repositories/slug"
+	tagRepository "tupulung/repositories/tag"
+	templateRepository "tupulung/repositories/template"
+	trendingRepository "tupulung/repositories/trending"
+	userRepository "tupulung/repositories/user"
+	commentService "tupulung/services/comment"
+	eventService "tupulung/services/event"
+	moderationService "tupulung/services/moderation"
+	userService "tupulung/services/user"
+	searchProvider "tupulung/utilities/search"
+	storageProvider "tupulung/utilities/storage"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type fixture struct {
+	reportRepo       *reportRepository.ReportRepositoryMock
+	auditRepo        *auditRepository.AuditRepositoryMock
+	userRepo         *userRepository.UserRepositoryMock
+	eventRepo        *eventRepository.EventRepositoryMock
+	commentRepo      *commentRepository.CommentRepositoryMock
+	notificationRepo *notificationRepository.NotificationRepositoryMock
+	search           *searchProvider.SearchMock
+	storage          *storageProvider.StorageMock
+}
+
+func newFixture() fixture {
+	return fixture{
+		reportRepo:       reportRepository.NewReportRepositoryMock(&mock.Mock{}),
+		auditRepo:        auditRepository.NewAuditRepositoryMock(&mock.Mock{}),
+		userRepo:         userRepository.NewUserRepositoryMock(&mock.Mock{}),
+		eventRepo:        eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+		commentRepo:      commentRepository.NewCommentRepositoryMock(&mock.Mock{}),
+		notificationRepo: notificationRepository.NewNotificationRepositoryMock(&mock.Mock{}),
+		search:           searchProvider.NewSearchMock(&mock.Mock{}),
+		storage:          storageProvider.NewStorageMock(&mock.Mock{}),
+	}
+}
+
+func (f fixture) service() *moderationService.ModerationService {
+	events := eventService.NewEventService(
+		f.eventRepo,
+		f.userRepo,
+		likeRepository.NewLikeRepositoryMock(&mock.Mock{}),
+		inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+		tagRepository.NewTagRepositoryMock(&mock.Mock{}),
+		templateRepository.NewTemplateRepositoryMock(&mock.Mock{}),
+		trendingRepository.NewTrendingRepositoryMock(&mock.Mock{}),
+		revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}),
+		slugRepository.NewSlugRepositoryMock(&mock.Mock{}),
+	)
+	comments := commentService.NewCommentService(f.commentRepo, f.userRepo)
+	users := userService.NewUserService(f.userRepo, f.eventRepo, reviewRepository.NewReviewRepositoryMock(&mock.Mock{}))
+	return moderationService.NewModerationService(f.reportRepo, f.auditRepo, f.userRepo, f.eventRepo, f.commentRepo, f.notificationRepo, events, comments, users)
+}
+
+func moderator() entities.User {
+	user := userRepository.UserCollection[1]
+	user.Role = moderationService.RoleModerator
+	return user
+}
+
+func TestReport(t *testing.T) {
+	reportReq := entities.ReportRequest{Reason: "spam", Details: "Posted ten times"}
+	t.Run("success", func(t *testing.T) {
+		f := newFixture()
+		f.eventRepo.Mock.On("FindWithTeam").Return(eventRepository.EventCollection[0], nil)
+		f.reportRepo.Mock.On("CountOpenByReporter").Return(int64(0), nil)
+		f.reportRepo.Mock.On("Store").Return(reportRepository.ReportCollection[0], nil)
+
+		data, err := f.service().Report(moderationService.TargetEvent, 1, 2, reportReq)
+
+		assert.Nil(t, err)
+		assert.Equal(t, reportRepository.ReportCollection[0].ID, data.ID)
+		assert.Equal(t, "open", data.Status)
+	})
+	t.Run("own-content", func(t *testing.T) {
+		f := newFixture()
+		f.eventRepo.Mock.On("FindWithTeam").Return(eventRepository.EventCollection[0], nil)
+
+		_, err := f.service().Report(moderationService.TargetEvent, 1, int(eventRepository.EventCollection[0].UserID), reportReq)
+
+		assert.Equal(t, 400, err.(web.WebError).Code)
+		f.reportRepo.Mock.AssertNotCalled(t, "Store")
+	})
+	t.Run("already-reported", func(t *testing.T) {
+		f := newFixture()
+		f.commentRepo.Mock.On("Find").Return(commentRepository.CommentCollection[0], nil)
+		f.reportRepo.Mock.On("CountOpenByReporter").Return(int64(1), nil)
+
+		_, err := f.service().Report(moderationService.TargetComment, 1, 2, reportReq)
+
+		assert.Equal(t, web.WebError{Code: 400, Message: "You have already reported this comment"}, err)
+		f.reportRepo.Mock.AssertNotCalled(t, "Store")
+	})
+	t.Run("target-not-found", func(t *testing.T) {
+		f := newFixture()
+		f.commentRepo.Mock.On("Find").Return(entities.Comment{}, nil)
+
+		_, err := f.service().Report(moderationService.TargetComment, 99, 2, reportReq)
+
+		assert.Equal(t, 400, err.(web.WebError).Code)
+	})
+	t.Run("validation-fail", func(t *testing.T) {
+		f := newFixture()
+
+		_, err := f.service().Report(moderationService.TargetUser, 1, 2, entities.ReportRequest{Reason: "other"})
+
+		assert.Equal(t, []web.ValidationErrorItem{{Field: "details", Error: "details must be filled when reason is other"}}, err.(web.ValidationError).Errors)
+	})
+}
+
+func TestFindAll(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		f := newFixture()
+		f.userRepo.Mock.On("Find").Return(moderator(), nil)
+		f.reportRepo.Mock.On("FindAll").Return(reportRepository.ReportCollection[:2], nil)
+		f.reportRepo.Mock.On("CountAll").Return(int64(2), nil)
+
+		data, pagination, err := f.service().FindAll(2, "open", "", 20, 1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(data))
+		assert.Equal(t, web.Pagination{Page: 1, Limit: 20, TotalPages: 1}, pagination)
+	})
+	t.Run("not-moderator", func(t *testing.T) {
+		f := newFixture()
+		f.userRepo.Mock.On("Find").Return(userRepository.UserCollection[0], nil)
+
+		_, _, err := f.service().FindAll(1, "open", "", 20, 1)
+
+		assert.Equal(t, 403, err.(web.WebError).Code)
+		f.reportRepo.Mock.AssertNotCalled(t, "FindAll")
+	})
+	t.Run("invalid-status", func(t *testing.T) {
+		f := newFixture()
+		f.userRepo.Mock.On("Find").Return(moderator(), nil)
+
+		_, _, err := f.service().FindAll(2, "closed", "", 20, 1)
+
+		assert.Equal(t, 400, err.(web.WebError).Code)
+	})
+}
+
+func TestAct(t *testing.T) {
+	t.Run("hide-event", func(t *testing.T) {
+		f := newFixture()
+		f.userRepo.Mock.On("Find").Return(moderator(), nil)
+		f.reportRepo.Mock.On("Find").Return(reportRepository.ReportCollection[0], nil)
+		f.eventRepo.Mock.On("Find").Return(eventRepository.EventCollection[0], nil)
+		f.eventRepo.Mock.On("Update").Return(eventRepository.EventCollection[0], nil)
+		f.search.Mock.On("IndexEvent").Return(nil)
+		f.reportRepo.Mock.On("Resolve", "actioned").Return(nil)
+		f.auditRepo.Mock.On("Store", "hide").Return(auditRepository.AuditLogCollection[0], nil)
+
+		_, err := f.service().Act(2, 1, entities.ModerationActionRequest{Action: "hide", Note: "Spam"}, f.storage, f.search)
+
+		assert.Nil(t, err)
+		f.eventRepo.Mock.AssertCalled(t, "Update")
+		f.reportRepo.Mock.AssertCalled(t, "Resolve", "actioned")
+		f.auditRepo.Mock.AssertCalled(t, "Store", "hide")
+	})
+	t.Run("dismiss", func(t *testing.T) {
+		f := newFixture()
+		f.userRepo.Mock.On("Find").Return(moderator(), nil)
+		f.reportRepo.Mock.On("Find").Return(reportRepository.ReportCollection[0], nil)
+		f.reportRepo.Mock.On("Resolve", "dismissed").Return(nil)
+		f.auditRepo.Mock.On("Store", "dismiss").Return(auditRepository.AuditLogCollection[0], nil)
+
+		_, err := f.service().Act(2, 1, entities.ModerationActionRequest{Action: "dismiss"}, f.storage, f.search)
+
+		assert.Nil(t, err)
+		f.eventRepo.Mock.AssertNotCalled(t, "Update")
+		f.reportRepo.Mock.AssertCalled(t, "Resolve", "dismissed")
+	})
+	t.Run("delete-comment", func(t *testing.T) {
+		f := newFixture()
+		f.userRepo.Mock.On("Find").Return(moderator(), nil)
+		f.reportRepo.Mock.On("Find").Return(reportRepository.ReportCollection[1], nil)
+		f.commentRepo.Mock.On("Find").Return(commentRepository.CommentCollection[0], nil)
+		f.commentRepo.Mock.On("Delete").Return(nil)
+		f.reportRepo.Mock.On("Resolve", "actioned").Return(nil)
+		f.auditRepo.Mock.On("Store", "delete").Return(auditRepository.AuditLogCollection[0], nil)
+
+		_, err := f.service().Act(2, 2, entities.ModerationActionRequest{Action: "delete"}, f.storage, f.search)
+
+		assert.Nil(t, err)
+		f.commentRepo.Mock.AssertCalled(t, "Delete")
+	})
+	t.Run("suspend-comment-author", func(t *testing.T) {
+		f := newFixture()
+		f.userRepo.Mock.On("Find").Return(moderator(), nil)
+		f.userRepo.Mock.On("Update").Return(userRepository.UserCollection[0], nil)
+		f.reportRepo.Mock.On("Find").Return(reportRepository.ReportCollection[1], nil)
+		f.commentRepo.Mock.On("Find").Return(commentRepository.CommentCollection[0], nil)
+		f.reportRepo.Mock.On("Resolve", "actioned").Return(nil)
+		f.auditRepo.Mock.On("Store", "suspend").Return(auditRepository.AuditLogCollection[0], nil)
+
+		_, err := f.service().Act(2, 2, entities.ModerationActionRequest{Action: "suspend", Days: 3}, f.storage, f.search)
+
+		assert.Nil(t, err)
+		f.userRepo.Mock.AssertCalled(t, "Update")
+	})
+	t.Run("warn-event-host", func(t *testing.T) {
+		f := newFixture()
+		f.userRepo.Mock.On("Find").Return(moderator(), nil)
+		f.reportRepo.Mock.On("Find").Return(reportRepository.ReportCollection[0], nil)
+		f.eventRepo.Mock.On("FindWithTeam").Return(eventRepository.EventCollection[0], nil)
+		f.notificationRepo.Mock.On("Store", eventRepository.EventCollection[0].UserID).Return(entities.Notification{}, nil)
+		f.reportRepo.Mock.On("Resolve", "actioned").Return(nil)
+		f.auditRepo.Mock.On("Store", "warn").Return(auditRepository.AuditLogCollection[0], nil)
+
+		_, err := f.service().Act(2, 1, entities.ModerationActionRequest{Action: "warn", Note: "Please stop reposting"}, f.storage, f.search)
+
+		assert.Nil(t, err)
+		f.notificationRepo.Mock.AssertCalled(t, "Store", eventRepository.EventCollection[0].UserID)
+	})
+	t.Run("hide-user", func(t *testing.T) {
+		report := reportRepository.ReportCollection[2]
+		report.Status = "open"
+		f := newFixture()
+		f.userRepo.Mock.On("Find").Return(moderator(), nil)
+		f.reportRepo.Mock.On("Find").Return(report, nil)
+
+		_, err := f.service().Act(1, 3, entities.ModerationActionRequest{Action: "hide"}, f.storage, f.search)
+
+		assert.Equal(t, 400, err.(web.WebError).Code)
+		f.reportRepo.Mock.AssertNotCalled(t, "Resolve", mock.Anything)
+		f.auditRepo.Mock.AssertNotCalled(t, "Store", mock.Anything)
+	})
+	t.Run("already-resolved", func(t *testing.T) {
+		f := newFixture()
+		f.userRepo.Mock.On("Find").Return(moderator(), nil)
+		f.reportRepo.Mock.On("Find").Return(reportRepository.ReportCollection[2], nil)
+
+		_, err := f.service().Act(1, 3, entities.ModerationActionRequest{Action: "dismiss"}, f.storage, f.search)
+
+		assert.Equal(t, web.WebError{Code: 400, Message: "Report has already been dismissed"}, err)
+	})
+}
+
+func TestAuditTrail(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		f := newFixture()
+		f.userRepo.Mock.On("Find").Return(moderator(), nil)
+		f.auditRepo.Mock.On("FindAll").Return(auditRepository.AuditLogCollection, nil)
+
+		data, err := f.service().AuditTrail(2, "event", 2, 50, 1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(data))
+		assert.Equal(t, "hide", data[0].Action)
+	})
+	t.Run("not-moderator", func(t *testing.T) {
+		f := newFixture()
+		f.userRepo.Mock.On("Find").Return(userRepository.UserCollection[0], nil)
+
+		_, err := f.service().AuditTrail(1, "", 0, 50, 1)
+
+		assert.Equal(t, 403, err.(web.WebError).Code)
+	})
+}
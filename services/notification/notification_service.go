@@ -0,0 +1,66 @@
+package notification
+
+import (
+	"time"
+	"tupulung/entities"
+	notificationRepository "tupulung/repositories/notification"
+
+	"github.com/jinzhu/copier"
+)
+
+type NotificationService struct {
+	notificationRepo notificationRepository.NotificationRepositoryInterface
+}
+
+func NewNotificationService(notificationRepo notificationRepository.NotificationRepositoryInterface) *NotificationService {
+	return &NotificationService{
+		notificationRepo: notificationRepo,
+	}
+}
+
+/*
+ * Find All
+ * -------------------------------
+ * Mengambil notifikasi milik user beserta jumlah yang belum dibaca
+ */
+func (service NotificationService) FindAll(userID int, unreadOnly bool, limit, page int) (entities.NotificationListResponse, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+	if page <= 0 {
+		page = 1
+	}
+	notifications, err := service.notificationRepo.FindByUser(userID, unreadOnly, limit, (page-1)*limit)
+	if err != nil {
+		return entities.NotificationListResponse{}, err
+	}
+	unread, err := service.notificationRepo.CountUnread(userID)
+	if err != nil {
+		return entities.NotificationListResponse{}, err
+	}
+
+	notificationsRes := []entities.NotificationResponse{}
+	copier.Copy(&notificationsRes, &notifications)
+	return entities.NotificationListResponse{
+		Unread:        unread,
+		Notifications: notificationsRes,
+	}, nil
+}
+
+/*
+ * Mark Read
+ * -------------------------------
+ * Menandai satu notifikasi milik user sebagai sudah dibaca
+ */
+func (service NotificationService) MarkRead(id int, userID int) error {
+	return service.notificationRepo.MarkRead(id, userID, time.Now())
+}
+
+/*
+ * Mark All Read
+ * -------------------------------
+ * Menandai semua notifikasi milik user sebagai sudah dibaca
+ */
+func (service NotificationService) MarkAllRead(userID int) error {
+	return service.notificationRepo.MarkRead(0, userID, time.Now())
+}
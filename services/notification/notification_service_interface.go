@@ -0,0 +1,9 @@
+package notification
+
+import "tupulung/entities"
+
+type NotificationServiceInterface interface {
+	FindAll(userID int, unreadOnly bool, limit, page int) (entities.NotificationListResponse, error)
+	MarkRead(id int, userID int) error
+	MarkAllRead(userID int) error
+}
@@ -0,0 +1,58 @@
+package notification_test
+
+import (
+	"testing"
+	"tupulung/entities/web"
+	notificationRepository "tupulung/repositories/notification"
+	notificationService "tupulung/services/notification"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFindAll(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		notificationRepositoryMock := notificationRepository.NewNotificationRepositoryMock(&mock.Mock{})
+		notificationRepositoryMock.Mock.On("FindByUser").Return(notificationRepository.NotificationCollection, nil)
+		notificationRepositoryMock.Mock.On("CountUnread").Return(int64(1), nil)
+
+		service := notificationService.NewNotificationService(notificationRepositoryMock)
+		res, err := service.FindAll(1, false, 20, 1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), res.Unread)
+		assert.Equal(t, len(notificationRepository.NotificationCollection), len(res.Notifications))
+		assert.Equal(t, notificationRepository.NotificationCollection[0].Title, res.Notifications[0].Title)
+	})
+	t.Run("repo-fail", func(t *testing.T) {
+		notificationRepositoryMock := notificationRepository.NewNotificationRepositoryMock(&mock.Mock{})
+		notificationRepositoryMock.Mock.On("FindByUser").Return(notificationRepository.NotificationCollection, web.WebError{Code: 500, Message: "server error"})
+
+		service := notificationService.NewNotificationService(notificationRepositoryMock)
+		_, err := service.FindAll(1, false, 20, 1)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestMarkRead(t *testing.T) {
+	t.Run("single", func(t *testing.T) {
+		notificationRepositoryMock := notificationRepository.NewNotificationRepositoryMock(&mock.Mock{})
+		notificationRepositoryMock.Mock.On("MarkRead", 1).Return(nil)
+
+		service := notificationService.NewNotificationService(notificationRepositoryMock)
+		err := service.MarkRead(1, 1)
+
+		assert.Nil(t, err)
+	})
+	t.Run("all", func(t *testing.T) {
+		notificationRepositoryMock := notificationRepository.NewNotificationRepositoryMock(&mock.Mock{})
+		notificationRepositoryMock.Mock.On("MarkRead", 0).Return(nil)
+
+		service := notificationService.NewNotificationService(notificationRepositoryMock)
+		err := service.MarkAllRead(1)
+
+		assert.Nil(t, err)
+		notificationRepositoryMock.Mock.AssertCalled(t, "MarkRead", 0)
+	})
+}
@@ -0,0 +1,347 @@
+package order
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+	"tupulung/deliveries/validations"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	eventRepository "tupulung/repositories/event"
+	inviteRepository "tupulung/repositories/invite"
+	orderRepository "tupulung/repositories/order"
+	participantRepository "tupulung/repositories/participant"
+	questionRepository "tupulung/repositories/question"
+	ticketRepository "tupulung/repositories/ticket"
+	userRepository "tupulung/repositories/user"
+	cohostService "tupulung/services/cohost"
+	participantService "tupulung/services/participant"
+	paymentProvider "tupulung/utilities/payment"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jinzhu/copier"
+)
+
+/*
+ * Lama reservasi tiket sebelum order pending dibatalkan otomatis
+ */
+const ReservationTTL = 15 * time.Minute
+
+type OrderService struct {
+	orderRepo       orderRepository.OrderRepositoryInterface
+	ticketRepo      ticketRepository.TicketRepositoryInterface
+	eventRepo       eventRepository.EventRepositoryInterface
+	userRepo        userRepository.UserRepositoryInterface
+	inviteRepo      inviteRepository.InviteRepositoryInterface
+	questionRepo    questionRepository.QuestionRepositoryInterface
+	participantRepo participantRepository.ParticipantRepositoryInterface
+	validate        *validator.Validate
+}
+
+func NewOrderService(
+	orderRepo orderRepository.OrderRepositoryInterface,
+	ticketRepo ticketRepository.TicketRepositoryInterface,
+	eventRepo eventRepository.EventRepositoryInterface,
+	userRepo userRepository.UserRepositoryInterface,
+	inviteRepo inviteRepository.InviteRepositoryInterface,
+	questionRepo questionRepository.QuestionRepositoryInterface,
+	participantRepo participantRepository.ParticipantRepositoryInterface,
+) *OrderService {
+	return &OrderService{
+		orderRepo:       orderRepo,
+		ticketRepo:      ticketRepo,
+		eventRepo:       eventRepo,
+		userRepo:        userRepo,
+		inviteRepo:      inviteRepo,
+		questionRepo:    questionRepo,
+		participantRepo: participantRepo,
+		validate:        validator.New(),
+	}
+}
+
+/*
+ * Find Mine
+ * -------------------------------
+ * Mengambil semua order milik user yang sedang login
+ */
+func (service OrderService) FindMine(userID int) ([]entities.OrderResponse, error) {
+	orders, err := service.orderRepo.FindByUser(userID)
+	if err != nil {
+		return []entities.OrderResponse{}, err
+	}
+	ordersRes := []entities.OrderResponse{}
+	copier.Copy(&ordersRes, &orders)
+	return ordersRes, nil
+}
+
+/*
+ * Find By Event
+ * -------------------------------
+ * Mengambil semua order sebuah event, hanya untuk host & co-host moderator
+ */
+func (service OrderService) FindByEvent(eventID int, userID int) ([]entities.OrderResponse, error) {
+	event, err := service.eventRepo.Find(eventID)
+	if err != nil {
+		return []entities.OrderResponse{}, web.WebError{Code: 400, Message: "Event is not exist"}
+	}
+	if !cohostService.Can(event, userID, cohostService.PermissionModerate) {
+		return []entities.OrderResponse{}, web.WebError{Code: 401, Message: "Only the host can see the orders of this event"}
+	}
+	orders, err := service.orderRepo.FindByEvent(eventID)
+	if err != nil {
+		return []entities.OrderResponse{}, err
+	}
+	ordersRes := []entities.OrderResponse{}
+	copier.Copy(&ordersRes, &orders)
+	return ordersRes, nil
+}
+
+/*
+ * Find
+ * -------------------------------
+ * Mengambil detail order, hanya untuk pembeli dan tim host
+ */
+func (service OrderService) Find(id int, userID int) (entities.OrderResponse, error) {
+	order, err := service.orderRepo.Find(id)
+	if err != nil {
+		return entities.OrderResponse{}, err
+	}
+	if int(order.UserID) != userID && !cohostService.IsTeamMember(order.Event, userID) {
+		return entities.OrderResponse{}, web.WebError{Code: 401, Message: "Cannot get order that belongs to someone else"}
+	}
+	orderRes := entities.OrderResponse{}
+	copier.Copy(&orderRes, &order)
+	return orderRes, nil
+}
+
+/*
+ * Create
+ * -------------------------------
+ * Me-reserve tiket dan membuat order pending. Order gratis langsung
+ * dikonfirmasi, order berbayar diteruskan ke payment provider
+ * dan dikonfirmasi melalui webhook
+ */
+func (service OrderService) Create(orderRequest entities.OrderRequest, eventID int, userID int, paymentProvider paymentProvider.PaymentInterface) (entities.OrderResponse, error) {
+	// Validation
+	err := validations.ValidateOrderRequest(service.validate, orderRequest)
+	if err != nil {
+		return entities.OrderResponse{}, err
+	}
+
+	user, err := service.userRepo.Find(userID)
+	if err != nil {
+		return entities.OrderResponse{}, web.WebError{Code: 400, Message: "No user matched with this authenticated user"}
+	}
+
+	// Release stale reservations so their tickets can be bought again
+	service.orderRepo.ExpireStale(time.Now())
+
+	ticketType, err := service.ticketRepo.Find(int(orderRequest.TicketTypeID))
+	if err != nil || int(ticketType.EventID) != eventID {
+		return entities.OrderResponse{}, web.WebError{Code: 400, Message: "Ticket type is not exist for this event"}
+	}
+	now := time.Now()
+	if ticketType.SalesStart != nil && now.Before(*ticketType.SalesStart) {
+		return entities.OrderResponse{}, web.WebError{Code: 400, Message: "Ticket sales for this ticket type have not started yet"}
+	}
+	if ticketType.SalesEnd != nil && now.After(*ticketType.SalesEnd) {
+		return entities.OrderResponse{}, web.WebError{Code: 400, Message: "Ticket sales for this ticket type have ended"}
+	}
+	if ticketType.Event.DatetimeEvent.Before(now) {
+		return entities.OrderResponse{}, web.WebError{Code: 400, Message: "Cannot order tickets for an event that already started"}
+	}
+
+	// Aturan event private sama dengan join, invite dipakai saat order dikonfirmasi
+	invite, err := participantService.ResolveInvite(service.inviteRepo, ticketType.Event, int(user.ID), orderRequest.InviteCode)
+	if err != nil {
+		return entities.OrderResponse{}, err
+	}
+	var inviteID *uint
+	if invite.ID != 0 {
+		inviteID = &invite.ID
+	}
+
+	// Pertanyaan required wajib dijawab seperti join, jawaban disimpan saat order dikonfirmasi
+	answers, err := participantService.ResolveAnswers(service.questionRepo, ticketType.Event, int(user.ID), orderRequest.Answers)
+	if err != nil {
+		return entities.OrderResponse{}, err
+	}
+	encodedAnswers := ""
+	if answers != nil {
+		encoded, _ := json.Marshal(answers)
+		encodedAnswers = string(encoded)
+	}
+
+	// Bentrok jadwal dicek seperti join, setelah semua syarat order terpenuhi
+	conflicts, err := participantService.CheckConflicts(service.participantRepo, ticketType.Event, int(user.ID), orderRequest.Force)
+	if err != nil {
+		return entities.OrderResponse{EventID: ticketType.EventID, UserID: user.ID, Conflicts: conflicts}, err
+	}
+
+	// Reserve inventory
+	err = service.ticketRepo.Reserve(int(ticketType.ID), orderRequest.Quantity)
+	if err != nil {
+		return entities.OrderResponse{}, err
+	}
+	order, err := service.orderRepo.Store(entities.Order{
+		EventID:      ticketType.EventID,
+		UserID:       user.ID,
+		TicketTypeID: ticketType.ID,
+		Quantity:     orderRequest.Quantity,
+		Amount:       ticketType.Price * int64(orderRequest.Quantity),
+		Currency:     ticketType.Currency,
+		Status:       "pending",
+		ExpiresAt:    now.Add(ReservationTTL),
+		InviteID:     inviteID,
+		Answers:      encodedAnswers,
+	})
+	if err != nil {
+		return entities.OrderResponse{}, err
+	}
+
+	if order.Amount == 0 {
+		// Free tickets are issued right away
+		err = service.orderRepo.Confirm(order, generateTickets(order))
+		if err != nil {
+			return entities.OrderResponse{}, err
+		}
+	} else {
+		charge, err := paymentProvider.CreateCharge(order)
+		if err != nil {
+			service.orderRepo.Release(order, "failed")
+			return entities.OrderResponse{}, web.WebError{Code: 502, Message: "Payment provider is not available, please try again later"}
+		}
+		order.PaymentReference = charge.Reference
+		order.PaymentURL = charge.RedirectURL
+		_, err = service.orderRepo.Update(order, int(order.ID))
+		if err != nil {
+			return entities.OrderResponse{}, err
+		}
+	}
+
+	order, err = service.orderRepo.Find(int(order.ID))
+	if err != nil {
+		return entities.OrderResponse{}, err
+	}
+	orderRes := entities.OrderResponse{}
+	copier.Copy(&orderRes, &order)
+	orderRes.Conflicts = conflicts
+	return orderRes, nil
+}
+
+/*
+ * Handle Webhook
+ * -------------------------------
+ * Memproses notifikasi dari payment provider. Notifikasi yang sama
+ * boleh dikirim berulang kali tanpa menerbitkan tiket ganda
+ */
+func (service OrderService) HandleWebhook(body []byte, signature string, paymentProvider paymentProvider.PaymentInterface) error {
+	paymentEvent, err := paymentProvider.ParseWebhook(body, signature)
+	if err != nil {
+		return err
+	}
+	order, err := service.orderRepo.FindByReference(paymentEvent.Reference)
+	if err != nil {
+		return err
+	}
+
+	switch paymentEvent.Status {
+	case "paid":
+		err = service.orderRepo.Confirm(order, generateTickets(order))
+		if webErr, ok := err.(web.WebError); ok && webErr.Code == 409 {
+			// Reservation expired and the tickets are gone, give the money back
+			err = paymentProvider.Refund(order.PaymentReference, order.Amount)
+			if err != nil {
+				return web.WebError{Code: 502, Message: err.Error()}
+			}
+			now := time.Now()
+			order.Status = "refunded"
+			order.RefundedAt = &now
+			_, err = service.orderRepo.Update(order, int(order.ID))
+		}
+		return err
+	case "failed":
+		return service.orderRepo.Release(order, "failed")
+	case "refunded":
+		if order.Status != "paid" {
+			return nil
+		}
+		return service.orderRepo.Refund(order)
+	}
+	return web.WebError{Code: 400, Message: "Unknown payment status"}
+}
+
+/*
+ * Refund
+ * -------------------------------
+ * Mengembalikan dana order yang sudah dibayar. Pembeli dapat
+ * meminta refund sebelum event dimulai, sedangkan pembuat event
+ * dan co-host ber-role owner (PermissionTeam) kapan saja
+ */
+func (service OrderService) Refund(id int, userID int, paymentProvider paymentProvider.PaymentInterface) (entities.OrderResponse, error) {
+	order, err := service.orderRepo.Find(id)
+	if err != nil {
+		return entities.OrderResponse{}, err
+	}
+	isOwner := cohostService.Can(order.Event, userID, cohostService.PermissionTeam)
+	if int(order.UserID) != userID && !isOwner {
+		return entities.OrderResponse{}, web.WebError{Code: 401, Message: "Cannot refund order that belongs to someone else"}
+	}
+	if order.Status != "paid" {
+		return entities.OrderResponse{}, web.WebError{Code: 400, Message: "Only paid orders can be refunded"}
+	}
+	if !isOwner && !order.Event.DatetimeEvent.After(time.Now()) {
+		return entities.OrderResponse{}, web.WebError{Code: 400, Message: "Refunds are no longer available after the event started"}
+	}
+
+	if order.Amount > 0 {
+		err = paymentProvider.Refund(order.PaymentReference, order.Amount)
+		if err != nil {
+			return entities.OrderResponse{}, web.WebError{Code: 502, Message: err.Error()}
+		}
+	}
+	err = service.orderRepo.Refund(order)
+	if err != nil {
+		return entities.OrderResponse{}, err
+	}
+
+	order, err = service.orderRepo.Find(id)
+	if err != nil {
+		return entities.OrderResponse{}, err
+	}
+	orderRes := entities.OrderResponse{}
+	copier.Copy(&orderRes, &order)
+	return orderRes, nil
+}
+
+/*
+ * Expire Reservations
+ * -------------------------------
+ * Membatalkan order pending yang melewati batas waktu reservasi
+ */
+func (service OrderService) ExpireReservations() (int, error) {
+	return service.orderRepo.ExpireStale(time.Now())
+}
+
+/*
+ * Generate Tickets
+ * -------------------------------
+ * Membuat tiket dengan kode unik sejumlah quantity order
+ */
+func generateTickets(order entities.Order) []entities.Ticket {
+	tickets := []entities.Ticket{}
+	for i := uint(0); i < order.Quantity; i++ {
+		code := make([]byte, 16)
+		rand.Read(code)
+		tickets = append(tickets, entities.Ticket{
+			OrderID:      order.ID,
+			EventID:      order.EventID,
+			TicketTypeID: order.TicketTypeID,
+			UserID:       order.UserID,
+			Code:         hex.EncodeToString(code),
+			Status:       "valid",
+		})
+	}
+	return tickets
+}
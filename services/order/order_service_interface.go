@@ -0,0 +1,16 @@
+package order
+
+import (
+	"tupulung/entities"
+	paymentProvider "tupulung/utilities/payment"
+)
+
+type OrderServiceInterface interface {
+	FindMine(userID int) ([]entities.OrderResponse, error)
+	FindByEvent(eventID int, userID int) ([]entities.OrderResponse, error)
+	Find(id int, userID int) (entities.OrderResponse, error)
+	Create(orderRequest entities.OrderRequest, eventID int, userID int, paymentProvider paymentProvider.PaymentInterface) (entities.OrderResponse, error)
+	HandleWebhook(body []byte, signature string, paymentProvider paymentProvider.PaymentInterface) error
+	Refund(id int, userID int, paymentProvider paymentProvider.PaymentInterface) (entities.OrderResponse, error)
+	ExpireReservations() (int, error)
+}
@@ -0,0 +1,423 @@
+package order_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	eventRepository "tupulung/repositories/event"
+	inviteRepository "tupulung/repositories/invite"
+	orderRepository "tupulung/repositories/order"
+	participantRepository "tupulung/repositories/participant"
+	questionRepository "tupulung/repositories/question"
+	ticketRepository "tupulung/repositories/ticket"
+	userRepository "tupulung/repositories/user"
+	orderService "tupulung/services/order"
+	paymentProvider "tupulung/utilities/payment"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func upcomingTicketType(index int) entities.TicketType {
+	ticketType := ticketRepository.TicketTypeCollection[index]
+	ticketType.Event = eventRepository.EventCollection[0]
+	ticketType.Event.DatetimeEvent = time.Now().AddDate(0, 1, 0)
+	return ticketType
+}
+
+func emptyQuestionRepository() *questionRepository.QuestionRepositoryMock {
+	questionRepositoryMock := questionRepository.NewQuestionRepositoryMock(&mock.Mock{})
+	questionRepositoryMock.Mock.On("FindByEvent").Return([]entities.EventQuestion{}, nil)
+	questionRepositoryMock.Mock.On("FindAnswers").Return([]entities.EventAnswer{}, nil)
+	return questionRepositoryMock
+}
+
+func emptyParticipantRepository() *participantRepository.ParticipantRepositoryMock {
+	participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+	participantRepositoryMock.Mock.On("FindSchedule").Return([]entities.Event{}, nil)
+	return participantRepositoryMock
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("paid", func(t *testing.T) {
+		orderSample := orderRepository.OrderCollection[0]
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(userRepository.UserCollection[1], nil)
+		ticketRepositoryMock := ticketRepository.NewTicketRepositoryMock(&mock.Mock{})
+		ticketRepositoryMock.Mock.On("Find").Return(upcomingTicketType(0), nil)
+		ticketRepositoryMock.Mock.On("Reserve").Return(nil)
+		orderRepositoryMock := orderRepository.NewOrderRepositoryMock(&mock.Mock{})
+		orderRepositoryMock.Mock.On("ExpireStale").Return(0, nil)
+		orderRepositoryMock.Mock.On("Store").Return(orderSample, nil)
+		orderRepositoryMock.Mock.On("Update").Return(orderSample, nil)
+		orderRepositoryMock.Mock.On("Find").Return(orderSample, nil)
+		paymentMock := paymentProvider.NewPaymentMock(&mock.Mock{})
+		paymentMock.Mock.On("CreateCharge").Return(entities.PaymentCharge{
+			Reference: orderSample.PaymentReference,
+			Status:    "pending",
+		}, nil)
+
+		service := orderService.NewOrderService(
+			orderRepositoryMock,
+			ticketRepositoryMock,
+			eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+			userRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			emptyQuestionRepository(),
+			emptyParticipantRepository(),
+		)
+		data, err := service.Create(entities.OrderRequest{TicketTypeID: 1, Quantity: 2}, 1, 2, paymentMock)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "pending", data.Status)
+		assert.Equal(t, orderSample.PaymentReference, data.PaymentReference)
+		orderRepositoryMock.Mock.AssertNotCalled(t, "Confirm")
+	})
+	t.Run("free", func(t *testing.T) {
+		orderSample := orderRepository.OrderCollection[0]
+		orderSample.Amount = 0
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(userRepository.UserCollection[1], nil)
+		ticketRepositoryMock := ticketRepository.NewTicketRepositoryMock(&mock.Mock{})
+		ticketRepositoryMock.Mock.On("Find").Return(upcomingTicketType(1), nil)
+		ticketRepositoryMock.Mock.On("Reserve").Return(nil)
+		orderRepositoryMock := orderRepository.NewOrderRepositoryMock(&mock.Mock{})
+		orderRepositoryMock.Mock.On("ExpireStale").Return(0, nil)
+		orderRepositoryMock.Mock.On("Store").Return(orderSample, nil)
+		orderRepositoryMock.Mock.On("Confirm").Return(nil)
+		orderRepositoryMock.Mock.On("Find").Return(orderSample, nil)
+		paymentMock := paymentProvider.NewPaymentMock(&mock.Mock{})
+
+		service := orderService.NewOrderService(
+			orderRepositoryMock,
+			ticketRepositoryMock,
+			eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+			userRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			emptyQuestionRepository(),
+			emptyParticipantRepository(),
+		)
+		_, err := service.Create(entities.OrderRequest{TicketTypeID: 2, Quantity: 2}, 1, 2, paymentMock)
+
+		assert.Nil(t, err)
+		orderRepositoryMock.Mock.AssertCalled(t, "Confirm")
+		paymentMock.Mock.AssertNotCalled(t, "CreateCharge")
+	})
+	t.Run("sold-out", func(t *testing.T) {
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(userRepository.UserCollection[1], nil)
+		ticketRepositoryMock := ticketRepository.NewTicketRepositoryMock(&mock.Mock{})
+		ticketRepositoryMock.Mock.On("Find").Return(upcomingTicketType(0), nil)
+		ticketRepositoryMock.Mock.On("Reserve").Return(web.WebError{Code: 409, Message: "Not enough tickets left for this ticket type"})
+		orderRepositoryMock := orderRepository.NewOrderRepositoryMock(&mock.Mock{})
+		orderRepositoryMock.Mock.On("ExpireStale").Return(0, nil)
+
+		service := orderService.NewOrderService(
+			orderRepositoryMock,
+			ticketRepositoryMock,
+			eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+			userRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			emptyQuestionRepository(),
+			emptyParticipantRepository(),
+		)
+		_, err := service.Create(entities.OrderRequest{TicketTypeID: 1, Quantity: 2}, 1, 2, paymentProvider.NewPaymentMock(&mock.Mock{}))
+
+		assert.Equal(t, web.WebError{Code: 409, Message: "Not enough tickets left for this ticket type"}, err)
+		orderRepositoryMock.Mock.AssertNotCalled(t, "Store")
+	})
+	t.Run("private-not-invited", func(t *testing.T) {
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(userRepository.UserCollection[1], nil)
+		ticketType := upcomingTicketType(0)
+		ticketType.Event.Visibility = "private"
+		ticketRepositoryMock := ticketRepository.NewTicketRepositoryMock(&mock.Mock{})
+		ticketRepositoryMock.Mock.On("Find").Return(ticketType, nil)
+		orderRepositoryMock := orderRepository.NewOrderRepositoryMock(&mock.Mock{})
+		orderRepositoryMock.Mock.On("ExpireStale").Return(0, nil)
+		inviteRepositoryMock := inviteRepository.NewInviteRepositoryMock(&mock.Mock{})
+		inviteRepositoryMock.Mock.On("FindUsableByUser").Return(entities.EventInvite{}, web.WebError{Code: 400})
+
+		service := orderService.NewOrderService(
+			orderRepositoryMock,
+			ticketRepositoryMock,
+			eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+			userRepositoryMock,
+			inviteRepositoryMock,
+			emptyQuestionRepository(),
+			emptyParticipantRepository(),
+		)
+		_, err := service.Create(entities.OrderRequest{TicketTypeID: 1, Quantity: 2}, 1, 2, paymentProvider.NewPaymentMock(&mock.Mock{}))
+
+		assert.Equal(t, web.WebError{Code: 403, Message: "This event is private, an invitation is required to join"}, err)
+		ticketRepositoryMock.Mock.AssertNotCalled(t, "Reserve")
+		orderRepositoryMock.Mock.AssertNotCalled(t, "Store")
+	})
+	t.Run("required-answers-missing", func(t *testing.T) {
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(userRepository.UserCollection[1], nil)
+		ticketRepositoryMock := ticketRepository.NewTicketRepositoryMock(&mock.Mock{})
+		ticketRepositoryMock.Mock.On("Find").Return(upcomingTicketType(0), nil)
+		orderRepositoryMock := orderRepository.NewOrderRepositoryMock(&mock.Mock{})
+		orderRepositoryMock.Mock.On("ExpireStale").Return(0, nil)
+		questionRepositoryMock := questionRepository.NewQuestionRepositoryMock(&mock.Mock{})
+		questionRepositoryMock.Mock.On("FindByEvent").Return(questionRepository.EventQuestionCollection, nil)
+		questionRepositoryMock.Mock.On("FindAnswers").Return([]entities.EventAnswer{}, nil)
+
+		service := orderService.NewOrderService(
+			orderRepositoryMock,
+			ticketRepositoryMock,
+			eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+			userRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			questionRepositoryMock,
+			emptyParticipantRepository(),
+		)
+		_, err := service.Create(entities.OrderRequest{TicketTypeID: 1, Quantity: 2}, 1, 2, paymentProvider.NewPaymentMock(&mock.Mock{}))
+
+		assert.IsType(t, web.ValidationError{}, err)
+		ticketRepositoryMock.Mock.AssertNotCalled(t, "Reserve")
+		orderRepositoryMock.Mock.AssertNotCalled(t, "Store")
+	})
+	t.Run("schedule-conflict", func(t *testing.T) {
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(userRepository.UserCollection[1], nil)
+		ticketType := upcomingTicketType(0)
+		ticketRepositoryMock := ticketRepository.NewTicketRepositoryMock(&mock.Mock{})
+		ticketRepositoryMock.Mock.On("Find").Return(ticketType, nil)
+		orderRepositoryMock := orderRepository.NewOrderRepositoryMock(&mock.Mock{})
+		orderRepositoryMock.Mock.On("ExpireStale").Return(0, nil)
+		other := eventRepository.EventCollection[1]
+		other.DatetimeEvent = ticketType.Event.DatetimeEvent
+		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		participantRepositoryMock.Mock.On("FindSchedule").Return([]entities.Event{other}, nil)
+
+		service := orderService.NewOrderService(
+			orderRepositoryMock,
+			ticketRepositoryMock,
+			eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+			userRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			emptyQuestionRepository(),
+			participantRepositoryMock,
+		)
+		data, err := service.Create(entities.OrderRequest{TicketTypeID: 1, Quantity: 2}, 1, 2, paymentProvider.NewPaymentMock(&mock.Mock{}))
+
+		assert.Equal(t, web.WebError{Code: 409, Message: "This event overlaps with other events you have joined"}, err)
+		assert.Len(t, data.Conflicts, 1)
+		ticketRepositoryMock.Mock.AssertNotCalled(t, "Reserve")
+	})
+	t.Run("other-event", func(t *testing.T) {
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(userRepository.UserCollection[1], nil)
+		ticketRepositoryMock := ticketRepository.NewTicketRepositoryMock(&mock.Mock{})
+		ticketRepositoryMock.Mock.On("Find").Return(upcomingTicketType(0), nil)
+		orderRepositoryMock := orderRepository.NewOrderRepositoryMock(&mock.Mock{})
+		orderRepositoryMock.Mock.On("ExpireStale").Return(0, nil)
+
+		service := orderService.NewOrderService(
+			orderRepositoryMock,
+			ticketRepositoryMock,
+			eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+			userRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			emptyQuestionRepository(),
+			emptyParticipantRepository(),
+		)
+		_, err := service.Create(entities.OrderRequest{TicketTypeID: 1, Quantity: 2}, 2, 2, paymentProvider.NewPaymentMock(&mock.Mock{}))
+
+		assert.Error(t, err)
+		ticketRepositoryMock.Mock.AssertNotCalled(t, "Reserve")
+	})
+	t.Run("charge-failed", func(t *testing.T) {
+		orderSample := orderRepository.OrderCollection[0]
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(userRepository.UserCollection[1], nil)
+		ticketRepositoryMock := ticketRepository.NewTicketRepositoryMock(&mock.Mock{})
+		ticketRepositoryMock.Mock.On("Find").Return(upcomingTicketType(0), nil)
+		ticketRepositoryMock.Mock.On("Reserve").Return(nil)
+		orderRepositoryMock := orderRepository.NewOrderRepositoryMock(&mock.Mock{})
+		orderRepositoryMock.Mock.On("ExpireStale").Return(0, nil)
+		orderRepositoryMock.Mock.On("Store").Return(orderSample, nil)
+		orderRepositoryMock.Mock.On("Release", "failed").Return(nil)
+		paymentMock := paymentProvider.NewPaymentMock(&mock.Mock{})
+		paymentMock.Mock.On("CreateCharge").Return(entities.PaymentCharge{}, errors.New("gateway timeout"))
+
+		service := orderService.NewOrderService(
+			orderRepositoryMock,
+			ticketRepositoryMock,
+			eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+			userRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			emptyQuestionRepository(),
+			emptyParticipantRepository(),
+		)
+		_, err := service.Create(entities.OrderRequest{TicketTypeID: 1, Quantity: 2}, 1, 2, paymentMock)
+
+		assert.Error(t, err)
+		orderRepositoryMock.Mock.AssertCalled(t, "Release", "failed")
+	})
+}
+
+func TestHandleWebhook(t *testing.T) {
+	t.Run("paid", func(t *testing.T) {
+		orderSample := orderRepository.OrderCollection[0]
+		orderRepositoryMock := orderRepository.NewOrderRepositoryMock(&mock.Mock{})
+		orderRepositoryMock.Mock.On("FindByReference").Return(orderSample, nil)
+		orderRepositoryMock.Mock.On("Confirm").Return(nil)
+		paymentMock := paymentProvider.NewPaymentMock(&mock.Mock{})
+		paymentMock.Mock.On("ParseWebhook").Return(entities.PaymentEvent{Reference: orderSample.PaymentReference, Status: "paid"}, nil)
+
+		service := orderService.NewOrderService(
+			orderRepositoryMock,
+			ticketRepository.NewTicketRepositoryMock(&mock.Mock{}),
+			eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			emptyQuestionRepository(),
+			emptyParticipantRepository(),
+		)
+		err := service.HandleWebhook([]byte("{}"), "signature", paymentMock)
+
+		assert.Nil(t, err)
+		orderRepositoryMock.Mock.AssertCalled(t, "Confirm")
+	})
+	t.Run("expired-sold-out", func(t *testing.T) {
+		orderSample := orderRepository.OrderCollection[0]
+		orderSample.Status = "expired"
+		orderRepositoryMock := orderRepository.NewOrderRepositoryMock(&mock.Mock{})
+		orderRepositoryMock.Mock.On("FindByReference").Return(orderSample, nil)
+		orderRepositoryMock.Mock.On("Confirm").Return(web.WebError{Code: 409, Message: "Not enough tickets left for this ticket type"})
+		orderRepositoryMock.Mock.On("Update").Return(orderSample, nil)
+		paymentMock := paymentProvider.NewPaymentMock(&mock.Mock{})
+		paymentMock.Mock.On("ParseWebhook").Return(entities.PaymentEvent{Reference: orderSample.PaymentReference, Status: "paid"}, nil)
+		paymentMock.Mock.On("Refund", orderSample.PaymentReference, orderSample.Amount).Return(nil)
+
+		service := orderService.NewOrderService(
+			orderRepositoryMock,
+			ticketRepository.NewTicketRepositoryMock(&mock.Mock{}),
+			eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			emptyQuestionRepository(),
+			emptyParticipantRepository(),
+		)
+		err := service.HandleWebhook([]byte("{}"), "signature", paymentMock)
+
+		assert.Nil(t, err)
+		paymentMock.Mock.AssertCalled(t, "Refund", orderSample.PaymentReference, orderSample.Amount)
+		orderRepositoryMock.Mock.AssertCalled(t, "Update")
+	})
+	t.Run("failed", func(t *testing.T) {
+		orderSample := orderRepository.OrderCollection[0]
+		orderRepositoryMock := orderRepository.NewOrderRepositoryMock(&mock.Mock{})
+		orderRepositoryMock.Mock.On("FindByReference").Return(orderSample, nil)
+		orderRepositoryMock.Mock.On("Release", "failed").Return(nil)
+		paymentMock := paymentProvider.NewPaymentMock(&mock.Mock{})
+		paymentMock.Mock.On("ParseWebhook").Return(entities.PaymentEvent{Reference: orderSample.PaymentReference, Status: "failed"}, nil)
+
+		service := orderService.NewOrderService(
+			orderRepositoryMock,
+			ticketRepository.NewTicketRepositoryMock(&mock.Mock{}),
+			eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			emptyQuestionRepository(),
+			emptyParticipantRepository(),
+		)
+		err := service.HandleWebhook([]byte("{}"), "signature", paymentMock)
+
+		assert.Nil(t, err)
+		orderRepositoryMock.Mock.AssertCalled(t, "Release", "failed")
+	})
+	t.Run("invalid-signature", func(t *testing.T) {
+		orderRepositoryMock := orderRepository.NewOrderRepositoryMock(&mock.Mock{})
+		paymentMock := paymentProvider.NewPaymentMock(&mock.Mock{})
+		paymentMock.Mock.On("ParseWebhook").Return(entities.PaymentEvent{}, web.WebError{Code: 401, Message: "invalid webhook signature"})
+
+		service := orderService.NewOrderService(
+			orderRepositoryMock,
+			ticketRepository.NewTicketRepositoryMock(&mock.Mock{}),
+			eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			emptyQuestionRepository(),
+			emptyParticipantRepository(),
+		)
+		err := service.HandleWebhook([]byte("{}"), "forged", paymentMock)
+
+		assert.Error(t, err)
+		orderRepositoryMock.Mock.AssertNotCalled(t, "FindByReference")
+	})
+}
+
+func TestRefund(t *testing.T) {
+	t.Run("buyer-before-event", func(t *testing.T) {
+		orderSample := orderRepository.OrderCollection[1]
+		orderSample.Event = eventRepository.EventCollection[0]
+		orderSample.Event.DatetimeEvent = time.Now().AddDate(0, 1, 0)
+		orderRepositoryMock := orderRepository.NewOrderRepositoryMock(&mock.Mock{})
+		orderRepositoryMock.Mock.On("Find").Return(orderSample, nil)
+		orderRepositoryMock.Mock.On("Refund").Return(nil)
+		paymentMock := paymentProvider.NewPaymentMock(&mock.Mock{})
+		paymentMock.Mock.On("Refund", orderSample.PaymentReference, orderSample.Amount).Return(nil)
+
+		service := orderService.NewOrderService(
+			orderRepositoryMock,
+			ticketRepository.NewTicketRepositoryMock(&mock.Mock{}),
+			eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			emptyQuestionRepository(),
+			emptyParticipantRepository(),
+		)
+		_, err := service.Refund(int(orderSample.ID), int(orderSample.UserID), paymentMock)
+
+		assert.Nil(t, err)
+		paymentMock.Mock.AssertCalled(t, "Refund", orderSample.PaymentReference, orderSample.Amount)
+		orderRepositoryMock.Mock.AssertCalled(t, "Refund")
+	})
+	t.Run("buyer-after-event", func(t *testing.T) {
+		orderSample := orderRepository.OrderCollection[1]
+		orderSample.Event = eventRepository.EventCollection[0]
+		orderSample.Event.DatetimeEvent = time.Now().AddDate(0, 0, -1)
+		orderRepositoryMock := orderRepository.NewOrderRepositoryMock(&mock.Mock{})
+		orderRepositoryMock.Mock.On("Find").Return(orderSample, nil)
+		paymentMock := paymentProvider.NewPaymentMock(&mock.Mock{})
+
+		service := orderService.NewOrderService(
+			orderRepositoryMock,
+			ticketRepository.NewTicketRepositoryMock(&mock.Mock{}),
+			eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			emptyQuestionRepository(),
+			emptyParticipantRepository(),
+		)
+		_, err := service.Refund(int(orderSample.ID), int(orderSample.UserID), paymentMock)
+
+		assert.Error(t, err)
+		paymentMock.Mock.AssertNotCalled(t, "Refund", orderSample.PaymentReference, orderSample.Amount)
+	})
+	t.Run("not-paid", func(t *testing.T) {
+		orderSample := orderRepository.OrderCollection[0]
+		orderSample.Event = eventRepository.EventCollection[0]
+		orderRepositoryMock := orderRepository.NewOrderRepositoryMock(&mock.Mock{})
+		orderRepositoryMock.Mock.On("Find").Return(orderSample, nil)
+
+		service := orderService.NewOrderService(
+			orderRepositoryMock,
+			ticketRepository.NewTicketRepositoryMock(&mock.Mock{}),
+			eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			emptyQuestionRepository(),
+			emptyParticipantRepository(),
+		)
+		_, err := service.Refund(int(orderSample.ID), int(orderSample.Event.UserID), paymentProvider.NewPaymentMock(&mock.Mock{}))
+
+		assert.Equal(t, web.WebError{Code: 400, Message: "Only paid orders can be refunded"}, err)
+	})
+}
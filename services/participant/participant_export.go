@@ -0,0 +1,124 @@
+package participant
+
+import (
+	"io"
+	"strconv"
+	"strings"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	cohostService "tupulung/services/cohost"
+	questionService "tupulung/services/question"
+	"tupulung/utilities/spreadsheet"
+)
+
+const (
+	exportBatchSize  = 500
+	exportTimeFormat = "2006-01-02 15:04:05"
+)
+
+/*
+ * Export formats
+ * -------------------------------
+ * Format file export yang didukung
+ * [format]: [content type]
+ */
+var exportFormats = map[string]string{
+	"csv":  "text/csv",
+	"xlsx": "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+}
+
+/*
+ * Export
+ * -------------------------------
+ * Menulis daftar participant event ke CSV atau XLSX beserta
+ * status RSVP, check-in dan jawaban registrasi. Participant dibaca
+ * per batch dan langsung ditulis ke writer dari open, sehingga
+ * daftar yang besar tidak dimuat sekaligus. Email user yang memilih
+ * menyembunyikan email dikosongkan.
+ * Hanya host dan co-host moderator yang dapat melakukan export
+ */
+func (service ParticipantService) Export(eventID, userID int, format string, open func(filename string, contentType string) io.Writer) error {
+	contentType, ok := exportFormats[format]
+	if !ok {
+		return web.WebError{Code: 400, Message: "format must be csv or xlsx"}
+	}
+	event, err := service.eventRepo.FindWithTeam(eventID)
+	if err != nil {
+		return web.WebError{Code: 400, Message: "Event is not exist"}
+	}
+	if !cohostService.Can(event, userID, cohostService.PermissionModerate) {
+		return web.WebError{Code: 401, Message: "Only the host can export participants"}
+	}
+	questions, err := service.questionRepo.FindByEvent(eventID)
+	if err != nil {
+		return err
+	}
+
+	writer := open("event-"+strconv.Itoa(eventID)+"-participants."+format, contentType)
+	var sheet spreadsheet.WriterInterface
+	if format == "xlsx" {
+		sheet, err = spreadsheet.NewXLSX(writer, "Participants")
+		if err != nil {
+			return err
+		}
+	} else {
+		sheet = spreadsheet.NewCSV(writer)
+	}
+
+	header := []string{"Name", "Email", "Joined At", "RSVP", "Checked In At"}
+	for _, question := range questions {
+		header = append(header, question.Label)
+	}
+	err = sheet.WriteRow(header)
+	if err != nil {
+		return err
+	}
+
+	err = service.participantRepo.FindInBatches(eventID, exportBatchSize, func(participants []entities.Participant) error {
+		userIDs := []uint{}
+		for _, participant := range participants {
+			userIDs = append(userIDs, participant.UserID)
+		}
+		answers, err := service.questionRepo.FindAnswersByUsers(eventID, userIDs)
+		if err != nil {
+			return err
+		}
+		answersByUser := map[uint]map[uint][]string{}
+		for _, answer := range answers {
+			if answersByUser[answer.UserID] == nil {
+				answersByUser[answer.UserID] = map[uint][]string{}
+			}
+			answersByUser[answer.UserID][answer.QuestionID] = questionService.AnswerValues(answer)
+		}
+
+		for _, participant := range participants {
+			err = sheet.WriteRow(exportRow(participant, questions, answersByUser[participant.UserID]))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return sheet.Close()
+}
+
+func exportRow(participant entities.Participant, questions []entities.EventQuestion, answers map[uint][]string) []string {
+	email := VisibleEmail(participant.User)
+	status := participant.Status
+	if status == "" {
+		status = RSVPGoing
+	}
+	checkedInAt := ""
+	if participant.CheckedInAt != nil {
+		checkedInAt = participant.CheckedInAt.Format(exportTimeFormat)
+	}
+
+	row := []string{participant.User.Name, email, participant.CreatedAt.Format(exportTimeFormat), status, checkedInAt}
+	for _, question := range questions {
+		row = append(row, strings.Join(answers[question.ID], ", "))
+	}
+	return row
+}
@@ -0,0 +1,152 @@
+package participant
+
+import (
+	"tupulung/entities"
+	"tupulung/entities/web"
+)
+
+const (
+	RSVPGoing    = "going"
+	RSVPMaybe    = "maybe"
+	RSVPNotGoing = "not_going"
+)
+
+/*
+ * Is RSVP Status
+ * -------------------------------
+ * Mengecek apakah status termasuk status RSVP yang dikenal
+ */
+func IsRSVPStatus(status string) bool {
+	return status == RSVPGoing || status == RSVPMaybe || status == RSVPNotGoing
+}
+
+/*
+ * Count RSVP
+ * -------------------------------
+ * Menghitung jumlah participant untuk setiap status RSVP,
+ * status kosong dianggap going
+ */
+func CountRSVP(participants []entities.Participant) entities.RSVPCountResponse {
+	count := entities.RSVPCountResponse{}
+	for _, participant := range participants {
+		switch participant.Status {
+		case RSVPMaybe:
+			count.Maybe++
+		case RSVPNotGoing:
+			count.NotGoing++
+		default:
+			count.Going++
+		}
+	}
+	return count
+}
+
+/*
+ * Is Full
+ * -------------------------------
+ * Mengecek apakah kursi event sudah habis, hanya status going
+ * yang dihitung. excludeUserID tidak ikut dihitung agar user
+ * yang mengganti status tidak menghitung dirinya sendiri
+ */
+func IsFull(event entities.Event, excludeUserID uint) bool {
+	if event.Capacity == 0 {
+		return false
+	}
+	going := 0
+	for _, participant := range event.RSVPs {
+		if participant.UserID != excludeUserID && participant.Status != RSVPMaybe && participant.Status != RSVPNotGoing {
+			going++
+		}
+	}
+	return going >= int(event.Capacity)
+}
+
+/*
+ * RSVP
+ * -------------------------------
+ * Mengubah status RSVP user pada event. User yang belum join
+ * akan ditambahkan dengan status tersebut beserta jawaban registrasinya,
+ * user yang sudah join cukup diganti statusnya tanpa harus leave
+ * dan join ulang. Jawaban yang dikirim saat mengganti status
+ * menggantikan jawaban sebelumnya. Bentrok jadwal dicek seperti join
+ */
+func (service ParticipantService) RSVP(userID, eventID int, status string, inviteCode string, answersReq []entities.EventAnswerRequest, force bool) (entities.RSVPResponse, error) {
+	if !IsRSVPStatus(status) {
+		return entities.RSVPResponse{}, web.WebError{Code: 400, Message: "status must be one of going, maybe or not_going"}
+	}
+
+	event, err := service.eventRepo.Find(eventID)
+	if err != nil {
+		return entities.RSVPResponse{}, web.WebError{Code: 400, Message: "Event is not exist"}
+	}
+
+	var current *entities.Participant
+	for i := range event.RSVPs {
+		if int(event.RSVPs[i].UserID) == userID {
+			current = &event.RSVPs[i]
+		}
+	}
+
+	rsvpRes := entities.RSVPResponse{
+		EventID:   event.ID,
+		UserID:    uint(userID),
+		Status:    status,
+		Conflicts: []entities.ScheduleConflictResponse{},
+	}
+	if current == nil {
+		rsvpRes.Conflicts, err = service.join(userID, eventID, inviteCode, status, answersReq, force)
+		if err != nil {
+			if len(rsvpRes.Conflicts) > 0 {
+				return rsvpRes, err
+			}
+			return entities.RSVPResponse{}, err
+		}
+		event.RSVPs = append(event.RSVPs, entities.Participant{UserID: uint(userID), EventID: event.ID, Status: status})
+	} else if current.Status != status {
+		if status == RSVPGoing && IsFull(event, current.UserID) {
+			return entities.RSVPResponse{}, web.WebError{Code: 400, Message: "This event is already full"}
+		}
+		// Pertanyaan required tetap wajib dijawab saat berubah menjadi going atau maybe
+		var answers []entities.EventAnswer
+		if status != RSVPNotGoing {
+			answers, err = ResolveAnswers(service.questionRepo, event, userID, answersReq)
+			if err != nil {
+				return entities.RSVPResponse{}, err
+			}
+		}
+		// Event baru masuk ke jadwal user saat berubah dari not_going
+		if current.Status == RSVPNotGoing && status != RSVPNotGoing {
+			rsvpRes.Conflicts, err = CheckConflicts(service.participantRepo, event, userID, force)
+			if err != nil {
+				return rsvpRes, err
+			}
+		}
+		err = service.participantRepo.UpdateStatus(int(current.ID), status, answers)
+		if err != nil {
+			return entities.RSVPResponse{}, err
+		}
+		current.Status = status
+	}
+
+	rsvpRes.RSVP = CountRSVP(event.RSVPs)
+	return rsvpRes, nil
+}
+
+/*
+ * Has Joined
+ * -------------------------------
+ * Mengecek apakah user sudah join event dengan status going atau
+ * maybe, participant yang tidak akan hadir tidak dihitung seperti
+ * penerima pengumuman. Event harus di-load beserta RSVPs
+ */
+func HasJoined(event entities.Event, userID int) bool {
+	if userID == 0 {
+		return false
+	}
+	for _, participant := range event.RSVPs {
+		if int(participant.UserID) == userID {
+			return participant.Status != RSVPNotGoing
+		}
+	}
+	return false
+}
@@ -0,0 +1,100 @@
+package participant
+
+import (
+	"time"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	participantRepository "tupulung/repositories/participant"
+)
+
+/*
+ * Overlaps
+ * -------------------------------
+ * Mengecek apakah dua event berlangsung pada waktu yang sama.
+ * Event dihitung per hari, dari tanggal mulai sampai akhir hari terakhir
+ */
+func Overlaps(a entities.Event, b entities.Event) bool {
+	return a.DatetimeEvent.Before(b.EndsAt()) && b.DatetimeEvent.Before(a.EndsAt())
+}
+
+/*
+ * Find Conflicts
+ * -------------------------------
+ * Mengambil event dari joined yang bentrok dengan event,
+ * event itu sendiri tidak ikut dihitung
+ */
+func FindConflicts(event entities.Event, joined []entities.Event) []entities.ScheduleConflictResponse {
+	conflicts := []entities.ScheduleConflictResponse{}
+	for _, other := range joined {
+		if other.ID == event.ID || !Overlaps(event, other) {
+			continue
+		}
+		conflicts = append(conflicts, entities.ScheduleConflictResponse{
+			EventID:          other.ID,
+			Title:            other.Title,
+			Slug:             other.Slug,
+			DatetimeEvent:    other.DatetimeEvent,
+			DatetimeEventEnd: other.DatetimeEventEnd,
+		})
+	}
+	return conflicts
+}
+
+/*
+ * Check conflicts
+ * -------------------------------
+ * Dipanggil sebelum event masuk ke jadwal user (join, RSVP going
+ * atau maybe, dan order tiket). Jika bentrok dengan event lain yang
+ * sudah di-join, error 409 dikembalikan beserta daftar event yang
+ * bentrok, kecuali force bernilai true
+ */
+func CheckConflicts(participantRepo participantRepository.ParticipantRepositoryInterface, event entities.Event, userID int, force bool) ([]entities.ScheduleConflictResponse, error) {
+	joined, err := participantRepo.FindSchedule(userID, event.DatetimeEvent)
+	if err != nil {
+		return []entities.ScheduleConflictResponse{}, err
+	}
+	conflicts := FindConflicts(event, joined)
+	if len(conflicts) > 0 && !force {
+		return conflicts, web.WebError{Code: 409, Message: "This event overlaps with other events you have joined"}
+	}
+	return conflicts, nil
+}
+
+/*
+ * Schedule
+ * -------------------------------
+ * Mengambil jadwal user, yaitu event yang di-join dan belum berakhir,
+ * event yang bentrok dengan event lain di jadwal ditandai HasConflict
+ */
+func (service ParticipantService) Schedule(userID int, now time.Time) ([]entities.ScheduleResponse, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	events, err := service.participantRepo.FindSchedule(userID, today)
+	if err != nil {
+		return []entities.ScheduleResponse{}, err
+	}
+
+	schedule := []entities.ScheduleResponse{}
+	for _, event := range events {
+		status := RSVPGoing
+		for _, participant := range event.RSVPs {
+			if int(participant.UserID) == userID && participant.Status != "" {
+				status = participant.Status
+			}
+		}
+		conflicts := FindConflicts(event, events)
+		schedule = append(schedule, entities.ScheduleResponse{
+			EventID:          event.ID,
+			Title:            event.Title,
+			Slug:             event.Slug,
+			HostedBy:         event.HostedBy,
+			Location:         event.Location,
+			Mode:             event.Mode,
+			DatetimeEvent:    event.DatetimeEvent,
+			DatetimeEventEnd: event.DatetimeEventEnd,
+			Status:           status,
+			HasConflict:      len(conflicts) > 0,
+			Conflicts:        conflicts,
+		})
+	}
+	return schedule, nil
+}
@@ -4,46 +4,244 @@ import (
 	"tupulung/entities"
 	"tupulung/entities/web"
 	eventRepository "tupulung/repositories/event"
+	inviteRepository "tupulung/repositories/invite"
 	participantRepository "tupulung/repositories/participant"
+	questionRepository "tupulung/repositories/question"
 	userRepository "tupulung/repositories/user"
+	cohostService "tupulung/services/cohost"
+	questionService "tupulung/services/question"
+
+	"github.com/jinzhu/copier"
 )
 
 type ParticipantService struct {
 	participantRepo participantRepository.ParticipantRepositoryInterface
 	userRepo        userRepository.UserRepositoryInterface
 	eventRepo       eventRepository.EventRepositoryInterface
+	inviteRepo      inviteRepository.InviteRepositoryInterface
+	questionRepo    questionRepository.QuestionRepositoryInterface
 }
 
 func NewParticipantService(repository participantRepository.ParticipantRepositoryInterface,
 	userRepository userRepository.UserRepositoryInterface,
 	eventRepository eventRepository.EventRepositoryInterface,
+	inviteRepository inviteRepository.InviteRepositoryInterface,
+	questionRepository questionRepository.QuestionRepositoryInterface,
 ) *ParticipantService {
 	return &ParticipantService{
 		participantRepo: repository,
 		userRepo:        userRepository,
 		eventRepo:       eventRepository,
+		inviteRepo:      inviteRepository,
+		questionRepo:    questionRepository,
 	}
 }
 
-func (service ParticipantService) Append(userID, eventID int) error {
+/*
+ * Find All participant
+ * -------------------------------
+ * Mengambil daftar participant event beserta invite yang digunakan,
+ * dapat difilter berdasarkan status RSVP.
+ * Hanya host dan co-host moderator yang dapat melihat
+ */
+func (service ParticipantService) FindAll(eventID, userID int, status string) ([]entities.ParticipantResponse, error) {
+	event, err := service.eventRepo.Find(eventID)
+	if err != nil {
+		return []entities.ParticipantResponse{}, web.WebError{Code: 400, Message: "Event is not exist"}
+	}
+	if !cohostService.Can(event, userID, cohostService.PermissionModerate) {
+		return []entities.ParticipantResponse{}, web.WebError{Code: 401, Message: "Only the host can see participant details"}
+	}
+
+	if status != "" && !IsRSVPStatus(status) {
+		return []entities.ParticipantResponse{}, web.WebError{Code: 400, Message: "status must be one of going, maybe or not_going"}
+	}
+
+	participants, err := service.participantRepo.FindByEvent(eventID, status)
+	if err != nil {
+		return []entities.ParticipantResponse{}, err
+	}
+
+	participantsRes := []entities.ParticipantResponse{}
+	for _, participant := range participants {
+		participantRes := entities.ParticipantResponse{}
+		copier.Copy(&participantRes, &participant)
+		participantRes.User.Email = VisibleEmail(participant.User)
+		participantRes.JoinedAt = participant.CreatedAt
+		if participant.Invite != nil {
+			participantRes.InviteCode = participant.Invite.Code
+		}
+		participantsRes = append(participantsRes, participantRes)
+	}
+	return participantsRes, nil
+}
+
+/*
+ * Visible Email
+ * -------------------------------
+ * Email participant yang memilih menyembunyikan email tidak
+ * ditampilkan kepada user lain, termasuk host
+ */
+func VisibleEmail(user entities.User) string {
+	if user.HideEmail {
+		return ""
+	}
+	return user.Email
+}
+
+/*
+ * Append
+ * -------------------------------
+ * Join event dengan status going. Jika event bentrok dengan event lain
+ * yang sudah di-join, join dibatalkan dengan error 409 beserta daftar
+ * event yang bentrok, kecuali force bernilai true
+ */
+func (service ParticipantService) Append(userID, eventID int, inviteCode string, answers []entities.EventAnswerRequest, force bool) (entities.JoinResponse, error) {
+	conflicts, err := service.join(userID, eventID, inviteCode, RSVPGoing, answers, force)
+	if err != nil && len(conflicts) == 0 {
+		return entities.JoinResponse{}, err
+	}
+	return entities.JoinResponse{
+		EventID:   uint(eventID),
+		UserID:    uint(userID),
+		Status:    RSVPGoing,
+		Conflicts: conflicts,
+	}, err
+}
+
+/*
+ * Join
+ * -------------------------------
+ * Menambahkan user ke event dengan status RSVP tertentu,
+ * kapasitas hanya dicek untuk status going. Jawaban registrasi
+ * divalidasi terhadap kuesioner event sebelum join, pertanyaan
+ * required tidak wajib dijawab untuk status not_going.
+ * Bentrok jadwal dicek paling akhir, setelah semua syarat join terpenuhi
+ */
+func (service ParticipantService) join(userID, eventID int, inviteCode string, status string, answersReq []entities.EventAnswerRequest, force bool) ([]entities.ScheduleConflictResponse, error) {
+	conflicts := []entities.ScheduleConflictResponse{}
 	user := entities.User{}
 	event := entities.Event{}
 
 	// get user data
 	user, err := service.userRepo.Find(userID)
 	if err != nil {
-		return web.WebError{Code: 400, Message: "No user matched with this authenticated user"}
+		return conflicts, web.WebError{Code: 400, Message: "No user matched with this authenticated user"}
 	}
 
 	event, eventErr := service.eventRepo.Find(eventID)
 	if eventErr != nil {
-		return web.WebError{Code: 400, Message: "Event is not exist"}
+		return conflicts, web.WebError{Code: 400, Message: "Event is not exist"}
 	}
-	tx := service.participantRepo.Append(user, event)
-	if tx != nil {
-		return web.WebError{Code: 400, Message: "You are already join this event"}
+	if len(event.TicketTypes) > 0 {
+		return conflicts, web.WebError{Code: 400, Message: "This event requires a ticket, please place an order"}
 	}
-	return nil
+	for _, participant := range event.RSVPs {
+		if participant.UserID == user.ID {
+			return conflicts, web.WebError{Code: 400, Message: "You are already join this event"}
+		}
+	}
+	if status == RSVPGoing && IsFull(event, 0) {
+		return conflicts, web.WebError{Code: 400, Message: "This event is already full"}
+	}
+
+	questions, err := service.questionRepo.FindByEvent(eventID)
+	if err != nil {
+		return conflicts, err
+	}
+	answers, err := questionService.ValidateAnswers(questions, answersReq, status != RSVPNotGoing)
+	if err != nil {
+		return conflicts, err
+	}
+
+	// Resolve the invite the user came through
+	participant := entities.Participant{UserID: user.ID, EventID: event.ID, Status: status}
+	invite, err := ResolveInvite(service.inviteRepo, event, int(user.ID), inviteCode)
+	if err != nil {
+		return conflicts, err
+	}
+	if invite.ID != 0 {
+		participant.InviteID = &invite.ID
+	}
+	for i := range answers {
+		answers[i].EventID = event.ID
+		answers[i].UserID = user.ID
+	}
+
+	if status != RSVPNotGoing {
+		conflicts, err = CheckConflicts(service.participantRepo, event, int(user.ID), force)
+		if err != nil {
+			return conflicts, err
+		}
+	}
+
+	// Participant, jawaban registrasi dan pemakaian invite disimpan dalam satu transaksi
+	return conflicts, service.participantRepo.Append(participant, answers)
+}
+
+/*
+ * Resolve invite
+ * -------------------------------
+ * Event private hanya bisa di-join (atau dibeli tiketnya) oleh tim host,
+ * user yang diundang secara personal, atau melalui kode invite yang
+ * masih berlaku. Untuk event lain kode invite hanya dicatat jika valid
+ */
+func ResolveInvite(inviteRepo inviteRepository.InviteRepositoryInterface, event entities.Event, userID int, inviteCode string) (entities.EventInvite, error) {
+	if inviteCode != "" {
+		invite, err := inviteRepo.FindUsableByCode(int(event.ID), inviteCode)
+		if err == nil {
+			return invite, nil
+		}
+		if event.Visibility == "private" {
+			return entities.EventInvite{}, err
+		}
+		return entities.EventInvite{}, nil
+	}
+	if event.Visibility != "private" || cohostService.IsTeamMember(event, userID) {
+		return entities.EventInvite{}, nil
+	}
+	invite, err := inviteRepo.FindUsableByUser(int(event.ID), userID)
+	if err != nil {
+		return entities.EventInvite{}, web.WebError{Code: 403, Message: "This event is private, an invitation is required to join"}
+	}
+	return invite, nil
+}
+
+/*
+ * Resolve answers
+ * -------------------------------
+ * Memvalidasi jawaban registrasi user yang berubah menjadi going
+ * atau maybe (melalui RSVP maupun order tiket). Jika tidak ada jawaban
+ * yang dikirim, jawaban dari join sebelumnya yang divalidasi dan nil
+ * dikembalikan agar jawaban lama tetap dipakai
+ */
+func ResolveAnswers(questionRepo questionRepository.QuestionRepositoryInterface, event entities.Event, userID int, answersReq []entities.EventAnswerRequest) ([]entities.EventAnswer, error) {
+	questions, err := questionRepo.FindByEvent(int(event.ID))
+	if err != nil {
+		return nil, err
+	}
+	if len(answersReq) == 0 {
+		stored, err := questionRepo.FindAnswers(int(event.ID), userID)
+		if err != nil {
+			return nil, err
+		}
+		existing := []entities.EventAnswerRequest{}
+		for _, answer := range stored {
+			existing = append(existing, entities.EventAnswerRequest{QuestionID: answer.QuestionID, Values: questionService.AnswerValues(answer)})
+		}
+		_, err = questionService.ValidateAnswers(questions, existing, true)
+		return nil, err
+	}
+
+	answers, err := questionService.ValidateAnswers(questions, answersReq, true)
+	if err != nil {
+		return nil, err
+	}
+	for i := range answers {
+		answers[i].EventID = event.ID
+		answers[i].UserID = uint(userID)
+	}
+	return answers, nil
 }
 
 func (service ParticipantService) Delete(userID, eventID int) error {
@@ -60,9 +258,36 @@ func (service ParticipantService) Delete(userID, eventID int) error {
 	if eventErr != nil {
 		return web.WebError{Code: 400, Message: "Event is not exist"}
 	}
+	if len(event.TicketTypes) > 0 {
+		return web.WebError{Code: 400, Message: "This event is ticketed, please request a refund to leave"}
+	}
 	tx := service.participantRepo.Delete(user, event)
 	if tx != nil {
 		return web.WebError{Code: 400, Message: "You are not a member of this event"}
 	}
 	return nil
 }
+
+/*
+ * Remove participant
+ * -------------------------------
+ * Mengeluarkan participant dari event oleh host atau co-host moderator
+ */
+func (service ParticipantService) Remove(eventID, participantUserID, userID int) error {
+	event, err := service.eventRepo.Find(eventID)
+	if err != nil {
+		return web.WebError{Code: 400, Message: "Event is not exist"}
+	}
+	if !cohostService.Can(event, userID, cohostService.PermissionModerate) {
+		return web.WebError{Code: 401, Message: "Only the host can remove participants"}
+	}
+	participant, err := service.userRepo.Find(participantUserID)
+	if err != nil {
+		return web.WebError{Code: 400, Message: "The requested user doesn't match with any record"}
+	}
+	err = service.participantRepo.Delete(participant, event)
+	if err != nil {
+		return web.WebError{Code: 400, Message: "User is not a member of this event"}
+	}
+	return nil
+}
@@ -1,6 +1,20 @@
 package participant
 
+import (
+	"io"
+	"time"
+	"tupulung/entities"
+)
+
 type ParticipantServiceInterface interface {
-	Append(userID, eventID int) error
+	FindAll(eventID, userID int, status string) ([]entities.ParticipantResponse, error)
+	Append(userID, eventID int, inviteCode string, answers []entities.EventAnswerRequest, force bool) (entities.JoinResponse, error)
+	Schedule(userID int, now time.Time) ([]entities.ScheduleResponse, error)
+	RSVP(userID, eventID int, status string, inviteCode string, answers []entities.EventAnswerRequest, force bool) (entities.RSVPResponse, error)
 	Delete(userID, eventID int) error
+	Remove(eventID, participantUserID, userID int) error
+	Ticket(eventID, userID int) (entities.ParticipantTicketResponse, error)
+	CheckIn(code string, eventID, userID int) (entities.ParticipantResponse, error)
+	Attendance(eventID, userID int) (entities.AttendanceResponse, error)
+	Export(eventID, userID int, format string, open func(filename string, contentType string) io.Writer) error
 }
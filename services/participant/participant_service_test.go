@@ -1,11 +1,19 @@
 package participant_test
 
 import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"io/ioutil"
+	"strings"
 	"testing"
+	"time"
 	"tupulung/entities"
 	"tupulung/entities/web"
 	eventRepository "tupulung/repositories/event"
+	inviteRepository "tupulung/repositories/invite"
 	participantRepository "tupulung/repositories/participant"
+	questionRepository "tupulung/repositories/question"
 	userRepository "tupulung/repositories/user"
 	participantService "tupulung/services/participant"
 
@@ -13,6 +21,13 @@ import (
 	"github.com/stretchr/testify/mock"
 )
 
+func emptyQuestionRepository() *questionRepository.QuestionRepositoryMock {
+	questionRepositoryMock := questionRepository.NewQuestionRepositoryMock(&mock.Mock{})
+	questionRepositoryMock.Mock.On("FindByEvent").Return([]entities.EventQuestion{}, nil)
+	questionRepositoryMock.Mock.On("FindAnswers").Return([]entities.EventAnswer{}, nil)
+	return questionRepositoryMock
+}
+
 func TestAppend(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		userSample := userRepository.UserCollection[0]
@@ -22,16 +37,105 @@ func TestAppend(t *testing.T) {
 		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
 		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
 		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
-		participantRepositoryMock.Mock.On("Append").Return(nil)
+		participantRepositoryMock.Mock.On("FindSchedule").Return([]entities.Event{}, nil)
+		inviteRepositoryMock := inviteRepository.NewInviteRepositoryMock(&mock.Mock{})
+		participantRepositoryMock.Mock.On("Append", mock.Anything).Return(nil)
 
 		Service := participantService.NewParticipantService(
 			participantRepositoryMock,
 			userRepositoryMock,
 			eventRepositoryMock,
+			inviteRepositoryMock,
+			emptyQuestionRepository(),
 		)
-		err := Service.Append(int(userSample.ID), int(eventSample.ID))
+		_, err := Service.Append(int(userSample.ID), int(eventSample.ID), "", nil, false)
 		assert.Nil(t, err)
 	})
+	t.Run("answers-stored", func(t *testing.T) {
+		userSample := userRepository.UserCollection[0]
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(userSample, nil)
+		eventSample := eventRepository.EventCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		participantRepositoryMock.Mock.On("FindSchedule").Return([]entities.Event{}, nil)
+		questionRepositoryMock := questionRepository.NewQuestionRepositoryMock(&mock.Mock{})
+		questionRepositoryMock.Mock.On("FindByEvent").Return(questionRepository.EventQuestionCollection, nil)
+		expected := []entities.EventAnswer{
+			{EventID: eventSample.ID, UserID: userSample.ID, QuestionID: 1, Value: `["Vegan"]`},
+			{EventID: eventSample.ID, UserID: userSample.ID, QuestionID: 2, Value: `["S","L"]`},
+		}
+		participantRepositoryMock.Mock.On("Append", expected).Return(nil)
+		Service := participantService.NewParticipantService(
+			participantRepositoryMock,
+			userRepositoryMock,
+			eventRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			questionRepositoryMock,
+		)
+		_, err := Service.Append(int(userSample.ID), int(eventSample.ID), "", []entities.EventAnswerRequest{
+			{QuestionID: 1, Value: "Vegan"},
+			{QuestionID: 2, Values: []string{"S", "L"}},
+		}, false)
+		assert.Nil(t, err)
+		participantRepositoryMock.Mock.AssertCalled(t, "Append", expected)
+	})
+	t.Run("answers-invalid", func(t *testing.T) {
+		userSample := userRepository.UserCollection[0]
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(userSample, nil)
+		eventSample := eventRepository.EventCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		participantRepositoryMock.Mock.On("FindSchedule").Return([]entities.Event{}, nil)
+		questionRepositoryMock := questionRepository.NewQuestionRepositoryMock(&mock.Mock{})
+		questionRepositoryMock.Mock.On("FindByEvent").Return(questionRepository.EventQuestionCollection, nil)
+		Service := participantService.NewParticipantService(
+			participantRepositoryMock,
+			userRepositoryMock,
+			eventRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			questionRepositoryMock,
+		)
+		_, err := Service.Append(int(userSample.ID), int(eventSample.ID), "", []entities.EventAnswerRequest{
+			{QuestionID: 2, Values: []string{"XXL"}},
+		}, false)
+		assert.Equal(t, web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors: []web.ValidationErrorItem{
+				{Field: "answers.2", Error: "XXL is not a valid option"},
+				{Field: "answers.1", Error: "Dietary preference must be answered"},
+			},
+		}, err)
+		participantRepositoryMock.Mock.AssertNotCalled(t, "Append", mock.Anything)
+	})
+	t.Run("answers-store-failed", func(t *testing.T) {
+		userSample := userRepository.UserCollection[0]
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(userSample, nil)
+		eventSample := eventRepository.EventCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		participantRepositoryMock.Mock.On("FindSchedule").Return([]entities.Event{}, nil)
+		participantRepositoryMock.Mock.On("Append", mock.Anything).Return(web.WebError{Code: 500, Message: "server error"})
+		questionRepositoryMock := questionRepository.NewQuestionRepositoryMock(&mock.Mock{})
+		questionRepositoryMock.Mock.On("FindByEvent").Return(questionRepository.EventQuestionCollection, nil)
+		Service := participantService.NewParticipantService(
+			participantRepositoryMock,
+			userRepositoryMock,
+			eventRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			questionRepositoryMock,
+		)
+		_, err := Service.Append(int(userSample.ID), int(eventSample.ID), "", []entities.EventAnswerRequest{
+			{QuestionID: 1, Value: "None"},
+		}, false)
+		assert.Equal(t, web.WebError{Code: 500, Message: "server error"}, err)
+	})
 	t.Run("repo-fail", func(t *testing.T) {
 		userSample := userRepository.UserCollection[0]
 		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
@@ -40,15 +144,135 @@ func TestAppend(t *testing.T) {
 		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
 		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
 		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
-		participantRepositoryMock.Mock.On("Append").Return(web.WebError{})
+		participantRepositoryMock.Mock.On("FindSchedule").Return([]entities.Event{}, nil)
+		inviteRepositoryMock := inviteRepository.NewInviteRepositoryMock(&mock.Mock{})
+		participantRepositoryMock.Mock.On("Append", mock.Anything).Return(web.WebError{})
 		Service := participantService.NewParticipantService(
 			participantRepositoryMock,
 			userRepositoryMock,
 			eventRepositoryMock,
+			inviteRepositoryMock,
+			emptyQuestionRepository(),
 		)
-		err := Service.Append(int(userSample.ID), int(eventSample.ID))
+		_, err := Service.Append(int(userSample.ID), int(eventSample.ID), "", nil, false)
 		assert.Error(t, err)
 	})
+	t.Run("event-full", func(t *testing.T) {
+		userSample := userRepository.UserCollection[0]
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(userSample, nil)
+		eventSample := eventRepository.EventCollection[0]
+		eventSample.Capacity = 1
+		eventSample.RSVPs = []entities.Participant{{ID: 2, UserID: 2, EventID: eventSample.ID, Status: "going"}}
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		participantRepositoryMock.Mock.On("FindSchedule").Return([]entities.Event{}, nil)
+		inviteRepositoryMock := inviteRepository.NewInviteRepositoryMock(&mock.Mock{})
+		participantRepositoryMock.Mock.On("Append", mock.Anything).Return(nil)
+		Service := participantService.NewParticipantService(
+			participantRepositoryMock,
+			userRepositoryMock,
+			eventRepositoryMock,
+			inviteRepositoryMock,
+			emptyQuestionRepository(),
+		)
+		_, err := Service.Append(int(userSample.ID), int(eventSample.ID), "", nil, false)
+		assert.Equal(t, web.WebError{Code: 400, Message: "This event is already full"}, err)
+		participantRepositoryMock.Mock.AssertNotCalled(t, "Append", mock.Anything)
+	})
+	t.Run("private-not-invited", func(t *testing.T) {
+		userSample := userRepository.UserCollection[1]
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(userSample, nil)
+		eventSample := eventRepository.EventCollection[0]
+		eventSample.Visibility = "private"
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		participantRepositoryMock.Mock.On("FindSchedule").Return([]entities.Event{}, nil)
+		inviteRepositoryMock := inviteRepository.NewInviteRepositoryMock(&mock.Mock{})
+		inviteRepositoryMock.Mock.On("FindUsableByUser").Return(entities.EventInvite{}, web.WebError{Code: 400})
+		Service := participantService.NewParticipantService(
+			participantRepositoryMock,
+			userRepositoryMock,
+			eventRepositoryMock,
+			inviteRepositoryMock,
+			emptyQuestionRepository(),
+		)
+		_, err := Service.Append(int(userSample.ID), int(eventSample.ID), "", nil, false)
+		assert.Equal(t, web.WebError{Code: 403, Message: "This event is private, an invitation is required to join"}, err)
+		participantRepositoryMock.Mock.AssertNotCalled(t, "Append", mock.Anything)
+	})
+	t.Run("ticketed-event", func(t *testing.T) {
+		userSample := userRepository.UserCollection[1]
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(userSample, nil)
+		eventSample := eventRepository.EventCollection[0]
+		eventSample.TicketTypes = []entities.TicketType{{Name: "Regular", Quantity: 10}}
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		participantRepositoryMock.Mock.On("FindSchedule").Return([]entities.Event{}, nil)
+		Service := participantService.NewParticipantService(
+			participantRepositoryMock,
+			userRepositoryMock,
+			eventRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			emptyQuestionRepository(),
+		)
+		_, err := Service.Append(int(userSample.ID), int(eventSample.ID), "", nil, false)
+		assert.Equal(t, web.WebError{Code: 400, Message: "This event requires a ticket, please place an order"}, err)
+		participantRepositoryMock.Mock.AssertNotCalled(t, "Append", mock.Anything)
+	})
+	t.Run("private-invite-code", func(t *testing.T) {
+		userSample := userRepository.UserCollection[1]
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(userSample, nil)
+		eventSample := eventRepository.EventCollection[0]
+		eventSample.Visibility = "private"
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		inviteSample := inviteRepository.InviteCollection[1]
+		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		participantRepositoryMock.Mock.On("FindSchedule").Return([]entities.Event{}, nil)
+		participantRepositoryMock.Mock.On("Append", mock.Anything).Return(nil)
+		inviteRepositoryMock := inviteRepository.NewInviteRepositoryMock(&mock.Mock{})
+		inviteRepositoryMock.Mock.On("FindUsableByCode").Return(inviteSample, nil)
+		Service := participantService.NewParticipantService(
+			participantRepositoryMock,
+			userRepositoryMock,
+			eventRepositoryMock,
+			inviteRepositoryMock,
+			emptyQuestionRepository(),
+		)
+		_, err := Service.Append(int(userSample.ID), int(eventSample.ID), inviteSample.Code, nil, false)
+		assert.Nil(t, err)
+		participantRepositoryMock.Mock.AssertCalled(t, "Append", mock.Anything)
+	})
+	t.Run("invite-used-up", func(t *testing.T) {
+		userSample := userRepository.UserCollection[1]
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(userSample, nil)
+		eventSample := eventRepository.EventCollection[0]
+		eventSample.Visibility = "private"
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		participantRepositoryMock.Mock.On("FindSchedule").Return([]entities.Event{}, nil)
+		participantRepositoryMock.Mock.On("Append", mock.Anything).Return(web.WebError{Code: 400, Message: "invite code is invalid or no longer usable"})
+		inviteRepositoryMock := inviteRepository.NewInviteRepositoryMock(&mock.Mock{})
+		inviteRepositoryMock.Mock.On("FindUsableByCode").Return(inviteRepository.InviteCollection[1], nil)
+		Service := participantService.NewParticipantService(
+			participantRepositoryMock,
+			userRepositoryMock,
+			eventRepositoryMock,
+			inviteRepositoryMock,
+			emptyQuestionRepository(),
+		)
+		_, err := Service.Append(int(userSample.ID), int(eventSample.ID), inviteRepository.InviteCollection[1].Code, nil, false)
+		assert.Equal(t, web.WebError{Code: 400, Message: "invite code is invalid or no longer usable"}, err)
+	})
 	t.Run("repo-fail-user", func(t *testing.T) {
 		userSample := userRepository.UserCollection[0]
 		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
@@ -57,13 +281,17 @@ func TestAppend(t *testing.T) {
 		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
 		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
 		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
-		participantRepositoryMock.Mock.On("Append").Return(web.WebError{})
+		participantRepositoryMock.Mock.On("FindSchedule").Return([]entities.Event{}, nil)
+		inviteRepositoryMock := inviteRepository.NewInviteRepositoryMock(&mock.Mock{})
+		participantRepositoryMock.Mock.On("Append", mock.Anything).Return(web.WebError{})
 		Service := participantService.NewParticipantService(
 			participantRepositoryMock,
 			userRepositoryMock,
 			eventRepositoryMock,
+			inviteRepositoryMock,
+			emptyQuestionRepository(),
 		)
-		err := Service.Append(int(userSample.ID), int(eventSample.ID))
+		_, err := Service.Append(int(userSample.ID), int(eventSample.ID), "", nil, false)
 		assert.Error(t, err)
 	})
 	t.Run("repo-fail-event", func(t *testing.T) {
@@ -74,16 +302,422 @@ func TestAppend(t *testing.T) {
 		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
 		eventRepositoryMock.Mock.On("Find").Return(entities.Event{}, web.WebError{})
 		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
-		participantRepositoryMock.Mock.On("Append").Return(web.WebError{})
+		participantRepositoryMock.Mock.On("FindSchedule").Return([]entities.Event{}, nil)
+		inviteRepositoryMock := inviteRepository.NewInviteRepositoryMock(&mock.Mock{})
+		participantRepositoryMock.Mock.On("Append", mock.Anything).Return(web.WebError{})
 		Service := participantService.NewParticipantService(
 			participantRepositoryMock,
 			userRepositoryMock,
 			eventRepositoryMock,
+			inviteRepositoryMock,
+			emptyQuestionRepository(),
 		)
-		err := Service.Append(int(userSample.ID), int(eventSample.ID))
+		_, err := Service.Append(int(userSample.ID), int(eventSample.ID), "", nil, false)
 		assert.Error(t, err)
 	})
 }
+func TestAppendScheduleConflict(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2022, 5, d, 0, 0, 0, 0, time.UTC) }
+	newService := func(participantRepositoryMock *participantRepository.ParticipantRepositoryMock) *participantService.ParticipantService {
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(userRepository.UserCollection[0], nil)
+		eventSample := eventRepository.EventCollection[0]
+		eventSample.DatetimeEvent = day(1)
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		return participantService.NewParticipantService(
+			participantRepositoryMock,
+			userRepositoryMock,
+			eventRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			emptyQuestionRepository(),
+		)
+	}
+	multiDayEnd := day(2)
+	joined := []entities.Event{
+		eventRepository.EventCollection[1],
+		eventRepository.EventCollection[1],
+	}
+	joined[0].DatetimeEvent, joined[0].DatetimeEventEnd = day(30).AddDate(0, -1, 0), &multiDayEnd
+	joined[1].ID, joined[1].DatetimeEvent = 3, day(2)
+
+	t.Run("conflict", func(t *testing.T) {
+		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		participantRepositoryMock.Mock.On("FindSchedule").Return(joined, nil)
+
+		data, err := newService(participantRepositoryMock).Append(1, 1, "", nil, false)
+
+		assert.Equal(t, web.WebError{Code: 409, Message: "This event overlaps with other events you have joined"}, err)
+		assert.Len(t, data.Conflicts, 1)
+		assert.Equal(t, uint(2), data.Conflicts[0].EventID)
+		participantRepositoryMock.Mock.AssertNotCalled(t, "Append", mock.Anything)
+	})
+	t.Run("force", func(t *testing.T) {
+		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		participantRepositoryMock.Mock.On("FindSchedule").Return(joined, nil)
+		participantRepositoryMock.Mock.On("Append", mock.Anything).Return(nil)
+
+		data, err := newService(participantRepositoryMock).Append(1, 1, "", nil, true)
+
+		assert.Nil(t, err)
+		assert.Len(t, data.Conflicts, 1)
+		participantRepositoryMock.Mock.AssertCalled(t, "Append", mock.Anything)
+	})
+	t.Run("already-joined", func(t *testing.T) {
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(userRepository.UserCollection[0], nil)
+		eventSample := eventRepository.EventCollection[0]
+		eventSample.DatetimeEvent = day(1)
+		eventSample.RSVPs = []entities.Participant{{UserID: userRepository.UserCollection[0].ID, Status: "going"}}
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		participantRepositoryMock.Mock.On("FindSchedule").Return(joined, nil)
+		Service := participantService.NewParticipantService(
+			participantRepositoryMock,
+			userRepositoryMock,
+			eventRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			emptyQuestionRepository(),
+		)
+
+		_, err := Service.Append(1, 1, "", nil, false)
+
+		assert.Equal(t, web.WebError{Code: 400, Message: "You are already join this event"}, err)
+		participantRepositoryMock.Mock.AssertNotCalled(t, "FindSchedule")
+	})
+	t.Run("rsvp-conflict", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventSample.DatetimeEvent = day(1)
+		eventSample.RSVPs = []entities.Participant{{ID: 2, UserID: 1, Status: "not_going"}}
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		participantRepositoryMock.Mock.On("FindSchedule").Return(joined, nil)
+		Service := participantService.NewParticipantService(
+			participantRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			eventRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			emptyQuestionRepository(),
+		)
+
+		data, err := Service.RSVP(1, 1, "going", "", nil, false)
+
+		assert.Equal(t, web.WebError{Code: 409, Message: "This event overlaps with other events you have joined"}, err)
+		assert.Len(t, data.Conflicts, 1)
+		participantRepositoryMock.Mock.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything)
+	})
+	t.Run("no-conflict", func(t *testing.T) {
+		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		participantRepositoryMock.Mock.On("FindSchedule").Return(joined[1:], nil)
+		participantRepositoryMock.Mock.On("Append", mock.Anything).Return(nil)
+
+		data, err := newService(participantRepositoryMock).Append(1, 1, "", nil, false)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "going", data.Status)
+		assert.Empty(t, data.Conflicts)
+	})
+}
+
+func TestSchedule(t *testing.T) {
+	t.Run("highlight-conflicts", func(t *testing.T) {
+		day := func(d int) time.Time { return time.Date(2022, 5, d, 0, 0, 0, 0, time.UTC) }
+		end := day(3)
+		events := []entities.Event{
+			eventRepository.EventCollection[0],
+			eventRepository.EventCollection[1],
+			eventRepository.EventCollection[0],
+		}
+		events[0].DatetimeEvent, events[0].DatetimeEventEnd = day(1), &end
+		events[0].RSVPs = []entities.Participant{{UserID: 1, EventID: 1, Status: "maybe"}}
+		events[1].DatetimeEvent = day(3)
+		events[2].ID, events[2].DatetimeEvent = 3, day(5)
+		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		participantRepositoryMock.Mock.On("FindSchedule").Return(events, nil)
+		Service := participantService.NewParticipantService(
+			participantRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			emptyQuestionRepository(),
+		)
+
+		data, err := Service.Schedule(1, day(1))
+
+		assert.Nil(t, err)
+		assert.Len(t, data, 3)
+		assert.Equal(t, "maybe", data[0].Status)
+		assert.True(t, data[0].HasConflict)
+		assert.Equal(t, uint(2), data[0].Conflicts[0].EventID)
+		assert.True(t, data[1].HasConflict)
+		assert.Equal(t, "going", data[1].Status)
+		assert.False(t, data[2].HasConflict)
+		assert.Empty(t, data[2].Conflicts)
+	})
+}
+
+func TestCanView(t *testing.T) {
+	hiddenAt := time.Now()
+	t.Run("hidden-event", func(t *testing.T) {
+		event := eventRepository.EventCollection[0]
+		event.HiddenAt = &hiddenAt
+		event.RSVPs = []entities.Participant{{UserID: 5, EventID: 1, Status: "going"}}
+		inviteRepositoryMock := inviteRepository.NewInviteRepositoryMock(&mock.Mock{})
+
+		assert.False(t, participantService.CanView(inviteRepositoryMock, event, 5, ""))
+		assert.True(t, participantService.CanView(inviteRepositoryMock, event, int(event.UserID), ""))
+	})
+	t.Run("private-invite-code", func(t *testing.T) {
+		event := eventRepository.EventCollection[0]
+		event.Visibility = "private"
+		inviteRepositoryMock := inviteRepository.NewInviteRepositoryMock(&mock.Mock{})
+		inviteRepositoryMock.Mock.On("FindUsableByCode").Return(entities.EventInvite{}, nil)
+
+		assert.True(t, participantService.CanView(inviteRepositoryMock, event, 0, "abc"))
+		inviteRepositoryMock.Mock.AssertNotCalled(t, "FindUsableByUser")
+	})
+	t.Run("private-guest", func(t *testing.T) {
+		event := eventRepository.EventCollection[0]
+		event.Visibility = "private"
+		inviteRepositoryMock := inviteRepository.NewInviteRepositoryMock(&mock.Mock{})
+		inviteRepositoryMock.Mock.On("FindUsableByUser").Return(entities.EventInvite{}, web.WebError{Code: 400})
+
+		assert.False(t, participantService.CanView(inviteRepositoryMock, event, 5, ""))
+		assert.False(t, participantService.CanView(inviteRepositoryMock, event, 0, ""))
+	})
+}
+
+func TestCanViewDetails(t *testing.T) {
+	event := eventRepository.EventCollection[0]
+	event.RSVPs = []entities.Participant{
+		{UserID: 5, EventID: 1, Status: "maybe"},
+		{UserID: 6, EventID: 1, Status: "not_going"},
+	}
+
+	assert.True(t, participantService.CanViewDetails(event, 5))
+	assert.False(t, participantService.CanViewDetails(event, 6))
+	assert.True(t, participantService.CanViewDetails(event, int(event.UserID)))
+}
+
+func TestFindAll(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		inviteSample := inviteRepository.InviteCollection[1]
+		participantSample := []entities.Participant{
+			{ID: 1, EventID: eventSample.ID, UserID: 2, User: userRepository.UserCollection[1], InviteID: &inviteSample.ID, Invite: &inviteSample},
+		}
+		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		participantRepositoryMock.Mock.On("FindByEvent").Return(participantSample, nil)
+		Service := participantService.NewParticipantService(
+			participantRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			eventRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			emptyQuestionRepository(),
+		)
+		data, err := Service.FindAll(int(eventSample.ID), int(eventSample.UserID), "")
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(data))
+		assert.Equal(t, inviteSample.Code, data[0].InviteCode)
+		assert.Equal(t, userRepository.UserCollection[1].Email, data[0].User.Email)
+	})
+	t.Run("hidden-email", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		hiddenUser := userRepository.UserCollection[1]
+		hiddenUser.HideEmail = true
+		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		participantRepositoryMock.Mock.On("FindByEvent").Return([]entities.Participant{
+			{ID: 1, EventID: eventSample.ID, UserID: hiddenUser.ID, User: hiddenUser},
+		}, nil)
+		Service := participantService.NewParticipantService(
+			participantRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			eventRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			emptyQuestionRepository(),
+		)
+		data, err := Service.FindAll(int(eventSample.ID), int(eventSample.UserID), "")
+		assert.Nil(t, err)
+		assert.Equal(t, "", data[0].User.Email)
+	})
+	t.Run("not-host", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		Service := participantService.NewParticipantService(
+			participantRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			eventRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			emptyQuestionRepository(),
+		)
+		_, err := Service.FindAll(int(eventSample.ID), 2, "")
+		assert.Error(t, err)
+		participantRepositoryMock.Mock.AssertNotCalled(t, "FindByEvent")
+	})
+	t.Run("invalid-status", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		Service := participantService.NewParticipantService(
+			participantRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			eventRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			emptyQuestionRepository(),
+		)
+		_, err := Service.FindAll(int(eventSample.ID), int(eventSample.UserID), "attending")
+		assert.Equal(t, web.WebError{Code: 400, Message: "status must be one of going, maybe or not_going"}, err)
+		participantRepositoryMock.Mock.AssertNotCalled(t, "FindByEvent")
+	})
+}
+
+func TestRSVP(t *testing.T) {
+	t.Run("switch-status", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventSample.RSVPs = []entities.Participant{
+			{ID: 1, UserID: 1, EventID: eventSample.ID, Status: "going"},
+			{ID: 2, UserID: 2, EventID: eventSample.ID, Status: "going"},
+		}
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		participantRepositoryMock.Mock.On("UpdateStatus", "maybe", []entities.EventAnswer(nil)).Return(nil)
+		Service := participantService.NewParticipantService(
+			participantRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			eventRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			emptyQuestionRepository(),
+		)
+		data, err := Service.RSVP(2, int(eventSample.ID), "maybe", "", nil, false)
+		assert.Nil(t, err)
+		assert.Equal(t, "maybe", data.Status)
+		assert.Equal(t, entities.RSVPCountResponse{Going: 1, Maybe: 1}, data.RSVP)
+		participantRepositoryMock.Mock.AssertNotCalled(t, "Append", mock.Anything)
+		participantRepositoryMock.Mock.AssertNotCalled(t, "Delete")
+	})
+	t.Run("required-answers-missing", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventSample.RSVPs = []entities.Participant{
+			{ID: 2, UserID: 2, EventID: eventSample.ID, Status: "not_going"},
+		}
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		questionRepositoryMock := questionRepository.NewQuestionRepositoryMock(&mock.Mock{})
+		questionRepositoryMock.Mock.On("FindByEvent").Return(questionRepository.EventQuestionCollection, nil)
+		questionRepositoryMock.Mock.On("FindAnswers").Return([]entities.EventAnswer{}, nil)
+		Service := participantService.NewParticipantService(
+			participantRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			eventRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			questionRepositoryMock,
+		)
+		_, err := Service.RSVP(2, int(eventSample.ID), "going", "", nil, false)
+		assert.IsType(t, web.ValidationError{}, err)
+		participantRepositoryMock.Mock.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything)
+	})
+	t.Run("answers-replaced", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventSample.RSVPs = []entities.Participant{
+			{ID: 2, UserID: 2, EventID: eventSample.ID, Status: "not_going"},
+		}
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		expected := []entities.EventAnswer{
+			{EventID: eventSample.ID, UserID: 2, QuestionID: 1, Value: `["Vegan"]`},
+		}
+		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		participantRepositoryMock.Mock.On("FindSchedule").Return([]entities.Event{}, nil)
+		participantRepositoryMock.Mock.On("UpdateStatus", "maybe", expected).Return(nil)
+		questionRepositoryMock := questionRepository.NewQuestionRepositoryMock(&mock.Mock{})
+		questionRepositoryMock.Mock.On("FindByEvent").Return(questionRepository.EventQuestionCollection, nil)
+		Service := participantService.NewParticipantService(
+			participantRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			eventRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			questionRepositoryMock,
+		)
+		data, err := Service.RSVP(2, int(eventSample.ID), "maybe", "", []entities.EventAnswerRequest{
+			{QuestionID: 1, Value: "Vegan"},
+		}, false)
+		assert.Nil(t, err)
+		assert.Equal(t, "maybe", data.Status)
+		participantRepositoryMock.Mock.AssertCalled(t, "UpdateStatus", "maybe", expected)
+	})
+	t.Run("going-when-full", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventSample.Capacity = 1
+		eventSample.RSVPs = []entities.Participant{
+			{ID: 1, UserID: 1, EventID: eventSample.ID, Status: "going"},
+			{ID: 2, UserID: 2, EventID: eventSample.ID, Status: "maybe"},
+		}
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		Service := participantService.NewParticipantService(
+			participantRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			eventRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			emptyQuestionRepository(),
+		)
+		_, err := Service.RSVP(2, int(eventSample.ID), "going", "", nil, false)
+		assert.Equal(t, web.WebError{Code: 400, Message: "This event is already full"}, err)
+		participantRepositoryMock.Mock.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything)
+	})
+	t.Run("maybe-when-full", func(t *testing.T) {
+		userSample := userRepository.UserCollection[1]
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(userSample, nil)
+		eventSample := eventRepository.EventCollection[0]
+		eventSample.Capacity = 1
+		eventSample.RSVPs = []entities.Participant{
+			{ID: 1, UserID: 1, EventID: eventSample.ID, Status: "going"},
+		}
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		participantRepositoryMock.Mock.On("FindSchedule").Return([]entities.Event{}, nil)
+		participantRepositoryMock.Mock.On("Append", mock.Anything).Return(nil)
+		Service := participantService.NewParticipantService(
+			participantRepositoryMock,
+			userRepositoryMock,
+			eventRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			emptyQuestionRepository(),
+		)
+		data, err := Service.RSVP(int(userSample.ID), int(eventSample.ID), "maybe", "", nil, false)
+		assert.Nil(t, err)
+		assert.Equal(t, entities.RSVPCountResponse{Going: 1, Maybe: 1}, data.RSVP)
+		participantRepositoryMock.Mock.AssertCalled(t, "Append", mock.Anything)
+	})
+	t.Run("invalid-status", func(t *testing.T) {
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		Service := participantService.NewParticipantService(
+			participantRepository.NewParticipantRepositoryMock(&mock.Mock{}),
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			eventRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			emptyQuestionRepository(),
+		)
+		_, err := Service.RSVP(1, 1, "attending", "", nil, false)
+		assert.Equal(t, web.WebError{Code: 400, Message: "status must be one of going, maybe or not_going"}, err)
+		eventRepositoryMock.Mock.AssertNotCalled(t, "Find")
+	})
+}
+
 func TestDelete(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		userSample := userRepository.UserCollection[0]
@@ -93,12 +727,15 @@ func TestDelete(t *testing.T) {
 		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
 		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
 		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		inviteRepositoryMock := inviteRepository.NewInviteRepositoryMock(&mock.Mock{})
 		participantRepositoryMock.Mock.On("Delete").Return(nil)
 
 		Service := participantService.NewParticipantService(
 			participantRepositoryMock,
 			userRepositoryMock,
 			eventRepositoryMock,
+			inviteRepositoryMock,
+			emptyQuestionRepository(),
 		)
 		err := Service.Delete(int(userSample.ID), int(eventSample.ID))
 		assert.Nil(t, err)
@@ -111,11 +748,14 @@ func TestDelete(t *testing.T) {
 		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
 		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
 		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		inviteRepositoryMock := inviteRepository.NewInviteRepositoryMock(&mock.Mock{})
 		participantRepositoryMock.Mock.On("Delete").Return(web.WebError{})
 		Service := participantService.NewParticipantService(
 			participantRepositoryMock,
 			userRepositoryMock,
 			eventRepositoryMock,
+			inviteRepositoryMock,
+			emptyQuestionRepository(),
 		)
 		err := Service.Delete(int(userSample.ID), int(eventSample.ID))
 		assert.Error(t, err)
@@ -128,11 +768,14 @@ func TestDelete(t *testing.T) {
 		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
 		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
 		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		inviteRepositoryMock := inviteRepository.NewInviteRepositoryMock(&mock.Mock{})
 		participantRepositoryMock.Mock.On("Delete").Return(web.WebError{})
 		Service := participantService.NewParticipantService(
 			participantRepositoryMock,
 			userRepositoryMock,
 			eventRepositoryMock,
+			inviteRepositoryMock,
+			emptyQuestionRepository(),
 		)
 		err := Service.Delete(int(userSample.ID), int(eventSample.ID))
 		assert.Error(t, err)
@@ -145,13 +788,311 @@ func TestDelete(t *testing.T) {
 		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
 		eventRepositoryMock.Mock.On("Find").Return(entities.Event{}, web.WebError{})
 		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		inviteRepositoryMock := inviteRepository.NewInviteRepositoryMock(&mock.Mock{})
 		participantRepositoryMock.Mock.On("Delete").Return(web.WebError{})
 		Service := participantService.NewParticipantService(
 			participantRepositoryMock,
 			userRepositoryMock,
 			eventRepositoryMock,
+			inviteRepositoryMock,
+			emptyQuestionRepository(),
 		)
 		err := Service.Delete(int(userSample.ID), int(eventSample.ID))
 		assert.Error(t, err)
 	})
 }
+
+func TestTicket(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		participantSample := participantRepository.ParticipantCollection[1]
+		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		participantRepositoryMock.Mock.On("FindByEventAndUser").Return(participantSample, nil)
+		Service := participantService.NewParticipantService(
+			participantRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			emptyQuestionRepository(),
+		)
+		data, err := Service.Ticket(int(participantSample.EventID), int(participantSample.UserID))
+		assert.Nil(t, err)
+		assert.Equal(t, participantService.TicketCode(participantSample), data.Code)
+		assert.Contains(t, data.QRCodeSVG, "format=svg")
+	})
+}
+
+func TestCheckIn(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		participantSample := participantRepository.ParticipantCollection[1]
+		eventSample := eventRepository.EventCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		participantRepositoryMock.Mock.On("FindByEventAndUser").Return(participantSample, nil)
+		participantRepositoryMock.Mock.On("CheckIn").Return(nil)
+		Service := participantService.NewParticipantService(
+			participantRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			eventRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			emptyQuestionRepository(),
+		)
+		data, err := Service.CheckIn(participantService.TicketCode(participantSample), int(eventSample.ID), int(eventSample.UserID))
+		assert.Nil(t, err)
+		assert.NotNil(t, data.CheckedInAt)
+	})
+	t.Run("already-checked-in", func(t *testing.T) {
+		participantSample := participantRepository.ParticipantCollection[1]
+		checkedInAt := time.Now()
+		participantSample.CheckedInAt = &checkedInAt
+		eventSample := eventRepository.EventCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		participantRepositoryMock.Mock.On("FindByEventAndUser").Return(participantSample, nil)
+		Service := participantService.NewParticipantService(
+			participantRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			eventRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			emptyQuestionRepository(),
+		)
+		_, err := Service.CheckIn(participantService.TicketCode(participantSample), int(eventSample.ID), int(eventSample.UserID))
+		assert.Equal(t, 409, err.(web.WebError).Code)
+		participantRepositoryMock.Mock.AssertNotCalled(t, "CheckIn")
+	})
+	t.Run("not-going", func(t *testing.T) {
+		participantSample := participantRepository.ParticipantCollection[1]
+		participantSample.Status = "maybe"
+		eventSample := eventRepository.EventCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		participantRepositoryMock.Mock.On("FindByEventAndUser").Return(participantSample, nil)
+		Service := participantService.NewParticipantService(
+			participantRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			eventRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			emptyQuestionRepository(),
+		)
+		_, err := Service.CheckIn(participantService.TicketCode(participantSample), int(eventSample.ID), int(eventSample.UserID))
+		assert.Equal(t, web.WebError{Code: 400, Message: "Only participants who are going can be checked in"}, err)
+		participantRepositoryMock.Mock.AssertNotCalled(t, "CheckIn")
+	})
+	t.Run("order-ticket", func(t *testing.T) {
+		participantSample := participantRepository.ParticipantCollection[1]
+		eventSample := eventRepository.EventCollection[0]
+		eventSample.TicketTypes = []entities.TicketType{{EventID: eventSample.ID}}
+		ticket := entities.Ticket{OrderID: 1, EventID: eventSample.ID, UserID: participantSample.UserID, Code: "abc123", Status: "valid"}
+		ticket.ID = 7
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		participantRepositoryMock.Mock.On("FindTicketByCode", "abc123").Return(ticket, nil)
+		participantRepositoryMock.Mock.On("FindByEventAndUser").Return(participantSample, nil)
+		participantRepositoryMock.Mock.On("CheckInTicket", uint(7)).Return(nil)
+		Service := participantService.NewParticipantService(
+			participantRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			eventRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			emptyQuestionRepository(),
+		)
+		data, err := Service.CheckIn("abc123", int(eventSample.ID), int(eventSample.UserID))
+		assert.Nil(t, err)
+		assert.NotNil(t, data.CheckedInAt)
+		participantRepositoryMock.Mock.AssertCalled(t, "CheckInTicket", uint(7))
+		participantRepositoryMock.Mock.AssertNotCalled(t, "CheckIn")
+	})
+	t.Run("refunded-order-ticket", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventSample.TicketTypes = []entities.TicketType{{EventID: eventSample.ID}}
+		ticket := entities.Ticket{OrderID: 1, EventID: eventSample.ID, UserID: 2, Code: "abc123", Status: "refunded"}
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		participantRepositoryMock.Mock.On("FindTicketByCode", "abc123").Return(ticket, nil)
+		Service := participantService.NewParticipantService(
+			participantRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			eventRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			emptyQuestionRepository(),
+		)
+		_, err := Service.CheckIn("abc123", int(eventSample.ID), int(eventSample.UserID))
+		assert.Equal(t, web.WebError{Code: 400, Message: "This ticket is no longer valid"}, err)
+		participantRepositoryMock.Mock.AssertNotCalled(t, "CheckInTicket", mock.Anything)
+	})
+	t.Run("forged-code", func(t *testing.T) {
+		participantSample := participantRepository.ParticipantCollection[1]
+		eventSample := eventRepository.EventCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		participantRepositoryMock.Mock.On("FindByEventAndUser").Return(participantSample, nil)
+		Service := participantService.NewParticipantService(
+			participantRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			eventRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			emptyQuestionRepository(),
+		)
+		_, err := Service.CheckIn("1.2.00000000000000000000000000000000", int(eventSample.ID), int(eventSample.UserID))
+		assert.Equal(t, web.WebError{Code: 400, Message: "Ticket code is invalid"}, err)
+		participantRepositoryMock.Mock.AssertNotCalled(t, "CheckIn")
+	})
+	t.Run("other-event", func(t *testing.T) {
+		participantSample := participantRepository.ParticipantCollection[1]
+		participantSample.EventID = 2
+		eventSample := eventRepository.EventCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		Service := participantService.NewParticipantService(
+			participantRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			eventRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			emptyQuestionRepository(),
+		)
+		_, err := Service.CheckIn(participantService.TicketCode(participantSample), int(eventSample.ID), int(eventSample.UserID))
+		assert.Equal(t, web.WebError{Code: 400, Message: "This ticket belongs to another event"}, err)
+	})
+	t.Run("not-host", func(t *testing.T) {
+		participantSample := participantRepository.ParticipantCollection[1]
+		eventSample := eventRepository.EventCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		Service := participantService.NewParticipantService(
+			participantRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			eventRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			emptyQuestionRepository(),
+		)
+		_, err := Service.CheckIn(participantService.TicketCode(participantSample), int(eventSample.ID), 2)
+		assert.Error(t, err)
+		participantRepositoryMock.Mock.AssertNotCalled(t, "FindByEventAndUser")
+	})
+}
+
+func TestAttendance(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		participantRepositoryMock.Mock.On("CountAttendance").Return(int64(4), int64(1), nil)
+		Service := participantService.NewParticipantService(
+			participantRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			eventRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			emptyQuestionRepository(),
+		)
+		data, err := Service.Attendance(int(eventSample.ID), int(eventSample.UserID))
+		assert.Nil(t, err)
+		assert.Equal(t, int64(3), data.NotCheckedIn)
+		assert.Equal(t, 0.25, data.Rate)
+	})
+}
+
+func TestExport(t *testing.T) {
+	checkedInAt := time.Date(2022, 5, 1, 9, 30, 0, 0, time.UTC)
+	hiddenUser := userRepository.UserCollection[1]
+	hiddenUser.HideEmail = true
+	batches := [][]entities.Participant{
+		{
+			{ID: 1, EventID: 1, UserID: 1, Status: "going", CreatedAt: time.Date(2022, 4, 1, 10, 0, 0, 0, time.UTC), CheckedInAt: &checkedInAt, User: userRepository.UserCollection[0]},
+		},
+		{
+			{ID: 2, EventID: 1, UserID: 2, Status: "maybe", CreatedAt: time.Date(2022, 4, 2, 10, 0, 0, 0, time.UTC), User: hiddenUser},
+		},
+	}
+	answers := []entities.EventAnswer{
+		{EventID: 1, QuestionID: 1, UserID: 1, Value: `["Vegan"]`},
+		{EventID: 1, QuestionID: 2, UserID: 1, Value: `["S","M"]`},
+	}
+	newService := func(eventSample entities.Event) (*participantService.ParticipantService, *participantRepository.ParticipantRepositoryMock) {
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("FindWithTeam").Return(eventSample, nil)
+		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		participantRepositoryMock.Mock.On("FindInBatches").Return(batches, nil)
+		questionRepositoryMock := questionRepository.NewQuestionRepositoryMock(&mock.Mock{})
+		questionRepositoryMock.Mock.On("FindByEvent").Return(questionRepository.EventQuestionCollection[:2], nil)
+		questionRepositoryMock.Mock.On("FindAnswersByUsers").Return(answers, nil)
+		service := participantService.NewParticipantService(
+			participantRepositoryMock,
+			userRepository.NewUserRepositoryMock(&mock.Mock{}),
+			eventRepositoryMock,
+			inviteRepository.NewInviteRepositoryMock(&mock.Mock{}),
+			questionRepositoryMock,
+		)
+		return service, participantRepositoryMock
+	}
+
+	t.Run("csv", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		service, _ := newService(eventSample)
+		buffer := bytes.Buffer{}
+		filename := ""
+		err := service.Export(int(eventSample.ID), int(eventSample.UserID), "csv", func(name string, contentType string) io.Writer {
+			filename = name
+			return &buffer
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, "event-1-participants.csv", filename)
+		lines := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+		assert.Equal(t, 3, len(lines))
+		assert.Equal(t, "Name,Email,Joined At,RSVP,Checked In At,Dietary preference,T-shirt size", lines[0])
+		assert.Equal(t, userRepository.UserCollection[0].Name+","+userRepository.UserCollection[0].Email+",2022-04-01 10:00:00,going,2022-05-01 09:30:00,Vegan,\"S, M\"", lines[1])
+		assert.Equal(t, hiddenUser.Name+",,2022-04-02 10:00:00,maybe,,,", lines[2])
+	})
+	t.Run("xlsx", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		service, _ := newService(eventSample)
+		buffer := bytes.Buffer{}
+		err := service.Export(int(eventSample.ID), int(eventSample.UserID), "xlsx", func(name string, contentType string) io.Writer {
+			return &buffer
+		})
+		assert.Nil(t, err)
+
+		archive, err := zip.NewReader(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+		assert.Nil(t, err)
+		sheet := ""
+		for _, file := range archive.File {
+			if file.Name == "xl/worksheets/sheet1.xml" {
+				reader, _ := file.Open()
+				content, _ := ioutil.ReadAll(reader)
+				sheet = string(content)
+			}
+		}
+		assert.Equal(t, 3, strings.Count(sheet, "<row "))
+		assert.Contains(t, sheet, `<c r="G2" t="inlineStr"><is><t xml:space="preserve">S, M</t></is></c>`)
+	})
+	t.Run("not-host", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		service, participantRepositoryMock := newService(eventSample)
+		opened := false
+		err := service.Export(int(eventSample.ID), int(eventSample.UserID)+1, "csv", func(name string, contentType string) io.Writer {
+			opened = true
+			return &bytes.Buffer{}
+		})
+
+		assert.Equal(t, web.WebError{Code: 401, Message: "Only the host can export participants"}, err)
+		assert.False(t, opened)
+		participantRepositoryMock.Mock.AssertNotCalled(t, "FindInBatches")
+	})
+	t.Run("invalid-format", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		service, _ := newService(eventSample)
+		err := service.Export(int(eventSample.ID), int(eventSample.UserID), "pdf", func(name string, contentType string) io.Writer {
+			return &bytes.Buffer{}
+		})
+
+		assert.Equal(t, web.WebError{Code: 400, Message: "format must be csv or xlsx"}, err)
+	})
+}
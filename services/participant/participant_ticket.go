@@ -0,0 +1,193 @@
+package participant
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+	"tupulung/config"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	cohostService "tupulung/services/cohost"
+
+	"github.com/jinzhu/copier"
+)
+
+/*
+ * Ticket Code
+ * -------------------------------
+ * Kode tiket participant dengan format {eventID}.{userID}.{signature}.
+ * Signature juga mencakup ID participant sehingga kode lama
+ * tidak berlaku lagi setelah user keluar lalu join ulang
+ */
+func TicketCode(participant entities.Participant) string {
+	return strconv.Itoa(int(participant.EventID)) + "." + strconv.Itoa(int(participant.UserID)) + "." + signTicket(participant)
+}
+
+func signTicket(participant entities.Participant) string {
+	mac := hmac.New(sha256.New, []byte(config.Get().Ticket.Secret))
+	mac.Write([]byte(strconv.Itoa(int(participant.ID)) + ":" + strconv.Itoa(int(participant.EventID)) + ":" + strconv.Itoa(int(participant.UserID))))
+	return hex.EncodeToString(mac.Sum(nil)[:16])
+}
+
+func parseTicketCode(code string) (int, int, string, bool) {
+	parts := strings.Split(strings.TrimSpace(code), ".")
+	if len(parts) != 3 {
+		return 0, 0, "", false
+	}
+	eventID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, "", false
+	}
+	userID, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, "", false
+	}
+	return eventID, userID, parts[2], true
+}
+
+/*
+ * Ticket
+ * -------------------------------
+ * Mengambil kode tiket milik participant yang sedang login
+ */
+func (service ParticipantService) Ticket(eventID, userID int) (entities.ParticipantTicketResponse, error) {
+	participant, err := service.participantRepo.FindByEventAndUser(eventID, userID)
+	if err != nil {
+		return entities.ParticipantTicketResponse{}, err
+	}
+
+	qrLink := config.Get().App.BaseURL + "/api/events/" + strconv.Itoa(eventID) + "/ticket/qr"
+	return entities.ParticipantTicketResponse{
+		EventID:     participant.EventID,
+		UserID:      participant.UserID,
+		Code:        TicketCode(participant),
+		CheckedInAt: participant.CheckedInAt,
+		QRCodePNG:   qrLink + "?format=png",
+		QRCodeSVG:   qrLink + "?format=svg",
+	}, nil
+}
+
+/*
+ * Check In
+ * -------------------------------
+ * Memvalidasi kode tiket dan mencatat waktu kehadiran participant,
+ * hanya host dan co-host moderator yang dapat melakukan check-in.
+ * Event bertiket memakai kode tiket order sehingga pembeli beberapa
+ * tiket dapat memasukkan setiap pemegang tiketnya. Participant yang
+ * tidak berstatus going tidak dapat check-in
+ */
+func (service ParticipantService) CheckIn(code string, eventID, userID int) (entities.ParticipantResponse, error) {
+	event, err := service.eventRepo.Find(eventID)
+	if err != nil {
+		return entities.ParticipantResponse{}, web.WebError{Code: 400, Message: "Event is not exist"}
+	}
+	if !cohostService.Can(event, userID, cohostService.PermissionModerate) {
+		return entities.ParticipantResponse{}, web.WebError{Code: 401, Message: "Only the host can check in participants"}
+	}
+
+	if len(event.TicketTypes) > 0 {
+		return service.checkInOrderTicket(code, event, userID)
+	}
+
+	codeEventID, codeUserID, signature, ok := parseTicketCode(code)
+	if !ok {
+		return entities.ParticipantResponse{}, web.WebError{Code: 400, Message: "Ticket code is invalid"}
+	}
+	if codeEventID != eventID {
+		return entities.ParticipantResponse{}, web.WebError{Code: 400, Message: "This ticket belongs to another event"}
+	}
+	participant, err := service.participantRepo.FindByEventAndUser(eventID, codeUserID)
+	if err != nil || !hmac.Equal([]byte(signature), []byte(signTicket(participant))) {
+		return entities.ParticipantResponse{}, web.WebError{Code: 400, Message: "Ticket code is invalid"}
+	}
+	if participant.Status == RSVPMaybe || participant.Status == RSVPNotGoing {
+		return entities.ParticipantResponse{}, web.WebError{Code: 400, Message: "Only participants who are going can be checked in"}
+	}
+	if participant.CheckedInAt != nil {
+		return entities.ParticipantResponse{}, web.WebError{Code: 409, Message: "This ticket has already been checked in at " + participant.CheckedInAt.Format("2006-01-02 15:04")}
+	}
+
+	// Repository action
+	now := time.Now()
+	err = service.participantRepo.CheckIn(int(participant.ID), userID, now)
+	if err != nil {
+		return entities.ParticipantResponse{}, err
+	}
+	participant.CheckedInAt = &now
+
+	participantRes := entities.ParticipantResponse{}
+	copier.Copy(&participantRes, &participant)
+	participantRes.JoinedAt = participant.CreatedAt
+	return participantRes, nil
+}
+
+func (service ParticipantService) checkInOrderTicket(code string, event entities.Event, userID int) (entities.ParticipantResponse, error) {
+	ticket, err := service.participantRepo.FindTicketByCode(strings.TrimSpace(code))
+	if err != nil {
+		return entities.ParticipantResponse{}, err
+	}
+	if ticket.EventID != event.ID {
+		return entities.ParticipantResponse{}, web.WebError{Code: 400, Message: "This ticket belongs to another event"}
+	}
+	if ticket.Status != "valid" {
+		return entities.ParticipantResponse{}, web.WebError{Code: 400, Message: "This ticket is no longer valid"}
+	}
+	if ticket.CheckedInAt != nil {
+		return entities.ParticipantResponse{}, web.WebError{Code: 409, Message: "This ticket has already been checked in at " + ticket.CheckedInAt.Format("2006-01-02 15:04")}
+	}
+	participant, err := service.participantRepo.FindByEventAndUser(int(event.ID), int(ticket.UserID))
+	if err != nil {
+		return entities.ParticipantResponse{}, web.WebError{Code: 400, Message: "Ticket code is invalid"}
+	}
+	if participant.Status == RSVPMaybe || participant.Status == RSVPNotGoing {
+		return entities.ParticipantResponse{}, web.WebError{Code: 400, Message: "Only participants who are going can be checked in"}
+	}
+
+	// Repository action
+	now := time.Now()
+	err = service.participantRepo.CheckInTicket(ticket.ID, participant.ID, userID, now)
+	if err != nil {
+		return entities.ParticipantResponse{}, err
+	}
+	if participant.CheckedInAt == nil {
+		participant.CheckedInAt = &now
+	}
+
+	participantRes := entities.ParticipantResponse{}
+	copier.Copy(&participantRes, &participant)
+	participantRes.JoinedAt = participant.CreatedAt
+	return participantRes, nil
+}
+
+/*
+ * Attendance
+ * -------------------------------
+ * Ringkasan jumlah participant going dan yang sudah hadir
+ */
+func (service ParticipantService) Attendance(eventID, userID int) (entities.AttendanceResponse, error) {
+	event, err := service.eventRepo.Find(eventID)
+	if err != nil {
+		return entities.AttendanceResponse{}, web.WebError{Code: 400, Message: "Event is not exist"}
+	}
+	if !cohostService.Can(event, userID, cohostService.PermissionModerate) {
+		return entities.AttendanceResponse{}, web.WebError{Code: 401, Message: "Only the host can see the attendance"}
+	}
+
+	joined, checkedIn, err := service.participantRepo.CountAttendance(eventID)
+	if err != nil {
+		return entities.AttendanceResponse{}, err
+	}
+	attendance := entities.AttendanceResponse{
+		EventID:      event.ID,
+		Joined:       joined,
+		CheckedIn:    checkedIn,
+		NotCheckedIn: joined - checkedIn,
+	}
+	if joined > 0 {
+		attendance.Rate = float64(checkedIn) / float64(joined)
+	}
+	return attendance, nil
+}
@@ -0,0 +1,50 @@
+package participant
+
+import (
+	"tupulung/entities"
+	inviteRepository "tupulung/repositories/invite"
+	cohostService "tupulung/services/cohost"
+)
+
+/*
+ * Can View
+ * -------------------------------
+ * Aturan akses detail event, dipakai juga oleh agenda, pengumuman,
+ * galeri, riwayat perubahan dan review. Tim host selalu bisa melihat,
+ * event yang disembunyikan moderator hanya untuk tim host, dan event
+ * private hanya untuk participant, user yang diundang, atau pemilik
+ * kode invite yang masih berlaku. Event harus di-load beserta
+ * Cohosts dan RSVPs
+ */
+func CanView(inviteRepo inviteRepository.InviteRepositoryInterface, event entities.Event, viewerID int, inviteCode string) bool {
+	if cohostService.IsTeamMember(event, viewerID) {
+		return true
+	}
+	if event.HiddenAt != nil {
+		return false
+	}
+	if event.Visibility != "private" || HasJoined(event, viewerID) {
+		return true
+	}
+	if inviteCode != "" {
+		if _, err := inviteRepo.FindUsableByCode(int(event.ID), inviteCode); err == nil {
+			return true
+		}
+	}
+	if viewerID == 0 {
+		return false
+	}
+	_, err := inviteRepo.FindUsableByUser(int(event.ID), viewerID)
+	return err == nil
+}
+
+/*
+ * Can View Details
+ * -------------------------------
+ * Link meeting, dial-in dan alamat yang disembunyikan hanya
+ * untuk tim host dan user yang join event dengan status going
+ * atau maybe. Event harus di-load beserta Cohosts dan RSVPs
+ */
+func CanViewDetails(event entities.Event, userID int) bool {
+	return cohostService.IsTeamMember(event, userID) || HasJoined(event, userID)
+}
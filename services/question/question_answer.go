@@ -0,0 +1,170 @@
+package question
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"tupulung/entities"
+	"tupulung/entities/web"
+)
+
+const (
+	TypeText         = "text"
+	TypeSingleChoice = "single_choice"
+	TypeMultiChoice  = "multi_choice"
+
+	maxAnswerLength = 1000
+)
+
+/*
+ * To Question Response
+ * -------------------------------
+ * Mengubah pertanyaan menjadi response, opsi disimpan sebagai JSON
+ */
+func ToQuestionResponse(question entities.EventQuestion) entities.EventQuestionResponse {
+	return entities.EventQuestionResponse{
+		ID:       question.ID,
+		EventID:  question.EventID,
+		Label:    question.Label,
+		Type:     question.Type,
+		Options:  QuestionOptions(question),
+		Required: question.Required,
+		Position: question.Position,
+	}
+}
+
+/*
+ * Question Options
+ * -------------------------------
+ * Membaca daftar opsi pertanyaan dari JSON yang tersimpan
+ */
+func QuestionOptions(question entities.EventQuestion) []string {
+	return decodeValues(question.Options)
+}
+
+/*
+ * Answer Values
+ * -------------------------------
+ * Membaca jawaban dari JSON yang tersimpan, jawaban text dan
+ * single choice selalu berisi satu value
+ */
+func AnswerValues(answer entities.EventAnswer) []string {
+	return decodeValues(answer.Value)
+}
+
+/*
+ * Validate Answers
+ * -------------------------------
+ * Mencocokkan jawaban dengan kuesioner event. Jawaban harus milik
+ * pertanyaan event ini, sesuai tipe dan opsinya, dan pertanyaan
+ * required wajib dijawab jika required bernilai true.
+ * Mengembalikan jawaban yang siap disimpan tanpa event dan user
+ */
+func ValidateAnswers(questions []entities.EventQuestion, answersReq []entities.EventAnswerRequest, required bool) ([]entities.EventAnswer, error) {
+	errors := []web.ValidationErrorItem{}
+	answered := map[uint][]string{}
+	invalid := map[uint]bool{}
+
+	questionsByID := map[uint]entities.EventQuestion{}
+	for _, question := range questions {
+		questionsByID[question.ID] = question
+	}
+
+	for _, answerReq := range answersReq {
+		field := "answers." + strconv.Itoa(int(answerReq.QuestionID))
+		question, ok := questionsByID[answerReq.QuestionID]
+		if !ok {
+			errors = append(errors, web.ValidationErrorItem{Field: field, Error: "question is not part of this event"})
+			continue
+		}
+		if _, ok := answered[question.ID]; ok {
+			errors = append(errors, web.ValidationErrorItem{Field: field, Error: "question is answered more than once"})
+			continue
+		}
+		values, message := normalizeAnswer(question, answerReq)
+		if message != "" {
+			invalid[question.ID] = true
+			errors = append(errors, web.ValidationErrorItem{Field: field, Error: message})
+			continue
+		}
+		answered[question.ID] = values
+	}
+
+	answers := []entities.EventAnswer{}
+	for _, question := range questions {
+		values := answered[question.ID]
+		if len(values) == 0 {
+			if required && question.Required && !invalid[question.ID] {
+				errors = append(errors, web.ValidationErrorItem{
+					Field: "answers." + strconv.Itoa(int(question.ID)),
+					Error: question.Label + " must be answered",
+				})
+			}
+			continue
+		}
+		answers = append(answers, entities.EventAnswer{QuestionID: question.ID, Value: encodeValues(values)})
+	}
+
+	if len(errors) > 0 {
+		return []entities.EventAnswer{}, web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors:  errors,
+		}
+	}
+	return answers, nil
+}
+
+func normalizeAnswer(question entities.EventQuestion, answerReq entities.EventAnswerRequest) ([]string, string) {
+	values := []string{}
+	if strings.TrimSpace(answerReq.Value) != "" {
+		values = append(values, strings.TrimSpace(answerReq.Value))
+	}
+	for _, value := range answerReq.Values {
+		if strings.TrimSpace(value) != "" {
+			values = append(values, strings.TrimSpace(value))
+		}
+	}
+
+	switch question.Type {
+	case TypeText:
+		if len(values) > 1 {
+			return nil, "text question accepts a single answer"
+		}
+		if len(values) == 1 && len(values[0]) > maxAnswerLength {
+			return nil, "answer cannot be more than " + strconv.Itoa(maxAnswerLength) + " characters"
+		}
+		return values, ""
+	case TypeSingleChoice:
+		if len(values) > 1 {
+			return nil, "only one option can be chosen"
+		}
+	}
+
+	options := map[string]bool{}
+	for _, option := range QuestionOptions(question) {
+		options[option] = true
+	}
+	chosen := map[string]bool{}
+	for _, value := range values {
+		if !options[value] {
+			return nil, value + " is not a valid option"
+		}
+		if chosen[value] {
+			return nil, value + " is chosen more than once"
+		}
+		chosen[value] = true
+	}
+	return values, ""
+}
+
+func encodeValues(values []string) string {
+	encoded, _ := json.Marshal(values)
+	return string(encoded)
+}
+
+func decodeValues(values string) []string {
+	decoded := []string{}
+	json.Unmarshal([]byte(values), &decoded)
+	return decoded
+}
@@ -0,0 +1,249 @@
+package question
+
+import (
+	"strings"
+	"tupulung/deliveries/validations"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	eventRepository "tupulung/repositories/event"
+	questionRepository "tupulung/repositories/question"
+	cohostService "tupulung/services/cohost"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jinzhu/copier"
+)
+
+type QuestionService struct {
+	questionRepo questionRepository.QuestionRepositoryInterface
+	eventRepo    eventRepository.EventRepositoryInterface
+	validate     *validator.Validate
+}
+
+func NewQuestionService(questionRepo questionRepository.QuestionRepositoryInterface, eventRepo eventRepository.EventRepositoryInterface) *QuestionService {
+	return &QuestionService{
+		questionRepo: questionRepo,
+		eventRepo:    eventRepo,
+		validate:     validator.New(),
+	}
+}
+
+/*
+ * Find All
+ * -------------------------------
+ * Mengambil kuesioner registrasi event agar bisa diisi saat join
+ */
+func (service QuestionService) FindAll(eventID int) ([]entities.EventQuestionResponse, error) {
+	_, err := service.eventRepo.Find(eventID)
+	if err != nil {
+		return []entities.EventQuestionResponse{}, web.WebError{Code: 400, Message: "Event is not exist"}
+	}
+	questions, err := service.questionRepo.FindByEvent(eventID)
+	if err != nil {
+		return []entities.EventQuestionResponse{}, err
+	}
+	questionsRes := []entities.EventQuestionResponse{}
+	for _, question := range questions {
+		questionsRes = append(questionsRes, ToQuestionResponse(question))
+	}
+	return questionsRes, nil
+}
+
+/*
+ * Create
+ * -------------------------------
+ * Menambahkan pertanyaan registrasi,
+ * hanya host dan co-host editor yang dapat melakukannya
+ */
+func (service QuestionService) Create(questionRequest entities.EventQuestionRequest, eventID int, userID int) (entities.EventQuestionResponse, error) {
+	event, err := service.eventRepo.Find(eventID)
+	if err != nil {
+		return entities.EventQuestionResponse{}, web.WebError{Code: 400, Message: "Event is not exist"}
+	}
+	if !cohostService.Can(event, userID, cohostService.PermissionUpdate) {
+		return entities.EventQuestionResponse{}, web.WebError{Code: 401, Message: "Only the host can manage registration questions"}
+	}
+	question, err := service.fillQuestion(questionRequest, entities.EventQuestion{EventID: event.ID})
+	if err != nil {
+		return entities.EventQuestionResponse{}, err
+	}
+
+	question, err = service.questionRepo.Store(question)
+	if err != nil {
+		return entities.EventQuestionResponse{}, err
+	}
+	return ToQuestionResponse(question), nil
+}
+
+/*
+ * Update
+ * -------------------------------
+ * Mengganti isi pertanyaan registrasi, jawaban yang sudah ada tetap disimpan
+ */
+func (service QuestionService) Update(questionRequest entities.EventQuestionRequest, id int, userID int) (entities.EventQuestionResponse, error) {
+	question, err := service.findManaged(id, userID)
+	if err != nil {
+		return entities.EventQuestionResponse{}, err
+	}
+	question, err = service.fillQuestion(questionRequest, question)
+	if err != nil {
+		return entities.EventQuestionResponse{}, err
+	}
+
+	question, err = service.questionRepo.Update(question)
+	if err != nil {
+		return entities.EventQuestionResponse{}, err
+	}
+	return ToQuestionResponse(question), nil
+}
+
+/*
+ * Delete
+ * -------------------------------
+ * Menghapus pertanyaan registrasi beserta jawabannya
+ */
+func (service QuestionService) Delete(id int, userID int) error {
+	_, err := service.findManaged(id, userID)
+	if err != nil {
+		return err
+	}
+	return service.questionRepo.Delete(id)
+}
+
+/*
+ * Answers
+ * -------------------------------
+ * Mengambil jawaban registrasi per participant,
+ * participantUserID 0 berarti semua participant.
+ * Hanya host dan co-host moderator yang dapat melihat
+ */
+func (service QuestionService) Answers(eventID int, userID int, participantUserID int) ([]entities.ParticipantAnswersResponse, error) {
+	questions, answers, err := service.findAnswers(eventID, userID, participantUserID)
+	if err != nil {
+		return []entities.ParticipantAnswersResponse{}, err
+	}
+
+	questionsByID := map[uint]entities.EventQuestion{}
+	for _, question := range questions {
+		questionsByID[question.ID] = question
+	}
+
+	participantsRes := []entities.ParticipantAnswersResponse{}
+	for _, answer := range answers {
+		question, ok := questionsByID[answer.QuestionID]
+		if !ok {
+			continue
+		}
+		if len(participantsRes) == 0 || participantsRes[len(participantsRes)-1].UserID != answer.UserID {
+			participantRes := entities.ParticipantAnswersResponse{UserID: answer.UserID, Answers: []entities.EventAnswerResponse{}}
+			copier.Copy(&participantRes.User, &answer.User)
+			participantsRes = append(participantsRes, participantRes)
+		}
+		last := &participantsRes[len(participantsRes)-1]
+		last.Answers = append(last.Answers, entities.EventAnswerResponse{
+			QuestionID: question.ID,
+			Label:      question.Label,
+			Type:       question.Type,
+			Values:     AnswerValues(answer),
+		})
+	}
+	return participantsRes, nil
+}
+
+/*
+ * Summary
+ * -------------------------------
+ * Merangkum jawaban registrasi setiap pertanyaan, pertanyaan pilihan
+ * dihitung per opsi sedangkan pertanyaan text ditampilkan apa adanya.
+ * Hanya host dan co-host moderator yang dapat melihat
+ */
+func (service QuestionService) Summary(eventID int, userID int) ([]entities.EventQuestionSummaryResponse, error) {
+	questions, answers, err := service.findAnswers(eventID, userID, 0)
+	if err != nil {
+		return []entities.EventQuestionSummaryResponse{}, err
+	}
+
+	summaries := []entities.EventQuestionSummaryResponse{}
+	indexByID := map[uint]int{}
+	for i, question := range questions {
+		summary := entities.EventQuestionSummaryResponse{QuestionID: question.ID, Label: question.Label, Type: question.Type}
+		if question.Type == TypeText {
+			summary.Answers = []string{}
+		} else {
+			summary.Options = map[string]int{}
+			for _, option := range QuestionOptions(question) {
+				summary.Options[option] = 0
+			}
+		}
+		summaries = append(summaries, summary)
+		indexByID[question.ID] = i
+	}
+
+	for _, answer := range answers {
+		i, ok := indexByID[answer.QuestionID]
+		if !ok {
+			continue
+		}
+		summaries[i].Responses++
+		for _, value := range AnswerValues(answer) {
+			if summaries[i].Type == TypeText {
+				summaries[i].Answers = append(summaries[i].Answers, value)
+				continue
+			}
+			// Opsi yang sudah dihapus dari pertanyaan tetap dihitung
+			summaries[i].Options[value]++
+		}
+	}
+	return summaries, nil
+}
+
+func (service QuestionService) findAnswers(eventID int, userID int, participantUserID int) ([]entities.EventQuestion, []entities.EventAnswer, error) {
+	event, err := service.eventRepo.Find(eventID)
+	if err != nil {
+		return nil, nil, web.WebError{Code: 400, Message: "Event is not exist"}
+	}
+	if !cohostService.Can(event, userID, cohostService.PermissionModerate) {
+		return nil, nil, web.WebError{Code: 401, Message: "Only the host can see registration answers"}
+	}
+	questions, err := service.questionRepo.FindByEvent(eventID)
+	if err != nil {
+		return nil, nil, err
+	}
+	answers, err := service.questionRepo.FindAnswers(eventID, participantUserID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return questions, answers, nil
+}
+
+func (service QuestionService) findManaged(id int, userID int) (entities.EventQuestion, error) {
+	question, err := service.questionRepo.Find(id)
+	if err != nil {
+		return entities.EventQuestion{}, err
+	}
+	event, err := service.eventRepo.Find(int(question.EventID))
+	if err != nil {
+		return entities.EventQuestion{}, web.WebError{Code: 400, Message: "Event is not exist"}
+	}
+	if !cohostService.Can(event, userID, cohostService.PermissionUpdate) {
+		return entities.EventQuestion{}, web.WebError{Code: 401, Message: "Only the host can manage registration questions"}
+	}
+	return question, nil
+}
+
+func (service QuestionService) fillQuestion(questionRequest entities.EventQuestionRequest, question entities.EventQuestion) (entities.EventQuestion, error) {
+	err := validations.ValidateEventQuestionRequest(service.validate, questionRequest)
+	if err != nil {
+		return entities.EventQuestion{}, err
+	}
+	options := []string{}
+	for _, option := range questionRequest.Options {
+		options = append(options, strings.TrimSpace(option))
+	}
+
+	question.Label = strings.TrimSpace(questionRequest.Label)
+	question.Type = questionRequest.Type
+	question.Options = encodeValues(options)
+	question.Required = questionRequest.Required
+	question.Position = questionRequest.Position
+	return question, nil
+}
@@ -0,0 +1,12 @@
+package question
+
+import "tupulung/entities"
+
+type QuestionServiceInterface interface {
+	FindAll(eventID int) ([]entities.EventQuestionResponse, error)
+	Create(questionRequest entities.EventQuestionRequest, eventID int, userID int) (entities.EventQuestionResponse, error)
+	Update(questionRequest entities.EventQuestionRequest, id int, userID int) (entities.EventQuestionResponse, error)
+	Delete(id int, userID int) error
+	Answers(eventID int, userID int, participantUserID int) ([]entities.ParticipantAnswersResponse, error)
+	Summary(eventID int, userID int) ([]entities.EventQuestionSummaryResponse, error)
+}
@@ -0,0 +1,186 @@
+package question_test
+
+import (
+	"testing"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	eventRepository "tupulung/repositories/event"
+	questionRepository "tupulung/repositories/question"
+	userRepository "tupulung/repositories/user"
+	questionService "tupulung/services/question"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFindAll(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventRepository.EventCollection[0], nil)
+		questionRepositoryMock := questionRepository.NewQuestionRepositoryMock(&mock.Mock{})
+		questionRepositoryMock.Mock.On("FindByEvent").Return(questionRepository.EventQuestionCollection, nil)
+
+		service := questionService.NewQuestionService(questionRepositoryMock, eventRepositoryMock)
+		data, err := service.FindAll(1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 3, len(data))
+		assert.Equal(t, []string{"None", "Vegetarian", "Vegan"}, data[0].Options)
+		assert.Equal(t, []string{}, data[2].Options)
+	})
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		questionRepositoryMock := questionRepository.NewQuestionRepositoryMock(&mock.Mock{})
+		questionRepositoryMock.Mock.On("Store").Return(questionRepository.EventQuestionCollection[0], nil)
+
+		service := questionService.NewQuestionService(questionRepositoryMock, eventRepositoryMock)
+		data, err := service.Create(entities.EventQuestionRequest{
+			Label:    "Dietary preference",
+			Type:     "single_choice",
+			Options:  []string{"None", "Vegetarian", "Vegan"},
+			Required: true,
+		}, int(eventSample.ID), int(eventSample.UserID))
+
+		assert.Nil(t, err)
+		assert.Equal(t, "Dietary preference", data.Label)
+	})
+	t.Run("not-host", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		questionRepositoryMock := questionRepository.NewQuestionRepositoryMock(&mock.Mock{})
+
+		service := questionService.NewQuestionService(questionRepositoryMock, eventRepositoryMock)
+		_, err := service.Create(entities.EventQuestionRequest{Label: "Notes", Type: "text"}, int(eventSample.ID), int(eventSample.UserID)+1)
+
+		assert.Equal(t, web.WebError{Code: 401, Message: "Only the host can manage registration questions"}, err)
+		questionRepositoryMock.Mock.AssertNotCalled(t, "Store")
+	})
+	t.Run("choice-without-options", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		questionRepositoryMock := questionRepository.NewQuestionRepositoryMock(&mock.Mock{})
+
+		service := questionService.NewQuestionService(questionRepositoryMock, eventRepositoryMock)
+		_, err := service.Create(entities.EventQuestionRequest{Label: "T-shirt size", Type: "multi_choice", Options: []string{"M"}}, int(eventSample.ID), int(eventSample.UserID))
+
+		assert.Equal(t, web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors:  []web.ValidationErrorItem{{Field: "options", Error: "choice question must have at least 2 options"}},
+		}, err)
+		questionRepositoryMock.Mock.AssertNotCalled(t, "Store")
+	})
+}
+
+func TestDelete(t *testing.T) {
+	t.Run("not-host", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		questionRepositoryMock := questionRepository.NewQuestionRepositoryMock(&mock.Mock{})
+		questionRepositoryMock.Mock.On("Find").Return(questionRepository.EventQuestionCollection[0], nil)
+
+		service := questionService.NewQuestionService(questionRepositoryMock, eventRepositoryMock)
+		err := service.Delete(1, int(eventSample.UserID)+1)
+
+		assert.Error(t, err)
+		questionRepositoryMock.Mock.AssertNotCalled(t, "Delete")
+	})
+}
+
+func TestAnswers(t *testing.T) {
+	answerSample := []entities.EventAnswer{
+		{ID: 1, EventID: 1, QuestionID: 1, UserID: 1, Value: `["Vegan"]`, User: userRepository.UserCollection[0]},
+		{ID: 2, EventID: 1, QuestionID: 2, UserID: 1, Value: `["S","M"]`, User: userRepository.UserCollection[0]},
+		{ID: 3, EventID: 1, QuestionID: 1, UserID: 2, Value: `["Vegan"]`, User: userRepository.UserCollection[1]},
+		{ID: 4, EventID: 1, QuestionID: 3, UserID: 2, Value: `["No peanuts please"]`, User: userRepository.UserCollection[1]},
+	}
+	t.Run("per-participant", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		questionRepositoryMock := questionRepository.NewQuestionRepositoryMock(&mock.Mock{})
+		questionRepositoryMock.Mock.On("FindByEvent").Return(questionRepository.EventQuestionCollection, nil)
+		questionRepositoryMock.Mock.On("FindAnswers").Return(answerSample, nil)
+
+		service := questionService.NewQuestionService(questionRepositoryMock, eventRepositoryMock)
+		data, err := service.Answers(int(eventSample.ID), int(eventSample.UserID), 0)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(data))
+		assert.Equal(t, uint(1), data[0].UserID)
+		assert.Equal(t, 2, len(data[0].Answers))
+		assert.Equal(t, []string{"S", "M"}, data[0].Answers[1].Values)
+		assert.Equal(t, "Anything else?", data[1].Answers[1].Label)
+	})
+	t.Run("summary", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		questionRepositoryMock := questionRepository.NewQuestionRepositoryMock(&mock.Mock{})
+		questionRepositoryMock.Mock.On("FindByEvent").Return(questionRepository.EventQuestionCollection, nil)
+		questionRepositoryMock.Mock.On("FindAnswers").Return(answerSample, nil)
+
+		service := questionService.NewQuestionService(questionRepositoryMock, eventRepositoryMock)
+		data, err := service.Summary(int(eventSample.ID), int(eventSample.UserID))
+
+		assert.Nil(t, err)
+		assert.Equal(t, 3, len(data))
+		assert.Equal(t, 2, data[0].Responses)
+		assert.Equal(t, map[string]int{"None": 0, "Vegetarian": 0, "Vegan": 2}, data[0].Options)
+		assert.Equal(t, map[string]int{"S": 1, "M": 1, "L": 0}, data[1].Options)
+		assert.Equal(t, []string{"No peanuts please"}, data[2].Answers)
+	})
+	t.Run("not-host", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		questionRepositoryMock := questionRepository.NewQuestionRepositoryMock(&mock.Mock{})
+
+		service := questionService.NewQuestionService(questionRepositoryMock, eventRepositoryMock)
+		_, err := service.Answers(int(eventSample.ID), int(eventSample.UserID)+1, 0)
+
+		assert.Equal(t, web.WebError{Code: 401, Message: "Only the host can see registration answers"}, err)
+		questionRepositoryMock.Mock.AssertNotCalled(t, "FindAnswers")
+	})
+}
+
+func TestValidateAnswers(t *testing.T) {
+	questions := questionRepository.EventQuestionCollection
+	t.Run("not-going-skips-required", func(t *testing.T) {
+		answers, err := questionService.ValidateAnswers(questions, []entities.EventAnswerRequest{}, false)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(answers))
+	})
+	t.Run("single-choice-multiple-values", func(t *testing.T) {
+		_, err := questionService.ValidateAnswers(questions, []entities.EventAnswerRequest{
+			{QuestionID: 1, Values: []string{"None", "Vegan"}},
+		}, true)
+
+		assert.Equal(t, web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors:  []web.ValidationErrorItem{{Field: "answers.1", Error: "only one option can be chosen"}},
+		}, err)
+	})
+	t.Run("unknown-question", func(t *testing.T) {
+		_, err := questionService.ValidateAnswers(questions, []entities.EventAnswerRequest{
+			{QuestionID: 1, Value: "None"},
+			{QuestionID: 99, Value: "Hello"},
+		}, true)
+
+		assert.Equal(t, web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors:  []web.ValidationErrorItem{{Field: "answers.99", Error: "question is not part of this event"}},
+		}, err)
+	})
+}
@@ -0,0 +1,171 @@
+package reminder
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"tupulung/entities"
+	notificationRepository "tupulung/repositories/notification"
+	reminderRepository "tupulung/repositories/reminder"
+	"tupulung/utilities/mail"
+
+	"github.com/labstack/gommon/log"
+)
+
+const (
+	// Interval pengecekan reminder yang jatuh tempo
+	Interval = time.Minute
+	// Jumlah maksimal reminder per jenis dalam satu putaran
+	BatchSize = 500
+)
+
+type ReminderService struct {
+	reminderRepo     reminderRepository.ReminderRepositoryInterface
+	notificationRepo notificationRepository.NotificationRepositoryInterface
+	mailer           mail.MailInterface
+	offsets          []time.Duration
+}
+
+func NewReminderService(
+	reminderRepo reminderRepository.ReminderRepositoryInterface,
+	notificationRepo notificationRepository.NotificationRepositoryInterface,
+	mailer mail.MailInterface,
+	offsets []time.Duration,
+) *ReminderService {
+	sorted := append([]time.Duration{}, offsets...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] > sorted[j] })
+	return &ReminderService{
+		reminderRepo:     reminderRepo,
+		notificationRepo: notificationRepo,
+		mailer:           mailer,
+		offsets:          sorted,
+	}
+}
+
+/*
+ * Parse Offsets
+ * -------------------------------
+ * Mengubah daftar offset dari config (contoh: "24h,1h")
+ * menjadi durasi sebelum event dimulai
+ */
+func ParseOffsets(value string) ([]time.Duration, error) {
+	offsets := []time.Duration{}
+	seen := map[time.Duration]bool{}
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		offset, err := time.ParseDuration(part)
+		if err != nil {
+			return []time.Duration{}, err
+		}
+		if offset <= 0 {
+			return []time.Duration{}, fmt.Errorf("reminder offset must be positive: %s", part)
+		}
+		if !seen[offset] {
+			seen[offset] = true
+			offsets = append(offsets, offset)
+		}
+	}
+	return offsets, nil
+}
+
+/*
+ * Key
+ * -------------------------------
+ * Nama reminder untuk sebuah offset yang disimpan di reminder_logs,
+ * contoh: 24h, 1h, 30m
+ */
+func Key(offset time.Duration) string {
+	if offset%time.Hour == 0 {
+		return fmt.Sprintf("%dh", offset/time.Hour)
+	}
+	if offset%time.Minute == 0 {
+		return fmt.Sprintf("%dm", offset/time.Minute)
+	}
+	return offset.String()
+}
+
+/*
+ * Run
+ * -------------------------------
+ * Mengirim reminder yang jatuh tempo. Reminder offset o dikirim untuk
+ * event yang dimulai dalam (now + offset berikutnya, now + o], sehingga
+ * event yang dibuat mendadak hanya menerima reminder terdekat.
+ * Setiap reminder diklaim dulu di reminder_logs agar tidak terkirim dua
+ * kali meskipun service restart atau berjalan di beberapa instance
+ *
+ * @return 	int		jumlah reminder yang dikirim
+ */
+func (service ReminderService) Run(now time.Time) (int, error) {
+	sent := 0
+	for i, offset := range service.offsets {
+		from := now
+		if i+1 < len(service.offsets) {
+			from = now.Add(service.offsets[i+1])
+		}
+		key := Key(offset)
+		reminders, err := service.reminderRepo.DueReminders(from, now.Add(offset), key, BatchSize)
+		if err != nil {
+			return sent, err
+		}
+		for _, reminder := range reminders {
+			ok, err := service.send(reminder, key, now)
+			if err != nil {
+				return sent, err
+			}
+			if ok {
+				sent++
+			}
+		}
+	}
+	return sent, nil
+}
+
+func (service ReminderService) send(reminder entities.DueReminder, key string, now time.Time) (bool, error) {
+	reminderLog := entities.ReminderLog{
+		EventID:  reminder.EventID,
+		UserID:   reminder.UserID,
+		Reminder: key,
+		StartsAt: reminder.DatetimeEvent,
+		SentAt:   now,
+	}
+	claimed, err := service.reminderRepo.Claim(reminderLog)
+	if err != nil || !claimed {
+		return false, err
+	}
+
+	eventID := reminder.EventID
+	title := "Reminder: " + reminder.Title
+	body := fmt.Sprintf("%s starts at %s", reminder.Title, reminder.DatetimeEvent.Format("2006-01-02 15:04"))
+	if reminder.Mode != "online" && reminder.Location != "" {
+		body += " in " + reminder.Location
+	}
+	if reminder.Mode != "in_person" && reminder.MeetingURL != "" {
+		body += ", join online at " + reminder.MeetingURL
+	}
+	_, err = service.notificationRepo.Store(entities.Notification{
+		UserID:  reminder.UserID,
+		EventID: &eventID,
+		Kind:    "reminder",
+		Title:   title,
+		Body:    body,
+	})
+	if err != nil {
+		// Lepas klaim agar dicoba lagi pada putaran berikutnya
+		if releaseErr := service.reminderRepo.Release(reminderLog); releaseErr != nil {
+			log.Warn("Cannot release reminder: " + releaseErr.Error())
+		}
+		return false, err
+	}
+
+	// Email bersifat best-effort, notifikasi in-app sudah tersimpan
+	if reminder.Email != "" {
+		if err := service.mailer.Send(reminder.Email, title, "Hi "+reminder.Name+",\n\n"+body+".\n"); err != nil {
+			log.Warn("Cannot send reminder email: " + err.Error())
+		}
+	}
+	return true, nil
+}
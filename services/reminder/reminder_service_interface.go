@@ -0,0 +1,7 @@
+package reminder
+
+import "time"
+
+type ReminderServiceInterface interface {
+	Run(now time.Time) (int, error)
+}
@@ -0,0 +1,110 @@
+package reminder_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	notificationRepository "tupulung/repositories/notification"
+	reminderRepository "tupulung/repositories/reminder"
+	reminderService "tupulung/services/reminder"
+	"tupulung/utilities/mail"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestParseOffsets(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		offsets, err := reminderService.ParseOffsets("24h, 1h,,30m,1h")
+		assert.Nil(t, err)
+		assert.Equal(t, []time.Duration{24 * time.Hour, time.Hour, 30 * time.Minute}, offsets)
+	})
+	t.Run("invalid", func(t *testing.T) {
+		_, err := reminderService.ParseOffsets("24h,tomorrow")
+		assert.Error(t, err)
+	})
+	t.Run("not-positive", func(t *testing.T) {
+		_, err := reminderService.ParseOffsets("-1h")
+		assert.Error(t, err)
+	})
+}
+
+func TestKey(t *testing.T) {
+	assert.Equal(t, "24h", reminderService.Key(24*time.Hour))
+	assert.Equal(t, "30m", reminderService.Key(30*time.Minute))
+	assert.Equal(t, "1m30s", reminderService.Key(90*time.Second))
+}
+
+func TestRun(t *testing.T) {
+	offsets := []time.Duration{time.Hour, 24 * time.Hour}
+	t.Run("success", func(t *testing.T) {
+		reminderRepositoryMock := reminderRepository.NewReminderRepositoryMock(&mock.Mock{})
+		reminderRepositoryMock.Mock.On("DueReminders", "24h").Return([]entities.DueReminder{}, nil)
+		reminderRepositoryMock.Mock.On("DueReminders", "1h").Return(reminderRepository.DueReminderCollection, nil)
+		reminderRepositoryMock.Mock.On("Claim", uint(1)).Return(true, nil)
+		reminderRepositoryMock.Mock.On("Claim", uint(2)).Return(true, nil)
+		notificationRepositoryMock := notificationRepository.NewNotificationRepositoryMock(&mock.Mock{})
+		notificationRepositoryMock.Mock.On("Store", mock.Anything).Return(entities.Notification{}, nil)
+		mailMock := mail.NewMailMock(&mock.Mock{})
+		mailMock.Mock.On("Send", "lorem@mail.com").Return(nil)
+		mailMock.Mock.On("Send", "ipsum@mail.com").Return(errors.New("smtp down"))
+
+		service := reminderService.NewReminderService(reminderRepositoryMock, notificationRepositoryMock, mailMock, offsets)
+		sent, err := service.Run(time.Now())
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, sent)
+		notificationRepositoryMock.Mock.AssertNumberOfCalls(t, "Store", 2)
+		mailMock.Mock.AssertNumberOfCalls(t, "Send", 2)
+	})
+	t.Run("already-claimed", func(t *testing.T) {
+		reminderRepositoryMock := reminderRepository.NewReminderRepositoryMock(&mock.Mock{})
+		reminderRepositoryMock.Mock.On("DueReminders", "24h").Return([]entities.DueReminder{}, nil)
+		reminderRepositoryMock.Mock.On("DueReminders", "1h").Return(reminderRepository.DueReminderCollection, nil)
+		reminderRepositoryMock.Mock.On("Claim", uint(1)).Return(false, nil)
+		reminderRepositoryMock.Mock.On("Claim", uint(2)).Return(true, nil)
+		notificationRepositoryMock := notificationRepository.NewNotificationRepositoryMock(&mock.Mock{})
+		notificationRepositoryMock.Mock.On("Store", uint(2)).Return(entities.Notification{}, nil)
+		mailMock := mail.NewMailMock(&mock.Mock{})
+		mailMock.Mock.On("Send", "ipsum@mail.com").Return(nil)
+
+		service := reminderService.NewReminderService(reminderRepositoryMock, notificationRepositoryMock, mailMock, offsets)
+		sent, err := service.Run(time.Now())
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, sent)
+		notificationRepositoryMock.Mock.AssertNotCalled(t, "Store", uint(1))
+		mailMock.Mock.AssertNotCalled(t, "Send", "lorem@mail.com")
+	})
+	t.Run("store-fail-release", func(t *testing.T) {
+		reminderRepositoryMock := reminderRepository.NewReminderRepositoryMock(&mock.Mock{})
+		reminderRepositoryMock.Mock.On("DueReminders", "24h").Return(reminderRepository.DueReminderCollection[:1], nil)
+		reminderRepositoryMock.Mock.On("Claim", uint(1)).Return(true, nil)
+		reminderRepositoryMock.Mock.On("Release", uint(1)).Return(nil)
+		notificationRepositoryMock := notificationRepository.NewNotificationRepositoryMock(&mock.Mock{})
+		notificationRepositoryMock.Mock.On("Store", uint(1)).Return(entities.Notification{}, web.WebError{Code: 500, Message: "server error"})
+		mailMock := mail.NewMailMock(&mock.Mock{})
+
+		service := reminderService.NewReminderService(reminderRepositoryMock, notificationRepositoryMock, mailMock, offsets)
+		sent, err := service.Run(time.Now())
+
+		assert.Error(t, err)
+		assert.Equal(t, 0, sent)
+		reminderRepositoryMock.Mock.AssertCalled(t, "Release", uint(1))
+		mailMock.Mock.AssertNotCalled(t, "Send", mock.Anything)
+	})
+	t.Run("repo-fail", func(t *testing.T) {
+		reminderRepositoryMock := reminderRepository.NewReminderRepositoryMock(&mock.Mock{})
+		reminderRepositoryMock.Mock.On("DueReminders", "24h").Return([]entities.DueReminder{}, web.WebError{Code: 500, Message: "server error"})
+		notificationRepositoryMock := notificationRepository.NewNotificationRepositoryMock(&mock.Mock{})
+		mailMock := mail.NewMailMock(&mock.Mock{})
+
+		service := reminderService.NewReminderService(reminderRepositoryMock, notificationRepositoryMock, mailMock, offsets)
+		_, err := service.Run(time.Now())
+
+		assert.Error(t, err)
+		reminderRepositoryMock.Mock.AssertNotCalled(t, "Claim", mock.Anything)
+	})
+}
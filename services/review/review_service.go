@@ -0,0 +1,295 @@
+package review
+
+import (
+	"math"
+	"strconv"
+	"time"
+	"tupulung/deliveries/validations"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	eventRepository "tupulung/repositories/event"
+	inviteRepository "tupulung/repositories/invite"
+	reviewRepository "tupulung/repositories/review"
+	cohostService "tupulung/services/cohost"
+	participantService "tupulung/services/participant"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jinzhu/copier"
+)
+
+/*
+ * Reputation Prior
+ * -------------------------------
+ * Skor reputasi host memakai rata-rata bayesian: setiap host
+ * dianggap sudah memiliki ReputationWeight review bernilai
+ * ReputationPrior, sehingga satu review bintang 5 tidak langsung
+ * mengalahkan host dengan ratusan review bintang 4
+ */
+const (
+	ReputationPrior  = 3.0
+	ReputationWeight = 5.0
+)
+
+type ReviewService struct {
+	reviewRepo reviewRepository.ReviewRepositoryInterface
+	eventRepo  eventRepository.EventRepositoryInterface
+	inviteRepo inviteRepository.InviteRepositoryInterface
+	validate   *validator.Validate
+}
+
+func NewReviewService(reviewRepo reviewRepository.ReviewRepositoryInterface, eventRepo eventRepository.EventRepositoryInterface, inviteRepo inviteRepository.InviteRepositoryInterface) *ReviewService {
+	return &ReviewService{
+		reviewRepo: reviewRepo,
+		eventRepo:  eventRepo,
+		inviteRepo: inviteRepo,
+		validate:   validator.New(),
+	}
+}
+
+/*
+ * Find All
+ * -------------------------------
+ * Mengambil ringkasan rating dan review sebuah event,
+ * aturan aksesnya sama dengan detail event
+ */
+func (service ReviewService) FindAll(eventID int, viewerID int, inviteCode string, limit, page int) (entities.ReviewListResponse, web.Pagination, error) {
+	event, err := service.eventRepo.Find(eventID)
+	if err != nil {
+		return entities.ReviewListResponse{}, web.Pagination{}, err
+	}
+	if !participantService.CanView(service.inviteRepo, event, viewerID, inviteCode) {
+		return entities.ReviewListResponse{}, web.Pagination{}, web.WebError{Code: 400, Message: "cannot get event data with specified id"}
+	}
+	if limit <= 0 {
+		limit = 20
+	}
+	if page <= 0 {
+		page = 1
+	}
+
+	reviews, err := service.reviewRepo.FindByEvent(eventID, limit, (page-1)*limit)
+	if err != nil {
+		return entities.ReviewListResponse{}, web.Pagination{}, err
+	}
+	count, err := service.reviewRepo.CountByEvent(eventID)
+	if err != nil {
+		return entities.ReviewListResponse{}, web.Pagination{}, err
+	}
+	totalPages := int(count) / limit
+	if int(count)%limit > 0 || totalPages == 0 {
+		totalPages++
+	}
+
+	reviewsRes := []entities.ReviewResponse{}
+	copier.Copy(&reviewsRes, &reviews)
+	return entities.ReviewListResponse{
+		Rating:  Summarize(event.Rating),
+		Reviews: reviewsRes,
+	}, web.Pagination{Page: page, Limit: limit, TotalPages: totalPages}, nil
+}
+
+/*
+ * Create
+ * -------------------------------
+ * Menambahkan review dari participant yang sudah join,
+ * hanya bisa dilakukan setelah event berakhir dan sekali per event
+ */
+func (service ReviewService) Create(reviewRequest entities.ReviewRequest, eventID int, userID int) (entities.ReviewResponse, error) {
+	err := validations.ValidateReviewRequest(service.validate, reviewRequest)
+	if err != nil {
+		return entities.ReviewResponse{}, err
+	}
+	event, err := service.eventRepo.Find(eventID)
+	if err != nil {
+		return entities.ReviewResponse{}, err
+	}
+	if cohostService.IsTeamMember(event, userID) {
+		return entities.ReviewResponse{}, web.WebError{Code: 400, Message: "Hosts cannot review their own event"}
+	}
+	if !attended(event, userID) {
+		return entities.ReviewResponse{}, web.WebError{Code: 400, Message: "Only participants who joined the event can review it"}
+	}
+	if time.Now().Before(event.EndsAt()) {
+		return entities.ReviewResponse{}, web.WebError{Code: 400, Message: "Event can only be reviewed after it ends"}
+	}
+	if _, err := service.reviewRepo.FindByUser(eventID, userID); err == nil {
+		return entities.ReviewResponse{}, web.WebError{Code: 400, Message: "You have already reviewed this event"}
+	}
+
+	review, err := service.reviewRepo.Store(entities.Review{
+		EventID: event.ID,
+		UserID:  uint(userID),
+		Rating:  reviewRequest.Rating,
+		Body:    reviewRequest.Body,
+	})
+	if err != nil {
+		return entities.ReviewResponse{}, err
+	}
+	if _, err := service.reviewRepo.RefreshRating(eventID); err != nil {
+		return entities.ReviewResponse{}, err
+	}
+	return service.find(int(review.ID))
+}
+
+// Participant yang membatalkan kehadiran tidak bisa memberi review
+func attended(event entities.Event, userID int) bool {
+	for _, participant := range event.RSVPs {
+		if int(participant.UserID) == userID {
+			return participant.Status == participantService.RSVPGoing || participant.CheckedInAt != nil
+		}
+	}
+	return false
+}
+
+/*
+ * Update
+ * -------------------------------
+ * Mengubah rating dan isi review milik user
+ */
+func (service ReviewService) Update(reviewRequest entities.ReviewRequest, id int, userID int) (entities.ReviewResponse, error) {
+	err := validations.ValidateReviewRequest(service.validate, reviewRequest)
+	if err != nil {
+		return entities.ReviewResponse{}, err
+	}
+	review, err := service.reviewRepo.Find(id)
+	if err != nil {
+		return entities.ReviewResponse{}, err
+	}
+	if int(review.UserID) != userID {
+		return entities.ReviewResponse{}, web.WebError{Code: 401, Message: "Cannot update review that belongs to someone else"}
+	}
+
+	review.Rating = reviewRequest.Rating
+	review.Body = reviewRequest.Body
+	_, err = service.reviewRepo.Update(review)
+	if err != nil {
+		return entities.ReviewResponse{}, err
+	}
+	if _, err := service.reviewRepo.RefreshRating(int(review.EventID)); err != nil {
+		return entities.ReviewResponse{}, err
+	}
+	return service.find(id)
+}
+
+/*
+ * Delete
+ * -------------------------------
+ * Menghapus review milik user
+ */
+func (service ReviewService) Delete(id int, userID int) error {
+	review, err := service.reviewRepo.Find(id)
+	if err != nil {
+		return err
+	}
+	if int(review.UserID) != userID {
+		return web.WebError{Code: 401, Message: "Cannot delete review that belongs to someone else"}
+	}
+	if err := service.reviewRepo.Delete(id); err != nil {
+		return err
+	}
+	_, err = service.reviewRepo.RefreshRating(int(review.EventID))
+	return err
+}
+
+/*
+ * Reply
+ * -------------------------------
+ * Host atau editor event membalas review secara publik,
+ * balasan yang sudah ada akan diganti
+ */
+func (service ReviewService) Reply(replyRequest entities.ReviewReplyRequest, id int, userID int) (entities.ReviewResponse, error) {
+	err := validations.ValidateReviewReplyRequest(service.validate, replyRequest)
+	if err != nil {
+		return entities.ReviewResponse{}, err
+	}
+	review, err := service.reviewRepo.Find(id)
+	if err != nil {
+		return entities.ReviewResponse{}, err
+	}
+	event, err := service.eventRepo.Find(int(review.EventID))
+	if err != nil {
+		return entities.ReviewResponse{}, err
+	}
+	if !cohostService.Can(event, userID, cohostService.PermissionUpdate) {
+		return entities.ReviewResponse{}, web.WebError{Code: 401, Message: "Only the host can reply to reviews of this event"}
+	}
+
+	now := time.Now()
+	repliedBy := uint(userID)
+	review.Reply = replyRequest.Reply
+	review.RepliedBy = &repliedBy
+	review.RepliedAt = &now
+	_, err = service.reviewRepo.Update(review)
+	if err != nil {
+		return entities.ReviewResponse{}, err
+	}
+	return service.find(id)
+}
+
+func (service ReviewService) find(id int) (entities.ReviewResponse, error) {
+	review, err := service.reviewRepo.Find(id)
+	if err != nil {
+		return entities.ReviewResponse{}, web.WebError{Code: 500, Message: "Cannot get review data"}
+	}
+	reviewRes := entities.ReviewResponse{}
+	copier.Copy(&reviewRes, &review)
+	return reviewRes, nil
+}
+
+/*
+ * Reputation
+ * -------------------------------
+ * Menghitung skor reputasi host dari semua review event miliknya
+ */
+func (service ReviewService) Reputation(hostID int) (entities.HostReputationResponse, error) {
+	reputation, err := service.reviewRepo.HostReputation(hostID)
+	if err != nil {
+		return entities.HostReputationResponse{}, err
+	}
+	return Reputation(reputation), nil
+}
+
+/*
+ * Reputation
+ * -------------------------------
+ * Mengubah agregasi review host menjadi skor reputasi
+ */
+func Reputation(reputation entities.HostReputation) entities.HostReputationResponse {
+	reputationRes := entities.HostReputationResponse{
+		Score:   round(ReputationPrior),
+		Reviews: reputation.Reviews,
+		Events:  reputation.Events,
+	}
+	if reputation.Reviews == 0 {
+		return reputationRes
+	}
+	reputationRes.Average = round(float64(reputation.Sum) / float64(reputation.Reviews))
+	reputationRes.Score = round((ReputationPrior*ReputationWeight + float64(reputation.Sum)) / (ReputationWeight + float64(reputation.Reviews)))
+	return reputationRes
+}
+
+/*
+ * Summarize
+ * -------------------------------
+ * Mengubah ringkasan rating event menjadi response,
+ * event tanpa review menghasilkan distribusi nol
+ */
+func Summarize(rating *entities.EventRating) entities.RatingSummaryResponse {
+	if rating == nil {
+		rating = &entities.EventRating{}
+	}
+	stars := []int64{rating.Stars1, rating.Stars2, rating.Stars3, rating.Stars4, rating.Stars5}
+	distribution := map[string]int64{}
+	for i, count := range stars {
+		distribution[strconv.Itoa(i+1)] = count
+	}
+	return entities.RatingSummaryResponse{
+		Count:        rating.Count,
+		Average:      round(rating.Average),
+		Distribution: distribution,
+	}
+}
+
+func round(value float64) float64 {
+	return math.Round(value*100) / 100
+}
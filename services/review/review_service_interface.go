@@ -0,0 +1,15 @@
+package review
+
+import (
+	"tupulung/entities"
+	"tupulung/entities/web"
+)
+
+type ReviewServiceInterface interface {
+	FindAll(eventID int, viewerID int, inviteCode string, limit, page int) (entities.ReviewListResponse, web.Pagination, error)
+	Create(reviewRequest entities.ReviewRequest, eventID int, userID int) (entities.ReviewResponse, error)
+	Update(reviewRequest entities.ReviewRequest, id int, userID int) (entities.ReviewResponse, error)
+	Delete(id int, userID int) error
+	Reply(replyRequest entities.ReviewReplyRequest, id int, userID int) (entities.ReviewResponse, error)
+	Reputation(hostID int) (entities.HostReputationResponse, error)
+}
@@ -0,0 +1,223 @@
+package review_test
+
+import (
+	"testing"
+	"time"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	eventRepository "tupulung/repositories/event"
+	inviteRepository "tupulung/repositories/invite"
+	reviewRepository "tupulung/repositories/review"
+	reviewService "tupulung/services/review"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Event milik user 1 yang sudah berakhir kemarin dan diikuti user 2
+func endedEvent() entities.Event {
+	event := eventRepository.EventCollection[0]
+	event.DatetimeEvent = time.Now().AddDate(0, 0, -3)
+	end := time.Now().AddDate(0, 0, -2)
+	event.DatetimeEventEnd = &end
+	event.RSVPs = []entities.Participant{{EventID: 1, UserID: 2, Status: "going"}}
+	return event
+}
+
+func TestCreate(t *testing.T) {
+	request := entities.ReviewRequest{Rating: 5, Body: "Materinya sangat bermanfaat"}
+	newService := func(event entities.Event, reviewRepositoryMock *reviewRepository.ReviewRepositoryMock) *reviewService.ReviewService {
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(event, nil)
+		return reviewService.NewReviewService(reviewRepositoryMock, eventRepositoryMock, inviteRepository.NewInviteRepositoryMock(&mock.Mock{}))
+	}
+	t.Run("success", func(t *testing.T) {
+		reviewRepositoryMock := reviewRepository.NewReviewRepositoryMock(&mock.Mock{})
+		reviewRepositoryMock.Mock.On("FindByUser").Return(entities.Review{}, web.WebError{Code: 400, Message: "cannot get review data with specified id"})
+		reviewRepositoryMock.Mock.On("Store", 5).Return(reviewRepository.ReviewCollection[0], nil)
+		reviewRepositoryMock.Mock.On("RefreshRating", 1).Return(reviewRepository.EventRatingCollection[0], nil)
+		reviewRepositoryMock.Mock.On("Find").Return(reviewRepository.ReviewCollection[0], nil)
+
+		actual, err := newService(endedEvent(), reviewRepositoryMock).Create(request, 1, 2)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 5, actual.Rating)
+		reviewRepositoryMock.Mock.AssertCalled(t, "RefreshRating", 1)
+	})
+	t.Run("not-ended", func(t *testing.T) {
+		event := endedEvent()
+		event.DatetimeEvent = time.Now()
+		event.DatetimeEventEnd = nil
+		reviewRepositoryMock := reviewRepository.NewReviewRepositoryMock(&mock.Mock{})
+
+		_, err := newService(event, reviewRepositoryMock).Create(request, 1, 2)
+
+		assert.Equal(t, web.WebError{Code: 400, Message: "Event can only be reviewed after it ends"}, err)
+		reviewRepositoryMock.Mock.AssertNotCalled(t, "Store", mock.Anything)
+	})
+	t.Run("not-participant", func(t *testing.T) {
+		reviewRepositoryMock := reviewRepository.NewReviewRepositoryMock(&mock.Mock{})
+
+		_, err := newService(endedEvent(), reviewRepositoryMock).Create(request, 1, 3)
+
+		assert.Equal(t, web.WebError{Code: 400, Message: "Only participants who joined the event can review it"}, err)
+	})
+	t.Run("not-going", func(t *testing.T) {
+		event := endedEvent()
+		event.RSVPs[0].Status = "not_going"
+		reviewRepositoryMock := reviewRepository.NewReviewRepositoryMock(&mock.Mock{})
+
+		_, err := newService(event, reviewRepositoryMock).Create(request, 1, 2)
+
+		assert.Equal(t, web.WebError{Code: 400, Message: "Only participants who joined the event can review it"}, err)
+	})
+	t.Run("host", func(t *testing.T) {
+		reviewRepositoryMock := reviewRepository.NewReviewRepositoryMock(&mock.Mock{})
+
+		_, err := newService(endedEvent(), reviewRepositoryMock).Create(request, 1, 1)
+
+		assert.Equal(t, web.WebError{Code: 400, Message: "Hosts cannot review their own event"}, err)
+	})
+	t.Run("already-reviewed", func(t *testing.T) {
+		reviewRepositoryMock := reviewRepository.NewReviewRepositoryMock(&mock.Mock{})
+		reviewRepositoryMock.Mock.On("FindByUser").Return(reviewRepository.ReviewCollection[0], nil)
+
+		_, err := newService(endedEvent(), reviewRepositoryMock).Create(request, 1, 2)
+
+		assert.Equal(t, web.WebError{Code: 400, Message: "You have already reviewed this event"}, err)
+		reviewRepositoryMock.Mock.AssertNotCalled(t, "Store", mock.Anything)
+	})
+	t.Run("validation-fail", func(t *testing.T) {
+		reviewRepositoryMock := reviewRepository.NewReviewRepositoryMock(&mock.Mock{})
+
+		_, err := newService(endedEvent(), reviewRepositoryMock).Create(entities.ReviewRequest{Rating: 6}, 1, 2)
+
+		assert.Equal(t, web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors:  []web.ValidationErrorItem{{Field: "rating", Error: "rating must be between 1 and 5"}},
+		}, err)
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		reviewRepositoryMock := reviewRepository.NewReviewRepositoryMock(&mock.Mock{})
+		reviewRepositoryMock.Mock.On("Find").Return(reviewRepository.ReviewCollection[0], nil)
+		reviewRepositoryMock.Mock.On("Update", 4).Return(reviewRepository.ReviewCollection[0], nil)
+		reviewRepositoryMock.Mock.On("RefreshRating", 1).Return(reviewRepository.EventRatingCollection[0], nil)
+
+		service := reviewService.NewReviewService(reviewRepositoryMock, eventRepository.NewEventRepositoryMock(&mock.Mock{}), inviteRepository.NewInviteRepositoryMock(&mock.Mock{}))
+		_, err := service.Update(entities.ReviewRequest{Rating: 4}, 1, 2)
+
+		assert.Nil(t, err)
+		reviewRepositoryMock.Mock.AssertCalled(t, "Update", 4)
+	})
+	t.Run("not-owner", func(t *testing.T) {
+		reviewRepositoryMock := reviewRepository.NewReviewRepositoryMock(&mock.Mock{})
+		reviewRepositoryMock.Mock.On("Find").Return(reviewRepository.ReviewCollection[0], nil)
+
+		service := reviewService.NewReviewService(reviewRepositoryMock, eventRepository.NewEventRepositoryMock(&mock.Mock{}), inviteRepository.NewInviteRepositoryMock(&mock.Mock{}))
+		_, err := service.Update(entities.ReviewRequest{Rating: 1}, 1, 3)
+
+		assert.Equal(t, web.WebError{Code: 401, Message: "Cannot update review that belongs to someone else"}, err)
+		reviewRepositoryMock.Mock.AssertNotCalled(t, "Update", mock.Anything)
+	})
+}
+
+func TestReply(t *testing.T) {
+	newService := func(reviewRepositoryMock *reviewRepository.ReviewRepositoryMock) *reviewService.ReviewService {
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(endedEvent(), nil)
+		return reviewService.NewReviewService(reviewRepositoryMock, eventRepositoryMock, inviteRepository.NewInviteRepositoryMock(&mock.Mock{}))
+	}
+	t.Run("host", func(t *testing.T) {
+		replied := reviewRepository.ReviewCollection[0]
+		replied.Reply = "Terima kasih sudah datang"
+		reviewRepositoryMock := reviewRepository.NewReviewRepositoryMock(&mock.Mock{})
+		reviewRepositoryMock.Mock.On("Find").Return(reviewRepository.ReviewCollection[0], nil).Once()
+		reviewRepositoryMock.Mock.On("Update", 5).Return(replied, nil)
+		reviewRepositoryMock.Mock.On("Find").Return(replied, nil)
+
+		actual, err := newService(reviewRepositoryMock).Reply(entities.ReviewReplyRequest{Reply: "Terima kasih sudah datang"}, 1, 1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "Terima kasih sudah datang", actual.Reply)
+		reviewRepositoryMock.Mock.AssertNotCalled(t, "RefreshRating", mock.Anything)
+	})
+	t.Run("not-host", func(t *testing.T) {
+		reviewRepositoryMock := reviewRepository.NewReviewRepositoryMock(&mock.Mock{})
+		reviewRepositoryMock.Mock.On("Find").Return(reviewRepository.ReviewCollection[0], nil)
+
+		_, err := newService(reviewRepositoryMock).Reply(entities.ReviewReplyRequest{Reply: "Terima kasih"}, 1, 2)
+
+		assert.Equal(t, web.WebError{Code: 401, Message: "Only the host can reply to reviews of this event"}, err)
+		reviewRepositoryMock.Mock.AssertNotCalled(t, "Update", mock.Anything)
+	})
+}
+
+func TestFindAll(t *testing.T) {
+	t.Run("summary", func(t *testing.T) {
+		event := endedEvent()
+		event.Rating = &reviewRepository.EventRatingCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(event, nil)
+		reviewRepositoryMock := reviewRepository.NewReviewRepositoryMock(&mock.Mock{})
+		reviewRepositoryMock.Mock.On("FindByEvent").Return(reviewRepository.ReviewCollection, nil)
+		reviewRepositoryMock.Mock.On("CountByEvent").Return(2, nil)
+
+		service := reviewService.NewReviewService(reviewRepositoryMock, eventRepositoryMock, inviteRepository.NewInviteRepositoryMock(&mock.Mock{}))
+		actual, pagination, err := service.FindAll(1, 0, "", 20, 1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, pagination.TotalPages)
+		assert.Equal(t, float64(4), actual.Rating.Average)
+		assert.Equal(t, map[string]int64{"1": 0, "2": 0, "3": 1, "4": 0, "5": 1}, actual.Rating.Distribution)
+		assert.Len(t, actual.Reviews, 2)
+	})
+	t.Run("private-guest", func(t *testing.T) {
+		event := endedEvent()
+		event.Visibility = "private"
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(event, nil)
+		reviewRepositoryMock := reviewRepository.NewReviewRepositoryMock(&mock.Mock{})
+
+		inviteRepositoryMock := inviteRepository.NewInviteRepositoryMock(&mock.Mock{})
+		inviteRepositoryMock.Mock.On("FindUsableByUser").Return(entities.EventInvite{}, web.WebError{Code: 400})
+
+		service := reviewService.NewReviewService(reviewRepositoryMock, eventRepositoryMock, inviteRepositoryMock)
+		_, _, err := service.FindAll(1, 3, "", 20, 1)
+
+		assert.Equal(t, web.WebError{Code: 400, Message: "cannot get event data with specified id"}, err)
+		reviewRepositoryMock.Mock.AssertNotCalled(t, "FindByEvent")
+	})
+}
+
+func TestReputation(t *testing.T) {
+	cases := []struct {
+		name       string
+		reputation entities.HostReputation
+		expected   entities.HostReputationResponse
+	}{
+		{
+			name:       "no-reviews",
+			reputation: entities.HostReputation{},
+			expected:   entities.HostReputationResponse{Score: 3},
+		},
+		{
+			name:       "single-review",
+			reputation: entities.HostReputation{Events: 1, Reviews: 1, Sum: 5},
+			expected:   entities.HostReputationResponse{Score: 3.33, Average: 5, Reviews: 1, Events: 1},
+		},
+		{
+			name:       "many-reviews",
+			reputation: entities.HostReputation{Events: 10, Reviews: 200, Sum: 800},
+			expected:   entities.HostReputationResponse{Score: 3.98, Average: 4, Reviews: 200, Events: 10},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, reviewService.Reputation(c.reputation))
+		})
+	}
+}
@@ -0,0 +1,208 @@
+package revision
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	eventRepository "tupulung/repositories/event"
+	inviteRepository "tupulung/repositories/invite"
+	participantRepository "tupulung/repositories/participant"
+	revisionRepository "tupulung/repositories/revision"
+	participantService "tupulung/services/participant"
+
+	"github.com/jinzhu/copier"
+)
+
+/*
+ * Tracked fields
+ * -------------------------------
+ * Field event yang dicatat pada revisi, sesuai urutan tampilnya
+ */
+var trackedFields = []string{
+	"title",
+	"hosted_by",
+	"cover",
+	"datetime_event",
+	"category_id",
+	"location",
+	"mode",
+	"description",
+	"capacity",
+	"visibility",
+	"tags",
+}
+
+type RevisionService struct {
+	revisionRepo    revisionRepository.RevisionRepositoryInterface
+	eventRepo       eventRepository.EventRepositoryInterface
+	participantRepo participantRepository.ParticipantRepositoryInterface
+	inviteRepo      inviteRepository.InviteRepositoryInterface
+}
+
+func NewRevisionService(revisionRepo revisionRepository.RevisionRepositoryInterface, eventRepo eventRepository.EventRepositoryInterface, participantRepo participantRepository.ParticipantRepositoryInterface, inviteRepo inviteRepository.InviteRepositoryInterface) *RevisionService {
+	return &RevisionService{
+		revisionRepo:    revisionRepo,
+		eventRepo:       eventRepo,
+		participantRepo: participantRepo,
+		inviteRepo:      inviteRepo,
+	}
+}
+
+/*
+ * Find All
+ * -------------------------------
+ * Mengambil riwayat perubahan event, aturan aksesnya sama dengan
+ * detail event. Perubahan alamat event yang alamatnya disembunyikan
+ * hanya bisa dilihat oleh tim host dan participant
+ */
+func (service RevisionService) FindAll(eventID, viewerID int, inviteCode string) ([]entities.EventRevisionResponse, error) {
+	event, err := service.eventRepo.Find(eventID)
+	if err != nil {
+		return []entities.EventRevisionResponse{}, err
+	}
+	if !participantService.CanView(service.inviteRepo, event, viewerID, inviteCode) {
+		return []entities.EventRevisionResponse{}, web.WebError{Code: 400, Message: "cannot get event data with specified id"}
+	}
+
+	revisions, err := service.revisionRepo.FindByEvent(eventID)
+	if err != nil {
+		return []entities.EventRevisionResponse{}, err
+	}
+	hideAddress := event.HideAddress && !participantService.CanViewDetails(event, viewerID)
+	revisionsRes := []entities.EventRevisionResponse{}
+	for _, revision := range revisions {
+		revisionRes := entities.EventRevisionResponse{}
+		copier.Copy(&revisionRes, &revision)
+		revisionRes.Changes = DecodeChanges(revision.Changes)
+		if hideAddress {
+			redactLocation(revisionRes.Changes)
+		}
+		revisionsRes = append(revisionsRes, revisionRes)
+	}
+	return revisionsRes, nil
+}
+
+/*
+ * Since Joined
+ * -------------------------------
+ * Ringkasan perubahan event sejak participant bergabung,
+ * field yang diubah lalu dikembalikan ke nilai awal tidak ditampilkan
+ */
+func (service RevisionService) SinceJoined(eventID, userID int) (entities.EventChangesResponse, error) {
+	participant, err := service.participantRepo.FindByEventAndUser(eventID, userID)
+	if err != nil {
+		return entities.EventChangesResponse{}, err
+	}
+	revisions, err := service.revisionRepo.FindByEventSince(eventID, participant.CreatedAt)
+	if err != nil {
+		return entities.EventChangesResponse{}, err
+	}
+	return entities.EventChangesResponse{
+		EventID:   uint(eventID),
+		JoinedAt:  participant.CreatedAt,
+		Revisions: len(revisions),
+		Changes:   Summarize(revisions),
+	}, nil
+}
+
+/*
+ * Snapshot
+ * -------------------------------
+ * Mengambil nilai field yang dicatat dari sebuah event
+ * dalam bentuk string agar mudah dibandingkan dan disimpan
+ */
+func Snapshot(event entities.Event, tags []string) map[string]string {
+	sortedTags := append([]string{}, tags...)
+	sort.Strings(sortedTags)
+	datetime := ""
+	if !event.DatetimeEvent.IsZero() {
+		datetime = event.DatetimeEvent.Format(time.RFC3339)
+	}
+	datetimeEnd := ""
+	if event.DatetimeEventEnd != nil {
+		datetimeEnd = event.DatetimeEventEnd.Format(time.RFC3339)
+	}
+	return map[string]string{
+		"title":              event.Title,
+		"hosted_by":          event.HostedBy,
+		"cover":              event.Cover,
+		"datetime_event":     datetime,
+		"datetime_event_end": datetimeEnd,
+		"category_id":        strconv.Itoa(int(event.CategoryID)),
+		"location":           event.Location,
+		"mode":               event.Mode,
+		"description":        event.Description,
+		"capacity":           strconv.Itoa(int(event.Capacity)),
+		"visibility":         event.Visibility,
+		"tags":               strings.Join(sortedTags, ","),
+	}
+}
+
+/*
+ * Diff
+ * -------------------------------
+ * Membandingkan dua snapshot event dan mengembalikan field yang berubah
+ */
+func Diff(before, after map[string]string) []entities.EventFieldChange {
+	changes := []entities.EventFieldChange{}
+	for _, field := range trackedFields {
+		if before[field] != after[field] {
+			changes = append(changes, entities.EventFieldChange{Field: field, Old: before[field], New: after[field]})
+		}
+	}
+	return changes
+}
+
+/*
+ * Summarize
+ * -------------------------------
+ * Menggabungkan beberapa revisi menjadi satu perubahan per field,
+ * nilai lama diambil dari revisi pertama dan nilai baru dari revisi terakhir
+ */
+func Summarize(revisions []entities.EventRevision) []entities.EventFieldChange {
+	before := map[string]string{}
+	after := map[string]string{}
+	for _, revision := range revisions {
+		for _, change := range DecodeChanges(revision.Changes) {
+			if _, ok := before[change.Field]; !ok {
+				before[change.Field] = change.Old
+			}
+			after[change.Field] = change.New
+		}
+	}
+	return Diff(before, after)
+}
+
+/*
+ * Encode Changes
+ * -------------------------------
+ * Mengubah daftar perubahan menjadi JSON untuk disimpan
+ */
+func EncodeChanges(changes []entities.EventFieldChange) string {
+	encoded, _ := json.Marshal(changes)
+	return string(encoded)
+}
+
+/*
+ * Decode Changes
+ * -------------------------------
+ * Membaca daftar perubahan dari JSON yang tersimpan
+ */
+func DecodeChanges(changes string) []entities.EventFieldChange {
+	decoded := []entities.EventFieldChange{}
+	json.Unmarshal([]byte(changes), &decoded)
+	return decoded
+}
+
+func redactLocation(changes []entities.EventFieldChange) {
+	for i := range changes {
+		if changes[i].Field == "location" {
+			changes[i].Old = ""
+			changes[i].New = ""
+		}
+	}
+}
@@ -0,0 +1,8 @@
+package revision
+
+import "tupulung/entities"
+
+type RevisionServiceInterface interface {
+	FindAll(eventID, viewerID int, inviteCode string) ([]entities.EventRevisionResponse, error)
+	SinceJoined(eventID, userID int) (entities.EventChangesResponse, error)
+}
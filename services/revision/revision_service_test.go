@@ -0,0 +1,114 @@
+package revision_test
+
+import (
+	"testing"
+	"time"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	eventRepository "tupulung/repositories/event"
+	inviteRepository "tupulung/repositories/invite"
+	participantRepository "tupulung/repositories/participant"
+	revisionRepository "tupulung/repositories/revision"
+	revisionService "tupulung/services/revision"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDiff(t *testing.T) {
+	t.Run("changed-fields", func(t *testing.T) {
+		event := eventRepository.EventCollection[0]
+		before := revisionService.Snapshot(event, []string{"golang", "meetup"})
+		event.Location = "Bandung"
+		event.Capacity = 80
+		after := revisionService.Snapshot(event, []string{"meetup", "golang"})
+
+		changes := revisionService.Diff(before, after)
+
+		assert.Equal(t, []entities.EventFieldChange{
+			{Field: "location", Old: eventRepository.EventCollection[0].Location, New: "Bandung"},
+			{Field: "capacity", Old: "0", New: "80"},
+		}, changes)
+	})
+}
+
+func TestFindAll(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventRepository.EventCollection[0], nil)
+		revisionRepositoryMock := revisionRepository.NewRevisionRepositoryMock(&mock.Mock{})
+		revisionRepositoryMock.Mock.On("FindByEvent").Return(revisionRepository.EventRevisionCollection, nil)
+
+		service := revisionService.NewRevisionService(revisionRepositoryMock, eventRepositoryMock, participantRepository.NewParticipantRepositoryMock(&mock.Mock{}), inviteRepository.NewInviteRepositoryMock(&mock.Mock{}))
+		data, err := service.FindAll(1, 0, "")
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(data))
+		assert.Equal(t, entities.EventFieldChange{Field: "location", Old: "Jakarta", New: "Bandung"}, data[0].Changes[0])
+	})
+	t.Run("hidden-address", func(t *testing.T) {
+		event := eventRepository.EventCollection[0]
+		event.HideAddress = true
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(event, nil)
+		revisionRepositoryMock := revisionRepository.NewRevisionRepositoryMock(&mock.Mock{})
+		revisionRepositoryMock.Mock.On("FindByEvent").Return(revisionRepository.EventRevisionCollection, nil)
+
+		service := revisionService.NewRevisionService(revisionRepositoryMock, eventRepositoryMock, participantRepository.NewParticipantRepositoryMock(&mock.Mock{}), inviteRepository.NewInviteRepositoryMock(&mock.Mock{}))
+		data, err := service.FindAll(1, 99, "")
+
+		assert.Nil(t, err)
+		assert.Equal(t, entities.EventFieldChange{Field: "location"}, data[0].Changes[0])
+		assert.Equal(t, entities.EventFieldChange{Field: "capacity", Old: "50", New: "80"}, data[0].Changes[1])
+
+		data, err = service.FindAll(1, int(event.UserID), "")
+
+		assert.Nil(t, err)
+		assert.Equal(t, entities.EventFieldChange{Field: "location", Old: "Jakarta", New: "Bandung"}, data[0].Changes[0])
+	})
+	t.Run("private-event", func(t *testing.T) {
+		event := eventRepository.EventCollection[0]
+		event.Visibility = "private"
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(event, nil)
+
+		inviteRepositoryMock := inviteRepository.NewInviteRepositoryMock(&mock.Mock{})
+		inviteRepositoryMock.Mock.On("FindUsableByUser").Return(entities.EventInvite{}, web.WebError{Code: 400})
+
+		service := revisionService.NewRevisionService(revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}), eventRepositoryMock, participantRepository.NewParticipantRepositoryMock(&mock.Mock{}), inviteRepositoryMock)
+		_, err := service.FindAll(1, 99, "")
+
+		assert.Equal(t, 400, err.(web.WebError).Code)
+	})
+}
+
+func TestSinceJoined(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		participant := participantRepository.ParticipantCollection[1]
+		participant.CreatedAt = time.Now().Add(-3 * time.Hour)
+		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		participantRepositoryMock.Mock.On("FindByEventAndUser").Return(participant, nil)
+		revisionRepositoryMock := revisionRepository.NewRevisionRepositoryMock(&mock.Mock{})
+		revisionRepositoryMock.Mock.On("FindByEventSince").Return(revisionRepository.EventRevisionCollection, nil)
+
+		service := revisionService.NewRevisionService(revisionRepositoryMock, eventRepository.NewEventRepositoryMock(&mock.Mock{}), participantRepositoryMock, inviteRepository.NewInviteRepositoryMock(&mock.Mock{}))
+		data, err := service.SinceJoined(1, 2)
+
+		// Lokasi sudah dikembalikan ke nilai awal sehingga tidak ditampilkan
+		assert.Nil(t, err)
+		assert.Equal(t, 2, data.Revisions)
+		assert.Equal(t, []entities.EventFieldChange{
+			{Field: "datetime_event", Old: "2030-01-01T00:00:00Z", New: "2030-01-08T00:00:00Z"},
+			{Field: "capacity", Old: "50", New: "80"},
+		}, data.Changes)
+	})
+	t.Run("not-participant", func(t *testing.T) {
+		participantRepositoryMock := participantRepository.NewParticipantRepositoryMock(&mock.Mock{})
+		participantRepositoryMock.Mock.On("FindByEventAndUser").Return(entities.Participant{}, web.WebError{Code: 404, Message: "you haven't joined this event"})
+
+		service := revisionService.NewRevisionService(revisionRepository.NewRevisionRepositoryMock(&mock.Mock{}), eventRepository.NewEventRepositoryMock(&mock.Mock{}), participantRepositoryMock, inviteRepository.NewInviteRepositoryMock(&mock.Mock{}))
+		_, err := service.SinceJoined(1, 99)
+
+		assert.Equal(t, 404, err.(web.WebError).Code)
+	})
+}
@@ -0,0 +1,318 @@
+package session
+
+import (
+	"strings"
+	"time"
+	"tupulung/deliveries/validations"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	eventRepository "tupulung/repositories/event"
+	inviteRepository "tupulung/repositories/invite"
+	sessionRepository "tupulung/repositories/session"
+	userRepository "tupulung/repositories/user"
+	cohostService "tupulung/services/cohost"
+	participantService "tupulung/services/participant"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jinzhu/copier"
+)
+
+// Format waktu mulai dan selesai sesi pada request
+const SessionTimeLayout = "2006-01-02 15:04"
+
+type SessionService struct {
+	sessionRepo sessionRepository.SessionRepositoryInterface
+	eventRepo   eventRepository.EventRepositoryInterface
+	userRepo    userRepository.UserRepositoryInterface
+	inviteRepo  inviteRepository.InviteRepositoryInterface
+	validate    *validator.Validate
+}
+
+func NewSessionService(sessionRepo sessionRepository.SessionRepositoryInterface, eventRepo eventRepository.EventRepositoryInterface, userRepo userRepository.UserRepositoryInterface, inviteRepo inviteRepository.InviteRepositoryInterface) *SessionService {
+	return &SessionService{
+		sessionRepo: sessionRepo,
+		eventRepo:   eventRepo,
+		userRepo:    userRepo,
+		inviteRepo:  inviteRepo,
+		validate:    validator.New(),
+	}
+}
+
+/*
+ * Find All
+ * -------------------------------
+ * Mengambil agenda event sesuai jadwal, sesi yang sudah
+ * dipilih viewer ke agenda pribadinya ditandai in_agenda
+ */
+func (service SessionService) FindAll(eventID int, viewerID int, inviteCode string) ([]entities.EventSessionResponse, error) {
+	event, err := service.eventRepo.Find(eventID)
+	if err != nil {
+		return []entities.EventSessionResponse{}, web.WebError{Code: 400, Message: "Event is not exist"}
+	}
+	if !participantService.CanView(service.inviteRepo, event, viewerID, inviteCode) {
+		return []entities.EventSessionResponse{}, web.WebError{Code: 400, Message: "cannot get event data with specified id"}
+	}
+	sessions, err := service.sessionRepo.FindByEvent(eventID)
+	if err != nil {
+		return []entities.EventSessionResponse{}, err
+	}
+
+	inAgenda := map[uint]bool{}
+	if viewerID != 0 {
+		ids, err := service.sessionRepo.AgendaSessionIDs(viewerID, eventID)
+		if err != nil {
+			return []entities.EventSessionResponse{}, err
+		}
+		for _, id := range ids {
+			inAgenda[id] = true
+		}
+	}
+
+	sessionsRes := []entities.EventSessionResponse{}
+	for _, session := range sessions {
+		sessionRes := ToSessionResponse(session)
+		sessionRes.InAgenda = inAgenda[session.ID]
+		sessionsRes = append(sessionsRes, sessionRes)
+	}
+	return sessionsRes, nil
+}
+
+/*
+ * Create
+ * -------------------------------
+ * Menambahkan sesi ke agenda event,
+ * hanya host dan co-host editor yang dapat melakukannya
+ */
+func (service SessionService) Create(sessionRequest entities.EventSessionRequest, eventID int, userID int) (entities.EventSessionResponse, error) {
+	event, err := service.eventRepo.Find(eventID)
+	if err != nil {
+		return entities.EventSessionResponse{}, web.WebError{Code: 400, Message: "Event is not exist"}
+	}
+	if !cohostService.Can(event, userID, cohostService.PermissionUpdate) {
+		return entities.EventSessionResponse{}, web.WebError{Code: 401, Message: "Only the host can manage the event agenda"}
+	}
+	session, err := service.fillSession(sessionRequest, event, entities.EventSession{EventID: event.ID})
+	if err != nil {
+		return entities.EventSessionResponse{}, err
+	}
+
+	session, err = service.sessionRepo.Store(session)
+	if err != nil {
+		return entities.EventSessionResponse{}, err
+	}
+	return service.find(int(session.ID))
+}
+
+/*
+ * Update
+ * -------------------------------
+ * Mengganti isi sesi beserta daftar pembicaranya
+ */
+func (service SessionService) Update(sessionRequest entities.EventSessionRequest, id int, userID int) (entities.EventSessionResponse, error) {
+	session, event, err := service.findManaged(id, userID)
+	if err != nil {
+		return entities.EventSessionResponse{}, err
+	}
+	session, err = service.fillSession(sessionRequest, event, session)
+	if err != nil {
+		return entities.EventSessionResponse{}, err
+	}
+
+	session, err = service.sessionRepo.Update(session)
+	if err != nil {
+		return entities.EventSessionResponse{}, err
+	}
+	return service.find(int(session.ID))
+}
+
+/*
+ * Delete
+ * -------------------------------
+ * Menghapus sesi dari agenda event
+ */
+func (service SessionService) Delete(id int, userID int) error {
+	_, _, err := service.findManaged(id, userID)
+	if err != nil {
+		return err
+	}
+	return service.sessionRepo.Delete(id)
+}
+
+/*
+ * Add To Agenda
+ * -------------------------------
+ * Menambahkan sesi ke agenda pribadi participant,
+ * hanya participant yang akan hadir yang dapat melakukannya
+ */
+func (service SessionService) AddToAgenda(sessionID int, userID int) error {
+	session, err := service.sessionRepo.Find(sessionID)
+	if err != nil {
+		return err
+	}
+	event, err := service.eventRepo.Find(int(session.EventID))
+	if err != nil {
+		return web.WebError{Code: 400, Message: "Event is not exist"}
+	}
+	if !isAttending(event, userID) {
+		return web.WebError{Code: 400, Message: "Only participants of the event can build an agenda"}
+	}
+	return service.sessionRepo.AddToAgenda(entities.AgendaItem{SessionID: session.ID, UserID: uint(userID)})
+}
+
+/*
+ * Remove From Agenda
+ * -------------------------------
+ * Menghapus sesi dari agenda pribadi participant
+ */
+func (service SessionService) RemoveFromAgenda(sessionID int, userID int) error {
+	return service.sessionRepo.RemoveFromAgenda(sessionID, userID)
+}
+
+/*
+ * Agenda
+ * -------------------------------
+ * Mengambil agenda pribadi user sesuai jadwal,
+ * eventID 0 berarti agenda dari semua event
+ */
+func (service SessionService) Agenda(eventID int, userID int) ([]entities.EventSessionResponse, error) {
+	sessions, err := service.sessionRepo.FindAgenda(userID, eventID)
+	if err != nil {
+		return []entities.EventSessionResponse{}, err
+	}
+	sessionsRes := []entities.EventSessionResponse{}
+	for _, session := range sessions {
+		sessionRes := ToSessionResponse(session)
+		sessionRes.InAgenda = true
+		sessionsRes = append(sessionsRes, sessionRes)
+	}
+	return sessionsRes, nil
+}
+
+/*
+ * To Session Response
+ * -------------------------------
+ * Mengubah sesi menjadi response, pembicara yang terhubung
+ * ke user memakai nama user tersebut
+ */
+func ToSessionResponse(session entities.EventSession) entities.EventSessionResponse {
+	sessionRes := entities.EventSessionResponse{
+		ID:          session.ID,
+		EventID:     session.EventID,
+		Title:       session.Title,
+		Description: session.Description,
+		Room:        session.Room,
+		StartsAt:    session.StartsAt,
+		EndsAt:      session.EndsAt,
+		Speakers:    []entities.SessionSpeakerResponse{},
+	}
+	for _, speaker := range session.Speakers {
+		speakerRes := entities.SessionSpeakerResponse{UserID: speaker.UserID, Name: speaker.Name}
+		if speaker.User != nil {
+			speakerRes.User = &entities.UserResponse{}
+			copier.Copy(speakerRes.User, speaker.User)
+			if speakerRes.Name == "" {
+				speakerRes.Name = speaker.User.Name
+			}
+		}
+		sessionRes.Speakers = append(sessionRes.Speakers, speakerRes)
+	}
+	return sessionRes
+}
+
+func (service SessionService) find(id int) (entities.EventSessionResponse, error) {
+	session, err := service.sessionRepo.Find(id)
+	if err != nil {
+		return entities.EventSessionResponse{}, err
+	}
+	return ToSessionResponse(session), nil
+}
+
+func (service SessionService) findManaged(id int, userID int) (entities.EventSession, entities.Event, error) {
+	session, err := service.sessionRepo.Find(id)
+	if err != nil {
+		return entities.EventSession{}, entities.Event{}, err
+	}
+	event, err := service.eventRepo.Find(int(session.EventID))
+	if err != nil {
+		return entities.EventSession{}, entities.Event{}, web.WebError{Code: 400, Message: "Event is not exist"}
+	}
+	if !cohostService.Can(event, userID, cohostService.PermissionUpdate) {
+		return entities.EventSession{}, entities.Event{}, web.WebError{Code: 401, Message: "Only the host can manage the event agenda"}
+	}
+	return session, event, nil
+}
+
+/*
+ * Fill Session
+ * -------------------------------
+ * Mengisi sesi dari request, waktu sesi harus berada
+ * di dalam rentang waktu event dan user pembicara harus ada
+ */
+func (service SessionService) fillSession(sessionRequest entities.EventSessionRequest, event entities.Event, session entities.EventSession) (entities.EventSession, error) {
+	err := validations.ValidateEventSessionRequest(service.validate, sessionRequest)
+	if err != nil {
+		return entities.EventSession{}, err
+	}
+	location := event.DatetimeEvent.Location()
+	startsAt, err := time.ParseInLocation(SessionTimeLayout, sessionRequest.StartsAt, location)
+	if err != nil {
+		return entities.EventSession{}, web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors:  []web.ValidationErrorItem{{Field: "starts_at", Error: "starts_at must use format " + SessionTimeLayout}},
+		}
+	}
+	endsAt, err := time.ParseInLocation(SessionTimeLayout, sessionRequest.EndsAt, location)
+	if err != nil {
+		return entities.EventSession{}, web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors:  []web.ValidationErrorItem{{Field: "ends_at", Error: "ends_at must use format " + SessionTimeLayout}},
+		}
+	}
+	if !endsAt.After(startsAt) {
+		return entities.EventSession{}, web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors:  []web.ValidationErrorItem{{Field: "ends_at", Error: "ends_at must be after starts_at"}},
+		}
+	}
+	if startsAt.Before(event.DatetimeEvent) || endsAt.After(event.EndsAt()) {
+		return entities.EventSession{}, web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors:  []web.ValidationErrorItem{{Field: "starts_at", Error: "session must take place within the event time"}},
+		}
+	}
+
+	speakers := []entities.SessionSpeaker{}
+	for i, speakerReq := range sessionRequest.Speakers {
+		speaker := entities.SessionSpeaker{Name: strings.TrimSpace(speakerReq.Name), Position: i}
+		if speakerReq.UserID != 0 {
+			user, err := service.userRepo.Find(int(speakerReq.UserID))
+			if err != nil {
+				return entities.EventSession{}, web.WebError{Code: 400, Message: "Speaker user is not exist"}
+			}
+			speaker.UserID = &user.ID
+		}
+		speakers = append(speakers, speaker)
+	}
+
+	session.Title = strings.TrimSpace(sessionRequest.Title)
+	session.Description = sessionRequest.Description
+	session.Room = strings.TrimSpace(sessionRequest.Room)
+	session.StartsAt = startsAt
+	session.EndsAt = endsAt
+	session.Speakers = speakers
+	return session, nil
+}
+
+// Participant yang menolak hadir tidak dapat menyusun agenda
+func isAttending(event entities.Event, userID int) bool {
+	for _, participant := range event.RSVPs {
+		if int(participant.UserID) == userID {
+			return participant.Status != participantService.RSVPNotGoing
+		}
+	}
+	return false
+}
@@ -0,0 +1,13 @@
+package session
+
+import "tupulung/entities"
+
+type SessionServiceInterface interface {
+	FindAll(eventID int, viewerID int, inviteCode string) ([]entities.EventSessionResponse, error)
+	Create(sessionRequest entities.EventSessionRequest, eventID int, userID int) (entities.EventSessionResponse, error)
+	Update(sessionRequest entities.EventSessionRequest, id int, userID int) (entities.EventSessionResponse, error)
+	Delete(id int, userID int) error
+	AddToAgenda(sessionID int, userID int) error
+	RemoveFromAgenda(sessionID int, userID int) error
+	Agenda(eventID int, userID int) ([]entities.EventSessionResponse, error)
+}
@@ -0,0 +1,242 @@
+package session_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	eventRepository "tupulung/repositories/event"
+	inviteRepository "tupulung/repositories/invite"
+	sessionRepository "tupulung/repositories/session"
+	userRepository "tupulung/repositories/user"
+	sessionService "tupulung/services/session"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func sampleEvent() entities.Event {
+	event := eventRepository.EventCollection[0]
+	event.DatetimeEvent = time.Date(2022, 5, 1, 0, 0, 0, 0, time.UTC)
+	event.RSVPs = []entities.Participant{
+		{EventID: event.ID, UserID: 2, Status: "going"},
+		{EventID: event.ID, UserID: 3, Status: "not_going"},
+	}
+	return event
+}
+
+func TestFindAll(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(sampleEvent(), nil)
+		sessionRepositoryMock := sessionRepository.NewSessionRepositoryMock(&mock.Mock{})
+		sessionRepositoryMock.Mock.On("FindByEvent").Return(sessionRepository.EventSessionCollection, nil)
+		sessionRepositoryMock.Mock.On("AgendaSessionIDs").Return([]uint{2}, nil)
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+
+		service := sessionService.NewSessionService(sessionRepositoryMock, eventRepositoryMock, userRepositoryMock, inviteRepository.NewInviteRepositoryMock(&mock.Mock{}))
+		data, err := service.FindAll(1, 2, "")
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(data))
+		assert.False(t, data[0].InAgenda)
+		assert.True(t, data[1].InAgenda)
+		assert.Equal(t, "Guest Speaker", data[1].Speakers[0].Name)
+	})
+	t.Run("guest", func(t *testing.T) {
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(sampleEvent(), nil)
+		sessionRepositoryMock := sessionRepository.NewSessionRepositoryMock(&mock.Mock{})
+		sessionRepositoryMock.Mock.On("FindByEvent").Return(sessionRepository.EventSessionCollection, nil)
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+
+		service := sessionService.NewSessionService(sessionRepositoryMock, eventRepositoryMock, userRepositoryMock, inviteRepository.NewInviteRepositoryMock(&mock.Mock{}))
+		data, err := service.FindAll(1, 0, "")
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(data))
+		sessionRepositoryMock.Mock.AssertNotCalled(t, "AgendaSessionIDs")
+	})
+	t.Run("private-event", func(t *testing.T) {
+		eventSample := sampleEvent()
+		eventSample.Visibility = "private"
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		sessionRepositoryMock := sessionRepository.NewSessionRepositoryMock(&mock.Mock{})
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+
+		inviteRepositoryMock := inviteRepository.NewInviteRepositoryMock(&mock.Mock{})
+		inviteRepositoryMock.Mock.On("FindUsableByUser").Return(entities.EventInvite{}, web.WebError{Code: 400})
+
+		service := sessionService.NewSessionService(sessionRepositoryMock, eventRepositoryMock, userRepositoryMock, inviteRepositoryMock)
+		_, err := service.FindAll(1, 5, "")
+
+		assert.Equal(t, web.WebError{Code: 400, Message: "cannot get event data with specified id"}, err)
+	})
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		eventSample := sampleEvent()
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		sessionRepositoryMock := sessionRepository.NewSessionRepositoryMock(&mock.Mock{})
+		sessionRepositoryMock.Mock.On("Store").Return(sessionRepository.EventSessionCollection[0], nil)
+		sessionRepositoryMock.Mock.On("Find").Return(sessionRepository.EventSessionCollection[0], nil)
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(userRepository.UserCollection[1], nil)
+
+		service := sessionService.NewSessionService(sessionRepositoryMock, eventRepositoryMock, userRepositoryMock, inviteRepository.NewInviteRepositoryMock(&mock.Mock{}))
+		data, err := service.Create(entities.EventSessionRequest{
+			Title:    "Opening keynote",
+			Room:     "Main hall",
+			StartsAt: "2022-05-01 09:00",
+			EndsAt:   "2022-05-01 10:00",
+			Speakers: []entities.SessionSpeakerRequest{{UserID: 2}},
+		}, int(eventSample.ID), int(eventSample.UserID))
+
+		assert.Nil(t, err)
+		assert.Equal(t, "Opening keynote", data.Title)
+	})
+	t.Run("not-host", func(t *testing.T) {
+		eventSample := sampleEvent()
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		sessionRepositoryMock := sessionRepository.NewSessionRepositoryMock(&mock.Mock{})
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+
+		service := sessionService.NewSessionService(sessionRepositoryMock, eventRepositoryMock, userRepositoryMock, inviteRepository.NewInviteRepositoryMock(&mock.Mock{}))
+		_, err := service.Create(entities.EventSessionRequest{
+			Title:    "Opening keynote",
+			StartsAt: "2022-05-01 09:00",
+			EndsAt:   "2022-05-01 10:00",
+		}, int(eventSample.ID), int(eventSample.UserID)+1)
+
+		assert.Equal(t, web.WebError{Code: 401, Message: "Only the host can manage the event agenda"}, err)
+		sessionRepositoryMock.Mock.AssertNotCalled(t, "Store")
+	})
+	t.Run("outside-event", func(t *testing.T) {
+		eventSample := sampleEvent()
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		sessionRepositoryMock := sessionRepository.NewSessionRepositoryMock(&mock.Mock{})
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+
+		service := sessionService.NewSessionService(sessionRepositoryMock, eventRepositoryMock, userRepositoryMock, inviteRepository.NewInviteRepositoryMock(&mock.Mock{}))
+		_, err := service.Create(entities.EventSessionRequest{
+			Title:    "After party",
+			StartsAt: "2022-05-01 23:00",
+			EndsAt:   "2022-05-02 01:00",
+		}, int(eventSample.ID), int(eventSample.UserID))
+
+		assert.Equal(t, web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors:  []web.ValidationErrorItem{{Field: "starts_at", Error: "session must take place within the event time"}},
+		}, err)
+		sessionRepositoryMock.Mock.AssertNotCalled(t, "Store")
+	})
+	t.Run("ends-before-starts", func(t *testing.T) {
+		eventSample := sampleEvent()
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		sessionRepositoryMock := sessionRepository.NewSessionRepositoryMock(&mock.Mock{})
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+
+		service := sessionService.NewSessionService(sessionRepositoryMock, eventRepositoryMock, userRepositoryMock, inviteRepository.NewInviteRepositoryMock(&mock.Mock{}))
+		_, err := service.Create(entities.EventSessionRequest{
+			Title:    "Opening keynote",
+			StartsAt: "2022-05-01 10:00",
+			EndsAt:   "2022-05-01 09:00",
+		}, int(eventSample.ID), int(eventSample.UserID))
+
+		assert.Equal(t, web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors:  []web.ValidationErrorItem{{Field: "ends_at", Error: "ends_at must be after starts_at"}},
+		}, err)
+	})
+	t.Run("speaker-without-name", func(t *testing.T) {
+		eventSample := sampleEvent()
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		sessionRepositoryMock := sessionRepository.NewSessionRepositoryMock(&mock.Mock{})
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+
+		service := sessionService.NewSessionService(sessionRepositoryMock, eventRepositoryMock, userRepositoryMock, inviteRepository.NewInviteRepositoryMock(&mock.Mock{}))
+		_, err := service.Create(entities.EventSessionRequest{
+			Title:    "Opening keynote",
+			StartsAt: "2022-05-01 09:00",
+			EndsAt:   "2022-05-01 10:00",
+			Speakers: []entities.SessionSpeakerRequest{{Name: " "}},
+		}, int(eventSample.ID), int(eventSample.UserID))
+
+		assert.Equal(t, web.ValidationError{
+			Code:    400,
+			Message: "Validation error",
+			Errors:  []web.ValidationErrorItem{{Field: "speakers[0]", Error: "speaker must have a user_id or a name"}},
+		}, err)
+	})
+	t.Run("speaker-user-not-exist", func(t *testing.T) {
+		eventSample := sampleEvent()
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		sessionRepositoryMock := sessionRepository.NewSessionRepositoryMock(&mock.Mock{})
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(entities.User{}, errors.New("not found"))
+
+		service := sessionService.NewSessionService(sessionRepositoryMock, eventRepositoryMock, userRepositoryMock, inviteRepository.NewInviteRepositoryMock(&mock.Mock{}))
+		_, err := service.Create(entities.EventSessionRequest{
+			Title:    "Opening keynote",
+			StartsAt: "2022-05-01 09:00",
+			EndsAt:   "2022-05-01 10:00",
+			Speakers: []entities.SessionSpeakerRequest{{UserID: 99}},
+		}, int(eventSample.ID), int(eventSample.UserID))
+
+		assert.Equal(t, web.WebError{Code: 400, Message: "Speaker user is not exist"}, err)
+		sessionRepositoryMock.Mock.AssertNotCalled(t, "Store")
+	})
+}
+
+func TestAddToAgenda(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(sampleEvent(), nil)
+		sessionRepositoryMock := sessionRepository.NewSessionRepositoryMock(&mock.Mock{})
+		sessionRepositoryMock.Mock.On("Find").Return(sessionRepository.EventSessionCollection[1], nil)
+		sessionRepositoryMock.Mock.On("AddToAgenda", uint(2)).Return(nil)
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+
+		service := sessionService.NewSessionService(sessionRepositoryMock, eventRepositoryMock, userRepositoryMock, inviteRepository.NewInviteRepositoryMock(&mock.Mock{}))
+		err := service.AddToAgenda(2, 2)
+
+		assert.Nil(t, err)
+		sessionRepositoryMock.Mock.AssertCalled(t, "AddToAgenda", uint(2))
+	})
+	t.Run("not-going", func(t *testing.T) {
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(sampleEvent(), nil)
+		sessionRepositoryMock := sessionRepository.NewSessionRepositoryMock(&mock.Mock{})
+		sessionRepositoryMock.Mock.On("Find").Return(sessionRepository.EventSessionCollection[1], nil)
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+
+		service := sessionService.NewSessionService(sessionRepositoryMock, eventRepositoryMock, userRepositoryMock, inviteRepository.NewInviteRepositoryMock(&mock.Mock{}))
+		err := service.AddToAgenda(2, 3)
+
+		assert.Equal(t, web.WebError{Code: 400, Message: "Only participants of the event can build an agenda"}, err)
+		sessionRepositoryMock.Mock.AssertNotCalled(t, "AddToAgenda", mock.Anything)
+	})
+	t.Run("not-participant", func(t *testing.T) {
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(sampleEvent(), nil)
+		sessionRepositoryMock := sessionRepository.NewSessionRepositoryMock(&mock.Mock{})
+		sessionRepositoryMock.Mock.On("Find").Return(sessionRepository.EventSessionCollection[1], nil)
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+
+		service := sessionService.NewSessionService(sessionRepositoryMock, eventRepositoryMock, userRepositoryMock, inviteRepository.NewInviteRepositoryMock(&mock.Mock{}))
+		err := service.AddToAgenda(2, 9)
+
+		assert.Equal(t, web.WebError{Code: 400, Message: "Only participants of the event can build an agenda"}, err)
+	})
+}
@@ -0,0 +1,96 @@
+package tag
+
+import (
+	"strconv"
+	"strings"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	tagRepository "tupulung/repositories/tag"
+)
+
+const (
+	MaxTagsPerEvent = 10
+	MaxTagLength    = 30
+)
+
+type TagService struct {
+	tagRepo tagRepository.TagRepositoryInterface
+}
+
+func NewTagService(tagRepo tagRepository.TagRepositoryInterface) *TagService {
+	return &TagService{
+		tagRepo: tagRepo,
+	}
+}
+
+/*
+ * Normalize Tags
+ * -------------------------------
+ * Menyeragamkan nama tag: huruf kecil, spasi & underscore menjadi "-",
+ * karakter selain huruf, angka, "-", "+" dan "#" dibuang.
+ * Setiap value boleh berisi beberapa tag yang dipisahkan koma
+ */
+func NormalizeTags(values []string) ([]string, error) {
+	names := []string{}
+	seen := map[string]bool{}
+	for _, value := range values {
+		for _, raw := range strings.Split(value, ",") {
+			name := NormalizeTag(raw)
+			if name == "" || seen[name] {
+				continue
+			}
+			if len(name) > MaxTagLength {
+				return []string{}, web.WebError{Code: 400, Message: "Tag " + name + " is longer than " + strconv.Itoa(MaxTagLength) + " characters"}
+			}
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	if len(names) > MaxTagsPerEvent {
+		return []string{}, web.WebError{Code: 400, Message: "An event can have at most " + strconv.Itoa(MaxTagsPerEvent) + " tags"}
+	}
+	return names, nil
+}
+
+func NormalizeTag(raw string) string {
+	builder := strings.Builder{}
+	for _, char := range strings.ToLower(strings.TrimSpace(raw)) {
+		switch {
+		case char >= 'a' && char <= 'z', char >= '0' && char <= '9', char == '+', char == '#':
+			builder.WriteRune(char)
+		case char == '-' || char == '_' || char == ' ':
+			if !strings.HasSuffix(builder.String(), "-") && builder.Len() > 0 {
+				builder.WriteRune('-')
+			}
+		}
+	}
+	return strings.TrimSuffix(builder.String(), "-")
+}
+
+/*
+ * Autocomplete
+ * -------------------------------
+ * Mencari tag berdasarkan awalan nama
+ */
+func (service TagService) Autocomplete(prefix string, limit int) ([]entities.TagResponse, error) {
+	prefix = NormalizeTag(prefix)
+	if prefix == "" {
+		return []entities.TagResponse{}, nil
+	}
+	if limit <= 0 || limit > 50 {
+		limit = 10
+	}
+	return service.tagRepo.Autocomplete(prefix, limit)
+}
+
+/*
+ * Popular
+ * -------------------------------
+ * Mengambil tag yang paling banyak dipakai
+ */
+func (service TagService) Popular(limit int) ([]entities.TagResponse, error) {
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	return service.tagRepo.Popular(limit)
+}
@@ -0,0 +1,8 @@
+package tag
+
+import "tupulung/entities"
+
+type TagServiceInterface interface {
+	Autocomplete(prefix string, limit int) ([]entities.TagResponse, error)
+	Popular(limit int) ([]entities.TagResponse, error)
+}
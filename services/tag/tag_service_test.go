@@ -0,0 +1,61 @@
+package tag_test
+
+import (
+	"testing"
+	"tupulung/entities"
+	tagRepository "tupulung/repositories/tag"
+	tagService "tupulung/services/tag"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		names, err := tagService.NormalizeTags([]string{" Go Lang ", "C++, c#", "go_lang", "", "Web--Dev!"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"go-lang", "c++", "c#", "web-dev"}, names)
+	})
+	t.Run("too-long", func(t *testing.T) {
+		_, err := tagService.NormalizeTags([]string{"this-tag-is-definitely-way-too-long-to-keep"})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestAutocomplete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tagRepositoryMock := tagRepository.NewTagRepositoryMock(&mock.Mock{})
+		tagRepositoryMock.Mock.On("Autocomplete").Return([]entities.TagResponse{{ID: 1, Name: "golang", Events: 3}}, nil)
+
+		service := tagService.NewTagService(tagRepositoryMock)
+		data, err := service.Autocomplete("Go", 5)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "golang", data[0].Name)
+	})
+	t.Run("empty-prefix", func(t *testing.T) {
+		tagRepositoryMock := tagRepository.NewTagRepositoryMock(&mock.Mock{})
+
+		service := tagService.NewTagService(tagRepositoryMock)
+		data, err := service.Autocomplete(" %% ", 5)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(data))
+		tagRepositoryMock.Mock.AssertNotCalled(t, "Autocomplete")
+	})
+}
+
+func TestPopular(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tagRepositoryMock := tagRepository.NewTagRepositoryMock(&mock.Mock{})
+		tagRepositoryMock.Mock.On("Popular").Return([]entities.TagResponse{{ID: 2, Name: "hackathon", Events: 7}}, nil)
+
+		service := tagService.NewTagService(tagRepositoryMock)
+		data, err := service.Popular(0)
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(7), data[0].Events)
+	})
+}
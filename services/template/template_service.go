@@ -0,0 +1,197 @@
+package template
+
+import (
+	"strings"
+	"tupulung/deliveries/validations"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	eventRepository "tupulung/repositories/event"
+	templateRepository "tupulung/repositories/template"
+	cohostService "tupulung/services/cohost"
+	tagService "tupulung/services/tag"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jinzhu/copier"
+)
+
+type TemplateService struct {
+	templateRepo templateRepository.TemplateRepositoryInterface
+	eventRepo    eventRepository.EventRepositoryInterface
+	validate     *validator.Validate
+}
+
+func NewTemplateService(templateRepo templateRepository.TemplateRepositoryInterface, eventRepo eventRepository.EventRepositoryInterface) *TemplateService {
+	return &TemplateService{
+		templateRepo: templateRepo,
+		eventRepo:    eventRepo,
+		validate:     validator.New(),
+	}
+}
+
+/*
+ * Find All
+ * -------------------------------
+ * Mengambil semua template milik user
+ */
+func (service TemplateService) FindAll(userID int) ([]entities.EventTemplateResponse, error) {
+	templates, err := service.templateRepo.FindByUser(userID)
+	if err != nil {
+		return []entities.EventTemplateResponse{}, err
+	}
+	templatesRes := []entities.EventTemplateResponse{}
+	for _, template := range templates {
+		templatesRes = append(templatesRes, ToTemplateResponse(template))
+	}
+	return templatesRes, nil
+}
+
+/*
+ * Find
+ * -------------------------------
+ * Mengambil template milik user berdasarkan ID
+ */
+func (service TemplateService) Find(id, userID int) (entities.EventTemplateResponse, error) {
+	template, err := service.findOwned(id, userID)
+	if err != nil {
+		return entities.EventTemplateResponse{}, err
+	}
+	return ToTemplateResponse(template), nil
+}
+
+/*
+ * Create
+ * -------------------------------
+ * Menyimpan template event baru milik user
+ */
+func (service TemplateService) Create(templateRequest entities.EventTemplateRequest, userID int) (entities.EventTemplateResponse, error) {
+	template, err := service.fillTemplate(templateRequest, entities.EventTemplate{})
+	if err != nil {
+		return entities.EventTemplateResponse{}, err
+	}
+	template.UserID = uint(userID)
+
+	template, err = service.templateRepo.Store(template)
+	if err != nil {
+		return entities.EventTemplateResponse{}, err
+	}
+	return ToTemplateResponse(template), nil
+}
+
+/*
+ * Create From Event
+ * -------------------------------
+ * Menyimpan event yang sudah ada sebagai template,
+ * hanya host dan co-host editor yang dapat melakukannya
+ */
+func (service TemplateService) CreateFromEvent(eventID int, name string, userID int) (entities.EventTemplateResponse, error) {
+	event, err := service.eventRepo.Find(eventID)
+	if err != nil {
+		return entities.EventTemplateResponse{}, web.WebError{Code: 400, Message: "Event is not exist"}
+	}
+	if !cohostService.Can(event, userID, cohostService.PermissionUpdate) {
+		return entities.EventTemplateResponse{}, web.WebError{Code: 401, Message: "Cannot save event that belongs to someone else as template"}
+	}
+
+	templateRequest := entities.EventTemplateRequest{}
+	copier.Copy(&templateRequest, &event)
+	templateRequest.Name = name
+	templateRequest.Tags = []string{}
+	for _, tag := range event.Tags {
+		templateRequest.Tags = append(templateRequest.Tags, tag.Name)
+	}
+	return service.Create(templateRequest, userID)
+}
+
+/*
+ * Update
+ * -------------------------------
+ * Mengganti seluruh isi template milik user
+ */
+func (service TemplateService) Update(templateRequest entities.EventTemplateRequest, id, userID int) (entities.EventTemplateResponse, error) {
+	template, err := service.findOwned(id, userID)
+	if err != nil {
+		return entities.EventTemplateResponse{}, err
+	}
+	template, err = service.fillTemplate(templateRequest, template)
+	if err != nil {
+		return entities.EventTemplateResponse{}, err
+	}
+
+	template, err = service.templateRepo.Update(template)
+	if err != nil {
+		return entities.EventTemplateResponse{}, err
+	}
+	return ToTemplateResponse(template), nil
+}
+
+/*
+ * Delete
+ * -------------------------------
+ * Menghapus template milik user
+ */
+func (service TemplateService) Delete(id, userID int) error {
+	_, err := service.findOwned(id, userID)
+	if err != nil {
+		return err
+	}
+	return service.templateRepo.Delete(id)
+}
+
+func (service TemplateService) findOwned(id, userID int) (entities.EventTemplate, error) {
+	template, err := service.templateRepo.Find(id)
+	if err != nil {
+		return entities.EventTemplate{}, err
+	}
+	// Template bersifat personal, template milik user lain dianggap tidak ada
+	if int(template.UserID) != userID {
+		return entities.EventTemplate{}, web.WebError{Code: 400, Message: "cannot get template data with specified id"}
+	}
+	return template, nil
+}
+
+func (service TemplateService) fillTemplate(templateRequest entities.EventTemplateRequest, template entities.EventTemplate) (entities.EventTemplate, error) {
+	err := validations.ValidateEventTemplateRequest(service.validate, templateRequest)
+	if err != nil {
+		return entities.EventTemplate{}, err
+	}
+	tagNames, err := tagService.NormalizeTags(templateRequest.Tags)
+	if err != nil {
+		return entities.EventTemplate{}, err
+	}
+
+	template.Name = templateRequest.Name
+	template.Title = templateRequest.Title
+	template.HostedBy = templateRequest.HostedBy
+	template.CategoryID = templateRequest.CategoryID
+	template.Location = templateRequest.Location
+	template.Description = templateRequest.Description
+	template.Capacity = templateRequest.Capacity
+	template.Visibility = templateRequest.Visibility
+	template.Tags = strings.Join(tagNames, ",")
+	return template, nil
+}
+
+/*
+ * To Template Response
+ * -------------------------------
+ * Mengubah template menjadi response, tags disimpan
+ * sebagai daftar nama yang dipisahkan koma
+ */
+func ToTemplateResponse(template entities.EventTemplate) entities.EventTemplateResponse {
+	templateRes := entities.EventTemplateResponse{}
+	copier.Copy(&templateRes, &template)
+	templateRes.Tags = TemplateTags(template)
+	return templateRes
+}
+
+/*
+ * Template Tags
+ * -------------------------------
+ * Mengambil daftar nama tag dari template
+ */
+func TemplateTags(template entities.EventTemplate) []string {
+	if template.Tags == "" {
+		return []string{}
+	}
+	return strings.Split(template.Tags, ",")
+}
@@ -0,0 +1,12 @@
+package template
+
+import "tupulung/entities"
+
+type TemplateServiceInterface interface {
+	FindAll(userID int) ([]entities.EventTemplateResponse, error)
+	Find(id, userID int) (entities.EventTemplateResponse, error)
+	Create(templateRequest entities.EventTemplateRequest, userID int) (entities.EventTemplateResponse, error)
+	CreateFromEvent(eventID int, name string, userID int) (entities.EventTemplateResponse, error)
+	Update(templateRequest entities.EventTemplateRequest, id, userID int) (entities.EventTemplateResponse, error)
+	Delete(id, userID int) error
+}
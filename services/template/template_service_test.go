@@ -0,0 +1,101 @@
+package template_test
+
+import (
+	"testing"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	eventRepository "tupulung/repositories/event"
+	tagRepository "tupulung/repositories/tag"
+	templateRepository "tupulung/repositories/template"
+	templateService "tupulung/services/template"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFindAll(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		templateRepositoryMock := templateRepository.NewTemplateRepositoryMock(&mock.Mock{})
+		templateRepositoryMock.Mock.On("FindByUser").Return(templateRepository.EventTemplateCollection[:1], nil)
+
+		service := templateService.NewTemplateService(templateRepositoryMock, eventRepository.NewEventRepositoryMock(&mock.Mock{}))
+		data, err := service.FindAll(1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(data))
+		assert.Equal(t, []string{"golang", "meetup"}, data[0].Tags)
+	})
+}
+
+func TestFind(t *testing.T) {
+	t.Run("other-user", func(t *testing.T) {
+		templateRepositoryMock := templateRepository.NewTemplateRepositoryMock(&mock.Mock{})
+		templateRepositoryMock.Mock.On("Find").Return(templateRepository.EventTemplateCollection[1], nil)
+
+		service := templateService.NewTemplateService(templateRepositoryMock, eventRepository.NewEventRepositoryMock(&mock.Mock{}))
+		_, err := service.Find(2, 1)
+
+		assert.Equal(t, 400, err.(web.WebError).Code)
+	})
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		templateRepositoryMock := templateRepository.NewTemplateRepositoryMock(&mock.Mock{})
+		templateRepositoryMock.Mock.On("Store").Return(templateRepository.EventTemplateCollection[0], nil)
+
+		service := templateService.NewTemplateService(templateRepositoryMock, eventRepository.NewEventRepositoryMock(&mock.Mock{}))
+		data, err := service.Create(entities.EventTemplateRequest{Name: "Monthly meetup", Tags: []string{"Golang", "meetup"}}, 1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "Monthly meetup", data.Name)
+	})
+	t.Run("validation-failed", func(t *testing.T) {
+		service := templateService.NewTemplateService(templateRepository.NewTemplateRepositoryMock(&mock.Mock{}), eventRepository.NewEventRepositoryMock(&mock.Mock{}))
+		_, err := service.Create(entities.EventTemplateRequest{Visibility: "secret"}, 1)
+
+		valErr, ok := err.(web.ValidationError)
+		assert.True(t, ok)
+		assert.Equal(t, 2, len(valErr.Errors))
+	})
+}
+
+func TestCreateFromEvent(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventSample.Tags = tagRepository.TagCollection
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		templateRepositoryMock := templateRepository.NewTemplateRepositoryMock(&mock.Mock{})
+		templateRepositoryMock.Mock.On("Store").Return(entities.EventTemplate{ID: 3, UserID: eventSample.UserID, Name: "Copy", Title: eventSample.Title, Tags: "golang,hackathon"}, nil)
+
+		service := templateService.NewTemplateService(templateRepositoryMock, eventRepositoryMock)
+		data, err := service.CreateFromEvent(1, "Copy", int(eventSample.UserID))
+
+		assert.Nil(t, err)
+		assert.Equal(t, eventSample.Title, data.Title)
+		assert.Equal(t, []string{"golang", "hackathon"}, data.Tags)
+	})
+	t.Run("not-host", func(t *testing.T) {
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventRepository.EventCollection[0], nil)
+
+		service := templateService.NewTemplateService(templateRepository.NewTemplateRepositoryMock(&mock.Mock{}), eventRepositoryMock)
+		_, err := service.CreateFromEvent(1, "Copy", 99)
+
+		assert.Equal(t, 401, err.(web.WebError).Code)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		templateRepositoryMock := templateRepository.NewTemplateRepositoryMock(&mock.Mock{})
+		templateRepositoryMock.Mock.On("Find").Return(templateRepository.EventTemplateCollection[0], nil)
+		templateRepositoryMock.Mock.On("Delete").Return(nil)
+
+		service := templateService.NewTemplateService(templateRepositoryMock, eventRepository.NewEventRepositoryMock(&mock.Mock{}))
+		err := service.Delete(1, 1)
+
+		assert.Nil(t, err)
+	})
+}
@@ -0,0 +1,165 @@
+package ticket
+
+import (
+	"time"
+	"tupulung/deliveries/validations"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	eventRepository "tupulung/repositories/event"
+	ticketRepository "tupulung/repositories/ticket"
+	cohostService "tupulung/services/cohost"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jinzhu/copier"
+)
+
+type TicketService struct {
+	ticketRepo ticketRepository.TicketRepositoryInterface
+	eventRepo  eventRepository.EventRepositoryInterface
+	validate   *validator.Validate
+}
+
+func NewTicketService(ticketRepo ticketRepository.TicketRepositoryInterface, eventRepo eventRepository.EventRepositoryInterface) *TicketService {
+	return &TicketService{
+		ticketRepo: ticketRepo,
+		eventRepo:  eventRepo,
+		validate:   validator.New(),
+	}
+}
+
+/*
+ * Find All
+ * -------------------------------
+ * Mengambil semua tipe tiket sebuah event beserta sisa tiketnya
+ */
+func (service TicketService) FindAll(eventID int) ([]entities.TicketTypeResponse, error) {
+	ticketTypes, err := service.ticketRepo.FindByEvent(eventID)
+	if err != nil {
+		return []entities.TicketTypeResponse{}, err
+	}
+	ticketTypesRes := []entities.TicketTypeResponse{}
+	copier.Copy(&ticketTypesRes, &ticketTypes)
+	return ticketTypesRes, nil
+}
+
+/*
+ * Create
+ * -------------------------------
+ * Membuat tipe tiket baru, hanya host & co-host editor yang dapat membuat
+ */
+func (service TicketService) Create(ticketTypeRequest entities.TicketTypeRequest, eventID int, userID int) (entities.TicketTypeResponse, error) {
+	// Validation
+	err := validations.ValidateTicketTypeRequest(service.validate, ticketTypeRequest)
+	if err != nil {
+		return entities.TicketTypeResponse{}, err
+	}
+
+	event, err := service.eventRepo.Find(eventID)
+	if err != nil {
+		return entities.TicketTypeResponse{}, web.WebError{Code: 400, Message: "Event is not exist"}
+	}
+	if !cohostService.Can(event, userID, cohostService.PermissionUpdate) {
+		return entities.TicketTypeResponse{}, web.WebError{Code: 401, Message: "Cannot manage tickets of event that belongs to someone else"}
+	}
+
+	ticketType := entities.TicketType{EventID: event.ID}
+	err = fillTicketType(&ticketType, ticketTypeRequest)
+	if err != nil {
+		return entities.TicketTypeResponse{}, err
+	}
+
+	// Repository action
+	ticketType, err = service.ticketRepo.Store(ticketType)
+	if err != nil {
+		return entities.TicketTypeResponse{}, err
+	}
+	ticketTypeRes := entities.TicketTypeResponse{}
+	copier.Copy(&ticketTypeRes, &ticketType)
+	return ticketTypeRes, nil
+}
+
+/*
+ * Update
+ * -------------------------------
+ * Mengubah tipe tiket, jumlah tiket tidak boleh
+ * kurang dari tiket yang sudah terjual & dipesan
+ */
+func (service TicketService) Update(ticketTypeRequest entities.TicketTypeRequest, id int, userID int) (entities.TicketTypeResponse, error) {
+	// Validation
+	err := validations.ValidateTicketTypeRequest(service.validate, ticketTypeRequest)
+	if err != nil {
+		return entities.TicketTypeResponse{}, err
+	}
+
+	ticketType, err := service.ticketRepo.Find(id)
+	if err != nil {
+		return entities.TicketTypeResponse{}, web.WebError{Code: 400, Message: "The requested ID doesn't match with any record"}
+	}
+	if !cohostService.Can(ticketType.Event, userID, cohostService.PermissionUpdate) {
+		return entities.TicketTypeResponse{}, web.WebError{Code: 401, Message: "Cannot manage tickets of event that belongs to someone else"}
+	}
+	if ticketTypeRequest.Quantity < ticketType.Sold+ticketType.Reserved {
+		return entities.TicketTypeResponse{}, web.WebError{Code: 400, Message: "Quantity cannot be less than tickets already sold or reserved"}
+	}
+	if ticketType.Sold+ticketType.Reserved > 0 && (ticketTypeRequest.Price != ticketType.Price || ticketTypeRequest.Currency != ticketType.Currency) {
+		return entities.TicketTypeResponse{}, web.WebError{Code: 400, Message: "Price cannot be changed after tickets are ordered"}
+	}
+
+	err = fillTicketType(&ticketType, ticketTypeRequest)
+	if err != nil {
+		return entities.TicketTypeResponse{}, err
+	}
+
+	// Repository action
+	ticketType, err = service.ticketRepo.Update(ticketType, id)
+	if err != nil {
+		return entities.TicketTypeResponse{}, err
+	}
+	ticketTypeRes := entities.TicketTypeResponse{}
+	copier.Copy(&ticketTypeRes, &ticketType)
+	return ticketTypeRes, nil
+}
+
+/*
+ * Delete
+ * -------------------------------
+ * Menghapus tipe tiket yang belum pernah dipesan
+ */
+func (service TicketService) Delete(id int, userID int) error {
+	ticketType, err := service.ticketRepo.Find(id)
+	if err != nil {
+		return web.WebError{Code: 400, Message: "The requested ID doesn't match with any record"}
+	}
+	if !cohostService.Can(ticketType.Event, userID, cohostService.PermissionUpdate) {
+		return web.WebError{Code: 401, Message: "Cannot manage tickets of event that belongs to someone else"}
+	}
+	if ticketType.Sold+ticketType.Reserved > 0 {
+		return web.WebError{Code: 400, Message: "Cannot delete ticket type that already has orders"}
+	}
+	return service.ticketRepo.Delete(id)
+}
+
+func fillTicketType(ticketType *entities.TicketType, ticketTypeRequest entities.TicketTypeRequest) error {
+	ticketType.Name = ticketTypeRequest.Name
+	ticketType.Description = ticketTypeRequest.Description
+	ticketType.Price = ticketTypeRequest.Price
+	ticketType.Currency = ticketTypeRequest.Currency
+	ticketType.Quantity = ticketTypeRequest.Quantity
+	ticketType.SalesStart = nil
+	ticketType.SalesEnd = nil
+	if ticketTypeRequest.SalesStart != "" {
+		salesStart, err := time.ParseInLocation("2006-01-02 15:04", ticketTypeRequest.SalesStart, time.Local)
+		if err != nil {
+			return web.WebError{Code: 400, Message: "sales_start format is invalid"}
+		}
+		ticketType.SalesStart = &salesStart
+	}
+	if ticketTypeRequest.SalesEnd != "" {
+		salesEnd, err := time.ParseInLocation("2006-01-02 15:04", ticketTypeRequest.SalesEnd, time.Local)
+		if err != nil {
+			return web.WebError{Code: 400, Message: "sales_end format is invalid"}
+		}
+		ticketType.SalesEnd = &salesEnd
+	}
+	return nil
+}
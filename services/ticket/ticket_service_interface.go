@@ -0,0 +1,10 @@
+package ticket
+
+import "tupulung/entities"
+
+type TicketServiceInterface interface {
+	FindAll(eventID int) ([]entities.TicketTypeResponse, error)
+	Create(ticketTypeRequest entities.TicketTypeRequest, eventID int, userID int) (entities.TicketTypeResponse, error)
+	Update(ticketTypeRequest entities.TicketTypeRequest, id int, userID int) (entities.TicketTypeResponse, error)
+	Delete(id int, userID int) error
+}
@@ -0,0 +1,140 @@
+package ticket_test
+
+import (
+	"testing"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	eventRepository "tupulung/repositories/event"
+	ticketRepository "tupulung/repositories/ticket"
+	ticketService "tupulung/services/ticket"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFindAll(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ticketRepositoryMock := ticketRepository.NewTicketRepositoryMock(&mock.Mock{})
+		ticketRepositoryMock.Mock.On("FindByEvent").Return(ticketRepository.TicketTypeCollection, nil)
+
+		service := ticketService.NewTicketService(ticketRepositoryMock, eventRepository.NewEventRepositoryMock(&mock.Mock{}))
+		data, err := service.FindAll(1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, len(ticketRepository.TicketTypeCollection), len(data))
+		assert.Equal(t, uint(88), data[0].Available)
+	})
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		ticketRepositoryMock := ticketRepository.NewTicketRepositoryMock(&mock.Mock{})
+		ticketRepositoryMock.Mock.On("Store").Return(ticketRepository.TicketTypeCollection[1], nil)
+
+		service := ticketService.NewTicketService(ticketRepositoryMock, eventRepositoryMock)
+		data, err := service.Create(entities.TicketTypeRequest{
+			Name:       "Community",
+			Currency:   "IDR",
+			Quantity:   20,
+			SalesStart: "2022-01-01 10:00",
+			SalesEnd:   "2022-01-10 10:00",
+		}, int(eventSample.ID), int(eventSample.UserID))
+
+		assert.Nil(t, err)
+		assert.Equal(t, ticketRepository.TicketTypeCollection[1].Name, data.Name)
+	})
+	t.Run("validation-fail", func(t *testing.T) {
+		ticketRepositoryMock := ticketRepository.NewTicketRepositoryMock(&mock.Mock{})
+
+		service := ticketService.NewTicketService(ticketRepositoryMock, eventRepository.NewEventRepositoryMock(&mock.Mock{}))
+		_, err := service.Create(entities.TicketTypeRequest{
+			Name:       "Regular",
+			Currency:   "Rupiah",
+			Quantity:   10,
+			SalesStart: "2022-01-10 10:00",
+			SalesEnd:   "2022-01-01 10:00",
+		}, 1, 1)
+
+		assert.Equal(t, 2, len(err.(web.ValidationError).Errors))
+		ticketRepositoryMock.Mock.AssertNotCalled(t, "Store")
+	})
+	t.Run("not-host", func(t *testing.T) {
+		eventSample := eventRepository.EventCollection[0]
+		eventRepositoryMock := eventRepository.NewEventRepositoryMock(&mock.Mock{})
+		eventRepositoryMock.Mock.On("Find").Return(eventSample, nil)
+		ticketRepositoryMock := ticketRepository.NewTicketRepositoryMock(&mock.Mock{})
+
+		service := ticketService.NewTicketService(ticketRepositoryMock, eventRepositoryMock)
+		_, err := service.Create(entities.TicketTypeRequest{Name: "Regular", Currency: "IDR", Quantity: 10}, int(eventSample.ID), 2)
+
+		assert.Error(t, err)
+		ticketRepositoryMock.Mock.AssertNotCalled(t, "Store")
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ticketTypeSample := ticketRepository.TicketTypeCollection[0]
+		ticketTypeSample.Event = eventRepository.EventCollection[0]
+		ticketRepositoryMock := ticketRepository.NewTicketRepositoryMock(&mock.Mock{})
+		ticketRepositoryMock.Mock.On("Find").Return(ticketTypeSample, nil)
+		ticketRepositoryMock.Mock.On("Update").Return(ticketTypeSample, nil)
+
+		service := ticketService.NewTicketService(ticketRepositoryMock, eventRepository.NewEventRepositoryMock(&mock.Mock{}))
+		_, err := service.Update(entities.TicketTypeRequest{
+			Name:     "Regular",
+			Price:    ticketTypeSample.Price,
+			Currency: ticketTypeSample.Currency,
+			Quantity: 150,
+		}, int(ticketTypeSample.ID), int(ticketTypeSample.Event.UserID))
+
+		assert.Nil(t, err)
+	})
+	t.Run("below-sold", func(t *testing.T) {
+		ticketTypeSample := ticketRepository.TicketTypeCollection[0]
+		ticketTypeSample.Event = eventRepository.EventCollection[0]
+		ticketRepositoryMock := ticketRepository.NewTicketRepositoryMock(&mock.Mock{})
+		ticketRepositoryMock.Mock.On("Find").Return(ticketTypeSample, nil)
+
+		service := ticketService.NewTicketService(ticketRepositoryMock, eventRepository.NewEventRepositoryMock(&mock.Mock{}))
+		_, err := service.Update(entities.TicketTypeRequest{
+			Name:     "Regular",
+			Price:    ticketTypeSample.Price,
+			Currency: ticketTypeSample.Currency,
+			Quantity: 5,
+		}, int(ticketTypeSample.ID), int(ticketTypeSample.Event.UserID))
+
+		assert.Equal(t, web.WebError{Code: 400, Message: "Quantity cannot be less than tickets already sold or reserved"}, err)
+		ticketRepositoryMock.Mock.AssertNotCalled(t, "Update")
+	})
+}
+
+func TestDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ticketTypeSample := ticketRepository.TicketTypeCollection[1]
+		ticketTypeSample.Event = eventRepository.EventCollection[0]
+		ticketRepositoryMock := ticketRepository.NewTicketRepositoryMock(&mock.Mock{})
+		ticketRepositoryMock.Mock.On("Find").Return(ticketTypeSample, nil)
+		ticketRepositoryMock.Mock.On("Delete").Return(nil)
+
+		service := ticketService.NewTicketService(ticketRepositoryMock, eventRepository.NewEventRepositoryMock(&mock.Mock{}))
+		err := service.Delete(int(ticketTypeSample.ID), int(ticketTypeSample.Event.UserID))
+
+		assert.Nil(t, err)
+	})
+	t.Run("has-orders", func(t *testing.T) {
+		ticketTypeSample := ticketRepository.TicketTypeCollection[0]
+		ticketTypeSample.Event = eventRepository.EventCollection[0]
+		ticketRepositoryMock := ticketRepository.NewTicketRepositoryMock(&mock.Mock{})
+		ticketRepositoryMock.Mock.On("Find").Return(ticketTypeSample, nil)
+
+		service := ticketService.NewTicketService(ticketRepositoryMock, eventRepository.NewEventRepositoryMock(&mock.Mock{}))
+		err := service.Delete(int(ticketTypeSample.ID), int(ticketTypeSample.Event.UserID))
+
+		assert.Error(t, err)
+		ticketRepositoryMock.Mock.AssertNotCalled(t, "Delete")
+	})
+}
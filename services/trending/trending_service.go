@@ -0,0 +1,94 @@
+package trending
+
+import (
+	"sort"
+	"time"
+	"tupulung/entities"
+	trendingRepository "tupulung/repositories/trending"
+)
+
+const (
+	// Interval perhitungan ulang skor trending
+	RecomputeInterval = 15 * time.Minute
+	// Interaksi yang lebih lama dari Window tidak dihitung
+	Window = 7 * 24 * time.Hour
+	// Bobot interaksi berkurang setengah setiap HalfLife
+	HalfLife = 48 * time.Hour
+)
+
+/*
+ * Activity weights
+ * -------------------------------
+ * Bobot setiap jenis interaksi pada skor trending
+ */
+var activityWeights = map[string]float64{
+	"view":    1,
+	"comment": 2,
+	"like":    3,
+	"join":    5,
+}
+
+type TrendingService struct {
+	trendingRepo trendingRepository.TrendingRepositoryInterface
+}
+
+func NewTrendingService(trendingRepo trendingRepository.TrendingRepositoryInterface) *TrendingService {
+	return &TrendingService{
+		trendingRepo: trendingRepo,
+	}
+}
+
+/*
+ * Recompute
+ * -------------------------------
+ * Menghitung ulang skor trending semua event dari like, join,
+ * komentar dan view dalam Window terakhir lalu menyimpannya
+ * ke tabel event_scores. Dijalankan secara berkala oleh scheduler
+ */
+func (service TrendingService) Recompute(now time.Time) ([]entities.EventScore, error) {
+	activities, err := service.trendingRepo.Activity(now.Add(-Window), now, HalfLife)
+	if err != nil {
+		return []entities.EventScore{}, err
+	}
+
+	scoresByEvent := map[uint]*entities.EventScore{}
+	for _, activity := range activities {
+		score, ok := scoresByEvent[activity.EventID]
+		if !ok {
+			score = &entities.EventScore{EventID: activity.EventID, ComputedAt: now}
+			scoresByEvent[activity.EventID] = score
+		}
+		score.Score += activityWeights[activity.Kind] * activity.Decayed
+		switch activity.Kind {
+		case "like":
+			score.Likes += activity.Count
+		case "join":
+			score.Joins += activity.Count
+		case "comment":
+			score.Comments += activity.Count
+		case "view":
+			score.Views += activity.Count
+		}
+	}
+
+	scores := []entities.EventScore{}
+	for _, score := range scoresByEvent {
+		scores = append(scores, *score)
+	}
+	sort.Slice(scores, func(i, j int) bool {
+		if scores[i].Score == scores[j].Score {
+			return scores[i].EventID < scores[j].EventID
+		}
+		return scores[i].Score > scores[j].Score
+	})
+
+	err = service.trendingRepo.ReplaceScores(scores)
+	if err != nil {
+		return []entities.EventScore{}, err
+	}
+	err = service.trendingRepo.PruneViews(now.Add(-Window))
+	if err != nil {
+		return []entities.EventScore{}, err
+	}
+	return scores, nil
+}
@@ -0,0 +1,10 @@
+package trending
+
+import (
+	"time"
+	"tupulung/entities"
+)
+
+type TrendingServiceInterface interface {
+	Recompute(now time.Time) ([]entities.EventScore, error)
+}
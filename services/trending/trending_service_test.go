@@ -0,0 +1,55 @@
+package trending_test
+
+import (
+	"testing"
+	"time"
+	"tupulung/entities"
+	"tupulung/entities/web"
+	trendingRepository "tupulung/repositories/trending"
+	trendingService "tupulung/services/trending"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRecompute(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		trendingRepositoryMock := trendingRepository.NewTrendingRepositoryMock(&mock.Mock{})
+		trendingRepositoryMock.Mock.On("Activity").Return(trendingRepository.EventActivityCollection, nil)
+		trendingRepositoryMock.Mock.On("ReplaceScores", mock.Anything).Return(nil)
+		trendingRepositoryMock.Mock.On("PruneViews").Return(nil)
+
+		now := time.Now()
+		service := trendingService.NewTrendingService(trendingRepositoryMock)
+		scores, err := service.Recompute(now)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []entities.EventScore{
+			{EventID: 2, Score: 5*5 + 2*1.5, Joins: 6, Comments: 3, ComputedAt: now},
+			{EventID: 1, Score: 3*2.5 + 1*12, Likes: 4, Views: 20, ComputedAt: now},
+		}, scores)
+		trendingRepositoryMock.Mock.AssertCalled(t, "ReplaceScores", scores)
+	})
+	t.Run("no-activity", func(t *testing.T) {
+		trendingRepositoryMock := trendingRepository.NewTrendingRepositoryMock(&mock.Mock{})
+		trendingRepositoryMock.Mock.On("Activity").Return([]entities.EventActivity{}, nil)
+		trendingRepositoryMock.Mock.On("ReplaceScores", []entities.EventScore{}).Return(nil)
+		trendingRepositoryMock.Mock.On("PruneViews").Return(nil)
+
+		service := trendingService.NewTrendingService(trendingRepositoryMock)
+		scores, err := service.Recompute(time.Now())
+
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(scores))
+	})
+	t.Run("repo-fail", func(t *testing.T) {
+		trendingRepositoryMock := trendingRepository.NewTrendingRepositoryMock(&mock.Mock{})
+		trendingRepositoryMock.Mock.On("Activity").Return([]entities.EventActivity{}, web.WebError{Code: 500, Message: "server error"})
+
+		service := trendingService.NewTrendingService(trendingRepositoryMock)
+		_, err := service.Recompute(time.Now())
+
+		assert.Error(t, err)
+		trendingRepositoryMock.Mock.AssertNotCalled(t, "ReplaceScores", mock.Anything)
+	})
+}
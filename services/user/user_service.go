@@ -11,7 +11,10 @@ import (
 	entity "tupulung/entities"
 	"tupulung/entities/web"
 	eventRepository "tupulung/repositories/event"
+	reviewRepository "tupulung/repositories/review"
 	userRepository "tupulung/repositories/user"
+	participantService "tupulung/services/participant"
+	reviewService "tupulung/services/review"
 	storageProvider "tupulung/utilities/storage"
 
 	"github.com/go-playground/validator/v10"
@@ -21,23 +24,26 @@ import (
 )
 
 type UserService struct {
-	userRepo  userRepository.UserRepositoryInterface
-	eventRepo eventRepository.EventRepositoryInterface
-	validate  *validator.Validate
+	userRepo   userRepository.UserRepositoryInterface
+	eventRepo  eventRepository.EventRepositoryInterface
+	reviewRepo reviewRepository.ReviewRepositoryInterface
+	validate   *validator.Validate
 }
 
-func NewUserService(repository userRepository.UserRepositoryInterface, eventRepo eventRepository.EventRepositoryInterface) *UserService {
+func NewUserService(repository userRepository.UserRepositoryInterface, eventRepo eventRepository.EventRepositoryInterface, reviewRepo reviewRepository.ReviewRepositoryInterface) *UserService {
 	return &UserService{
-		userRepo:  repository,
-		eventRepo: eventRepo,
-		validate:  validator.New(),
+		userRepo:   repository,
+		eventRepo:  eventRepo,
+		reviewRepo: reviewRepo,
+		validate:   validator.New(),
 	}
 }
 
 /*
  * User Service - Find
  * -------------------------------
- * Mencari user berdasarkan ID
+ * Mencari user berdasarkan ID beserta reputasinya sebagai host,
+ * email disembunyikan jika user memilih hide email
  */
 func (service UserService) Find(id int) (entity.UserResponse, error) {
 
@@ -47,8 +53,18 @@ func (service UserService) Find(id int) (entity.UserResponse, error) {
 	// proses menjadi user response
 	userRes := entity.UserResponse{}
 	copier.Copy(&userRes, &user)
+	if err != nil {
+		return userRes, err
+	}
+	userRes.Email = participantService.VisibleEmail(user)
 
-	return userRes, err
+	// Reputasi hanya pelengkap profil, kegagalan tidak menggagalkan response
+	reputation, err := service.reviewRepo.HostReputation(id)
+	if err == nil {
+		reputationRes := reviewService.Reputation(reputation)
+		userRes.Reputation = &reputationRes
+	}
+	return userRes, nil
 }
 
 /*
@@ -67,6 +83,9 @@ func (service UserService) GetJoinedEvents(userID int) ([]entity.EventResponse,
 	// proses menjadi user response
 	eventRes := []entity.EventResponse{}
 	copier.Copy(&eventRes, &events)
+	for i, event := range events {
+		eventRes[i].User.Email = participantService.VisibleEmail(event.User)
+	}
 
 	return eventRes, err
 }
@@ -107,6 +126,14 @@ func (service UserService) Create(userRequest entity.UserRequest, avatar *multip
 	}
 	user.Password = string(hashedPassword)
 
+	// Privasi email dan pilihan berhenti menerima reminder
+	if userRequest.HideEmail != nil {
+		user.HideEmail = *userRequest.HideEmail
+	}
+	if userRequest.ReminderOptOut != nil {
+		user.ReminderOptOut = *userRequest.ReminderOptOut
+	}
+
 	// Upload avatar if exists
 	if avatar != nil {
 
@@ -126,7 +153,7 @@ func (service UserService) Create(userRequest entity.UserRequest, avatar *multip
 	}
 
 	// Konversi hasil repository menjadi user response
-	userRes := entity.UserResponse{}
+	userRes := entity.UserProfileResponse{}
 	copier.Copy(&userRes, &user)
 
 	// generate token
@@ -148,7 +175,7 @@ func (service UserService) Create(userRequest entity.UserRequest, avatar *multip
  * -------------------------------
  * Edit data user / edit profile
  */
-func (service UserService) Update(userRequest entity.UserRequest, userID int, avatar *multipart.FileHeader, storageProvider storageProvider.StorageInterface) (entity.UserResponse, error) {
+func (service UserService) Update(userRequest entity.UserRequest, userID int, avatar *multipart.FileHeader, storageProvider storageProvider.StorageInterface) (entity.UserProfileResponse, error) {
 
 	// validation
 	userFiles := []*multipart.FileHeader{}
@@ -157,20 +184,20 @@ func (service UserService) Update(userRequest entity.UserRequest, userID int, av
 	}
 	err := validations.ValidateUpdateUserRequest(userFiles)
 	if err != nil {
-		return entity.UserResponse{}, err
+		return entity.UserProfileResponse{}, err
 	}
 
 	// Get user by ID via repository
 	user, err := service.userRepo.Find(userID)
 	if err != nil {
-		return entity.UserResponse{}, web.WebError{Code: 400, Message: "The requested ID doesn't match with any record"}
+		return entity.UserProfileResponse{}, web.WebError{Code: 400, Message: "The requested ID doesn't match with any record"}
 	}
 
 	// Hanya hash password jika password juga diganti (tidak kosong)
 	if userRequest.Password != "" {
 		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(userRequest.Password), bcrypt.DefaultCost)
 		if err != nil {
-			return entity.UserResponse{}, web.WebError{Code: 500, Message: "server error: hashing failed"}
+			return entity.UserProfileResponse{}, web.WebError{Code: 500, Message: "server error: hashing failed"}
 		}
 		user.Password = string(hashedPassword)
 	}
@@ -189,19 +216,25 @@ func (service UserService) Update(userRequest entity.UserRequest, userID int, av
 		filename := uuid.New().String() + avatar.Filename
 		avatarURL, err := storageProvider.UploadFromRequest("avatar/"+filename, avatar)
 		if err != nil {
-			return entity.UserResponse{}, web.WebError{Code: 500, Message: err.Error()}
+			return entity.UserProfileResponse{}, web.WebError{Code: 500, Message: err.Error()}
 		}
 		user.Avatar = avatarURL
 	}
 
 	// Konversi dari request ke domain entity user - mengabaikan nilai kosong pada request
 	copier.CopyWithOption(&user, &userRequest, copier.Option{IgnoreEmpty: true, DeepCopy: true})
+	if userRequest.HideEmail != nil {
+		user.HideEmail = *userRequest.HideEmail
+	}
+	if userRequest.ReminderOptOut != nil {
+		user.ReminderOptOut = *userRequest.ReminderOptOut
+	}
 
 	// Update via repository
 	user, err = service.userRepo.Update(user, userID)
 
 	// Konversi user domain menjadi user response
-	userRes := entity.UserResponse{}
+	userRes := entity.UserProfileResponse{}
 	copier.Copy(&userRes, &user)
 
 	return userRes, err
@@ -242,3 +275,39 @@ func (service UserService) Delete(userID int, storageProvider storageProvider.St
 	err = service.userRepo.Delete(userID)
 	return err
 }
+
+/*
+ * User Service - Suspend
+ * -------------------------------
+ * Menangguhkan user hingga waktu tertentu, user yang
+ * ditangguhkan tidak bisa login. Hak akses moderator
+ * dicek oleh service moderation
+ */
+func (service UserService) Suspend(userID int, until time.Time) error {
+
+	// Cari user berdasarkan ID via repo
+	user, err := service.userRepo.Find(userID)
+	if err != nil {
+		return web.WebError{Code: 400, Message: "The requested ID doesn't match with any record"}
+	}
+	user.SuspendedUntil = &until
+	user.Events = nil
+
+	// Update via repository
+	_, err = service.userRepo.Update(user, userID)
+	return err
+}
+
+/*
+ * User Service - Is Suspended
+ * -------------------------------
+ * Mengecek apakah user sedang ditangguhkan, digunakan
+ * middleware JWT untuk menolak token user tersebut
+ */
+func (service UserService) IsSuspended(userID int) bool {
+	user, err := service.userRepo.Find(userID)
+	if err != nil {
+		return false
+	}
+	return user.SuspendedUntil != nil && user.SuspendedUntil.After(time.Now())
+}
@@ -10,6 +10,6 @@ type UserServiceInterface interface {
 	Find(id int) (entity.UserResponse, error)
 	GetJoinedEvents(userID int) ([]entity.EventResponse, error)
 	Create(userRequest entity.UserRequest, avatar *multipart.FileHeader, storastorageProvider storageProvider.StorageInterface) (entity.AuthResponse, error)
-	Update(userRequest entity.UserRequest, userID int, avatar *multipart.FileHeader, storastorageProvider storageProvider.StorageInterface) (entity.UserResponse, error)
+	Update(userRequest entity.UserRequest, userID int, avatar *multipart.FileHeader, storastorageProvider storageProvider.StorageInterface) (entity.UserProfileResponse, error)
 	Delete(userID int, storastorageProvider storageProvider.StorageInterface) error
 }
@@ -1,13 +1,16 @@
 package user_test
 
 import (
+	"encoding/json"
 	"mime/multipart"
 	"net/textproto"
 	"testing"
+	"time"
 
 	"tupulung/entities"
 	"tupulung/entities/web"
 	eventRepository "tupulung/repositories/event"
+	reviewRepository "tupulung/repositories/review"
 	userRepository "tupulung/repositories/user"
 	userService "tupulung/services/user"
 	_storageProvider "tupulung/utilities/storage"
@@ -23,14 +26,55 @@ func TestFind(t *testing.T) {
 		userSample := userRepository.UserCollection[0]
 		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
 		userRepositoryMock.Mock.On("Find").Return(userSample, nil)
+		reviewRepositoryMock := reviewRepository.NewReviewRepositoryMock(&mock.Mock{})
+		reviewRepositoryMock.Mock.On("HostReputation").Return(entities.HostReputation{Events: 2, Reviews: 5, Sum: 25}, nil)
 
 		Service := userService.NewUserService(
 			userRepositoryMock,
 			eventRepositoryMock,
+			reviewRepositoryMock,
 		)
-		_, err := Service.Find(int(userSample.ID))
+		actual, err := Service.Find(int(userSample.ID))
 
 		assert.Nil(t, err)
+		assert.Equal(t, &entities.HostReputationResponse{Score: 4, Average: 5, Reviews: 5, Events: 2}, actual.Reputation)
+	})
+	t.Run("reputation-fail", func(t *testing.T) {
+		userSample := userRepository.UserCollection[0]
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(userSample, nil)
+		reviewRepositoryMock := reviewRepository.NewReviewRepositoryMock(&mock.Mock{})
+		reviewRepositoryMock.Mock.On("HostReputation").Return(entities.HostReputation{}, web.WebError{Code: 500, Message: "server error"})
+
+		Service := userService.NewUserService(
+			userRepositoryMock,
+			eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+			reviewRepositoryMock,
+		)
+		actual, err := Service.Find(int(userSample.ID))
+
+		assert.Nil(t, err)
+		assert.Nil(t, actual.Reputation)
+	})
+	t.Run("hidden-email", func(t *testing.T) {
+		userSample := userRepository.UserCollection[0]
+		userSample.HideEmail = true
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(userSample, nil)
+		reviewRepositoryMock := reviewRepository.NewReviewRepositoryMock(&mock.Mock{})
+		reviewRepositoryMock.Mock.On("HostReputation").Return(entities.HostReputation{}, nil)
+
+		Service := userService.NewUserService(
+			userRepositoryMock,
+			eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+			reviewRepositoryMock,
+		)
+		actual, err := Service.Find(int(userSample.ID))
+
+		assert.Nil(t, err)
+		assert.Equal(t, "", actual.Email)
+		body, _ := json.Marshal(entities.UserResponse{Email: userSample.Email, HideEmail: true})
+		assert.NotContains(t, string(body), userSample.Email)
 	})
 }
 func TestGetJoinedEvent(t *testing.T) {
@@ -44,6 +88,7 @@ func TestGetJoinedEvent(t *testing.T) {
 		Service := userService.NewUserService(
 			userRepositoryMock,
 			eventRepositoryMock,
+			reviewRepository.NewReviewRepositoryMock(&mock.Mock{}),
 		)
 		actual, err := Service.GetJoinedEvents(int(userSample.ID))
 		expected := []entities.EventResponse{}
@@ -62,6 +107,7 @@ func TestGetJoinedEvent(t *testing.T) {
 		Service := userService.NewUserService(
 			userRepositoryMock,
 			eventRepositoryMock,
+			reviewRepository.NewReviewRepositoryMock(&mock.Mock{}),
 		)
 		actual, err := Service.GetJoinedEvents(int(userSample.ID))
 		expected := []entities.EventResponse{}
@@ -102,10 +148,11 @@ func TestCreate(t *testing.T) {
 		Service := userService.NewUserService(
 			userRepositoryMock,
 			eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+			reviewRepository.NewReviewRepositoryMock(&mock.Mock{}),
 		)
 		actual, err := Service.Create(sampleRequest, sampleFileRequest, storageProvider)
 
-		expected := entities.UserResponse{}
+		expected := entities.UserProfileResponse{}
 		copier.Copy(&expected, &sampleUser)
 
 		assert.Nil(t, err)
@@ -126,6 +173,7 @@ func TestCreate(t *testing.T) {
 		Service := userService.NewUserService(
 			userRepositoryMock,
 			eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+			reviewRepository.NewReviewRepositoryMock(&mock.Mock{}),
 		)
 		actual, err := Service.Create(sampleRequest, sampleFileRequest, storageProvider)
 
@@ -149,6 +197,7 @@ func TestCreate(t *testing.T) {
 		Service := userService.NewUserService(
 			userRepositoryMock,
 			eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+			reviewRepository.NewReviewRepositoryMock(&mock.Mock{}),
 		)
 		actual, err := Service.Create(sampleRequest, sampleFileRequest, storageProvider)
 
@@ -171,6 +220,7 @@ func TestCreate(t *testing.T) {
 		Service := userService.NewUserService(
 			userRepositoryMock,
 			eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+			reviewRepository.NewReviewRepositoryMock(&mock.Mock{}),
 		)
 		actual, err := Service.Create(sampleRequest, sampleFileRequest, storageProvider)
 
@@ -190,6 +240,7 @@ func TestCreate(t *testing.T) {
 		Service := userService.NewUserService(
 			userRepositoryMock,
 			eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+			reviewRepository.NewReviewRepositoryMock(&mock.Mock{}),
 		)
 		actual, err := Service.Create(sampleRequest, sampleFileRequest, storageProvider)
 
@@ -235,9 +286,10 @@ func TestUpdate(t *testing.T) {
 		Service := userService.NewUserService(
 			userRepositoryMock,
 			eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+			reviewRepository.NewReviewRepositoryMock(&mock.Mock{}),
 		)
 		actual, err := Service.Update(sampleRequest, int(sampleUser.ID), sampleFileRequest, storageProvider)
-		expected := entities.UserResponse{}
+		expected := entities.UserProfileResponse{}
 		copier.Copy(&expected, &userOutput)
 
 		assert.Nil(t, err)
@@ -263,10 +315,11 @@ func TestUpdate(t *testing.T) {
 		Service := userService.NewUserService(
 			userRepositoryMock,
 			eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+			reviewRepository.NewReviewRepositoryMock(&mock.Mock{}),
 		)
 		actual, err := Service.Update(sampleRequest, int(sampleUser.ID), sampleFileRequest, storageProvider)
 		assert.Error(t, err)
-		assert.Equal(t, entities.UserResponse{}, actual)
+		assert.Equal(t, entities.UserProfileResponse{}, actual)
 	})
 	t.Run("find-fail", func(t *testing.T) {
 		sampleRequest := sampleRequestCentral
@@ -297,10 +350,11 @@ func TestUpdate(t *testing.T) {
 		Service := userService.NewUserService(
 			userRepositoryMock,
 			eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+			reviewRepository.NewReviewRepositoryMock(&mock.Mock{}),
 		)
 		actual, err := Service.Update(sampleRequest, int(sampleUser.ID), sampleFileRequest, storageProvider)
 		assert.Error(t, err)
-		assert.Equal(t, entities.UserResponse{}, actual)
+		assert.Equal(t, entities.UserProfileResponse{}, actual)
 	})
 	t.Run("upload-fail", func(t *testing.T) {
 		sampleRequest := sampleRequestCentral
@@ -331,10 +385,11 @@ func TestUpdate(t *testing.T) {
 		Service := userService.NewUserService(
 			userRepositoryMock,
 			eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+			reviewRepository.NewReviewRepositoryMock(&mock.Mock{}),
 		)
 		actual, err := Service.Update(sampleRequest, int(sampleUser.ID), sampleFileRequest, storageProvider)
 		assert.Error(t, err)
-		assert.Equal(t, entities.UserResponse{}, actual)
+		assert.Equal(t, entities.UserProfileResponse{}, actual)
 	})
 }
 
@@ -355,6 +410,7 @@ func TestDelete(t *testing.T) {
 		Service := userService.NewUserService(
 			userRepositoryMock,
 			eventRepositoryMock,
+			reviewRepository.NewReviewRepositoryMock(&mock.Mock{}),
 		)
 		err := Service.Delete(int(sampleCustomer.ID), storageProvider)
 		assert.Nil(t, err)
@@ -375,6 +431,7 @@ func TestDelete(t *testing.T) {
 		Service := userService.NewUserService(
 			userRepositoryMock,
 			eventRepositoryMock,
+			reviewRepository.NewReviewRepositoryMock(&mock.Mock{}),
 		)
 		err := Service.Delete(int(sampleCustomer.ID), storageProvider)
 		assert.Error(t, err)
@@ -395,8 +452,37 @@ func TestDelete(t *testing.T) {
 		Service := userService.NewUserService(
 			userRepositoryMock,
 			eventRepositoryMock,
+			reviewRepository.NewReviewRepositoryMock(&mock.Mock{}),
 		)
 		err := Service.Delete(int(sampleCustomer.ID), storageProvider)
 		assert.Error(t, err)
 	})
 }
+
+func TestIsSuspended(t *testing.T) {
+	newService := func(user entities.User) *userService.UserService {
+		userRepositoryMock := userRepository.NewUserRepositoryMock(&mock.Mock{})
+		userRepositoryMock.Mock.On("Find").Return(user, nil)
+		return userService.NewUserService(
+			userRepositoryMock,
+			eventRepository.NewEventRepositoryMock(&mock.Mock{}),
+			reviewRepository.NewReviewRepositoryMock(&mock.Mock{}),
+		)
+	}
+	t.Run("suspended", func(t *testing.T) {
+		sampleUser := userRepository.UserCollection[0]
+		until := time.Now().Add(time.Hour)
+		sampleUser.SuspendedUntil = &until
+		assert.True(t, newService(sampleUser).IsSuspended(int(sampleUser.ID)))
+	})
+	t.Run("suspension-ended", func(t *testing.T) {
+		sampleUser := userRepository.UserCollection[0]
+		until := time.Now().Add(-time.Hour)
+		sampleUser.SuspendedUntil = &until
+		assert.False(t, newService(sampleUser).IsSuspended(int(sampleUser.ID)))
+	})
+	t.Run("not-suspended", func(t *testing.T) {
+		sampleUser := userRepository.UserCollection[0]
+		assert.False(t, newService(sampleUser).IsSuspended(int(sampleUser.ID)))
+	})
+}
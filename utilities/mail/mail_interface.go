@@ -0,0 +1,15 @@
+package mail
+
+type MailInterface interface {
+	/*
+	 * Send
+	 * -------------------------------
+	 * Mengirim email text biasa ke satu penerima
+	 *
+	 * @param 	to 		alamat email penerima
+	 * @param 	subject 	judul email
+	 * @param 	body 		isi email
+	 * @return 	error		error
+	 */
+	Send(to string, subject string, body string) error
+}
@@ -0,0 +1,18 @@
+package mail
+
+import "github.com/stretchr/testify/mock"
+
+type MailMock struct {
+	Mock *mock.Mock
+}
+
+func NewMailMock(mock *mock.Mock) *MailMock {
+	return &MailMock{
+		Mock: mock,
+	}
+}
+
+func (mailer MailMock) Send(to string, subject string, body string) error {
+	args := mailer.Mock.Called(to)
+	return args.Error(0)
+}
@@ -0,0 +1,57 @@
+package mail
+
+import (
+	"net/smtp"
+	"strings"
+	"tupulung/config"
+
+	"github.com/labstack/gommon/log"
+)
+
+type SMTP struct {
+	host     string
+	port     string
+	username string
+	password string
+	from     string
+}
+
+func NewSMTP() *SMTP {
+	config := config.Get()
+	return &SMTP{
+		host:     config.Mail.Host,
+		port:     config.Mail.Port,
+		username: config.Mail.Username,
+		password: config.Mail.Password,
+		from:     config.Mail.From,
+	}
+}
+
+/*
+ * Send
+ * -------------------------------
+ * Mengirim email melalui SMTP, jika MAIL_HOST belum diatur
+ * email hanya dicatat ke log agar development tetap berjalan
+ */
+func (mailer SMTP) Send(to string, subject string, body string) error {
+	if mailer.host == "" {
+		log.Info("Mail to " + to + ": " + subject)
+		return nil
+	}
+	var auth smtp.Auth
+	if mailer.username != "" {
+		auth = smtp.PlainAuth("", mailer.username, mailer.password, mailer.host)
+	}
+	message := "From: " + mailer.from + "\r\n" +
+		"To: " + sanitizeHeader(to) + "\r\n" +
+		"Subject: " + sanitizeHeader(subject) + "\r\n" +
+		"MIME-Version: 1.0\r\n" +
+		"Content-Type: text/plain; charset=UTF-8\r\n" +
+		"\r\n" + body
+	return smtp.SendMail(mailer.host+":"+mailer.port, auth, mailer.from, []string{to}, []byte(message))
+}
+
+// Header tidak boleh mengandung baris baru agar tidak bisa disisipi header lain
+func sanitizeHeader(value string) string {
+	return strings.NewReplacer("\r", " ", "\n", " ").Replace(value)
+}
@@ -10,9 +10,38 @@ func Migrate(db *gorm.DB) {
 	db.AutoMigrate(
 		&entities.User{},
 		&entities.Category{},
+		&entities.Tag{},
 		&entities.Event{},
 		&entities.Comment{},
 		&entities.Participant{},
 		&entities.Like{},
+		&entities.EventInvite{},
+		&entities.EventCohost{},
+		&entities.TicketType{},
+		&entities.Order{},
+		&entities.Ticket{},
+		&entities.EventImage{},
+		&entities.EventTemplate{},
+		&entities.EventView{},
+		&entities.EventScore{},
+		&entities.EventRevision{},
+		&entities.EventSlugRedirect{},
+		&entities.EventQuestion{},
+		&entities.EventAnswer{},
+		&entities.Notification{},
+		&entities.ReminderLog{},
+		&entities.Report{},
+		&entities.AuditLog{},
+		&entities.Review{},
+		&entities.EventRating{},
+		&entities.EventSession{},
+		&entities.SessionSpeaker{},
+		&entities.AgendaItem{},
+		&entities.Announcement{},
+		&entities.AnnouncementEmail{},
+		&entities.EventImport{},
+		&entities.EventImportError{},
+		&entities.Group{},
+		&entities.GroupMember{},
 	)
 }
@@ -0,0 +1,92 @@
+package payment
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"tupulung/config"
+	"tupulung/entities"
+	"tupulung/entities/web"
+)
+
+/*
+ * Fake payment provider
+ * -------------------------------
+ * Provider pembayaran in-process untuk development & testing offline.
+ * Tagihan disimpan di memory, pembayaran dikonfirmasi dengan mengirim
+ * webhook yang di-sign menggunakan PAYMENT_WEBHOOK_SECRET
+ */
+type Fake struct {
+	secret  string
+	mutex   *sync.Mutex
+	charges map[string]int64
+}
+
+func NewFake() *Fake {
+	return &Fake{
+		secret:  config.Get().Payment.WebhookSecret,
+		mutex:   &sync.Mutex{},
+		charges: map[string]int64{},
+	}
+}
+
+func (payment Fake) CreateCharge(order entities.Order) (entities.PaymentCharge, error) {
+	bytes := make([]byte, 12)
+	_, err := rand.Read(bytes)
+	if err != nil {
+		return entities.PaymentCharge{}, web.WebError{Code: 500, Message: err.Error()}
+	}
+	reference := "fake_" + hex.EncodeToString(bytes)
+
+	payment.mutex.Lock()
+	payment.charges[reference] = order.Amount
+	payment.mutex.Unlock()
+
+	return entities.PaymentCharge{
+		Reference:   reference,
+		RedirectURL: config.Get().App.BaseURL + "/api/payments/fake/" + reference,
+		Status:      "pending",
+	}, nil
+}
+
+func (payment Fake) Refund(reference string, amount int64) error {
+	payment.mutex.Lock()
+	defer payment.mutex.Unlock()
+
+	charged, ok := payment.charges[reference]
+	if !ok {
+		return web.WebError{Code: 400, Message: "payment reference is unknown to the provider"}
+	}
+	if amount > charged {
+		return web.WebError{Code: 400, Message: "refund amount exceeds the charged amount"}
+	}
+	payment.charges[reference] = charged - amount
+	return nil
+}
+
+func (payment Fake) ParseWebhook(body []byte, signature string) (entities.PaymentEvent, error) {
+	if !hmac.Equal([]byte(payment.Sign(body)), []byte(signature)) {
+		return entities.PaymentEvent{}, web.WebError{Code: 401, Message: "webhook signature is invalid"}
+	}
+	event := entities.PaymentEvent{}
+	err := json.Unmarshal(body, &event)
+	if err != nil || event.Reference == "" {
+		return entities.PaymentEvent{}, web.WebError{Code: 400, Message: "webhook payload is invalid"}
+	}
+	return event, nil
+}
+
+/*
+ * Sign
+ * -------------------------------
+ * Membuat signature webhook (hex HMAC-SHA256 dari body),
+ * digunakan untuk mensimulasikan notifikasi dari provider
+ */
+func (payment Fake) Sign(body []byte) string {
+	mac := hmac.New(sha256.New, []byte(payment.secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,26 @@
+package payment
+
+import (
+	"errors"
+	"tupulung/config"
+)
+
+/*
+ * New
+ * -------------------------------
+ * Membuat payment provider berdasarkan PAYMENT_PROVIDER,
+ * provider yang tidak dikenal atau tanpa secret ditolak
+ */
+func New(paymentConfig *config.AppConfig) (PaymentInterface, error) {
+	switch paymentConfig.Payment.Provider {
+	case "fake":
+		if paymentConfig.Payment.WebhookSecret == "" {
+			return nil, errors.New("PAYMENT_WEBHOOK_SECRET must be set")
+		}
+		return NewFake(), nil
+	case "":
+		return nil, errors.New("PAYMENT_PROVIDER must be set")
+	default:
+		return nil, errors.New("unknown payment provider " + paymentConfig.Payment.Provider)
+	}
+}
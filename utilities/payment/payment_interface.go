@@ -0,0 +1,39 @@
+package payment
+
+import "tupulung/entities"
+
+type PaymentInterface interface {
+	/*
+	 * Create charge
+	 * -------------------------------
+	 * Membuat tagihan pembayaran untuk order
+	 *
+	 * @param 	order 		order yang akan dibayar
+	 * @return 	PaymentCharge	reference & url pembayaran dari provider
+	 * @return 	error		error
+	 */
+	CreateCharge(order entities.Order) (entities.PaymentCharge, error)
+
+	/*
+	 * Refund
+	 * -------------------------------
+	 * Mengembalikan dana pembayaran yang sudah berhasil
+	 *
+	 * @param 	reference 	reference pembayaran dari provider
+	 * @param 	amount 		jumlah dana yang dikembalikan
+	 * @return 	error		error
+	 */
+	Refund(reference string, amount int64) error
+
+	/*
+	 * Parse webhook
+	 * -------------------------------
+	 * Verifikasi signature dan baca notifikasi status pembayaran dari provider
+	 *
+	 * @param 	body 		raw body request webhook
+	 * @param 	signature 	signature yang dikirim provider
+	 * @return 	PaymentEvent	reference & status pembayaran
+	 * @return 	error		error
+	 */
+	ParseWebhook(body []byte, signature string) (entities.PaymentEvent, error)
+}
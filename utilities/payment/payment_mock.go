@@ -0,0 +1,32 @@
+package payment
+
+import (
+	"tupulung/entities"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type PaymentMock struct {
+	Mock *mock.Mock
+}
+
+func NewPaymentMock(mock *mock.Mock) *PaymentMock {
+	return &PaymentMock{
+		Mock: mock,
+	}
+}
+
+func (payment PaymentMock) CreateCharge(order entities.Order) (entities.PaymentCharge, error) {
+	args := payment.Mock.Called()
+	return args.Get(0).(entities.PaymentCharge), args.Error(1)
+}
+
+func (payment PaymentMock) Refund(reference string, amount int64) error {
+	args := payment.Mock.Called(reference, amount)
+	return args.Error(0)
+}
+
+func (payment PaymentMock) ParseWebhook(body []byte, signature string) (entities.PaymentEvent, error) {
+	args := payment.Mock.Called()
+	return args.Get(0).(entities.PaymentEvent), args.Error(1)
+}
@@ -0,0 +1,44 @@
+package qrcode
+
+import (
+	"bytes"
+	"fmt"
+
+	goqrcode "github.com/skip2/go-qrcode"
+)
+
+/*
+ * PNG
+ * -------------------------------
+ * Membuat gambar QR code berformat PNG dengan lebar size pixel
+ */
+func PNG(content string, size int) ([]byte, error) {
+	return goqrcode.Encode(content, goqrcode.Medium, size)
+}
+
+/*
+ * SVG
+ * -------------------------------
+ * Membuat gambar QR code berformat SVG, setiap modul
+ * digambar sebagai satu kotak pada viewBox
+ */
+func SVG(content string, size int) ([]byte, error) {
+	code, err := goqrcode.New(content, goqrcode.Medium)
+	if err != nil {
+		return nil, err
+	}
+	bitmap := code.Bitmap()
+
+	buffer := bytes.Buffer{}
+	fmt.Fprintf(&buffer, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">`, size, size, len(bitmap), len(bitmap))
+	fmt.Fprintf(&buffer, `<rect width="%d" height="%d" fill="#ffffff"/><path fill="#000000" d="`, len(bitmap), len(bitmap))
+	for y, row := range bitmap {
+		for x, dark := range row {
+			if dark {
+				fmt.Fprintf(&buffer, "M%d %dh1v1h-1z", x, y)
+			}
+		}
+	}
+	buffer.WriteString(`"/></svg>`)
+	return buffer.Bytes(), nil
+}
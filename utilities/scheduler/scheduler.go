@@ -0,0 +1,45 @@
+package scheduler
+
+import (
+	"time"
+
+	"github.com/labstack/gommon/log"
+)
+
+/*
+ * Every
+ * -------------------------------
+ * Menjalankan job secara berkala di background, job langsung
+ * dijalankan sekali saat scheduler dimulai. Error hanya dicatat
+ * ke log agar job berikutnya tetap berjalan
+ *
+ * @param 	name 		nama job untuk keperluan log
+ * @param 	interval 	jarak waktu antar eksekusi job
+ * @param 	job 		fungsi yang dijalankan
+ * @return 	func()		fungsi untuk menghentikan scheduler
+ */
+func Every(name string, interval time.Duration, job func() error) func() {
+	ticker := time.NewTicker(interval)
+	done := make(chan struct{})
+	run := func() {
+		if err := job(); err != nil {
+			log.Warn("Scheduled job " + name + " failed: " + err.Error())
+		}
+	}
+
+	go func() {
+		run()
+		for {
+			select {
+			case <-ticker.C:
+				run()
+			case <-done:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+	return func() {
+		close(done)
+	}
+}
@@ -0,0 +1,270 @@
+package search
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"tupulung/config"
+	"tupulung/entities"
+	"tupulung/entities/web"
+
+	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/mapping"
+)
+
+/*
+ * Event field boosts
+ * -------------------------------
+ * Bobot relevansi untuk setiap field event yang diindex
+ * [field]: [boost]
+ */
+var eventFieldBoosts = map[string]float64{
+	"title":       3,
+	"category":    2,
+	"tags":        2,
+	"hosted_by":   1.5,
+	"host":        1.5,
+	"location":    1.5,
+	"description": 1,
+}
+
+/*
+ * Event mapping version
+ * -------------------------------
+ * Naikkan versi setiap kali eventIndexMapping berubah agar index
+ * lama dibuat ulang dan diisi kembali saat aplikasi dijalankan
+ */
+const eventMappingVersion = "2"
+
+var eventMappingVersionKey = []byte("event_mapping_version")
+
+type Bleve struct {
+	index bleve.Index
+}
+
+func NewBleve() *Bleve {
+	path := config.Get().Search.IndexPath
+	index, err := bleve.Open(path)
+	if err == nil {
+		// Index dengan mapping lama dihapus, server akan melakukan reindex karena index kosong
+		version, _ := index.GetInternal(eventMappingVersionKey)
+		if string(version) != eventMappingVersion {
+			index.Close()
+			if err := os.RemoveAll(path); err != nil {
+				panic(err.Error())
+			}
+			err = bleve.ErrorIndexPathDoesNotExist
+		}
+	}
+	if err == bleve.ErrorIndexPathDoesNotExist {
+		index, err = bleve.New(path, eventIndexMapping())
+		if err == nil {
+			err = index.SetInternal(eventMappingVersionKey, []byte(eventMappingVersion))
+		}
+	}
+	if err != nil {
+		panic(err.Error())
+	}
+	return &Bleve{
+		index: index,
+	}
+}
+
+/*
+ * Event index mapping
+ * -------------------------------
+ * Semua field event dianalisa sebagai text dan disimpan
+ * agar bisa dicari dan di-highlight
+ */
+func eventIndexMapping() mapping.IndexMapping {
+	eventMapping := bleve.NewDocumentStaticMapping()
+	for field := range eventFieldBoosts {
+		fieldMapping := bleve.NewTextFieldMapping()
+		fieldMapping.Analyzer = "standard"
+		eventMapping.AddFieldMappingsAt(field, fieldMapping)
+	}
+
+	// Visibility hanya digunakan untuk filter, tidak dianalisa
+	visibilityMapping := bleve.NewTextFieldMapping()
+	visibilityMapping.Analyzer = "keyword"
+	visibilityMapping.Store = false
+	eventMapping.AddFieldMappingsAt("visibility", visibilityMapping)
+
+	indexMapping := bleve.NewIndexMapping()
+	indexMapping.DefaultMapping = eventMapping
+	return indexMapping
+}
+
+func (search Bleve) IndexEvent(event entities.Event) error {
+	document := map[string]interface{}{
+		"title":       event.Title,
+		"category":    event.Category.Title,
+		"tags":        eventTagNames(event),
+		"hosted_by":   event.HostedBy,
+		"host":        event.User.Name,
+		"location":    event.Location,
+		"description": event.Description,
+		"visibility":  event.Visibility,
+	}
+	// Event yang disembunyikan moderator diperlakukan seperti event unlisted
+	if event.HiddenAt != nil {
+		document["visibility"] = "hidden"
+	}
+	// Alamat yang disembunyikan tidak diindex agar tidak bisa dicari
+	if event.HideAddress {
+		delete(document, "location")
+	}
+	err := search.index.Index(strconv.Itoa(int(event.ID)), document)
+	if err != nil {
+		return web.WebError{Code: 500, Message: err.Error()}
+	}
+	return nil
+}
+
+func eventTagNames(event entities.Event) string {
+	names := []string{}
+	for _, tag := range event.Tags {
+		names = append(names, tag.Name)
+	}
+	return strings.Join(names, " ")
+}
+
+func (search Bleve) DeleteEvent(id uint) error {
+	err := search.index.Delete(strconv.Itoa(int(id)))
+	if err != nil {
+		return web.WebError{Code: 500, Message: err.Error()}
+	}
+	return nil
+}
+
+/*
+ * Search events
+ * -------------------------------
+ * Setiap field dicari dengan tiga cara: exact phrase (bobot tertinggi),
+ * term match, dan fuzzy match (toleransi typo 1 karakter)
+ */
+func (search Bleve) SearchEvents(keyword string, limit, offset int) ([]entities.EventSearchHit, error) {
+	disjunction := bleve.NewDisjunctionQuery()
+	fields := []string{}
+	for field, boost := range eventFieldBoosts {
+		fields = append(fields, field)
+
+		phrase := bleve.NewMatchPhraseQuery(keyword)
+		phrase.SetField(field)
+		phrase.SetBoost(boost * 2)
+
+		match := bleve.NewMatchQuery(keyword)
+		match.SetField(field)
+		match.SetBoost(boost)
+
+		fuzzy := bleve.NewMatchQuery(keyword)
+		fuzzy.SetField(field)
+		fuzzy.SetFuzziness(1)
+		fuzzy.SetPrefix(1)
+		fuzzy.SetBoost(boost * 0.5)
+
+		disjunction.AddQuery(phrase, match, fuzzy)
+	}
+
+	request := bleve.NewSearchRequestOptions(disjunction, limit, offset, false)
+	request.Highlight = bleve.NewHighlightWithStyle("html")
+	request.Highlight.Fields = fields
+	result, err := search.index.Search(request)
+	if err != nil {
+		return []entities.EventSearchHit{}, web.WebError{Code: 500, Message: err.Error()}
+	}
+
+	hits := []entities.EventSearchHit{}
+	for _, hit := range result.Hits {
+		id, err := strconv.Atoi(hit.ID)
+		if err != nil {
+			continue
+		}
+
+		// Hanya ambil fragment yang benar-benar mengandung kata yang cocok
+		highlights := map[string][]string{}
+		for field, fragments := range hit.Fragments {
+			for _, fragment := range fragments {
+				if strings.Contains(fragment, "<mark>") {
+					highlights[field] = append(highlights[field], fragment)
+				}
+			}
+		}
+		hits = append(hits, entities.EventSearchHit{
+			ID:         uint(id),
+			Score:      hit.Score,
+			Highlights: highlights,
+		})
+	}
+	return hits, nil
+}
+
+/*
+ * Suggest events
+ * -------------------------------
+ * Kata terakhir diperlakukan sebagai prefix, kata sebelumnya
+ * harus cocok (dengan toleransi typo) pada judul event.
+ * Event unlisted & private tidak pernah disarankan
+ */
+func (search Bleve) SuggestEvents(prefix string, limit int) ([]entities.EventSuggestionResponse, error) {
+	terms := strings.Fields(strings.ToLower(prefix))
+	if len(terms) == 0 {
+		return []entities.EventSuggestionResponse{}, nil
+	}
+
+	conjunction := bleve.NewConjunctionQuery()
+	for _, term := range terms[:len(terms)-1] {
+		match := bleve.NewMatchQuery(term)
+		match.SetField("title")
+		match.SetFuzziness(1)
+		conjunction.AddQuery(match)
+	}
+	prefixQuery := bleve.NewPrefixQuery(terms[len(terms)-1])
+	prefixQuery.SetField("title")
+	conjunction.AddQuery(prefixQuery)
+
+	hidden := bleve.NewDisjunctionQuery()
+	for _, visibility := range []string{"unlisted", "private", "hidden"} {
+		term := bleve.NewTermQuery(visibility)
+		term.SetField("visibility")
+		hidden.AddQuery(term)
+	}
+	visible := bleve.NewBooleanQuery()
+	visible.AddMust(conjunction)
+	visible.AddMustNot(hidden)
+
+	request := bleve.NewSearchRequestOptions(visible, limit, 0, false)
+	request.Fields = []string{"title"}
+	request.Highlight = bleve.NewHighlightWithStyle("html")
+	request.Highlight.Fields = []string{"title"}
+	result, err := search.index.Search(request)
+	if err != nil {
+		return []entities.EventSuggestionResponse{}, web.WebError{Code: 500, Message: err.Error()}
+	}
+
+	suggestions := []entities.EventSuggestionResponse{}
+	for _, hit := range result.Hits {
+		id, err := strconv.Atoi(hit.ID)
+		if err != nil {
+			continue
+		}
+		suggestion := entities.EventSuggestionResponse{ID: uint(id)}
+		if title, ok := hit.Fields["title"].(string); ok {
+			suggestion.Title = title
+			suggestion.Highlight = title
+		}
+		if fragments := hit.Fragments["title"]; len(fragments) > 0 {
+			suggestion.Highlight = fragments[0]
+		}
+		suggestions = append(suggestions, suggestion)
+	}
+	return suggestions, nil
+}
+
+func (search Bleve) CountEvents() (uint64, error) {
+	count, err := search.index.DocCount()
+	if err != nil {
+		return 0, web.WebError{Code: 500, Message: err.Error()}
+	}
+	return count, nil
+}
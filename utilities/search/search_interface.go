@@ -0,0 +1,58 @@
+package search
+
+import "tupulung/entities"
+
+type SearchInterface interface {
+	/*
+	 * Index event
+	 * -------------------------------
+	 * Menambahkan atau memperbarui dokumen event pada search index
+	 *
+	 * @param 	event 		event beserta relasi user dan category
+	 * @return 	error		error
+	 */
+	IndexEvent(event entities.Event) error
+
+	/*
+	 * Delete event
+	 * -------------------------------
+	 * Menghapus dokumen event dari search index
+	 *
+	 * @param 	id 			ID event
+	 * @return 	error		error
+	 */
+	DeleteEvent(id uint) error
+
+	/*
+	 * Search events
+	 * -------------------------------
+	 * Mencari event berdasarkan query pada semua field yang diindex,
+	 * hasil diurutkan berdasarkan relevansi
+	 *
+	 * @param 	query 		kata kunci pencarian
+	 * @param 	limit 		jumlah maksimal hasil
+	 * @param 	offset 		jumlah hasil teratas yang dilewati
+	 * @return 	[]hit		ID event, skor relevansi dan highlight per field
+	 * @return 	error		error
+	 */
+	SearchEvents(query string, limit, offset int) ([]entities.EventSearchHit, error)
+
+	/*
+	 * Suggest events
+	 * -------------------------------
+	 * Autocomplete judul event berdasarkan prefix yang sedang diketik
+	 *
+	 * @param 	prefix 		kata kunci yang sedang diketik
+	 * @param 	limit 		jumlah maksimal saran
+	 * @return 	[]suggestion	ID dan judul event
+	 * @return 	error		error
+	 */
+	SuggestEvents(prefix string, limit int) ([]entities.EventSuggestionResponse, error)
+
+	/*
+	 * Count events
+	 * -------------------------------
+	 * Menghitung jumlah dokumen event pada search index
+	 */
+	CountEvents() (uint64, error)
+}
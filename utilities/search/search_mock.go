@@ -0,0 +1,42 @@
+package search
+
+import (
+	"tupulung/entities"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type SearchMock struct {
+	Mock *mock.Mock
+}
+
+func NewSearchMock(mock *mock.Mock) *SearchMock {
+	return &SearchMock{
+		Mock: mock,
+	}
+}
+
+func (search SearchMock) IndexEvent(event entities.Event) error {
+	args := search.Mock.Called()
+	return args.Error(0)
+}
+
+func (search SearchMock) DeleteEvent(id uint) error {
+	args := search.Mock.Called()
+	return args.Error(0)
+}
+
+func (search SearchMock) SearchEvents(query string, limit, offset int) ([]entities.EventSearchHit, error) {
+	args := search.Mock.Called(query, offset)
+	return args.Get(0).([]entities.EventSearchHit), args.Error(1)
+}
+
+func (search SearchMock) SuggestEvents(prefix string, limit int) ([]entities.EventSuggestionResponse, error) {
+	args := search.Mock.Called(prefix)
+	return args.Get(0).([]entities.EventSuggestionResponse), args.Error(1)
+}
+
+func (search SearchMock) CountEvents() (uint64, error) {
+	args := search.Mock.Called()
+	return uint64(args.Int(0)), args.Error(1)
+}
@@ -0,0 +1,56 @@
+package spreadsheet
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+)
+
+// Jumlah baris yang ditampung sebelum dikirim ke writer
+const csvFlushEvery = 100
+
+type CSV struct {
+	writer *csv.Writer
+	rows   int
+}
+
+func NewCSV(w io.Writer) *CSV {
+	return &CSV{
+		writer: csv.NewWriter(w),
+	}
+}
+
+func (sheet *CSV) WriteRow(cells []string) error {
+	escaped := make([]string, len(cells))
+	for i, cell := range cells {
+		escaped[i] = escapeFormula(cell)
+	}
+	err := sheet.writer.Write(escaped)
+	if err != nil {
+		return err
+	}
+	sheet.rows++
+	if sheet.rows%csvFlushEvery == 0 {
+		sheet.writer.Flush()
+		return sheet.writer.Error()
+	}
+	return nil
+}
+
+func (sheet *CSV) Close() error {
+	sheet.writer.Flush()
+	return sheet.writer.Error()
+}
+
+/*
+ * Escape formula
+ * -------------------------------
+ * Cell yang diawali karakter formula diberi prefix petik
+ * agar tidak dieksekusi saat dibuka di aplikasi spreadsheet
+ */
+func escapeFormula(cell string) string {
+	if cell != "" && strings.ContainsAny(cell[:1], "=+-@\t\r") {
+		return "'" + cell
+	}
+	return cell
+}
@@ -0,0 +1,24 @@
+package spreadsheet
+
+type WriterInterface interface {
+	/*
+	 * Write row
+	 * -------------------------------
+	 * Menulis satu baris ke spreadsheet secara langsung
+	 * tanpa menampung seluruh isi file di memory
+	 *
+	 * @var cells	[]string	isi setiap kolom
+	 * @return 	error		error
+	 */
+	WriteRow(cells []string) error
+
+	/*
+	 * Close
+	 * -------------------------------
+	 * Menutup spreadsheet dan menulis sisa buffer,
+	 * wajib dipanggil setelah baris terakhir ditulis
+	 *
+	 * @return 	error		error
+	 */
+	Close() error
+}
@@ -0,0 +1,125 @@
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strconv"
+)
+
+/*
+ * XLSX static parts
+ * -------------------------------
+ * Bagian minimal workbook SpreadsheetML dengan satu sheet,
+ * isi sheet ditulis terakhir agar bisa di-stream baris per baris
+ */
+const (
+	xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types"><Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/><Default Extension="xml" ContentType="application/xml"/><Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/><Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/></Types>`
+	xlsxRootRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/></Relationships>`
+	xlsxWorkbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/></Relationships>`
+	xlsxSheetOpen = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+	xlsxSheetClose = `</sheetData></worksheet>`
+)
+
+type XLSX struct {
+	archive *zip.Writer
+	sheet   *bufio.Writer
+	rows    int
+}
+
+/*
+ * New XLSX
+ * -------------------------------
+ * Membuat workbook dengan satu sheet bernama sheetName,
+ * nama sheet maksimal 31 karakter
+ */
+func NewXLSX(w io.Writer, sheetName string) (*XLSX, error) {
+	archive := zip.NewWriter(w)
+	workbook := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="` + escapeXML(sheetName) + `" sheetId="1" r:id="rId1"/></sheets></workbook>`
+
+	parts := []struct {
+		name    string
+		content string
+	}{
+		{name: "[Content_Types].xml", content: xlsxContentTypes},
+		{name: "_rels/.rels", content: xlsxRootRels},
+		{name: "xl/workbook.xml", content: workbook},
+		{name: "xl/_rels/workbook.xml.rels", content: xlsxWorkbookRels},
+	}
+	for _, part := range parts {
+		file, err := archive.Create(part.name)
+		if err != nil {
+			return nil, err
+		}
+		_, err = io.WriteString(file, part.content)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	file, err := archive.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, err
+	}
+	sheet := bufio.NewWriter(file)
+	_, err = sheet.WriteString(xlsxSheetOpen)
+	if err != nil {
+		return nil, err
+	}
+	return &XLSX{
+		archive: archive,
+		sheet:   sheet,
+	}, nil
+}
+
+func (workbook *XLSX) WriteRow(cells []string) error {
+	workbook.rows++
+	row := strconv.Itoa(workbook.rows)
+	workbook.sheet.WriteString(`<row r="` + row + `">`)
+	for i, cell := range cells {
+		workbook.sheet.WriteString(`<c r="` + columnName(i) + row + `" t="inlineStr"><is><t xml:space="preserve">`)
+		workbook.sheet.WriteString(escapeXML(cell))
+		workbook.sheet.WriteString(`</t></is></c>`)
+	}
+	_, err := workbook.sheet.WriteString(`</row>`)
+	return err
+}
+
+func (workbook *XLSX) Close() error {
+	_, err := workbook.sheet.WriteString(xlsxSheetClose)
+	if err != nil {
+		return err
+	}
+	err = workbook.sheet.Flush()
+	if err != nil {
+		return err
+	}
+	return workbook.archive.Close()
+}
+
+/*
+ * Column name
+ * -------------------------------
+ * Mengubah index kolom (mulai dari 0) menjadi nama kolom A, B, ..., Z, AA
+ */
+func columnName(index int) string {
+	name := ""
+	for index >= 0 {
+		name = string(rune('A'+index%26)) + name
+		index = index/26 - 1
+	}
+	return name
+}
+
+func escapeXML(value string) string {
+	buffer := bytes.Buffer{}
+	xml.EscapeText(&buffer, []byte(value))
+	return buffer.String()
+}
@@ -3,6 +3,7 @@ package storage
 import (
 	"context"
 	"mime/multipart"
+	"net/url"
 	"tupulung/config"
 	"tupulung/entities/web"
 
@@ -88,3 +89,28 @@ func (storage S3) Delete(fileNamePath string) error {
 	}
 	return nil
 }
+
+/*
+ * Copy file
+ * -------------------------------
+ * Menyalin file yang sudah ada pada cloud storage service ke path baru
+ *
+ * @param 	sourcePath 		path file sumber pada cloud storage service
+ * @param 	fileNamePath 	nama file beserta path tujuan
+ * @return 	string			fileUrl dari file hasil salinan
+ * @return 	error			error
+ */
+func (storage S3) Copy(sourcePath string, fileNamePath string) (string, error) {
+	// s3 Client
+	client := s3.NewFromConfig(storage.awsConfig)
+	bucket := config.Get().AwsS3.Bucket
+	_, err := client.CopyObject(context.TODO(), &s3.CopyObjectInput{
+		Bucket:     aws.String(bucket),
+		CopySource: aws.String(bucket + "/" + (&url.URL{Path: sourcePath}).EscapedPath()),
+		Key:        aws.String(fileNamePath),
+	})
+	if err != nil {
+		return "", err
+	}
+	return "https://" + bucket + ".s3." + config.Get().AwsS3.Region + ".amazonaws.com/" + fileNamePath, nil
+}
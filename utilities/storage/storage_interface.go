@@ -27,4 +27,16 @@ type StorageInterface interface {
 	 * @return 	error			error
 	 */
 	Delete(fileNamePath string) error
+
+	/*
+	 * Copy file
+	 * -------------------------------
+	 * Menyalin file yang sudah ada pada cloud storage service ke path baru
+	 *
+	 * @param 	sourcePath 		path file sumber pada cloud storage service
+	 * @param 	fileNamePath 	nama file beserta path tujuan
+	 * @return 	string			fileUrl dari file hasil salinan
+	 * @return 	error			error
+	 */
+	Copy(sourcePath string, fileNamePath string) (string, error)
 }
\ No newline at end of file
@@ -43,4 +43,19 @@ func (storage StorageMock) UploadFromRequest(fileNamePath string, fileHeader *mu
 func (storage StorageMock) Delete(fileNamePath string) error {
 	args := storage.Mock.Called()
 	return args.Error(0)
-}
\ No newline at end of file
+}
+
+/*
+ * Copy file
+ * -------------------------------
+ * Menyalin file yang sudah ada pada cloud storage service ke path baru
+ *
+ * @param 	sourcePath 		path file sumber pada cloud storage service
+ * @param 	fileNamePath 	nama file beserta path tujuan
+ * @return 	string			fileUrl dari file hasil salinan
+ * @return 	error			error
+ */
+func (storage StorageMock) Copy(sourcePath string, fileNamePath string) (string, error) {
+	args := storage.Mock.Called()
+	return args.String(0), args.Error(1)
+}